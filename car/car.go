@@ -0,0 +1,140 @@
+// The car package reads and writes CARv1 ("Content Addressable aRchive")
+// streams: a header naming one or more root CIDs, followed by a sequence
+// of blocks, each prefixed by its CID.
+//
+// Write produces a CAR by walking a DAG from a root link, guided by a selector,
+// and emitting every block the walk loads, in the order it loads them.
+// Read consumes a CAR, checks every block against its CID, and hands the
+// blocks to a Storer (such as one from the storage package).
+//
+// CARs are inherently about CIDs, so only cidlink.Link is supported.
+//
+// See https://github.com/ipld/specs/blob/master/block-layer/content-addressable-archives.md
+// for the format.
+package car
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	cid "github.com/ipfs/go-cid"
+
+	ipld "github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/codec/dagcbor"
+	"github.com/ipld/go-ipld-prime/fluent"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	basicnode "github.com/ipld/go-ipld-prime/node/basic"
+)
+
+// maxSectionSize bounds the size of the header, and of each block section,
+// that Read will accept; anything bigger is rejected before it's allocated.
+const maxSectionSize = 32 << 20
+
+// writeHeader writes the header: a varint length, then a DAG-CBOR map
+// of the form {"roots": [<cid>, ...], "version": 1}.
+func writeHeader(w io.Writer, roots []cid.Cid) error {
+	header := fluent.MustBuildMap(basicnode.Prototype.Map, 2, func(na fluent.MapAssembler) {
+		na.AssembleEntry("roots").CreateList(len(roots), func(na fluent.ListAssembler) {
+			for _, c := range roots {
+				na.AssembleValue().AssignLink(cidlink.Link{c})
+			}
+		})
+		na.AssembleEntry("version").AssignInt(1)
+	})
+	var buf bytes.Buffer
+	if err := (dagcbor.EncodeOptions{Strict: true}).Encode(header, &buf); err != nil {
+		return err
+	}
+	return writeSection(w, buf.Bytes())
+}
+
+// readHeader reads and validates the header, returning its roots.
+func readHeader(br *bufio.Reader) ([]ipld.Link, error) {
+	data, err := readSection(br)
+	if err != nil {
+		if err == io.EOF {
+			return nil, fmt.Errorf("invalid car header: empty input")
+		}
+		return nil, fmt.Errorf("invalid car header: %w", err)
+	}
+	nb := basicnode.Prototype.Map.NewBuilder()
+	if err := dagcbor.Decoder(nb, bytes.NewReader(data)); err != nil {
+		return nil, fmt.Errorf("invalid car header: %w", err)
+	}
+	header := nb.Build()
+	version, err := header.LookupByString("version")
+	if err != nil {
+		return nil, fmt.Errorf("invalid car header: no version")
+	}
+	v, err := version.AsInt()
+	if err != nil {
+		return nil, fmt.Errorf("invalid car header: version must be an int")
+	}
+	if v != 1 {
+		return nil, fmt.Errorf("invalid car header: unsupported version %d", v)
+	}
+	rootsNode, err := header.LookupByString("roots")
+	if err != nil || rootsNode.ReprKind() != ipld.ReprKind_List {
+		return nil, fmt.Errorf("invalid car header: roots must be a list")
+	}
+	if rootsNode.Length() < 1 {
+		return nil, fmt.Errorf("invalid car header: no roots")
+	}
+	roots := make([]ipld.Link, 0, rootsNode.Length())
+	for itr := rootsNode.ListIterator(); !itr.Done(); {
+		_, v, err := itr.Next()
+		if err != nil {
+			return nil, err
+		}
+		lnk, err := v.AsLink()
+		if err != nil {
+			return nil, fmt.Errorf("invalid car header: roots must be links")
+		}
+		roots = append(roots, lnk)
+	}
+	return roots, nil
+}
+
+// writeSection writes a varint length, then the data.
+func writeSection(w io.Writer, data ...[]byte) error {
+	var size int
+	for _, d := range data {
+		size += len(d)
+	}
+	var buf [binary.MaxVarintLen64]byte
+	if _, err := w.Write(buf[:binary.PutUvarint(buf[:], uint64(size))]); err != nil {
+		return err
+	}
+	for _, d := range data {
+		if _, err := w.Write(d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readSection reads a varint length, then that much data.
+// It returns io.EOF if (and only if) the reader is already at its end.
+func readSection(br *bufio.Reader) ([]byte, error) {
+	size, err := binary.ReadUvarint(br)
+	if err != nil {
+		return nil, err
+	}
+	if size == 0 {
+		return nil, fmt.Errorf("empty section")
+	}
+	if size > maxSectionSize {
+		return nil, fmt.Errorf("section of %d bytes is too large (the limit is %d)", size, maxSectionSize)
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(br, data); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return data, nil
+}
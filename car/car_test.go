@@ -0,0 +1,178 @@
+package car
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	cid "github.com/ipfs/go-cid"
+	. "github.com/warpfork/go-wish"
+
+	ipld "github.com/ipld/go-ipld-prime"
+	_ "github.com/ipld/go-ipld-prime/codec/dagcbor"
+	"github.com/ipld/go-ipld-prime/fluent"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	basicnode "github.com/ipld/go-ipld-prime/node/basic"
+	"github.com/ipld/go-ipld-prime/storage"
+	"github.com/ipld/go-ipld-prime/traversal"
+	"github.com/ipld/go-ipld-prime/traversal/selector"
+	"github.com/ipld/go-ipld-prime/traversal/selector/builder"
+)
+
+var lb = cidlink.LinkBuilder{cid.Prefix{
+	Version:  1,
+	Codec:    0x71,
+	MhType:   0x12,
+	MhLength: 32,
+}}
+
+// fixture stores a small DAG, and returns its root:
+//
+//	root -> {"left": leaf1, "right": middle}
+//	middle -> {"leaf": leaf2, "again": leaf1}
+func fixture(t *testing.T, store *storage.MemoryStore) (root ipld.Link, all []ipld.Link) {
+	build := func(n ipld.Node) ipld.Link {
+		lnk, err := lb.Build(context.Background(), ipld.LinkContext{}, n, store.Storer)
+		Require(t, err, ShouldEqual, nil)
+		return lnk
+	}
+	leaf1 := build(basicnode.NewString("leaf one"))
+	leaf2 := build(basicnode.NewString("leaf two"))
+	middle := build(fluent.MustBuildMap(basicnode.Prototype.Map, 2, func(na fluent.MapAssembler) {
+		na.AssembleEntry("leaf").AssignLink(leaf2)
+		na.AssembleEntry("again").AssignLink(leaf1)
+	}))
+	root = build(fluent.MustBuildMap(basicnode.Prototype.Map, 2, func(na fluent.MapAssembler) {
+		na.AssembleEntry("left").AssignLink(leaf1)
+		na.AssembleEntry("right").AssignLink(middle)
+	}))
+	return root, []ipld.Link{root, leaf1, middle, leaf2}
+}
+
+func selectAll(t *testing.T) selector.Selector {
+	ssb := builder.NewSelectorSpecBuilder(basicnode.Prototype.Any)
+	s, err := ssb.ExploreRecursive(selector.RecursionLimitNone(), ssb.ExploreAll(ssb.ExploreRecursiveEdge())).Selector()
+	Require(t, err, ShouldEqual, nil)
+	return s
+}
+
+// sections splits a CAR into its blocks' CIDs, skipping the header.
+func sections(t *testing.T, data []byte) []ipld.Link {
+	var lnks []ipld.Link
+	_, err := Read(bytes.NewReader(data), func(ipld.LinkContext) (io.Writer, ipld.StoreCommitter, error) {
+		return ioutil.Discard, func(lnk ipld.Link) error {
+			lnks = append(lnks, lnk)
+			return nil
+		}, nil
+	})
+	Require(t, err, ShouldEqual, nil)
+	return lnks
+}
+
+func TestRoundtrip(t *testing.T) {
+	src := storage.MemoryStore{}
+	root, all := fixture(t, &src)
+
+	var buf bytes.Buffer
+	err := Write(&buf, root, selectAll(t), traversal.Config{LinkLoader: src.Loader})
+	Require(t, err, ShouldEqual, nil)
+
+	t.Run("blocks are in traversal order, once each", func(t *testing.T) {
+		Wish(t, sections(t, buf.Bytes()), ShouldEqual, all)
+	})
+	t.Run("reading", func(t *testing.T) {
+		dst := storage.MemoryStore{}
+		roots, err := Read(bytes.NewReader(buf.Bytes()), dst.Storer)
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, roots, ShouldEqual, []ipld.Link{root})
+		Wish(t, dst.Len(), ShouldEqual, 4)
+		for _, lnk := range all {
+			nb := basicnode.Prototype.Any.NewBuilder()
+			Wish(t, lnk.Load(context.Background(), ipld.LinkContext{}, nb, dst.Loader), ShouldEqual, nil)
+		}
+	})
+}
+
+func TestWriteSelective(t *testing.T) {
+	src := storage.MemoryStore{}
+	root, all := fixture(t, &src)
+
+	// Only follow the "left" link: that's the root and leaf1.
+	ssb := builder.NewSelectorSpecBuilder(basicnode.Prototype.Any)
+	s, err := ssb.ExploreFields(func(efsb builder.ExploreFieldsSpecBuilder) {
+		efsb.Insert("left", ssb.Matcher())
+	}).Selector()
+	Require(t, err, ShouldEqual, nil)
+
+	var buf bytes.Buffer
+	Require(t, Write(&buf, root, s, traversal.Config{LinkLoader: src.Loader}), ShouldEqual, nil)
+	Wish(t, sections(t, buf.Bytes()), ShouldEqual, all[:2])
+}
+
+func TestWriteMissingBlock(t *testing.T) {
+	src := storage.MemoryStore{}
+	root, _ := fixture(t, &src)
+
+	// A store with only the root block in it.
+	partial := storage.MemoryStore{}
+	r, _ := src.Loader(root, ipld.LinkContext{})
+	w, commit, _ := partial.Storer(ipld.LinkContext{})
+	io.Copy(w, r)
+	Require(t, commit(root), ShouldEqual, nil)
+
+	var buf bytes.Buffer
+	err := Write(&buf, root, selectAll(t), traversal.Config{LinkLoader: partial.Loader})
+	Wish(t, err != nil, ShouldEqual, true)
+}
+
+func TestHeader(t *testing.T) {
+	c, _ := cid.Decode("bafyreihdwdcefgh4dqkjv67uzcmw7ojee6xedzdetojuzjevtenxquvyku")
+	var buf bytes.Buffer
+	Require(t, writeHeader(&buf, []cid.Cid{c}), ShouldEqual, nil)
+	// {"roots": [<cid>], "version": 1}, in canonical DAG-CBOR, with a varint length.
+	Wish(t, hex.EncodeToString(buf.Bytes()), ShouldEqual, "3aa265726f6f747381d82a58250001711220e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b8556776657273696f6e01")
+
+	read := func(header string) error {
+		b, _ := hex.DecodeString(header)
+		_, err := Read(bytes.NewReader(b), (&storage.MemoryStore{}).Storer)
+		return err
+	}
+	t.Run("valid", func(t *testing.T) {
+		Wish(t, read(hex.EncodeToString(buf.Bytes())), ShouldEqual, nil)
+	})
+	t.Run("empty", func(t *testing.T) {
+		Wish(t, read(""), ShouldEqual, fmt.Errorf("invalid car header: empty input"))
+	})
+	t.Run("wrong version", func(t *testing.T) {
+		// {"roots": [], "version": 2}
+		Wish(t, read("11a265726f6f7473806776657273696f6e02").Error(), ShouldEqual, "invalid car header: unsupported version 2")
+	})
+	t.Run("no roots", func(t *testing.T) {
+		// {"roots": [], "version": 1}
+		Wish(t, read("11a265726f6f7473806776657273696f6e01").Error(), ShouldEqual, "invalid car header: no roots")
+	})
+	t.Run("truncated", func(t *testing.T) {
+		Wish(t, read(hex.EncodeToString(buf.Bytes()[:20])) != nil, ShouldEqual, true)
+	})
+}
+
+func TestReadHashMismatch(t *testing.T) {
+	src := storage.MemoryStore{}
+	root, _ := fixture(t, &src)
+	var buf bytes.Buffer
+	Require(t, Write(&buf, root, selectAll(t), traversal.Config{LinkLoader: src.Loader}), ShouldEqual, nil)
+
+	// Flip the last byte, which is in the last block.
+	b := buf.Bytes()
+	b[len(b)-1] ^= 0xff
+	dst := storage.MemoryStore{}
+	_, err := Read(bytes.NewReader(b), dst.Storer)
+	var target ipld.ErrHashMismatch
+	Wish(t, errors.As(err, &target), ShouldEqual, true)
+	Wish(t, dst.Len(), ShouldEqual, 3)
+}
@@ -0,0 +1,60 @@
+package car
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	cid "github.com/ipfs/go-cid"
+
+	ipld "github.com/ipld/go-ipld-prime"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+)
+
+// Read reads a CARv1 from r, hands each of its blocks to the storer,
+// and returns the roots named in its header.
+//
+// The header must be version 1, and name at least one root.
+// Each block is checked against its CID before it's stored;
+// a block whose hash doesn't match is an ipld.ErrHashMismatch error, and nothing more is stored after it.
+// (Blocks before it have already been stored.)
+// Read doesn't check that the roots, or anything else, are among the blocks:
+// a CAR can legitimately be a partial DAG.
+func Read(r io.Reader, storer ipld.Storer) ([]ipld.Link, error) {
+	br := bufio.NewReader(r)
+	roots, err := readHeader(br)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		data, err := readSection(br)
+		if err != nil {
+			if err == io.EOF {
+				return roots, nil
+			}
+			return nil, fmt.Errorf("invalid car block: %w", err)
+		}
+		n, c, err := cid.CidFromBytes(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid car block: %w", err)
+		}
+		block := data[n:]
+		actual, err := c.Prefix().Sum(block)
+		if err != nil {
+			return nil, fmt.Errorf("invalid car block %s: %w", c, err)
+		}
+		if !actual.Equals(c) {
+			return nil, ipld.ErrHashMismatch{Expected: cidlink.Link{c}, Actual: cidlink.Link{actual}}
+		}
+		w, commit, err := storer(ipld.LinkContext{})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(block); err != nil {
+			return nil, err
+		}
+		if err := commit(cidlink.Link{c}); err != nil {
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package car
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	cid "github.com/ipfs/go-cid"
+
+	ipld "github.com/ipld/go-ipld-prime"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	basicnode "github.com/ipld/go-ipld-prime/node/basic"
+	"github.com/ipld/go-ipld-prime/traversal"
+	"github.com/ipld/go-ipld-prime/traversal/selector"
+)
+
+// Write writes a CARv1 to w, with root as its only root, containing
+// the root block and every block reached by walking from it with the selector.
+//
+// The walk is done by traversal.Progress.WalkAdv, using the given Config;
+// its LinkLoader is wrapped so that every block it loads is recorded.
+// Blocks are written in the order the walk loads them (so the root comes first),
+// and each block is written only once, even if the walk reaches it more than once.
+// A block is written only after it's been loaded successfully -- so in particular,
+// after its hash has been checked.
+// Links with the identity multihash don't have blocks to write:
+// their content is inlined in the link (see cidlink.InliningLinkBuilder).
+//
+// If the Config's LinkTargetNodePrototypeChooser is nil, blocks are loaded
+// with basicnode.Prototype.Any.
+//
+// If the walk fails, Write returns the error, and what's been written to w
+// so far is not a complete CAR.
+func Write(w io.Writer, root ipld.Link, s selector.Selector, cfg traversal.Config) error {
+	rootLnk, ok := root.(cidlink.Link)
+	if !ok {
+		return fmt.Errorf("car only supports cidlink.Link, not %T", root)
+	}
+	if cfg.Ctx == nil {
+		cfg.Ctx = context.Background()
+	}
+	if cfg.LinkTargetNodePrototypeChooser == nil {
+		cfg.LinkTargetNodePrototypeChooser = func(ipld.Link, ipld.LinkContext) (ipld.NodePrototype, error) {
+			return basicnode.Prototype.Any, nil
+		}
+	}
+	if err := writeHeader(w, []cid.Cid{rootLnk.Cid}); err != nil {
+		return err
+	}
+	rec := &recorder{
+		ctx:     cfg.Ctx,
+		w:       w,
+		loader:  cfg.LinkLoader,
+		written: make(map[cid.Cid]struct{}),
+	}
+	cfg.LinkLoader = rec.load
+
+	// Load the root ourselves; the walk starts from a node, not a link.
+	np, err := cfg.LinkTargetNodePrototypeChooser(root, ipld.LinkContext{})
+	if err != nil {
+		return err
+	}
+	nb := np.NewBuilder()
+	if err := root.Load(cfg.Ctx, ipld.LinkContext{}, nb, rec.load); err != nil {
+		return err
+	}
+	if err := rec.flush(); err != nil {
+		return err
+	}
+	// A successful load of a block is always followed straight away by a visit to
+	// the node it contains, so that's when the pending block can be written.
+	err = traversal.Progress{Cfg: &cfg}.WalkAdv(nb.Build(), s, func(traversal.Progress, ipld.Node, traversal.VisitReason) error {
+		return rec.flush()
+	})
+	if err != nil {
+		return err
+	}
+	return rec.flush()
+}
+
+// recorder wraps a Loader, keeping the last block it loaded,
+// so it can be written out once the Link has accepted it.
+type recorder struct {
+	ctx     context.Context
+	w       io.Writer
+	loader  ipld.Loader
+	written map[cid.Cid]struct{}
+
+	pending     cid.Cid
+	pendingData []byte
+}
+
+func (rec *recorder) load(lnk ipld.Link, lnkCtx ipld.LinkContext) (io.Reader, error) {
+	if err := rec.ctx.Err(); err != nil {
+		return nil, err
+	}
+	cl, ok := lnk.(cidlink.Link)
+	if !ok {
+		return nil, fmt.Errorf("car only supports cidlink.Link, not %T", lnk)
+	}
+	if rec.loader == nil {
+		return nil, fmt.Errorf("no link loader configured")
+	}
+	r, err := rec.loader(lnk, lnkCtx)
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if _, done := rec.written[cl.Cid]; !done {
+		rec.pending, rec.pendingData = cl.Cid, data
+	}
+	return bytes.NewReader(data), nil
+}
+
+func (rec *recorder) flush() error {
+	if !rec.pending.Defined() {
+		return nil
+	}
+	c, data := rec.pending, rec.pendingData
+	rec.pending, rec.pendingData = cid.Undef, nil
+	rec.written[c] = struct{}{}
+	return writeSection(rec.w, c.Bytes(), data)
+}
@@ -0,0 +1,141 @@
+package dagcbor
+
+import (
+	"bytes"
+	"errors"
+	"math"
+
+	"github.com/polydawn/refmt/cbor"
+	"github.com/polydawn/refmt/shared"
+	"github.com/polydawn/refmt/tok"
+)
+
+// This file contains the parts of the strict mode which aren't just a flag
+// threaded through the marshaller: the map key ordering, and the checks
+// used by the strict decoder to reject anything that isn't canonical.
+//
+// The strict decoder checks what it can on the token stream (indefinite
+// lengths, key order, tags, floats), and checks everything else -- shortest
+// integers and lengths, 64-bit floats -- by re-encoding each token and
+// comparing the result to the bytes that were consumed to produce it.
+// The refmt encoder only ever emits the canonical form of each token,
+// so any difference means the input wasn't canonical.
+
+// ErrNonFiniteFloat is returned by EncoderStrict for NaN and infinite floats,
+// and by DecoderStrict when it finds one in the data: canonical DAG-CBOR has no encoding for them.
+var ErrNonFiniteFloat = errors.New("NaN and infinite floats have no canonical encoding")
+
+// ErrNonCanonical is returned by DecoderStrict when the data is valid
+// DAG-CBOR, but not in canonical form.
+type ErrNonCanonical struct {
+	Reason string
+}
+
+func (e ErrNonCanonical) Error() string {
+	return "data is not canonical dag-cbor: " + e.Reason
+}
+
+// canonicalKeyLess is the map key ordering of canonical DAG-CBOR:
+// shorter keys sort first; keys of the same length sort bytewise.
+func canonicalKeyLess(a, b string) bool {
+	if len(a) != len(b) {
+		return len(a) < len(b)
+	}
+	return a < b
+}
+
+// strictTokenSource decorates a token source, and rejects any token that
+// isn't in canonical form.  'seen' must be fed with the bytes the wrapped
+// token source reads (e.g. with an io.TeeReader); or, if there are no bytes
+// to be had, it may be nil, and only the checks on tokens are made.
+type strictTokenSource struct {
+	src  shared.TokenSource
+	seen *bytes.Buffer // bytes consumed by src since the last token.
+	redo *bytes.Buffer // canonical encoding of the last token.
+	enc  *cbor.Encoder // writes to redo.
+
+	stk []strictFrame // one per open map or list, innermost last.
+}
+
+type strictFrame struct {
+	isMap     bool
+	expectKey bool
+	lastKey   string
+	first     bool
+}
+
+func newStrictTokenSource(src shared.TokenSource, seen *bytes.Buffer) *strictTokenSource {
+	if seen == nil {
+		return &strictTokenSource{src: src}
+	}
+	redo := &bytes.Buffer{}
+	return &strictTokenSource{
+		src:  src,
+		seen: seen,
+		redo: redo,
+		enc:  cbor.NewEncoder(redo),
+	}
+}
+
+func (s *strictTokenSource) Step(tk *tok.Token) (bool, error) {
+	done, err := s.src.Step(tk)
+	if err != nil {
+		return done, err
+	}
+	if err := s.check(tk); err != nil {
+		return done, err
+	}
+	if s.seen == nil {
+		return done, nil
+	}
+	if _, err := s.enc.Step(tk); err != nil {
+		return done, err
+	}
+	if !bytes.Equal(s.seen.Bytes(), s.redo.Bytes()) {
+		return done, ErrNonCanonical{"value is not in its canonical encoding"}
+	}
+	s.seen.Reset()
+	s.redo.Reset()
+	return done, nil
+}
+
+func (s *strictTokenSource) check(tk *tok.Token) error {
+	var top *strictFrame
+	if len(s.stk) > 0 {
+		top = &s.stk[len(s.stk)-1]
+	}
+	switch tk.Type {
+	case tok.TMapClose, tok.TArrClose:
+		s.stk = s.stk[:len(s.stk)-1]
+		return nil
+	}
+	if top != nil && top.isMap {
+		if top.expectKey {
+			if !top.first && !canonicalKeyLess(top.lastKey, tk.Str) {
+				return ErrNonCanonical{"map keys are not in canonical order"}
+			}
+			top.lastKey, top.first, top.expectKey = tk.Str, false, false
+			return nil
+		}
+		top.expectKey = true
+	}
+	if tk.Tagged && !(tk.Type == tok.TBytes && tk.Tag == linkTag) {
+		return ErrNonCanonical{"unexpected cbor tag"}
+	}
+	switch tk.Type {
+	case tok.TMapOpen, tok.TArrOpen:
+		if tk.Length < 0 {
+			return ErrNonCanonical{"indefinite length"}
+		}
+		s.stk = append(s.stk, strictFrame{
+			isMap:     tk.Type == tok.TMapOpen,
+			expectKey: true,
+			first:     true,
+		})
+	case tok.TFloat64:
+		if math.IsNaN(tk.Float64) || math.IsInf(tk.Float64, 0) {
+			return ErrNonFiniteFloat
+		}
+	}
+	return nil
+}
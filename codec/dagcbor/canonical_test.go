@@ -0,0 +1,101 @@
+package dagcbor
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+
+	cid "github.com/ipfs/go-cid"
+	. "github.com/warpfork/go-wish"
+
+	"github.com/ipld/go-ipld-prime/fluent"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	basicnode "github.com/ipld/go-ipld-prime/node/basic"
+)
+
+func TestStrict(t *testing.T) {
+	// The same map as the roundtrip fixture, with its keys in canonical order.
+	var serialCanonical = "\xa4cmap\xa2cone\x01ctwo\x02dlist\x82ethreedfoureplainkolde stringfnested\xa1fdeeper\x81fthings"
+
+	t.Run("encoding sorts map keys", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := EncoderStrict(n, &buf)
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, buf.String(), ShouldEqual, serialCanonical)
+
+		// Building the same map in another order makes no difference.
+		n2 := fluent.MustBuildMap(basicnode.Prototype__Map{}, 3, func(na fluent.MapAssembler) {
+			na.AssembleEntry("bb").AssignInt(1)
+			na.AssembleEntry("c").AssignInt(2)
+			na.AssembleEntry("ab").AssignInt(3)
+		})
+		buf.Reset()
+		err = EncoderStrict(n2, &buf)
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, buf.String(), ShouldEqual, "\xa3ac\x02bab\x03bbb\x01")
+	})
+	t.Run("encoding rejects non-finite floats", func(t *testing.T) {
+		for _, f := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+			var buf bytes.Buffer
+			err := EncoderStrict(basicnode.NewFloat(f), &buf)
+			Wish(t, err, ShouldEqual, ErrNonFiniteFloat)
+		}
+	})
+	t.Run("decoding accepts canonical data", func(t *testing.T) {
+		nb := basicnode.Prototype__Map{}.NewBuilder()
+		err := DecoderStrict(nb, strings.NewReader(serialCanonical))
+		Require(t, err, ShouldEqual, nil)
+		var buf bytes.Buffer
+		err = EncoderStrict(nb.Build(), &buf)
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, buf.String(), ShouldEqual, serialCanonical)
+	})
+	t.Run("decoding accepts links", func(t *testing.T) {
+		c, err := cid.Decode("bafyreiabc2m5ynbmtvgcfcs5vsi7lwppkmalvo36ooc5bftvtaknbfqiwi")
+		Require(t, err, ShouldEqual, nil)
+		n := fluent.MustBuildMap(basicnode.Prototype__Map{}, 1, func(na fluent.MapAssembler) {
+			na.AssembleEntry("l").AssignLink(cidlink.Link{Cid: c})
+		})
+		var buf bytes.Buffer
+		err = EncoderStrict(n, &buf)
+		Require(t, err, ShouldEqual, nil)
+		nb := basicnode.Prototype.Any.NewBuilder()
+		err = DecoderStrict(nb, &buf)
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, nb.Build(), ShouldEqual, n)
+	})
+	t.Run("decoding rejects non-canonical data", func(t *testing.T) {
+		for _, tcase := range []struct {
+			name   string
+			serial string
+			err    error
+		}{
+			{"unsorted keys", serial, ErrNonCanonical{"map keys are not in canonical order"}},
+			{"unsorted keys of same length", "\xa2bbb\x01bab\x02", ErrNonCanonical{"map keys are not in canonical order"}},
+			{"unsorted keys in nested map", "\x81\xa2bbb\x01ac\x02", ErrNonCanonical{"map keys are not in canonical order"}},
+			{"indefinite length list", "\x9f\x01\xff", ErrNonCanonical{"indefinite length"}},
+			{"indefinite length map", "\xbfaa\x01\xff", ErrNonCanonical{"indefinite length"}},
+			{"non-minimal int", "\x18\x01", ErrNonCanonical{"value is not in its canonical encoding"}},
+			{"non-minimal negative int", "\x39\x00\x01", ErrNonCanonical{"value is not in its canonical encoding"}},
+			{"non-minimal length", "\x78\x01a", ErrNonCanonical{"value is not in its canonical encoding"}},
+			{"float32", "\xfa\x3f\x80\x00\x00", ErrNonCanonical{"value is not in its canonical encoding"}},
+			{"NaN", "\xfb\x7f\xf8\x00\x00\x00\x00\x00\x00", ErrNonFiniteFloat},
+			{"Infinity", "\xfb\x7f\xf0\x00\x00\x00\x00\x00\x00", ErrNonFiniteFloat},
+			{"unknown tag", "\xc1\x01", ErrNonCanonical{"unexpected cbor tag"}},
+			{"trailing bytes", "\x01\xff", ErrNonCanonical{"trailing data after the value"}},
+			{"two values", "\x01\x02", ErrNonCanonical{"trailing data after the value"}},
+		} {
+			t.Run(tcase.name, func(t *testing.T) {
+				nb := basicnode.Prototype.Any.NewBuilder()
+				err := DecoderStrict(nb, strings.NewReader(tcase.serial))
+				Wish(t, err, ShouldEqual, tcase.err)
+			})
+		}
+	})
+	t.Run("non-strict decoding accepts non-canonical data", func(t *testing.T) {
+		nb := basicnode.Prototype.Any.NewBuilder()
+		err := Decoder(nb, strings.NewReader("\x9f\x18\x01\xfa\x3f\x80\x00\x00\xff"))
+		Require(t, err, ShouldEqual, nil)
+	})
+}
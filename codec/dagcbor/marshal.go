@@ -2,6 +2,8 @@ package dagcbor
 
 import (
 	"fmt"
+	"math"
+	"sort"
 
 	"github.com/polydawn/refmt/shared"
 	"github.com/polydawn/refmt/tok"
@@ -14,11 +16,21 @@ import (
 // except for the `case ipld.ReprKind_Link` block,
 // which is dag-cbor's special sauce for schemafree links.
 func Marshal(n ipld.Node, sink shared.TokenSink) error {
+	return EncodeOptions{}.Marshal(n, sink)
+}
+
+// MarshalStrict is EncodeOptions{Strict: true}.Marshal; see EncodeOptions.Strict.
+func MarshalStrict(n ipld.Node, sink shared.TokenSink) error {
+	return EncodeOptions{Strict: true}.Marshal(n, sink)
+}
+
+// Marshal feeds the content of a node to a token sink, according to the options.
+func (opts EncodeOptions) Marshal(n ipld.Node, sink shared.TokenSink) error {
 	var tk tok.Token
-	return marshal(n, &tk, sink)
+	return marshal(n, &tk, sink, opts.Strict)
 }
 
-func marshal(n ipld.Node, tk *tok.Token, sink shared.TokenSink) error {
+func marshal(n ipld.Node, tk *tok.Token, sink shared.TokenSink, strict bool) error {
 	switch n.ReprKind() {
 	case ipld.ReprKind_Invalid:
 		return fmt.Errorf("cannot traverse a node that is absent")
@@ -34,6 +46,9 @@ func marshal(n ipld.Node, tk *tok.Token, sink shared.TokenSink) error {
 			return err
 		}
 		// Emit map contents (and recurse).
+		if strict {
+			return marshalSortedMapEntries(n, tk, sink)
+		}
 		for itr := n.MapIterator(); !itr.Done(); {
 			k, v, err := itr.Next()
 			if err != nil {
@@ -47,7 +62,7 @@ func marshal(n ipld.Node, tk *tok.Token, sink shared.TokenSink) error {
 			if _, err := sink.Step(tk); err != nil {
 				return err
 			}
-			if err := marshal(v, tk, sink); err != nil {
+			if err := marshal(v, tk, sink, strict); err != nil {
 				return err
 			}
 		}
@@ -69,7 +84,7 @@ func marshal(n ipld.Node, tk *tok.Token, sink shared.TokenSink) error {
 			if err != nil {
 				return err
 			}
-			if err := marshal(v, tk, sink); err != nil {
+			if err := marshal(v, tk, sink, strict); err != nil {
 				return err
 			}
 		}
@@ -87,12 +102,20 @@ func marshal(n ipld.Node, tk *tok.Token, sink shared.TokenSink) error {
 		_, err = sink.Step(tk)
 		return err
 	case ipld.ReprKind_Int:
+		if un, ok := n.(ipld.UintNode); ok {
+			if v, err := un.AsUint(); err == nil && v > math.MaxInt64 {
+				tk.Type = tok.TUint
+				tk.Uint = v
+				_, err = sink.Step(tk)
+				return err
+			}
+		}
 		v, err := n.AsInt()
 		if err != nil {
 			return err
 		}
 		tk.Type = tok.TInt
-		tk.Int = int64(v)
+		tk.Int = v
 		_, err = sink.Step(tk)
 		return err
 	case ipld.ReprKind_Float:
@@ -100,6 +123,9 @@ func marshal(n ipld.Node, tk *tok.Token, sink shared.TokenSink) error {
 		if err != nil {
 			return err
 		}
+		if strict && (math.IsNaN(v) || math.IsInf(v, 0)) {
+			return ErrNonFiniteFloat
+		}
 		tk.Type = tok.TFloat64
 		tk.Float64 = v
 		_, err = sink.Step(tk)
@@ -143,3 +169,41 @@ func marshal(n ipld.Node, tk *tok.Token, sink shared.TokenSink) error {
 		panic("unreachable")
 	}
 }
+
+// marshalSortedMapEntries emits the entries of a map in canonical order,
+// followed by the map close token; it's the strict mode's replacement for
+// the iterate-and-emit loop in marshal.
+func marshalSortedMapEntries(n ipld.Node, tk *tok.Token, sink shared.TokenSink) error {
+	type entry struct {
+		k string
+		v ipld.Node
+	}
+	entries := make([]entry, 0, n.Length())
+	for itr := n.MapIterator(); !itr.Done(); {
+		k, v, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		ks, err := k.AsString()
+		if err != nil {
+			return err
+		}
+		entries = append(entries, entry{ks, v})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return canonicalKeyLess(entries[i].k, entries[j].k)
+	})
+	for _, e := range entries {
+		tk.Type = tok.TString
+		tk.Str = e.k
+		if _, err := sink.Step(tk); err != nil {
+			return err
+		}
+		if err := marshal(e.v, tk, sink, true); err != nil {
+			return err
+		}
+	}
+	tk.Type = tok.TMapClose
+	_, err := sink.Step(tk)
+	return err
+}
@@ -3,8 +3,6 @@ package dagcbor
 import (
 	"io"
 
-	"github.com/polydawn/refmt/cbor"
-
 	ipld "github.com/ipld/go-ipld-prime"
 	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
 )
@@ -12,6 +10,10 @@ import (
 var (
 	_ cidlink.MulticodecDecoder = Decoder
 	_ cidlink.MulticodecEncoder = Encoder
+	_ cidlink.MulticodecDecoder = DecoderStrict
+	_ cidlink.MulticodecEncoder = EncoderStrict
+	_ cidlink.MulticodecDecoder = DecodeOptions{}.Decode
+	_ cidlink.MulticodecEncoder = EncodeOptions{}.Encode
 )
 
 func init() {
@@ -19,28 +21,29 @@ func init() {
 	cidlink.RegisterMulticodecEncoder(0x71, Encoder)
 }
 
+// Decoder decodes with the default options; see DecodeOptions.
 func Decoder(na ipld.NodeAssembler, r io.Reader) error {
-	// Probe for a builtin fast path.  Shortcut to that if possible.
-	//  (ipldcbor.NodeBuilder supports this, for example.)
-	type detectFastPath interface {
-		DecodeDagCbor(io.Reader) error
-	}
-	if na2, ok := na.(detectFastPath); ok {
-		return na2.DecodeDagCbor(r)
-	}
-	// Okay, generic builder path.
-	return Unmarshal(na, cbor.NewDecoder(cbor.DecodeOptions{}, r))
+	return DecodeOptions{}.Decode(na, r)
 }
 
+// Encoder encodes with the default options; see EncodeOptions.
 func Encoder(n ipld.Node, w io.Writer) error {
-	// Probe for a builtin fast path.  Shortcut to that if possible.
-	//  (ipldcbor.Node supports this, for example.)
-	type detectFastPath interface {
-		EncodeDagCbor(io.Writer) error
-	}
-	if n2, ok := n.(detectFastPath); ok {
-		return n2.EncodeDagCbor(w)
-	}
-	// Okay, generic inspection path.
-	return Marshal(n, cbor.NewEncoder(w))
+	return EncodeOptions{}.Encode(n, w)
+}
+
+// DecoderStrict decodes with DecodeOptions.Strict set,
+// rejecting anything that isn't canonical DAG-CBOR.
+func DecoderStrict(na ipld.NodeAssembler, r io.Reader) error {
+	return DecodeOptions{Strict: true}.Decode(na, r)
+}
+
+// EncoderStrict encodes with EncodeOptions.Strict set,
+// emitting canonical DAG-CBOR.
+//
+// EncoderStrict has the same signature as Encoder, so it can be registered
+// in its place when links should always be computed over canonical data:
+//
+//	cidlink.RegisterMulticodecEncoder(0x71, dagcbor.EncoderStrict)
+func EncoderStrict(n ipld.Node, w io.Writer) error {
+	return EncodeOptions{Strict: true}.Encode(n, w)
 }
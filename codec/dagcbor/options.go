@@ -0,0 +1,161 @@
+package dagcbor
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/polydawn/refmt/cbor"
+
+	ipld "github.com/ipld/go-ipld-prime"
+)
+
+// DefaultAllocationBudget is the allocation budget used by decoding
+// when DecodeOptions.AllocationBudget is zero.
+const DefaultAllocationBudget = 1048576 * 10
+
+// EncodeOptions configures encoding.  The zero value is the default
+// configuration, as used by Encoder and Marshal.
+//
+// The Encode method has the signature of a cidlink.MulticodecEncoder,
+// so a configured encoder can be registered like this:
+//
+//	cidlink.RegisterMulticodecEncoder(0x71, dagcbor.EncodeOptions{Strict: true}.Encode)
+type EncodeOptions struct {
+	// Strict makes the encoder emit the canonical form of DAG-CBOR:
+	// map entries are sorted by key (shortest keys first, and bytewise among
+	// keys of the same length), and NaN or infinite floats are rejected with
+	// ErrNonFiniteFloat.
+	// (Integers and lengths are always emitted in their shortest form, and
+	// floats always in 64 bits; that much is true without Strict as well.)
+	//
+	// Two nodes that are equal encode to the same bytes with Strict,
+	// regardless of the order their maps were built in.
+	Strict bool
+}
+
+// DecodeOptions configures decoding.  The zero value is the default
+// configuration, as used by Decoder and Unmarshal.
+//
+// The Decode method has the signature of a cidlink.MulticodecDecoder,
+// so a configured decoder can be registered like this:
+//
+//	cidlink.RegisterMulticodecDecoder(0x71, dagcbor.DecodeOptions{MaxDepth: 64}.Decode)
+type DecodeOptions struct {
+	// AllocationBudget limits the resources that decoding one message may demand,
+	// as a defense against messages crafted to exhaust memory.
+	// It's *roughly* in units of bytes.
+	// When it's exceeded, decoding halts with ErrAllocationBudgetExceeded.
+	// Zero means DefaultAllocationBudget.
+	AllocationBudget int
+
+	// MaxDepth limits how deeply maps and lists may be nested.
+	// When it's exceeded, decoding halts with ErrMaxDepthExceeded.
+	// Zero means no limit.
+	MaxDepth int
+
+	// DuplicateKeys says what to do with maps that have the same key more than once.
+	DuplicateKeys DuplicateKeys
+
+	// DontParseLinks turns off the recognition of links:
+	// the tag that marks a link is ignored, and its content is decoded as plain bytes.
+	DontParseLinks bool
+
+	// Strict makes the decoder reject any data that isn't in the canonical form
+	// of DAG-CBOR (the form EncodeOptions.Strict produces), or that has
+	// anything following the value, with an ErrNonCanonical or ErrNonFiniteFloat error.
+	// Data decoded this way is guaranteed to re-encode to exactly the same bytes,
+	// and so to the same CID.
+	// (Canonical DAG-CBOR has no tags but the one for links, so Strict rejects
+	// any others before they'd reach a TagHandler.)
+	Strict bool
+
+	// TagHandler is called for each value with a CBOR tag other than the one for links.
+	// If it's nil, such values are rejected with ErrUnknownTag, as the DAG-CBOR spec says they should be.
+	TagHandler TagHandler
+}
+
+// TagHandler decides what a tagged value means.
+// The tagged value ('content') is decoded with basicnode; the handler
+// should assign whatever that means to 'na' -- for example, a tag 1 timestamp
+// could be assigned as a string, or as a struct of a schema type --
+// or return an error to reject it.
+type TagHandler func(tag uint64, content ipld.Node, na ipld.NodeAssembler) error
+
+// DuplicateKeys is a policy for maps that have the same key more than once.
+type DuplicateKeys uint8
+
+const (
+	// DuplicateKeys_Assembler leaves repeated keys to the NodeAssembler.
+	// (Most reject them with ipld.ErrRepeatedMapKey.)  This is the default.
+	DuplicateKeys_Assembler DuplicateKeys = iota
+	// DuplicateKeys_Reject makes the decoder itself reject repeated keys
+	// with ipld.ErrRepeatedMapKey, whatever the NodeAssembler would do.
+	DuplicateKeys_Reject
+	// DuplicateKeys_FirstWins keeps the first value for each key,
+	// and skips the values of any repeats.
+	DuplicateKeys_FirstWins
+)
+
+// Encode encodes a node as DAG-CBOR to the writer, according to the options.
+//
+// With the default options, nodes which have their own DAG-CBOR encoding
+// (an `EncodeDagCbor(io.Writer) error` method) are left to do it themselves.
+func (opts EncodeOptions) Encode(n ipld.Node, w io.Writer) error {
+	if opts == (EncodeOptions{}) {
+		// Probe for a builtin fast path.  Shortcut to that if possible.
+		//  (ipldcbor.Node supports this, for example.)
+		type detectFastPath interface {
+			EncodeDagCbor(io.Writer) error
+		}
+		if n2, ok := n.(detectFastPath); ok {
+			return n2.EncodeDagCbor(w)
+		}
+	}
+	// Okay, generic inspection path.
+	return opts.Marshal(n, cbor.NewEncoder(w))
+}
+
+// Decode decodes DAG-CBOR from the reader into the NodeAssembler, according to the options.
+//
+// With the default options, assemblers which have their own DAG-CBOR decoding
+// (a `DecodeDagCbor(io.Reader) error` method) are left to do it themselves.
+func (opts DecodeOptions) Decode(na ipld.NodeAssembler, r io.Reader) error {
+	if opts.isDefault() {
+		// Probe for a builtin fast path.  Shortcut to that if possible.
+		//  (ipldcbor.NodeBuilder supports this, for example.)
+		type detectFastPath interface {
+			DecodeDagCbor(io.Reader) error
+		}
+		if na2, ok := na.(detectFastPath); ok {
+			return na2.DecodeDagCbor(r)
+		}
+	}
+	if opts.Strict {
+		// The strict checks need to see the bytes, as well as the tokens.
+		var seen bytes.Buffer
+		dec := cbor.NewDecoder(cbor.DecodeOptions{}, io.TeeReader(r, &seen))
+		if err := opts.unmarshal(na, newStrictTokenSource(dec, &seen)); err != nil {
+			return err
+		}
+		// Canonical data is exactly one value, so anything after it is rejected too.
+		// (The decoder doesn't read ahead, so whatever's left in r is what followed the value.)
+		var extra [1]byte
+		if _, err := io.ReadFull(r, extra[:]); err == nil {
+			return ErrNonCanonical{"trailing data after the value"}
+		}
+		return nil
+	}
+	// Okay, generic builder path.
+	return opts.unmarshal(na, cbor.NewDecoder(cbor.DecodeOptions{}, r))
+}
+
+// isDefault is true for the zero value.
+// (DecodeOptions can't be compared with ==, because of the TagHandler func.)
+func (opts DecodeOptions) isDefault() bool {
+	return opts.AllocationBudget == 0 &&
+		opts.MaxDepth == 0 &&
+		opts.DuplicateKeys == DuplicateKeys_Assembler &&
+		!opts.DontParseLinks &&
+		!opts.Strict &&
+		opts.TagHandler == nil
+}
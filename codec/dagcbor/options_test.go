@@ -0,0 +1,87 @@
+package dagcbor
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/warpfork/go-wish"
+
+	ipld "github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/fluent"
+	"github.com/ipld/go-ipld-prime/must"
+	basicnode "github.com/ipld/go-ipld-prime/node/basic"
+)
+
+func TestDecodeOptions(t *testing.T) {
+	decode := func(opts DecodeOptions, serial string) (ipld.Node, error) {
+		nb := basicnode.Prototype.Any.NewBuilder()
+		if err := opts.Decode(nb, strings.NewReader(serial)); err != nil {
+			return nil, err
+		}
+		return nb.Build(), nil
+	}
+	t.Run("allocation budget", func(t *testing.T) {
+		serial := "\x82jaaaaaaaaaajbbbbbbbbbb" // ["aaaaaaaaaa", "bbbbbbbbbb"]
+		_, err := decode(DecodeOptions{AllocationBudget: 20}, serial)
+		Wish(t, err, ShouldEqual, ErrAllocationBudgetExceeded)
+		_, err = decode(DecodeOptions{AllocationBudget: 40}, serial)
+		Wish(t, err, ShouldEqual, nil)
+	})
+	t.Run("max depth", func(t *testing.T) {
+		serial := "\x81\x81\x81\x01" // [[[1]]]
+		_, err := decode(DecodeOptions{MaxDepth: 2}, serial)
+		Wish(t, err, ShouldEqual, ErrMaxDepthExceeded)
+		_, err = decode(DecodeOptions{MaxDepth: 3}, serial)
+		Wish(t, err, ShouldEqual, nil)
+		_, err = decode(DecodeOptions{}, serial)
+		Wish(t, err, ShouldEqual, nil)
+	})
+	t.Run("duplicate keys", func(t *testing.T) {
+		serial := "\xa3aa\x01aa\x82\x02\xa1ab\x03ac\x04" // {"a": 1, "a": [2, {"b": 3}], "c": 4}
+		_, err := decode(DecodeOptions{DuplicateKeys: DuplicateKeys_Reject}, serial)
+		Wish(t, err, ShouldEqual, ipld.ErrRepeatedMapKey{Key: basicnode.NewString("a")})
+		n, err := decode(DecodeOptions{DuplicateKeys: DuplicateKeys_FirstWins}, serial)
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, n, ShouldEqual, fluent.MustBuildMap(basicnode.Prototype.Map, 2, func(na fluent.MapAssembler) {
+			na.AssembleEntry("a").AssignInt(1)
+			na.AssembleEntry("c").AssignInt(4)
+		}))
+		// The default leaves it to the assembler; basicnode rejects repeated keys.
+		_, err = decode(DecodeOptions{}, serial)
+		Wish(t, err, ShouldBeSameTypeAs, ipld.ErrRepeatedMapKey{})
+	})
+	t.Run("links not parsed", func(t *testing.T) {
+		serial := "\xd8\x2a\x43\x00\x01\x02" // a tag 42 around some bytes
+		n, err := decode(DecodeOptions{DontParseLinks: true}, serial)
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, n, ShouldEqual, basicnode.NewBytes([]byte{0x00, 0x01, 0x02}))
+		_, err = decode(DecodeOptions{}, serial)
+		Wish(t, err != nil, ShouldEqual, true) // not a valid CID, so not a valid link.
+	})
+	t.Run("tags", func(t *testing.T) {
+		serial := "\xa1\x61t\xc1\x1a\x5f\x5e\x10\x00" // {"t": 1(1600000000)}, a tag 1 timestamp.
+		_, err := decode(DecodeOptions{}, serial)
+		Wish(t, err, ShouldEqual, ErrUnknownTag{Tag: 1})
+
+		timestamps := func(tag uint64, content ipld.Node, na ipld.NodeAssembler) error {
+			if tag != 1 {
+				return ErrUnknownTag{Tag: tag}
+			}
+			secs, err := content.AsInt()
+			if err != nil {
+				return err
+			}
+			return na.AssignString(time.Unix(secs, 0).UTC().Format(time.RFC3339))
+		}
+		n, err := decode(DecodeOptions{TagHandler: timestamps}, serial)
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, must.String(must.Node(n.LookupByString("t"))), ShouldEqual, "2020-09-13T12:26:40Z")
+
+		_, err = decode(DecodeOptions{TagHandler: timestamps}, "\xc2\x41\x01") // tag 2, a bignum.
+		Wish(t, err, ShouldEqual, ErrUnknownTag{Tag: 2})
+
+		_, err = decode(DecodeOptions{TagHandler: timestamps, Strict: true}, serial)
+		Wish(t, err, ShouldEqual, ErrNonCanonical{"unexpected cbor tag"})
+	})
+}
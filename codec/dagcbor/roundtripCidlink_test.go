@@ -1,9 +1,7 @@
 package dagcbor
 
 import (
-	"bytes"
 	"context"
-	"io"
 	"testing"
 
 	. "github.com/warpfork/go-wish"
@@ -12,6 +10,7 @@ import (
 	ipld "github.com/ipld/go-ipld-prime"
 	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
 	basicnode "github.com/ipld/go-ipld-prime/node/basic"
+	"github.com/ipld/go-ipld-prime/storage"
 )
 
 func TestRoundtripCidlink(t *testing.T) {
@@ -22,20 +21,12 @@ func TestRoundtripCidlink(t *testing.T) {
 		MhLength: 4,
 	}}
 
-	buf := bytes.Buffer{}
-	lnk, err := lb.Build(context.Background(), ipld.LinkContext{}, n,
-		func(ipld.LinkContext) (io.Writer, ipld.StoreCommitter, error) {
-			return &buf, func(lnk ipld.Link) error { return nil }, nil
-		},
-	)
+	store := storage.MemoryStore{}
+	lnk, err := lb.Build(context.Background(), ipld.LinkContext{}, n, store.Storer)
 	Require(t, err, ShouldEqual, nil)
 
 	nb := basicnode.Prototype__Any{}.NewBuilder()
-	err = lnk.Load(context.Background(), ipld.LinkContext{}, nb,
-		func(lnk ipld.Link, _ ipld.LinkContext) (io.Reader, error) {
-			return bytes.NewReader(buf.Bytes()), nil
-		},
-	)
+	err = lnk.Load(context.Background(), ipld.LinkContext{}, nb, store.Loader)
 	Require(t, err, ShouldEqual, nil)
 	Wish(t, nb.Build(), ShouldEqual, n)
 }
@@ -5,6 +5,7 @@ import (
 	"context"
 	"crypto/rand"
 	"io"
+	"math"
 	"strings"
 	"testing"
 
@@ -14,6 +15,7 @@ import (
 	ipld "github.com/ipld/go-ipld-prime"
 	"github.com/ipld/go-ipld-prime/fluent"
 	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	"github.com/ipld/go-ipld-prime/must"
 	basicnode "github.com/ipld/go-ipld-prime/node/basic"
 )
 
@@ -103,3 +105,36 @@ func TestRoundtripLinksAndBytes(t *testing.T) {
 	reconstructed := nb.Build()
 	Wish(t, reconstructed, ShouldEqual, linkByteNode)
 }
+
+func TestRoundtripBigInts(t *testing.T) {
+	n := fluent.MustBuildList(basicnode.Prototype.List, 3, func(na fluent.ListAssembler) {
+		na.AssembleValue().AssignInt(math.MaxInt64)
+		na.AssembleValue().AssignInt(math.MinInt64)
+		na.AssembleValue().AssignNode(basicnode.NewUint(math.MaxUint64))
+	})
+	serial := "\x83" +
+		"\x1b\x7f\xff\xff\xff\xff\xff\xff\xff" +
+		"\x3b\x7f\xff\xff\xff\xff\xff\xff\xff" +
+		"\x1b\xff\xff\xff\xff\xff\xff\xff\xff"
+	t.Run("encoding", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := Encoder(n, &buf)
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, buf.String(), ShouldEqual, serial)
+	})
+	t.Run("decoding", func(t *testing.T) {
+		nb := basicnode.Prototype.Any.NewBuilder()
+		err := Decoder(nb, strings.NewReader(serial))
+		Require(t, err, ShouldEqual, nil)
+		n := nb.Build()
+		Wish(t, must.Int(must.Node(n.LookupByIndex(0))), ShouldEqual, int64(math.MaxInt64))
+		Wish(t, must.Int(must.Node(n.LookupByIndex(1))), ShouldEqual, int64(math.MinInt64))
+		big := must.Node(n.LookupByIndex(2))
+		Wish(t, big.ReprKind(), ShouldEqual, ipld.ReprKind_Int)
+		u, err := big.(ipld.UintNode).AsUint()
+		Wish(t, err, ShouldEqual, nil)
+		Wish(t, u, ShouldEqual, uint64(math.MaxUint64))
+		_, err = big.AsInt()
+		Wish(t, err != nil, ShouldEqual, true)
+	})
+}
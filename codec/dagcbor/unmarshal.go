@@ -11,13 +11,26 @@ import (
 
 	ipld "github.com/ipld/go-ipld-prime"
 	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	basicnode "github.com/ipld/go-ipld-prime/node/basic"
 )
 
 var (
 	ErrInvalidMultibase         = errors.New("invalid multibase on IPLD link")
 	ErrAllocationBudgetExceeded = errors.New("message structure demanded too many resources to process")
+	ErrMaxDepthExceeded         = errors.New("message structure is nested too deeply")
 )
 
+// ErrUnknownTag is returned when the data contains a CBOR tag which the decoder
+// doesn't know (that's any tag but the one for links), and there's no
+// DecodeOptions.TagHandler to handle it.
+type ErrUnknownTag struct {
+	Tag uint64
+}
+
+func (e ErrUnknownTag) Error() string {
+	return fmt.Sprintf("unhandled cbor tag %d", e.Tag)
+}
+
 const (
 	mapEntryGasScore  = 8
 	listEntryGasScore = 4
@@ -25,18 +38,46 @@ const (
 
 // This should be identical to the general feature in the parent package,
 // except for the `case tok.TBytes` block,
-// which has dag-cbor's special sauce for detecting schemafree links.
+// which has dag-cbor's special sauce for detecting schemafree links,
+// and for the checks configured by DecodeOptions.
 
+// Unmarshal is DecodeOptions{}.Unmarshal; see that method.
 func Unmarshal(na ipld.NodeAssembler, tokSrc shared.TokenSource) error {
+	return DecodeOptions{}.Unmarshal(na, tokSrc)
+}
+
+// Unmarshal feeds the data from a token source into a NodeAssembler,
+// according to the options.
+//
+// When Strict is set, only the checks which can be made on the tokens are made
+// (map key order, indefinite lengths, tags, and non-finite floats):
+// whether integers and floats were in their shortest form can only be seen in the bytes.
+// Use Decode for the full check.
+func (opts DecodeOptions) Unmarshal(na ipld.NodeAssembler, tokSrc shared.TokenSource) error {
+	if opts.Strict {
+		tokSrc = newStrictTokenSource(tokSrc, nil)
+	}
+	return opts.unmarshal(na, tokSrc)
+}
+
+func (opts DecodeOptions) unmarshal(na ipld.NodeAssembler, tokSrc shared.TokenSource) error {
 	// Have a gas budget, which will be decremented as we allocate memory, and an error returned when execeeded (or about to be exceeded).
 	//  This is a DoS defense mechanism.
 	//  It's *roughly* in units of bytes (but only very, VERY roughly) -- it also treats words as 1 in many cases.
-	// FUTURE: this ought be configurable somehow.  (How, and at what granularity though?)
-	var gas int = 1048576 * 10
-	return unmarshal1(na, tokSrc, &gas)
+	st := unmarshalState{opts: opts, gas: opts.AllocationBudget}
+	if st.gas == 0 {
+		st.gas = DefaultAllocationBudget
+	}
+	return st.unmarshal1(na, tokSrc)
 }
 
-func unmarshal1(na ipld.NodeAssembler, tokSrc shared.TokenSource, gas *int) error {
+type unmarshalState struct {
+	opts  DecodeOptions
+	gas   int
+	depth int
+}
+
+func (st *unmarshalState) unmarshal1(na ipld.NodeAssembler, tokSrc shared.TokenSource) error {
 	var tk tok.Token
 	done, err := tokSrc.Step(&tk)
 	if err != nil {
@@ -45,13 +86,59 @@ func unmarshal1(na ipld.NodeAssembler, tokSrc shared.TokenSource, gas *int) erro
 	if done && !tk.Type.IsValue() {
 		return fmt.Errorf("unexpected eof")
 	}
-	return unmarshal2(na, tokSrc, &tk, gas)
+	return st.unmarshal2(na, tokSrc, &tk)
+}
+
+// descend is called on entering a map or list, and checks the depth limit.
+// The caller must call ascend when leaving it again.
+func (st *unmarshalState) descend() error {
+	st.depth++
+	if st.opts.MaxDepth > 0 && st.depth > st.opts.MaxDepth {
+		return ErrMaxDepthExceeded
+	}
+	return nil
+}
+
+func (st *unmarshalState) ascend() {
+	st.depth--
+}
+
+// skip consumes the rest of a value whose first token is already in tk,
+// without assembling it anywhere.  It's used to discard the values of repeated map keys.
+// (Skipped values still count against the gas budget: they still had to be read.)
+func (st *unmarshalState) skip(tokSrc shared.TokenSource, tk *tok.Token) error {
+	open := 0
+	for {
+		switch tk.Type {
+		case tok.TMapOpen, tok.TArrOpen:
+			if err := st.descend(); err != nil {
+				return err
+			}
+			open++
+		case tok.TMapClose, tok.TArrClose:
+			st.ascend()
+			open--
+		}
+		st.gas -= len(tk.Str) + len(tk.Bytes) + 1
+		if st.gas < 0 {
+			return ErrAllocationBudgetExceeded
+		}
+		if open == 0 {
+			return nil
+		}
+		if _, err := tokSrc.Step(tk); err != nil {
+			return err
+		}
+	}
 }
 
 // starts with the first token already primed.  Necessary to get recursion
 //  to flow right without a peek+unpeek system.
-func unmarshal2(na ipld.NodeAssembler, tokSrc shared.TokenSource, tk *tok.Token, gas *int) error {
+func (st *unmarshalState) unmarshal2(na ipld.NodeAssembler, tokSrc shared.TokenSource, tk *tok.Token) error {
 	// FUTURE: check for schema.TypedNodeBuilder that's going to parse a Link (they can slurp any token kind they want).
+	if tk.Tagged && !(tk.Tag == linkTag && tk.Type == tok.TBytes) {
+		return st.unmarshalTagged(na, tokSrc, tk)
+	}
 	switch tk.Type {
 	case tok.TMapOpen:
 		expectLen := tk.Length
@@ -60,14 +147,22 @@ func unmarshal2(na ipld.NodeAssembler, tokSrc shared.TokenSource, tk *tok.Token,
 			expectLen = math.MaxInt32
 			allocLen = 0
 		} else {
-			if *gas-allocLen < 0 { // halt early if this will clearly demand too many resources
+			if st.gas-allocLen < 0 { // halt early if this will clearly demand too many resources
 				return ErrAllocationBudgetExceeded
 			}
 		}
+		if err := st.descend(); err != nil {
+			return err
+		}
+		defer st.ascend()
 		ma, err := na.BeginMap(allocLen)
 		if err != nil {
 			return err
 		}
+		var seen map[string]struct{}
+		if st.opts.DuplicateKeys != DuplicateKeys_Assembler {
+			seen = make(map[string]struct{}, allocLen)
+		}
 		observedLen := 0
 		for {
 			_, err := tokSrc.Step(tk)
@@ -81,8 +176,11 @@ func unmarshal2(na ipld.NodeAssembler, tokSrc shared.TokenSource, tk *tok.Token,
 				}
 				return ma.Finish()
 			case tok.TString:
-				*gas -= len(tk.Str) + mapEntryGasScore
-				if *gas < 0 {
+				if tk.Tagged {
+					return fmt.Errorf("unexpected cbor tag %d on map key", tk.Tag)
+				}
+				st.gas -= len(tk.Str) + mapEntryGasScore
+				if st.gas < 0 {
 					return ErrAllocationBudgetExceeded
 				}
 				// continue
@@ -93,11 +191,26 @@ func unmarshal2(na ipld.NodeAssembler, tokSrc shared.TokenSource, tk *tok.Token,
 			if observedLen > expectLen {
 				return fmt.Errorf("unexpected continuation of map elements beyond declared length")
 			}
+			if seen != nil {
+				if _, exists := seen[tk.Str]; exists {
+					if st.opts.DuplicateKeys == DuplicateKeys_Reject {
+						return ipld.ErrRepeatedMapKey{Key: basicnode.NewString(tk.Str)}
+					}
+					if _, err := tokSrc.Step(tk); err != nil {
+						return err
+					}
+					if err := st.skip(tokSrc, tk); err != nil {
+						return err
+					}
+					continue
+				}
+				seen[tk.Str] = struct{}{}
+			}
 			mva, err := ma.AssembleEntry(tk.Str)
 			if err != nil { // return in error if the key was rejected
 				return err
 			}
-			err = unmarshal1(mva, tokSrc, gas)
+			err = st.unmarshal1(mva, tokSrc)
 			if err != nil { // return in error if some part of the recursion errored
 				return err
 			}
@@ -111,10 +224,14 @@ func unmarshal2(na ipld.NodeAssembler, tokSrc shared.TokenSource, tk *tok.Token,
 			expectLen = math.MaxInt32
 			allocLen = 0
 		} else {
-			if *gas-allocLen < 0 { // halt early if this will clearly demand too many resources
+			if st.gas-allocLen < 0 { // halt early if this will clearly demand too many resources
 				return ErrAllocationBudgetExceeded
 			}
 		}
+		if err := st.descend(); err != nil {
+			return err
+		}
+		defer st.ascend()
 		la, err := na.BeginList(allocLen)
 		if err != nil {
 			return err
@@ -132,15 +249,15 @@ func unmarshal2(na ipld.NodeAssembler, tokSrc shared.TokenSource, tk *tok.Token,
 				}
 				return la.Finish()
 			default:
-				*gas -= listEntryGasScore
-				if *gas < 0 {
+				st.gas -= listEntryGasScore
+				if st.gas < 0 {
 					return ErrAllocationBudgetExceeded
 				}
 				observedLen++
 				if observedLen > expectLen {
 					return fmt.Errorf("unexpected continuation of array elements beyond declared length")
 				}
-				err := unmarshal2(la.AssembleValue(), tokSrc, tk, gas)
+				err := st.unmarshal2(la.AssembleValue(), tokSrc, tk)
 				if err != nil { // return in error if some part of the recursion errored
 					return err
 				}
@@ -151,53 +268,52 @@ func unmarshal2(na ipld.NodeAssembler, tokSrc shared.TokenSource, tk *tok.Token,
 	case tok.TNull:
 		return na.AssignNull()
 	case tok.TString:
-		*gas -= len(tk.Str)
-		if *gas < 0 {
+		st.gas -= len(tk.Str)
+		if st.gas < 0 {
 			return ErrAllocationBudgetExceeded
 		}
 		return na.AssignString(tk.Str)
 	case tok.TBytes:
-		*gas -= len(tk.Bytes)
-		if *gas < 0 {
+		st.gas -= len(tk.Bytes)
+		if st.gas < 0 {
 			return ErrAllocationBudgetExceeded
 		}
-		if !tk.Tagged {
+		if !tk.Tagged || st.opts.DontParseLinks {
 			return na.AssignBytes(tk.Bytes)
 		}
-		switch tk.Tag {
-		case linkTag:
-			if len(tk.Bytes) < 1 || tk.Bytes[0] != 0 {
-				return ErrInvalidMultibase
-			}
-			elCid, err := cid.Cast(tk.Bytes[1:])
-			if err != nil {
-				return err
-			}
-			return na.AssignLink(cidlink.Link{elCid})
-		default:
-			return fmt.Errorf("unhandled cbor tag %d", tk.Tag)
+		// Any other tag would have gone to unmarshalTagged.
+		if len(tk.Bytes) < 1 || tk.Bytes[0] != 0 {
+			return ErrInvalidMultibase
+		}
+		elCid, err := cid.Cast(tk.Bytes[1:])
+		if err != nil {
+			return err
 		}
+		return na.AssignLink(cidlink.Link{elCid})
 	case tok.TBool:
-		*gas -= 1
-		if *gas < 0 {
+		st.gas -= 1
+		if st.gas < 0 {
 			return ErrAllocationBudgetExceeded
 		}
 		return na.AssignBool(tk.Bool)
 	case tok.TInt:
-		*gas -= 1
-		if *gas < 0 {
+		st.gas -= 1
+		if st.gas < 0 {
 			return ErrAllocationBudgetExceeded
 		}
-		return na.AssignInt(int(tk.Int)) // FIXME overflow check
+		return na.AssignInt(tk.Int)
 	case tok.TUint:
-		*gas -= 1
-		if *gas < 0 {
+		st.gas -= 1
+		if st.gas < 0 {
 			return ErrAllocationBudgetExceeded
 		}
-		return na.AssignInt(int(tk.Uint)) // FIXME overflow check
+		if tk.Uint > math.MaxInt64 {
+			return na.AssignNode(basicnode.NewUint(tk.Uint))
+		}
+		return na.AssignInt(int64(tk.Uint))
 	case tok.TFloat64:
-		*gas -= 1
-		if *gas < 0 {
+		st.gas -= 1
+		if st.gas < 0 {
 			return ErrAllocationBudgetExceeded
 		}
 		return na.AssignFloat(tk.Float64)
@@ -205,3 +321,19 @@ func unmarshal2(na ipld.NodeAssembler, tokSrc shared.TokenSource, tk *tok.Token,
 		panic("unreachable")
 	}
 }
+
+// unmarshalTagged handles a value with any tag but the link tag.
+// The tagged value is decoded into a basicnode, and handed to the TagHandler,
+// which assembles whatever it likes.
+func (st *unmarshalState) unmarshalTagged(na ipld.NodeAssembler, tokSrc shared.TokenSource, tk *tok.Token) error {
+	tag := uint64(tk.Tag)
+	if st.opts.TagHandler == nil {
+		return ErrUnknownTag{tag}
+	}
+	tk.Tagged = false
+	nb := basicnode.Prototype.Any.NewBuilder()
+	if err := st.unmarshal2(nb, tokSrc, tk); err != nil {
+		return err
+	}
+	return st.opts.TagHandler(tag, nb.Build(), na)
+}
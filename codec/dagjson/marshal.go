@@ -1,7 +1,9 @@
 package dagjson
 
 import (
+	"encoding/base64"
 	"fmt"
+	"math"
 
 	"github.com/polydawn/refmt/shared"
 	"github.com/polydawn/refmt/tok"
@@ -11,8 +13,8 @@ import (
 )
 
 // This should be identical to the general feature in the parent package,
-// except for the `case ipld.ReprKind_Link` block,
-// which is dag-json's special sauce for schemafree links.
+// except for the `case ipld.ReprKind_Link` and `case ipld.ReprKind_Bytes` blocks,
+// which are dag-json's special sauce for schemafree links and for bytes.
 
 func Marshal(n ipld.Node, sink shared.TokenSink) error {
 	var tk tok.Token
@@ -84,12 +86,20 @@ func Marshal(n ipld.Node, sink shared.TokenSink) error {
 		_, err = sink.Step(&tk)
 		return err
 	case ipld.ReprKind_Int:
+		if un, ok := n.(ipld.UintNode); ok {
+			if v, err := un.AsUint(); err == nil && v > math.MaxInt64 {
+				tk.Type = tok.TUint
+				tk.Uint = v
+				_, err = sink.Step(&tk)
+				return err
+			}
+		}
 		v, err := n.AsInt()
 		if err != nil {
 			return err
 		}
 		tk.Type = tok.TInt
-		tk.Int = int64(v)
+		tk.Int = v
 		_, err = sink.Step(&tk)
 		return err
 	case ipld.ReprKind_Float:
@@ -115,10 +125,38 @@ func Marshal(n ipld.Node, sink shared.TokenSink) error {
 		if err != nil {
 			return err
 		}
-		tk.Type = tok.TBytes
-		tk.Bytes = v
-		_, err = sink.Step(&tk)
-		return err
+		// Precisely seven tokens to emit:
+		tk.Type = tok.TMapOpen
+		tk.Length = 1
+		if _, err = sink.Step(&tk); err != nil {
+			return err
+		}
+		tk.Type = tok.TString
+		tk.Str = "/"
+		if _, err = sink.Step(&tk); err != nil {
+			return err
+		}
+		tk.Type = tok.TMapOpen
+		if _, err = sink.Step(&tk); err != nil {
+			return err
+		}
+		tk.Type = tok.TString
+		tk.Str = "bytes"
+		if _, err = sink.Step(&tk); err != nil {
+			return err
+		}
+		tk.Str = base64.RawStdEncoding.EncodeToString(v)
+		if _, err = sink.Step(&tk); err != nil {
+			return err
+		}
+		tk.Type = tok.TMapClose
+		if _, err = sink.Step(&tk); err != nil {
+			return err
+		}
+		if _, err = sink.Step(&tk); err != nil {
+			return err
+		}
+		return nil
 	case ipld.ReprKind_Link:
 		v, err := n.AsLink()
 		if err != nil {
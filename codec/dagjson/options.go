@@ -0,0 +1,133 @@
+package dagjson
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/polydawn/refmt/json"
+	"github.com/polydawn/refmt/shared"
+
+	ipld "github.com/ipld/go-ipld-prime"
+)
+
+// DefaultAllocationBudget is the allocation budget used by decoding
+// when DecodeOptions.AllocationBudget is zero.
+const DefaultAllocationBudget = 1048576 * 10
+
+// EncodeOptions configures encoding.  The zero value is the default
+// configuration, as used by Encoder: each map entry and list element
+// goes on its own line, indented with tabs.
+//
+// The Encode method has the signature of a cidlink.MulticodecEncoder,
+// so a configured encoder can be registered like this:
+//
+//	cidlink.RegisterMulticodecEncoder(0x0129, dagjson.EncodeOptions{Compact: true}.Encode)
+type EncodeOptions struct {
+	// Compact turns off all whitespace: everything goes on one line.
+	// When it's set, Indent is ignored.
+	Compact bool
+
+	// Indent is used once per level of nesting, at the start of each line.
+	// Empty means a tab.
+	Indent string
+}
+
+// DecodeOptions configures decoding.  The zero value is the default
+// configuration, as used by Decoder and Unmarshal.
+//
+// The Decode method has the signature of a cidlink.MulticodecDecoder,
+// so a configured decoder can be registered like this:
+//
+//	cidlink.RegisterMulticodecDecoder(0x0129, dagjson.DecodeOptions{MaxDepth: 64}.Decode)
+type DecodeOptions struct {
+	// AllocationBudget limits the resources that decoding one message may demand,
+	// as a defense against messages crafted to exhaust memory.
+	// It's *roughly* in units of bytes.
+	// When it's exceeded, decoding halts with ErrAllocationBudgetExceeded.
+	// Zero means DefaultAllocationBudget.
+	AllocationBudget int
+
+	// MaxDepth limits how deeply maps and lists may be nested.
+	// When it's exceeded, decoding halts with ErrMaxDepthExceeded.
+	// Zero means no limit.
+	MaxDepth int
+
+	// DuplicateKeys says what to do with maps that have the same key more than once.
+	DuplicateKeys DuplicateKeys
+
+	// DontParseLinks turns off the recognition of links:
+	// `{"/": "<cid>"}` is decoded as a plain map.
+	// (Bytes, in the `{"/": {"bytes": "<base64>"}}` form, are still recognized.)
+	DontParseLinks bool
+}
+
+// DuplicateKeys is a policy for maps that have the same key more than once.
+type DuplicateKeys uint8
+
+const (
+	// DuplicateKeys_Assembler leaves repeated keys to the NodeAssembler.
+	// (Most reject them with ipld.ErrRepeatedMapKey.)  This is the default.
+	DuplicateKeys_Assembler DuplicateKeys = iota
+	// DuplicateKeys_Reject makes the decoder itself reject repeated keys
+	// with ipld.ErrRepeatedMapKey, whatever the NodeAssembler would do.
+	DuplicateKeys_Reject
+	// DuplicateKeys_FirstWins keeps the first value for each key,
+	// and skips the values of any repeats.
+	DuplicateKeys_FirstWins
+)
+
+// Marshal feeds the content of a node to a token sink.
+// (None of the options concern tokens, so this is the same as the Marshal function;
+// it's here for symmetry with DecodeOptions.Unmarshal.)
+func (opts EncodeOptions) Marshal(n ipld.Node, sink shared.TokenSink) error {
+	return Marshal(n, sink)
+}
+
+// Encode encodes a node as DAG-JSON to the writer, according to the options.
+func (opts EncodeOptions) Encode(n ipld.Node, w io.Writer) error {
+	// Shell out directly to generic inspection path.
+	//  (There's not really any fastpaths of note for json.)
+	var jsonOpts json.EncodeOptions
+	if !opts.Compact {
+		jsonOpts.Line = []byte{'\n'}
+		jsonOpts.Indent = []byte{'\t'}
+		if opts.Indent != "" {
+			jsonOpts.Indent = []byte(opts.Indent)
+		}
+	}
+	return Marshal(n, newUintEncoder(w, jsonOpts))
+}
+
+// Decode decodes DAG-JSON from the reader into the NodeAssembler, according to the options.
+//
+// The reader must contain a single JSON value; anything but whitespace after it is an error.
+func (opts DecodeOptions) Decode(na ipld.NodeAssembler, r io.Reader) error {
+	// Shell out directly to generic builder path.
+	//  (There's not really any fastpaths of note for json.)
+	err := opts.Unmarshal(na, newUintDecoder(r))
+	if err != nil {
+		return err
+	}
+	// Slurp any remaining whitespace.
+	//  (This is relevant if our reader is tee'ing bytes to a hasher, and
+	//   the json contained any trailing whitespace.)
+	//  (We can't actually support multiple objects per reader from here;
+	//   we can't unpeek if we find a non-whitespace token, so our only
+	//    option is to error if this reader seems to contain more content.)
+	var buf [1]byte
+	for {
+		_, err := r.Read(buf[:])
+		switch buf[0] {
+		case ' ', 0x0, '\t', '\r', '\n': // continue
+		default:
+			return fmt.Errorf("unexpected content after end of json object")
+		}
+		if err == nil {
+			continue
+		} else if err == io.EOF {
+			return nil
+		} else {
+			return err
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package dagjson
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	. "github.com/warpfork/go-wish"
+
+	ipld "github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/fluent"
+	basicnode "github.com/ipld/go-ipld-prime/node/basic"
+)
+
+func TestEncodeOptions(t *testing.T) {
+	n := fluent.MustBuildMap(basicnode.Prototype.Map, 2, func(na fluent.MapAssembler) {
+		na.AssembleEntry("a").AssignInt(1)
+		na.AssembleEntry("b").CreateList(1, func(na fluent.ListAssembler) {
+			na.AssembleValue().AssignString("c")
+		})
+	})
+	for _, tcase := range []struct {
+		name   string
+		opts   EncodeOptions
+		expect string
+	}{
+		{"default", EncodeOptions{}, "{\n\t\"a\": 1,\n\t\"b\": [\n\t\t\"c\"\n\t]\n}\n"},
+		{"indent", EncodeOptions{Indent: "  "}, "{\n  \"a\": 1,\n  \"b\": [\n    \"c\"\n  ]\n}\n"},
+		{"compact", EncodeOptions{Compact: true, Indent: "  "}, `{"a":1,"b":["c"]}`},
+	} {
+		t.Run(tcase.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := tcase.opts.Encode(n, &buf)
+			Require(t, err, ShouldEqual, nil)
+			Wish(t, buf.String(), ShouldEqual, tcase.expect)
+		})
+	}
+}
+
+func TestDecodeOptions(t *testing.T) {
+	decode := func(opts DecodeOptions, serial string) (ipld.Node, error) {
+		nb := basicnode.Prototype.Any.NewBuilder()
+		if err := opts.Decode(nb, strings.NewReader(serial)); err != nil {
+			return nil, err
+		}
+		return nb.Build(), nil
+	}
+	t.Run("allocation budget", func(t *testing.T) {
+		serial := `["aaaaaaaaaa", "bbbbbbbbbb"]`
+		_, err := decode(DecodeOptions{AllocationBudget: 20}, serial)
+		Wish(t, err, ShouldEqual, ErrAllocationBudgetExceeded)
+		_, err = decode(DecodeOptions{AllocationBudget: 40}, serial)
+		Wish(t, err, ShouldEqual, nil)
+	})
+	t.Run("max depth", func(t *testing.T) {
+		serial := `[[{"a":1}]]`
+		_, err := decode(DecodeOptions{MaxDepth: 2}, serial)
+		Wish(t, err, ShouldEqual, ErrMaxDepthExceeded)
+		_, err = decode(DecodeOptions{MaxDepth: 3}, serial)
+		Wish(t, err, ShouldEqual, nil)
+		_, err = decode(DecodeOptions{}, serial)
+		Wish(t, err, ShouldEqual, nil)
+	})
+	t.Run("duplicate keys", func(t *testing.T) {
+		serial := `{"a": 1, "a": [2, {"b": 3}], "c": 4}`
+		_, err := decode(DecodeOptions{DuplicateKeys: DuplicateKeys_Reject}, serial)
+		Wish(t, err, ShouldEqual, ipld.ErrRepeatedMapKey{Key: basicnode.NewString("a")})
+		n, err := decode(DecodeOptions{DuplicateKeys: DuplicateKeys_FirstWins}, serial)
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, n, ShouldEqual, fluent.MustBuildMap(basicnode.Prototype.Map, 2, func(na fluent.MapAssembler) {
+			na.AssembleEntry("a").AssignInt(1)
+			na.AssembleEntry("c").AssignInt(4)
+		}))
+		// The default leaves it to the assembler; basicnode rejects repeated keys.
+		_, err = decode(DecodeOptions{}, serial)
+		Wish(t, err, ShouldBeSameTypeAs, ipld.ErrRepeatedMapKey{})
+	})
+	t.Run("duplicate keys skipping a link and bytes", func(t *testing.T) {
+		serial := `{"a": 1, "a": {"/": "bafyreiabc2m5ynbmtvgcfcs5vsi7lwppkmalvo36ooc5bftvtaknbfqiwi"}, "a": {"/": {"bytes": "AQI"}}, "c": 4}`
+		n, err := decode(DecodeOptions{DuplicateKeys: DuplicateKeys_FirstWins}, serial)
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, n.Length(), ShouldEqual, 2)
+	})
+	t.Run("links not parsed", func(t *testing.T) {
+		serial := `{"/":"bafyreiabc2m5ynbmtvgcfcs5vsi7lwppkmalvo36ooc5bftvtaknbfqiwi"}`
+		n, err := decode(DecodeOptions{DontParseLinks: true}, serial)
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, n.ReprKind(), ShouldEqual, ipld.ReprKind_Map)
+		n, err = decode(DecodeOptions{}, serial)
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, n.ReprKind(), ShouldEqual, ipld.ReprKind_Link)
+	})
+}
@@ -2,12 +2,17 @@ package dagjson
 
 import (
 	"bytes"
+	"encoding/base64"
+	"math"
 	"strings"
 	"testing"
 
+	"github.com/polydawn/refmt/json"
 	. "github.com/warpfork/go-wish"
 
+	ipld "github.com/ipld/go-ipld-prime"
 	"github.com/ipld/go-ipld-prime/fluent"
+	"github.com/ipld/go-ipld-prime/must"
 	basicnode "github.com/ipld/go-ipld-prime/node/basic"
 )
 
@@ -79,3 +84,151 @@ func TestRoundtripScalar(t *testing.T) {
 		Wish(t, nb.Build(), ShouldEqual, simple)
 	})
 }
+
+func TestRoundtripBytes(t *testing.T) {
+	nb := basicnode.Prototype__Bytes{}.NewBuilder()
+	nb.AssignBytes([]byte{0x01, 0x02, 0x03, 0xfe})
+	simple := nb.Build()
+	t.Run("encoding", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := Encoder(simple, &buf)
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, buf.String(), ShouldEqual, `{
+	"/": {
+		"bytes": "AQID/g"
+	}
+}
+`)
+	})
+	t.Run("decoding", func(t *testing.T) {
+		for _, serial := range []string{
+			`{"/":{"bytes":"AQID/g"}}`,
+			`{"/":{"bytes":"AQID/g=="}}`,
+		} {
+			nb := basicnode.Prototype__Bytes{}.NewBuilder()
+			err := Decoder(nb, strings.NewReader(serial))
+			Require(t, err, ShouldEqual, nil)
+			Wish(t, nb.Build(), ShouldEqual, simple)
+		}
+	})
+	t.Run("decoding invalid base64", func(t *testing.T) {
+		nb := basicnode.Prototype__Any{}.NewBuilder()
+		err := Decoder(nb, strings.NewReader(`{"/":{"bytes":"!!"}}`))
+		Wish(t, err, ShouldEqual, base64.CorruptInputError(0))
+	})
+}
+
+// Make sure that maps that *almost* look like bytes are handled safely,
+// and that every token peeked while looking for bytes is reprocessed correctly.
+func TestUnmarshalTrickyMapsAlmostBytes(t *testing.T) {
+	for _, tcase := range []struct {
+		name   string
+		serial string
+	}{
+		{"extra key in outer map", `{"/":{"bytes":"AQI"},"a":1}`},
+		{"extra key in inner map", `{"/":{"bytes":"AQI","a":1}}`},
+		{"other key in inner map", `{"/":{"bites":"AQI"}}`},
+		{"non-string in inner map", `{"/":{"bytes":[1,{"/":{"bytes":"AQI"}}]}}`},
+		{"empty inner map", `{"/":{}}`},
+	} {
+		t.Run(tcase.name, func(t *testing.T) {
+			nb := basicnode.Prototype__Any{}.NewBuilder()
+			err := Decoder(nb, strings.NewReader(tcase.serial))
+			Require(t, err, ShouldEqual, nil)
+			n := nb.Build()
+			Wish(t, n.ReprKind(), ShouldEqual, ipld.ReprKind_Map)
+			var buf bytes.Buffer
+			err = Marshal(n, json.NewEncoder(&buf, json.EncodeOptions{}))
+			Require(t, err, ShouldEqual, nil)
+			Wish(t, buf.String(), ShouldEqual, tcase.serial)
+		})
+	}
+}
+
+func TestRoundtripBigInts(t *testing.T) {
+	n := fluent.MustBuildList(basicnode.Prototype.List, 3, func(na fluent.ListAssembler) {
+		na.AssembleValue().AssignInt(math.MaxInt64)
+		na.AssembleValue().AssignInt(math.MinInt64)
+		na.AssembleValue().AssignNode(basicnode.NewUint(math.MaxUint64))
+	})
+	serial := "[\n\t9223372036854775807,\n\t-9223372036854775808,\n\t18446744073709551615\n]\n"
+	t.Run("encoding", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := Encoder(n, &buf)
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, buf.String(), ShouldEqual, serial)
+	})
+	t.Run("decoding", func(t *testing.T) {
+		nb := basicnode.Prototype.Any.NewBuilder()
+		err := Decoder(nb, strings.NewReader(serial))
+		Require(t, err, ShouldEqual, nil)
+		n := nb.Build()
+		Wish(t, must.Int(must.Node(n.LookupByIndex(0))), ShouldEqual, int64(math.MaxInt64))
+		Wish(t, must.Int(must.Node(n.LookupByIndex(1))), ShouldEqual, int64(math.MinInt64))
+		u, err := must.Node(n.LookupByIndex(2)).(ipld.UintNode).AsUint()
+		Wish(t, err, ShouldEqual, nil)
+		Wish(t, u, ShouldEqual, uint64(math.MaxUint64))
+	})
+	t.Run("decoding compact", func(t *testing.T) {
+		// No whitespace: the bytes read past the end of each number are separators.
+		nb := basicnode.Prototype.Any.NewBuilder()
+		err := Decoder(nb, strings.NewReader(`{"a":18446744073709551615,"b":[18446744073709551614]}`))
+		Require(t, err, ShouldEqual, nil)
+		n := nb.Build()
+		u, err := must.Node(n.LookupByString("a")).(ipld.UintNode).AsUint()
+		Wish(t, err, ShouldEqual, nil)
+		Wish(t, u, ShouldEqual, uint64(math.MaxUint64))
+		u, err = must.Node(must.Node(n.LookupByString("b")).LookupByIndex(0)).(ipld.UintNode).AsUint()
+		Wish(t, err, ShouldEqual, nil)
+		Wish(t, u, ShouldEqual, uint64(math.MaxUint64-1))
+	})
+	// The rest of these check the details of refmt's json encoder and decoder that uint64.go depends on.
+	t.Run("encoding with options, next to the placeholder value", func(t *testing.T) {
+		n := fluent.MustBuildMap(basicnode.Prototype.Map, 2, func(na fluent.MapAssembler) {
+			na.AssembleEntry("a").AssignNode(basicnode.NewUint(math.MaxUint64))
+			na.AssembleEntry("b").CreateList(3, func(na fluent.ListAssembler) {
+				na.AssembleValue().AssignInt(math.MinInt64)
+				na.AssembleValue().AssignNode(basicnode.NewUint(math.MaxInt64 + 1))
+				na.AssembleValue().AssignInt(math.MinInt64)
+			})
+		})
+		var buf bytes.Buffer
+		err := EncodeOptions{Compact: true}.Encode(n, &buf)
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, buf.String(), ShouldEqual, `{"a":18446744073709551615,"b":[-9223372036854775808,9223372036854775808,-9223372036854775808]}`)
+		buf.Reset()
+		err = EncodeOptions{Indent: "  "}.Encode(n, &buf)
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, buf.String(), ShouldEqual, "{\n  \"a\": 18446744073709551615,\n  \"b\": [\n    -9223372036854775808,\n    9223372036854775808,\n    -9223372036854775808\n  ]\n}\n")
+	})
+	t.Run("decoding after long strings and whitespace", func(t *testing.T) {
+		long := strings.Repeat("x", 100)
+		nb := basicnode.Prototype.Any.NewBuilder()
+		err := Decoder(nb, strings.NewReader(`{"`+long+`":`+strings.Repeat(" \n\t", 20)+`18446744073709551615}`))
+		Require(t, err, ShouldEqual, nil)
+		u, err := must.Node(nb.Build().LookupByString(long)).(ipld.UintNode).AsUint()
+		Wish(t, err, ShouldEqual, nil)
+		Wish(t, u, ShouldEqual, uint64(math.MaxUint64))
+	})
+	t.Run("decoding at the end of input", func(t *testing.T) {
+		// Nothing is read past the end of the number here.
+		nb := basicnode.Prototype.Any.NewBuilder()
+		err := Decoder(nb, strings.NewReader(`9223372036854775808`))
+		Require(t, err, ShouldEqual, nil)
+		u, err := nb.Build().(ipld.UintNode).AsUint()
+		Wish(t, err, ShouldEqual, nil)
+		Wish(t, u, ShouldEqual, uint64(math.MaxInt64+1))
+	})
+	t.Run("decoding integers too large for a uint64", func(t *testing.T) {
+		// The last digits of these are a valid uint64, but they aren't one as a whole.
+		for _, serial := range []string{
+			`[118446744073709551615]`,
+			`[1` + strings.Repeat("0", 40) + `18446744073709551615]`,
+		} {
+			nb := basicnode.Prototype.Any.NewBuilder()
+			err := Decoder(nb, strings.NewReader(serial))
+			Require(t, err, ShouldEqual, nil)
+			Wish(t, must.Node(nb.Build().LookupByIndex(0)).ReprKind(), ShouldEqual, ipld.ReprKind_Float)
+		}
+	})
+}
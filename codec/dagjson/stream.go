@@ -0,0 +1,59 @@
+package dagjson
+
+import (
+	"fmt"
+	"io"
+
+	ipld "github.com/ipld/go-ipld-prime"
+)
+
+// DecodeStream is DecodeOptions{}.DecodeStream; see that method.
+func DecodeStream(r io.Reader, np ipld.NodePrototype, fn func(ipld.Node) error) error {
+	return DecodeOptions{}.DecodeStream(r, np, fn)
+}
+
+// DecodeStream decodes a sequence of DAG-JSON values from the reader,
+// building each one with a new builder from the NodePrototype,
+// and calling fn with each node as soon as it's complete.
+// The values may be separated by any amount of whitespace,
+// so newline-delimited logs work, as do values that span several lines.
+//
+// DecodeStream returns nil when the reader ends cleanly between values.
+// If the reader ends partway through a value, the error is io.ErrUnexpectedEOF.
+// If fn returns an error, decoding halts and that error is returned as-is.
+//
+// The options (budgets, limits, and so on) apply to each value separately.
+//
+// Use Decode instead when the reader should hold exactly one value --
+// for example, when the bytes are being hashed, and trailing content
+// would mean the hash isn't of the value that was decoded.
+func (opts DecodeOptions) DecodeStream(r io.Reader, np ipld.NodePrototype, fn func(ipld.Node) error) error {
+	// The same refmt decoder is used for every value, because it may have
+	// read a byte past the end of one value (e.g. after a number) which
+	// belongs to the next.  Reset just makes it ready for a new value.
+	dec := newUintDecoder(r)
+	for {
+		st := opts.newUnmarshalState()
+		done, err := dec.Step(&st.tk)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if done && !st.tk.Type.IsValue() {
+			return fmt.Errorf("unexpected eof")
+		}
+		nb := np.NewBuilder()
+		if err := st.unmarshal(nb, dec); err != nil {
+			if err == io.EOF {
+				return io.ErrUnexpectedEOF
+			}
+			return err
+		}
+		if err := fn(nb.Build()); err != nil {
+			return err
+		}
+		dec.Reset()
+	}
+}
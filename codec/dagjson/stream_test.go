@@ -0,0 +1,69 @@
+package dagjson
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	. "github.com/warpfork/go-wish"
+
+	ipld "github.com/ipld/go-ipld-prime"
+	basicnode "github.com/ipld/go-ipld-prime/node/basic"
+)
+
+func TestDecodeStream(t *testing.T) {
+	// collect decodes a stream, and re-encodes each node compactly,
+	// so the results are easy to compare.
+	collect := func(serial string) ([]string, error) {
+		var got []string
+		err := DecodeStream(strings.NewReader(serial), basicnode.Prototype.Any, func(n ipld.Node) error {
+			var buf bytes.Buffer
+			if err := (EncodeOptions{Compact: true}).Encode(n, &buf); err != nil {
+				return err
+			}
+			got = append(got, buf.String())
+			return nil
+		})
+		return got, err
+	}
+	t.Run("newline delimited", func(t *testing.T) {
+		got, err := collect("{\"a\":1}\n{\"b\":[2]}\n{\"/\":\"bafkqaaa\"}\n")
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, got, ShouldEqual, []string{`{"a":1}`, `{"b":[2]}`, `{"/":"bafkqaaa"}`})
+	})
+	t.Run("scalars and multiline values", func(t *testing.T) {
+		got, err := collect("1 2\n\"three\"\t[\n\t4\n]\n")
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, got, ShouldEqual, []string{`1`, `2`, `"three"`, `[4]`})
+	})
+	t.Run("empty", func(t *testing.T) {
+		got, err := collect(" \n")
+		Wish(t, err, ShouldEqual, nil)
+		Wish(t, len(got), ShouldEqual, 0)
+	})
+	t.Run("truncated", func(t *testing.T) {
+		got, err := collect("{\"a\":1}\n{\"b\":")
+		Wish(t, err, ShouldEqual, io.ErrUnexpectedEOF)
+		Wish(t, got, ShouldEqual, []string{`{"a":1}`})
+	})
+	t.Run("halted by callback", func(t *testing.T) {
+		stop := fmt.Errorf("stop")
+		calls := 0
+		err := DecodeStream(strings.NewReader("1\n2\n3\n"), basicnode.Prototype.Any, func(ipld.Node) error {
+			calls++
+			if calls == 2 {
+				return stop
+			}
+			return nil
+		})
+		Wish(t, err, ShouldEqual, stop)
+		Wish(t, calls, ShouldEqual, 2)
+	})
+	t.Run("decode stays strict", func(t *testing.T) {
+		nb := basicnode.Prototype.Any.NewBuilder()
+		err := Decoder(nb, strings.NewReader("{\"a\":1}\n{\"b\":2}\n"))
+		Wish(t, err != nil, ShouldEqual, true)
+	})
+}
@@ -0,0 +1,163 @@
+package dagjson
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+
+	"github.com/polydawn/refmt/json"
+	"github.com/polydawn/refmt/tok"
+)
+
+// The refmt json encoder and decoder only know about int64s:
+// the encoder has no case for TUint tokens at all,
+// and the decoder parses any integer too large for an int64 as a float (losing precision).
+// The types in this file wrap them, so that integers up to math.MaxUint64 survive the trip.
+// Encode and Decode use these; the Marshal and Unmarshal functions,
+// which work on whatever token sink or source they're given, can't.
+//
+// These wrappers depend on how refmt (at the version in go.mod, v0.0.0-20190807091052-3d65705ee9f1)
+// writes and reads numbers, as noted on each of them below.
+// TestRoundtripBigInts covers those details, so check it first when upgrading refmt.
+
+// uintEncoder is a token sink which handles TUint tokens,
+// and passes everything else to a refmt json encoder.
+//
+// The refmt encoder takes care of separators and indentation as usual:
+// for a TUint, it's given a TInt with a placeholder value,
+// and the placeholder's digits are swapped for the real ones on their way to the writer.
+// This relies on the encoder writing a number's digits in a single call, and nothing else in that call;
+// if it stops doing that, Step returns an error rather than writing the placeholder.
+type uintEncoder struct {
+	enc *json.Encoder
+	w   uintWriter
+}
+
+// uintPlaceholder is the value given to the refmt encoder in place of a uint.
+// It's the longest int64 there is, so it can't be mistaken for a separator or indentation.
+const uintPlaceholder = math.MinInt64
+
+var uintPlaceholderDigits = []byte(strconv.FormatInt(uintPlaceholder, 10))
+
+func newUintEncoder(w io.Writer, cfg json.EncodeOptions) *uintEncoder {
+	e := &uintEncoder{w: uintWriter{w: w}}
+	e.enc = json.NewEncoder(&e.w, cfg)
+	return e
+}
+
+func (e *uintEncoder) Step(tk *tok.Token) (bool, error) {
+	if tk.Type != tok.TUint {
+		return e.enc.Step(tk)
+	}
+	e.w.digits = strconv.AppendUint(e.w.digits[:0], tk.Uint, 10)
+	done, err := e.enc.Step(&tok.Token{Type: tok.TInt, Int: uintPlaceholder})
+	if err == nil && len(e.w.digits) != 0 {
+		err = fmt.Errorf("dagjson: cannot encode %d: the json encoder did not write it as a single number", tk.Uint)
+	}
+	e.w.digits = e.w.digits[:0]
+	return done, err
+}
+
+type uintWriter struct {
+	w      io.Writer
+	digits []byte // if set, replaces the next write of uintPlaceholderDigits.  Only set during uintEncoder.Step.
+}
+
+func (uw *uintWriter) Write(p []byte) (int, error) {
+	if len(uw.digits) == 0 || !bytes.Equal(p, uintPlaceholderDigits) {
+		return uw.w.Write(p)
+	}
+	_, err := uw.w.Write(uw.digits)
+	uw.digits = uw.digits[:0]
+	return len(p), err
+}
+
+// uintDecoder is a token source which wraps a refmt json decoder,
+// and turns any TFloat64 token that was actually an integer (too large for an int64)
+// back into a TUint, by looking at the last bytes the decoder read to produce it.
+//
+// This relies on the decoder reading a number's digits last,
+// followed by at most one byte past their end (see parseBigUint).
+type uintDecoder struct {
+	*json.Decoder
+	r recordingReader
+}
+
+func newUintDecoder(r io.Reader) *uintDecoder {
+	d := &uintDecoder{r: recordingReader{r: r}}
+	d.Decoder = json.NewDecoder(&d.r)
+	return d
+}
+
+func (d *uintDecoder) Step(tk *tok.Token) (bool, error) {
+	done, err := d.Decoder.Step(tk)
+	if err == nil && tk.Type == tok.TFloat64 {
+		if u, ok := parseBigUint(d.r.tail[:d.r.n], d.r.dropped); ok {
+			tk.Type = tok.TUint
+			tk.Uint = u
+		}
+	}
+	d.r.n, d.r.dropped = 0, false
+	return done, err
+}
+
+// recordingReader keeps the last bytes read through it in 'tail',
+// until they're cleared by the uintDecoder after each token.
+// Only the tail is kept -- enough for the digits of a uint64, the byte after them, and then some --
+// so that strings and whitespace aren't copied.
+type recordingReader struct {
+	r       io.Reader
+	tail    [32]byte
+	n       int  // how much of tail is in use.
+	dropped bool // whether bytes were read before the ones in tail.
+}
+
+func (rr *recordingReader) Read(p []byte) (int, error) {
+	n, err := rr.r.Read(p)
+	rr.record(p[:n])
+	return n, err
+}
+
+func (rr *recordingReader) record(p []byte) {
+	if len(p) >= len(rr.tail) {
+		rr.dropped = rr.dropped || rr.n > 0 || len(p) > len(rr.tail)
+		rr.n = copy(rr.tail[:], p[len(p)-len(rr.tail):])
+		return
+	}
+	if over := rr.n + len(p) - len(rr.tail); over > 0 {
+		rr.dropped = true
+		rr.n = copy(rr.tail[:], rr.tail[over:rr.n])
+	}
+	rr.n += copy(rr.tail[rr.n:], p)
+}
+
+// parseBigUint finds the number in the last bytes read for one token,
+// and parses it, if it's an integer too large for an int64 but not for a uint64.
+//
+// Besides the number itself, the bytes may start with whitespace and separators,
+// and end with one more byte (the decoder reads a byte past the end of a number,
+// to know it's ended; that byte is handed back, but it's already been seen here).
+// If earlier bytes were dropped, the number must not start right at the beginning:
+// it may be longer than what's left of it.
+func parseBigUint(seen []byte, dropped bool) (uint64, bool) {
+	trimmed := bytes.TrimLeft(seen, " \t\r\n,:[")
+	if dropped && len(trimmed) == len(seen) {
+		return 0, false
+	}
+	seen = trimmed
+	if n := len(seen); n > 0 && (seen[n-1] < '0' || seen[n-1] > '9') {
+		seen = seen[:n-1]
+	}
+	for _, b := range seen {
+		if b < '0' || b > '9' {
+			return 0, false
+		}
+	}
+	u, err := strconv.ParseUint(string(seen), 10, 64)
+	if err != nil || u <= math.MaxInt64 {
+		return 0, false
+	}
+	return u, true
+}
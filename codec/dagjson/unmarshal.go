@@ -1,7 +1,11 @@
 package dagjson
 
 import (
+	"encoding/base64"
+	"errors"
 	"fmt"
+	"math"
+	"strings"
 
 	cid "github.com/ipfs/go-cid"
 	"github.com/polydawn/refmt/shared"
@@ -9,159 +13,324 @@ import (
 
 	ipld "github.com/ipld/go-ipld-prime"
 	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	basicnode "github.com/ipld/go-ipld-prime/node/basic"
+)
+
+var (
+	ErrAllocationBudgetExceeded = errors.New("message structure demanded too many resources to process")
+	ErrMaxDepthExceeded         = errors.New("message structure is nested too deeply")
+)
+
+const (
+	mapEntryGasScore  = 8
+	listEntryGasScore = 4
 )
 
 // This drifts pretty far from the general unmarshal in the parent package:
 //   - we know JSON never has length hints, so we ignore that field in tokens;
 //   - we know JSON never has tags, so we ignore that field as well;
-//   - we have dag-json's special sauce for detecting schemafree links
+//   - we have dag-json's special sauce for detecting schemafree links and bytes
 //      (and this unfortunately turns out to *significantly* convolute the first
 //       several steps of handling maps, because it necessitates peeking several
 //        tokens before deciding what kind of value to create).
 
+// Unmarshal is DecodeOptions{}.Unmarshal; see that method.
 func Unmarshal(na ipld.NodeAssembler, tokSrc shared.TokenSource) error {
-	var st unmarshalState
-	done, err := tokSrc.Step(&st.tk[0])
+	return DecodeOptions{}.Unmarshal(na, tokSrc)
+}
+
+// Unmarshal feeds the data from a token source into a NodeAssembler,
+// according to the options.
+func (opts DecodeOptions) Unmarshal(na ipld.NodeAssembler, tokSrc shared.TokenSource) error {
+	st := opts.newUnmarshalState()
+	done, err := tokSrc.Step(&st.tk)
 	if err != nil {
 		return err
 	}
-	if done && !st.tk[0].Type.IsValue() {
+	if done && !st.tk.Type.IsValue() {
 		return fmt.Errorf("unexpected eof")
 	}
 	return st.unmarshal(na, tokSrc)
 }
 
 type unmarshalState struct {
-	tk    [4]tok.Token // mostly, only 0'th is used... but [1:4] are used during lookahead for links.
-	shift int          // how many times to slide something out of tk[1:4] instead of getting a new token.
+	tk    tok.Token    // the current token.
+	ahead [6]tok.Token // tokens peeked by specialLookahead, but not yet consumed.
+	n     int          // how many tokens are in 'ahead'.
+
+	opts  DecodeOptions
+	gas   int // decremented as we allocate memory; see DecodeOptions.AllocationBudget.
+	depth int // how many maps and lists we're inside of; see DecodeOptions.MaxDepth.
+}
+
+func (opts DecodeOptions) newUnmarshalState() *unmarshalState {
+	st := &unmarshalState{opts: opts, gas: opts.AllocationBudget}
+	if st.gas == 0 {
+		st.gas = DefaultAllocationBudget
+	}
+	return st
+}
+
+// spend decrements the gas budget, and errors if it's exceeded.
+func (st *unmarshalState) spend(gas int) error {
+	st.gas -= gas
+	if st.gas < 0 {
+		return ErrAllocationBudgetExceeded
+	}
+	return nil
+}
+
+// descend is called on entering a map or list, and checks the depth limit.
+// The caller must call ascend when leaving it again.
+func (st *unmarshalState) descend() error {
+	st.depth++
+	if st.opts.MaxDepth > 0 && st.depth > st.opts.MaxDepth {
+		return ErrMaxDepthExceeded
+	}
+	return nil
+}
+
+func (st *unmarshalState) ascend() {
+	st.depth--
+}
+
+// skip consumes the rest of a value whose first token is already in tk,
+// without assembling it anywhere.  It's used to discard the values of repeated map keys.
+// (Skipped values still count against the gas budget: they still had to be read.)
+func (st *unmarshalState) skip(tokSrc shared.TokenSource) error {
+	open := 0
+	for {
+		switch st.tk.Type {
+		case tok.TMapOpen, tok.TArrOpen:
+			if err := st.descend(); err != nil {
+				return err
+			}
+			open++
+		case tok.TMapClose, tok.TArrClose:
+			st.ascend()
+			open--
+		}
+		if err := st.spend(len(st.tk.Str) + 1); err != nil {
+			return err
+		}
+		if open == 0 {
+			return nil
+		}
+		if err := st.step(tokSrc); err != nil {
+			return err
+		}
+	}
 }
 
-// step leaves a "new" token in tk[0],
-// taking account of an shift left by linkLookahead.
-// It's only necessary to use this when handling maps,
-// since the situations resulting in nonzero shift are otherwise unreachable.
+// step leaves a "new" token in tk,
+// taking account of any tokens buffered by specialLookahead.
 //
-// At most, 'step' will be shifting buffered tokens for:
-//   - the first map key
-//   - the first map value (which will be a string)
-//   - the second map key
-// and so (fortunately! whew!) we can do this in a fixed amount of memory,
-// since none of those states can reach a recursion.
+// At most, we peek six tokens ahead (for bytes: the "/" key, the inner map open,
+// the "bytes" key, the string, and both map closes),
+// and so (fortunately! whew!) we can do this in a fixed amount of memory.
+// A recursion can begin while tokens are still buffered
+// (if something that started out like bytes turns out to be a regular map),
+// which is why all steps -- in maps, lists, and lookaheads alike -- must go through here (or through peek).
 func (st *unmarshalState) step(tokSrc shared.TokenSource) error {
-	switch st.shift {
-	case 0:
-		_, err := tokSrc.Step(&st.tk[0])
+	if st.n == 0 {
+		_, err := tokSrc.Step(&st.tk)
 		return err
-	case 1:
-		st.tk[0] = st.tk[1]
-		st.shift--
-		return nil
-	case 2:
-		st.tk[0] = st.tk[1]
-		st.tk[1] = st.tk[2]
-		st.shift--
-		return nil
-	case 3:
-		st.tk[0] = st.tk[1]
-		st.tk[1] = st.tk[2]
-		st.tk[2] = st.tk[3]
-		st.shift--
-		return nil
-	default:
-		panic("unreachable")
 	}
+	st.tk = st.ahead[0]
+	copy(st.ahead[:st.n-1], st.ahead[1:st.n])
+	st.n--
+	return nil
 }
 
-// linkLookahead is called after receiving a TMapOpen token;
-// when it returns, we will have either created a link, OR
-// it's not a link, and the caller should proceed to start a map
+// peek yields the i'th token after the current one, without consuming anything.
+func (st *unmarshalState) peek(tokSrc shared.TokenSource, i int) (*tok.Token, error) {
+	for st.n <= i {
+		if _, err := tokSrc.Step(&st.ahead[st.n]); err != nil {
+			return nil, err
+		}
+		st.n++
+	}
+	return &st.ahead[i], nil
+}
+
+// discard drops the first 'count' peeked tokens.
+func (st *unmarshalState) discard(count int) {
+	copy(st.ahead[:st.n-count], st.ahead[count:st.n])
+	st.n -= count
+}
+
+// specialLookahead is called after receiving a TMapOpen token;
+// when it returns, we will have either created a link or bytes, OR
+// it's neither, and the caller should proceed to start a map
 // and while using st.step to ensure the peeked tokens are handled, OR
 // in case of error, the error should just rise.
-// If the bool return is true, we got a link, and you should not
+// If the bool return is true, we got a link or bytes, and you should not
 // continue to attempt to build a map.
-func (st *unmarshalState) linkLookahead(na ipld.NodeAssembler, tokSrc shared.TokenSource) (bool, error) {
-	// Peek next token.  If it's a "/" string, link is still a possibility
-	_, err := tokSrc.Step(&st.tk[1])
+//
+// Links look like `{"/": "<cid>"}`;
+// bytes look like `{"/": {"bytes": "<base64>"}}`.
+func (st *unmarshalState) specialLookahead(na ipld.NodeAssembler, tokSrc shared.TokenSource) (bool, error) {
+	// Peek next token.  If it's a "/" string, link or bytes is still a possibility
+	tk, err := st.peek(tokSrc, 0)
 	if err != nil {
 		return false, err
 	}
-	if st.tk[1].Type != tok.TString {
-		st.shift = 1
+	if tk.Type != tok.TString || tk.Str != "/" {
 		return false, nil
 	}
-	if st.tk[1].Str != "/" {
-		st.shift = 1
-		return false, nil
-	}
-	// Peek next token.  If it's a string, link is still a possibility.
-	//  We won't try to parse it as a CID until we're sure it's the only thing in the map, though.
-	_, err = tokSrc.Step(&st.tk[2])
+	// Peek next token.  If it's a string, link is still a possibility;
+	//  if it's a map open, bytes are.
+	//  We won't try to parse the string as a CID until we're sure it's the only thing in the map, though.
+	tk, err = st.peek(tokSrc, 1)
 	if err != nil {
 		return false, err
 	}
-	if st.tk[2].Type != tok.TString {
-		st.shift = 2
+	switch tk.Type {
+	case tok.TString:
+		if st.opts.DontParseLinks {
+			return false, nil
+		}
+		return st.linkLookahead(na, tokSrc)
+	case tok.TMapOpen:
+		return st.bytesLookahead(na, tokSrc)
+	default:
 		return false, nil
 	}
+}
+
+func (st *unmarshalState) linkLookahead(na ipld.NodeAssembler, tokSrc shared.TokenSource) (bool, error) {
 	// Peek next token.  If it's map close, we've got a link!
 	//  (Otherwise it had better be a string, because another map key is the
 	//   only other valid transition here... but we'll leave that check to the caller.
-	_, err = tokSrc.Step(&st.tk[3])
+	tk, err := st.peek(tokSrc, 2)
 	if err != nil {
 		return false, err
 	}
-	if st.tk[3].Type != tok.TMapClose {
-		st.shift = 3
+	if tk.Type != tok.TMapClose {
 		return false, nil
 	}
 	// Okay, we made it -- this looks like a link.  Parse it.
 	//  If it *doesn't* parse as a CID, we treat this as an error.
-	elCid, err := cid.Decode(st.tk[2].Str)
+	elCid, err := cid.Decode(st.ahead[1].Str)
 	if err != nil {
 		return false, err
 	}
+	st.discard(3)
 	if err := na.AssignLink(cidlink.Link{elCid}); err != nil {
 		return false, err
 	}
 	return true, nil
+}
 
+func (st *unmarshalState) bytesLookahead(na ipld.NodeAssembler, tokSrc shared.TokenSource) (bool, error) {
+	// The inner map must have exactly one entry: "bytes", with a string value...
+	tk, err := st.peek(tokSrc, 2)
+	if err != nil {
+		return false, err
+	}
+	if tk.Type != tok.TString || tk.Str != "bytes" {
+		return false, nil
+	}
+	tk, err = st.peek(tokSrc, 3)
+	if err != nil {
+		return false, err
+	}
+	if tk.Type != tok.TString {
+		return false, nil
+	}
+	tk, err = st.peek(tokSrc, 4)
+	if err != nil {
+		return false, err
+	}
+	if tk.Type != tok.TMapClose {
+		return false, nil
+	}
+	// ... and the outer map must end right after it.
+	tk, err = st.peek(tokSrc, 5)
+	if err != nil {
+		return false, err
+	}
+	if tk.Type != tok.TMapClose {
+		return false, nil
+	}
+	// Okay, we made it -- this looks like bytes.  Decode them.
+	//  If they *don't* decode as base64, we treat this as an error.
+	//  (Padding is accepted, although we never emit it.)
+	if err := st.spend(len(st.ahead[3].Str)); err != nil {
+		return false, err
+	}
+	bs, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(st.ahead[3].Str, "="))
+	if err != nil {
+		return false, err
+	}
+	st.discard(6)
+	if err := na.AssignBytes(bs); err != nil {
+		return false, err
+	}
+	return true, nil
 }
 
 // starts with the first token already primed.  Necessary to get recursion
 //  to flow right without a peek+unpeek system.
 func (st *unmarshalState) unmarshal(na ipld.NodeAssembler, tokSrc shared.TokenSource) error {
 	// FUTURE: check for schema.TypedNodeBuilder that's going to parse a Link (they can slurp any token kind they want).
-	switch st.tk[0].Type {
+	switch st.tk.Type {
 	case tok.TMapOpen:
-		// dag-json has special needs: we pump a few tokens ahead to look for dag-json's "link" pattern.
-		//  We can't actually call BeginMap until we're sure it's not gonna turn out to be a link.
-		gotLink, err := st.linkLookahead(na, tokSrc)
-		if err != nil { // return in error if any token peeks failed or if structure looked like a link but failed to parse as CID.
+		// dag-json has special needs: we pump a few tokens ahead to look for dag-json's "link" and "bytes" patterns.
+		//  We can't actually call BeginMap until we're sure it's not gonna turn out to be a link or bytes.
+		gotSpecial, err := st.specialLookahead(na, tokSrc)
+		if err != nil { // return in error if any token peeks failed or if structure looked like a link or bytes but failed to parse.
 			return err
 		}
-		if gotLink {
+		if gotSpecial {
 			return nil
 		}
 
 		// Okay, now back to regularly scheduled map logic.
+		if err := st.descend(); err != nil {
+			return err
+		}
+		defer st.ascend()
 		ma, err := na.BeginMap(-1)
 		if err != nil {
 			return err
 		}
+		var seen map[string]struct{}
+		if st.opts.DuplicateKeys != DuplicateKeys_Assembler {
+			seen = make(map[string]struct{})
+		}
 		for {
 			err := st.step(tokSrc) // shift next token into slot 0.
 			if err != nil {        // return in error if next token unreadable
 				return err
 			}
-			switch st.tk[0].Type {
+			switch st.tk.Type {
 			case tok.TMapClose:
 				return ma.Finish()
 			case tok.TString:
 				// continue
 			default:
-				return fmt.Errorf("unexpected %s token while expecting map key", st.tk[0].Type)
+				return fmt.Errorf("unexpected %s token while expecting map key", st.tk.Type)
 			}
-			mva, err := ma.AssembleEntry(st.tk[0].Str)
+			if err := st.spend(len(st.tk.Str) + mapEntryGasScore); err != nil {
+				return err
+			}
+			if seen != nil {
+				if _, exists := seen[st.tk.Str]; exists {
+					if st.opts.DuplicateKeys == DuplicateKeys_Reject {
+						return ipld.ErrRepeatedMapKey{Key: basicnode.NewString(st.tk.Str)}
+					}
+					if err := st.step(tokSrc); err != nil {
+						return err
+					}
+					if err := st.skip(tokSrc); err != nil {
+						return err
+					}
+					continue
+				}
+				seen[st.tk.Str] = struct{}{}
+			}
+			mva, err := ma.AssembleEntry(st.tk.Str)
 			if err != nil { // return in error if the key was rejected
 				return err
 			}
@@ -178,19 +347,26 @@ func (st *unmarshalState) unmarshal(na ipld.NodeAssembler, tokSrc shared.TokenSo
 	case tok.TMapClose:
 		return fmt.Errorf("unexpected mapClose token")
 	case tok.TArrOpen:
+		if err := st.descend(); err != nil {
+			return err
+		}
+		defer st.ascend()
 		la, err := na.BeginList(-1)
 		if err != nil {
 			return err
 		}
 		for {
-			_, err := tokSrc.Step(&st.tk[0])
+			err := st.step(tokSrc)
 			if err != nil {
 				return err
 			}
-			switch st.tk[0].Type {
+			switch st.tk.Type {
 			case tok.TArrClose:
 				return la.Finish()
 			default:
+				if err := st.spend(listEntryGasScore); err != nil {
+					return err
+				}
 				err := st.unmarshal(la.AssembleValue(), tokSrc)
 				if err != nil { // return in error if some part of the recursion errored
 					return err
@@ -202,17 +378,23 @@ func (st *unmarshalState) unmarshal(na ipld.NodeAssembler, tokSrc shared.TokenSo
 	case tok.TNull:
 		return na.AssignNull()
 	case tok.TString:
-		return na.AssignString(st.tk[0].Str)
+		if err := st.spend(len(st.tk.Str)); err != nil {
+			return err
+		}
+		return na.AssignString(st.tk.Str)
 	case tok.TBytes:
-		return na.AssignBytes(st.tk[0].Bytes)
+		return na.AssignBytes(st.tk.Bytes)
 	case tok.TBool:
-		return na.AssignBool(st.tk[0].Bool)
+		return na.AssignBool(st.tk.Bool)
 	case tok.TInt:
-		return na.AssignInt(int(st.tk[0].Int)) // FIXME overflow check
+		return na.AssignInt(st.tk.Int)
 	case tok.TUint:
-		return na.AssignInt(int(st.tk[0].Uint)) // FIXME overflow check
+		if st.tk.Uint > math.MaxInt64 {
+			return na.AssignNode(basicnode.NewUint(st.tk.Uint))
+		}
+		return na.AssignInt(int64(st.tk.Uint))
 	case tok.TFloat64:
-		return na.AssignFloat(st.tk[0].Float64)
+		return na.AssignFloat(st.tk.Float64)
 	default:
 		panic("unreachable")
 	}
@@ -0,0 +1,200 @@
+package dagpb
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"io"
+	"testing"
+
+	cid "github.com/ipfs/go-cid"
+	. "github.com/warpfork/go-wish"
+
+	ipld "github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/fluent"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	"github.com/ipld/go-ipld-prime/must"
+	basicnode "github.com/ipld/go-ipld-prime/node/basic"
+	typednode "github.com/ipld/go-ipld-prime/node/typed"
+)
+
+// An empty UnixFS directory, and a directory containing it as "foo".
+var (
+	emptyDirSerial = "\x0a\x02\x08\x01"
+	emptyDirCid    = "QmUNLLsPACCz1vLxQVkXqqLX5R1X345qqfHbsf67hvA3Nn"
+	dirSerial, _   = hex.DecodeString("122b0a22122059948439065f29619ef41280cbb932be52c56d99c5966b65e0111239f098bbef1203666f6f18040a020801")
+	dirCid         = "QmSzQpWhK1jbLofRWBoWr1VUsKhYU9GeHWCPf31Hb653XM"
+)
+
+var emptyDirLink = func() ipld.Link {
+	c, err := cid.Decode(emptyDirCid)
+	if err != nil {
+		panic(err)
+	}
+	return cidlink.Link{Cid: c}
+}()
+
+var lbV0 = cidlink.LinkBuilder{Prefix: cid.Prefix{
+	Version:  0,
+	Codec:    0x70,
+	MhType:   0x12,
+	MhLength: 32,
+}}
+
+func buildDir(np ipld.NodePrototype) ipld.Node {
+	return fluent.MustBuildMap(np, 2, func(na fluent.MapAssembler) {
+		na.AssembleEntry("Links").CreateList(1, func(na fluent.ListAssembler) {
+			na.AssembleValue().CreateMap(3, func(na fluent.MapAssembler) {
+				na.AssembleEntry("Hash").AssignLink(emptyDirLink)
+				na.AssembleEntry("Name").AssignString("foo")
+				na.AssembleEntry("Tsize").AssignInt(4)
+			})
+		})
+		na.AssembleEntry("Data").AssignBytes([]byte{0x08, 0x01})
+	})
+}
+
+func TestRoundtrip(t *testing.T) {
+	t.Run("empty links", func(t *testing.T) {
+		nb := basicnode.Prototype.Any.NewBuilder()
+		err := Decoder(nb, bytes.NewBufferString(emptyDirSerial))
+		Require(t, err, ShouldEqual, nil)
+		n := nb.Build()
+		Wish(t, must.Node(n.LookupByString("Links")).Length(), ShouldEqual, 0)
+		Wish(t, must.Node(n.LookupByString("Data")), ShouldEqual, basicnode.NewBytes([]byte{0x08, 0x01}))
+
+		var buf bytes.Buffer
+		err = Encoder(n, &buf)
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, buf.String(), ShouldEqual, emptyDirSerial)
+	})
+	t.Run("no data", func(t *testing.T) {
+		nb := basicnode.Prototype.Any.NewBuilder()
+		err := Decoder(nb, bytes.NewBufferString(""))
+		Require(t, err, ShouldEqual, nil)
+		n := nb.Build()
+		Wish(t, n.Length(), ShouldEqual, 1)
+		_, err = n.LookupByString("Data")
+		Wish(t, err, ShouldBeSameTypeAs, ipld.ErrNotExists{})
+	})
+	t.Run("with links", func(t *testing.T) {
+		n := buildDir(basicnode.Prototype.Map)
+		var buf bytes.Buffer
+		err := Encoder(n, &buf)
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, buf.Bytes(), ShouldEqual, dirSerial)
+
+		nb := basicnode.Prototype.Any.NewBuilder()
+		err = Decoder(nb, bytes.NewReader(dirSerial))
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, nb.Build(), ShouldEqual, n)
+	})
+	t.Run("with typed nodes", func(t *testing.T) {
+		np, err := typednode.NewPrototype(TypeSystem(), "PBNode")
+		Require(t, err, ShouldEqual, nil)
+		n := buildDir(np)
+		var buf bytes.Buffer
+		err = Encoder(n, &buf)
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, buf.Bytes(), ShouldEqual, dirSerial)
+
+		nb := np.Representation().NewBuilder()
+		err = Decoder(nb, bytes.NewReader(dirSerial))
+		Require(t, err, ShouldEqual, nil)
+		link := must.Node(must.Node(nb.Build().LookupByString("Links")).LookupByIndex(0))
+		Wish(t, must.String(must.Node(link.LookupByString("Name"))), ShouldEqual, "foo")
+		Wish(t, must.Int(must.Node(link.LookupByString("Tsize"))), ShouldEqual, int64(4))
+	})
+}
+
+func TestLinks(t *testing.T) {
+	blocks := map[string][]byte{}
+	storer := func(ipld.LinkContext) (io.Writer, ipld.StoreCommitter, error) {
+		var buf bytes.Buffer
+		return &buf, func(lnk ipld.Link) error {
+			blocks[lnk.String()] = buf.Bytes()
+			return nil
+		}, nil
+	}
+	loader := func(lnk ipld.Link, _ ipld.LinkContext) (io.Reader, error) {
+		return bytes.NewReader(blocks[lnk.String()]), nil
+	}
+
+	emptyDir := fluent.MustBuildMap(basicnode.Prototype.Map, 2, func(na fluent.MapAssembler) {
+		na.AssembleEntry("Links").CreateList(0, func(na fluent.ListAssembler) {})
+		na.AssembleEntry("Data").AssignBytes([]byte{0x08, 0x01})
+	})
+	lnk, err := lbV0.Build(context.Background(), ipld.LinkContext{}, emptyDir, storer)
+	Require(t, err, ShouldEqual, nil)
+	Wish(t, lnk.String(), ShouldEqual, emptyDirCid)
+	lnk, err = lbV0.Build(context.Background(), ipld.LinkContext{}, buildDir(basicnode.Prototype.Map), storer)
+	Require(t, err, ShouldEqual, nil)
+	Wish(t, lnk.String(), ShouldEqual, dirCid)
+
+	// Load the directory, and then follow its link.
+	nb := basicnode.Prototype.Any.NewBuilder()
+	err = lnk.Load(context.Background(), ipld.LinkContext{}, nb, loader)
+	Require(t, err, ShouldEqual, nil)
+	child, err := must.Node(must.Node(must.Node(nb.Build().LookupByString("Links")).LookupByIndex(0)).LookupByString("Hash")).AsLink()
+	Require(t, err, ShouldEqual, nil)
+	Wish(t, child, ShouldEqual, emptyDirLink)
+	nb = basicnode.Prototype.Any.NewBuilder()
+	err = child.Load(context.Background(), ipld.LinkContext{}, nb, loader)
+	Require(t, err, ShouldEqual, nil)
+	Wish(t, nb.Build(), ShouldEqual, emptyDir)
+}
+
+func TestDecodeRejects(t *testing.T) {
+	link := string(dirSerial[:0x2d])
+	for _, tcase := range []struct {
+		name   string
+		serial string
+		err    string
+	}{
+		{"data before links", emptyDirSerial + link, "invalid dag-pb data: Links field after Data field"},
+		{"repeated data", emptyDirSerial + emptyDirSerial, "invalid dag-pb data: repeated Data field"},
+		{"unknown field", "\x1a\x00", "invalid dag-pb data: unknown PBNode field 3"},
+		{"wrong wire type", "\x08\x01", "invalid dag-pb data: PBNode field 1 has wire type 0, not 2"},
+		{"truncated", "\x0a\x05\x08\x01", "invalid dag-pb data: unexpected end of data in field of length 5"},
+		{"truncated varint", "\x0a\x80", "invalid dag-pb data: unexpected end of data in varint"},
+		{"non-minimal varint", "\x0a\x82\x00\x08\x01", "invalid dag-pb data: varint is not in its shortest form"},
+		{"link without hash", "\x12\x05\x12\x03foo", "invalid dag-pb data: PBLink has no Hash"},
+		{"link fields out of order", "\x12\x29\x12\x03foo\x0a\x22" + link[4:38], "invalid dag-pb data: PBLink field 1 is repeated or out of order"},
+		{"link hash not a cid", "\x12\x04\x0a\x02\x12\x20", "invalid dag-pb data: PBLink Hash is not a CID: expected 1 as the cid version number, got: 18"},
+	} {
+		t.Run(tcase.name, func(t *testing.T) {
+			nb := basicnode.Prototype.Any.NewBuilder()
+			err := Decoder(nb, bytes.NewBufferString(tcase.serial))
+			Require(t, err != nil, ShouldEqual, true)
+			Wish(t, err.Error(), ShouldEqual, tcase.err)
+		})
+	}
+}
+
+func TestEncodeRejects(t *testing.T) {
+	for _, tcase := range []struct {
+		name string
+		n    ipld.Node
+		err  string
+	}{
+		{"not a map", basicnode.NewString("x"), "invalid dag-pb data: PBNode must be a map, not string"},
+		{"no links", fluent.MustBuildMap(basicnode.Prototype.Map, 0, func(na fluent.MapAssembler) {}), "invalid dag-pb data: PBNode has no Links"},
+		{"unknown field", fluent.MustBuildMap(basicnode.Prototype.Map, 2, func(na fluent.MapAssembler) {
+			na.AssembleEntry("Links").CreateList(0, func(na fluent.ListAssembler) {})
+			na.AssembleEntry("Extra").AssignBool(true)
+		}), `invalid dag-pb data: unknown PBNode field "Extra"`},
+		{"link without hash", fluent.MustBuildMap(basicnode.Prototype.Map, 1, func(na fluent.MapAssembler) {
+			na.AssembleEntry("Links").CreateList(1, func(na fluent.ListAssembler) {
+				na.AssembleValue().CreateMap(1, func(na fluent.MapAssembler) {
+					na.AssembleEntry("Name").AssignString("foo")
+				})
+			})
+		}), "invalid dag-pb data: PBLink has no Hash"},
+	} {
+		t.Run(tcase.name, func(t *testing.T) {
+			_, err := Marshal(tcase.n)
+			Require(t, err != nil, ShouldEqual, true)
+			Wish(t, err.Error(), ShouldEqual, tcase.err)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+/*
+	The dagpb package implements the DAG-PB codec (multicodec 0x70):
+	the protobuf format of the blocks of UnixFS, and of IPFS's original merkledag.
+
+	DAG-PB can only hold data of one shape, which looks like this in the Data Model:
+
+		{
+			"Links": [
+				{"Hash": <link>, "Name": "some-name", "Tsize": 123},
+				...
+			],
+			"Data": <bytes>
+		}
+
+	"Links" is always present (though it may be empty);
+	"Data" is absent if the block has no data field.
+	In each link, "Hash" is always present, while "Name" and "Tsize" are absent
+	if the block doesn't have them.
+	This is the shape described by the PBNode type in TypeSystem,
+	so the typednode package can be used with it
+	to check data against the shape while it's built.
+
+	Encoding is strict, so that equal data always makes the same bytes,
+	and blocks made by other DAG-PB implementations are reproduced byte for byte:
+	the fields are always written in the same order (links first, then data;
+	and hash, name, then size within each link), and nothing else is written.
+	Links are written in the order they're found in the list -- they're not sorted.
+	(Implementations which sort links by name do so when building the node, not when encoding it.)
+
+	Decoding is strict in the same way: fields out of order, repeated fields,
+	unknown fields, and links without a hash are all rejected.
+
+	Importing this package registers the codec with cidlink,
+	so links to DAG-PB blocks can be loaded (and so traversed) like any other.
+*/
+package dagpb
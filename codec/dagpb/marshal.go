@@ -0,0 +1,129 @@
+package dagpb
+
+import (
+	ipld "github.com/ipld/go-ipld-prime"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+)
+
+// Marshal encodes a node of the shape described in the package docs as a DAG-PB block.
+//
+// Nodes of any other shape are rejected: the map must have a "Links" list,
+// may have "Data" bytes, and may have nothing else;
+// and likewise for the links' "Hash", "Name", and "Tsize".
+// Absent values (as found in typed nodes with optional fields) are treated as missing.
+// Hashes must be CID links.
+func Marshal(n ipld.Node) ([]byte, error) {
+	if n.ReprKind() != ipld.ReprKind_Map {
+		return nil, invalid("PBNode must be a map, not %s", n.ReprKind())
+	}
+	var links, data ipld.Node
+	for itr := n.MapIterator(); !itr.Done(); {
+		k, v, err := itr.Next()
+		if err != nil {
+			return nil, err
+		}
+		ks, err := k.AsString()
+		if err != nil {
+			return nil, err
+		}
+		if v.IsAbsent() {
+			continue
+		}
+		switch ks {
+		case "Links":
+			links = v
+		case "Data":
+			data = v
+		default:
+			return nil, invalid("unknown PBNode field %q", ks)
+		}
+	}
+	if links == nil {
+		return nil, invalid("PBNode has no Links")
+	}
+	if links.ReprKind() != ipld.ReprKind_List {
+		return nil, invalid("PBNode Links must be a list, not %s", links.ReprKind())
+	}
+
+	var buf, linkBuf []byte
+	for itr := links.ListIterator(); !itr.Done(); {
+		_, link, err := itr.Next()
+		if err != nil {
+			return nil, err
+		}
+		linkBuf, err = marshalLink(linkBuf[:0], link)
+		if err != nil {
+			return nil, err
+		}
+		buf = appendBytes(buf, fieldPBNodeLinks, linkBuf)
+	}
+	if data != nil {
+		bs, err := data.AsBytes()
+		if err != nil {
+			return nil, invalid("PBNode Data must be bytes, not %s", data.ReprKind())
+		}
+		buf = appendBytes(buf, fieldPBNodeData, bs)
+	}
+	return buf, nil
+}
+
+func marshalLink(buf []byte, n ipld.Node) ([]byte, error) {
+	if n.ReprKind() != ipld.ReprKind_Map {
+		return nil, invalid("PBLink must be a map, not %s", n.ReprKind())
+	}
+	var hash, name, tsize ipld.Node
+	for itr := n.MapIterator(); !itr.Done(); {
+		k, v, err := itr.Next()
+		if err != nil {
+			return nil, err
+		}
+		ks, err := k.AsString()
+		if err != nil {
+			return nil, err
+		}
+		if v.IsAbsent() {
+			continue
+		}
+		switch ks {
+		case "Hash":
+			hash = v
+		case "Name":
+			name = v
+		case "Tsize":
+			tsize = v
+		default:
+			return nil, invalid("unknown PBLink field %q", ks)
+		}
+	}
+	if hash == nil {
+		return nil, invalid("PBLink has no Hash")
+	}
+	lnk, err := hash.AsLink()
+	if err != nil {
+		return nil, invalid("PBLink Hash must be a link, not %s", hash.ReprKind())
+	}
+	cl, ok := lnk.(cidlink.Link)
+	if !ok {
+		return nil, invalid("PBLink Hash must be a CID link")
+	}
+	buf = appendBytes(buf, fieldPBLinkHash, cl.Bytes())
+	if name != nil {
+		s, err := name.AsString()
+		if err != nil {
+			return nil, invalid("PBLink Name must be a string, not %s", name.ReprKind())
+		}
+		buf = appendBytes(buf, fieldPBLinkName, []byte(s))
+	}
+	if tsize != nil {
+		v, err := tsize.AsInt()
+		if err != nil {
+			return nil, invalid("PBLink Tsize must be an int, not %s", tsize.ReprKind())
+		}
+		if v < 0 {
+			return nil, invalid("PBLink Tsize must not be negative")
+		}
+		buf = appendKey(buf, fieldPBLinkTsize, wireTypeVarint)
+		buf = appendVarint(buf, uint64(v))
+	}
+	return buf, nil
+}
@@ -0,0 +1,46 @@
+package dagpb
+
+import (
+	"io"
+	"io/ioutil"
+
+	ipld "github.com/ipld/go-ipld-prime"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+)
+
+var (
+	_ cidlink.MulticodecDecoder = Decoder
+	_ cidlink.MulticodecEncoder = Encoder
+)
+
+func init() {
+	cidlink.RegisterMulticodecDecoder(0x70, Decoder)
+	cidlink.RegisterMulticodecEncoder(0x70, Encoder)
+}
+
+// byteAccessor is a reader interface that can access underlying bytes.
+type byteAccessor interface {
+	Bytes() []byte
+}
+
+func Decoder(na ipld.NodeAssembler, r io.Reader) error {
+	// DAG-PB blocks are small, and parsing is simplest with the whole block in hand,
+	//  so that's what we do; we use the reader's own buffer, if it has one.
+	if buf, ok := r.(byteAccessor); ok {
+		return Unmarshal(na, buf.Bytes())
+	}
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	return Unmarshal(na, data)
+}
+
+func Encoder(n ipld.Node, w io.Writer) error {
+	data, err := Marshal(n)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
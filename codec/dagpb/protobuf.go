@@ -0,0 +1,96 @@
+package dagpb
+
+import (
+	"fmt"
+)
+
+// This file contains the few bits of the protobuf wire format that DAG-PB needs:
+// varints, and length-delimited fields.  That's all of it -- DAG-PB uses no other wire types --
+// so we do without a general protobuf library.
+
+const (
+	wireTypeVarint = 0
+	wireTypeBytes  = 2
+)
+
+// Field numbers.  (Note that in PBNode, Links are always written before Data,
+// despite having the higher field number.)
+const (
+	fieldPBNodeData  = 1
+	fieldPBNodeLinks = 2
+	fieldPBLinkHash  = 1
+	fieldPBLinkName  = 2
+	fieldPBLinkTsize = 3
+)
+
+func invalid(format string, args ...interface{}) error {
+	return fmt.Errorf("invalid dag-pb data: "+format, args...)
+}
+
+// decodeVarint decodes the varint at the start of data,
+// returning its value and the number of bytes it took.
+// Varints which aren't in their shortest form are rejected,
+// so that anything we decode re-encodes to exactly the same bytes.
+func decodeVarint(data []byte) (uint64, int, error) {
+	var v uint64
+	for i := 0; i < len(data); i++ {
+		if i == 10 {
+			return 0, 0, invalid("varint overflows 64 bits")
+		}
+		b := data[i]
+		if i == 9 && b > 1 {
+			return 0, 0, invalid("varint overflows 64 bits")
+		}
+		v |= uint64(b&0x7f) << (7 * uint(i))
+		if b < 0x80 {
+			if i > 0 && b == 0 {
+				return 0, 0, invalid("varint is not in its shortest form")
+			}
+			return v, i + 1, nil
+		}
+	}
+	return 0, 0, invalid("unexpected end of data in varint")
+}
+
+// decodeKey decodes a field key: a field number and a wire type.
+func decodeKey(data []byte) (int, int, int, error) {
+	v, n, err := decodeVarint(data)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	if v>>3 > 1<<29 {
+		return 0, 0, 0, invalid("field number out of range")
+	}
+	return int(v >> 3), int(v & 0x7), n, nil
+}
+
+// decodeBytes decodes a length-delimited field's content.
+// The returned slice shares memory with data.
+func decodeBytes(data []byte) ([]byte, int, error) {
+	l, n, err := decodeVarint(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	if l > uint64(len(data)-n) {
+		return nil, 0, invalid("unexpected end of data in field of length %d", l)
+	}
+	return data[n : n+int(l)], n + int(l), nil
+}
+
+func appendVarint(buf []byte, v uint64) []byte {
+	for v >= 0x80 {
+		buf = append(buf, byte(v)|0x80)
+		v >>= 7
+	}
+	return append(buf, byte(v))
+}
+
+func appendKey(buf []byte, field int, wireType int) []byte {
+	return appendVarint(buf, uint64(field)<<3|uint64(wireType))
+}
+
+func appendBytes(buf []byte, field int, v []byte) []byte {
+	buf = appendKey(buf, field, wireTypeBytes)
+	buf = appendVarint(buf, uint64(len(v)))
+	return append(buf, v...)
+}
@@ -0,0 +1,46 @@
+package dagpb
+
+import (
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+// TypeSystem returns a TypeSystem containing the types describing DAG-PB data:
+// PBNode (the type of a whole block) and PBLink.
+//
+// In the schema DSL, they're:
+//
+//	type PBNode struct {
+//		Links [PBLink]
+//		Data optional Bytes
+//	}
+//
+//	type PBLink struct {
+//		Hash Link
+//		Name optional String
+//		Tsize optional Int
+//	}
+func TypeSystem() *schema.TypeSystem {
+	ts := schema.TypeSystem{}
+	ts.Init()
+	ts.Accumulate(schema.SpawnString("String"))
+	ts.Accumulate(schema.SpawnInt("Int"))
+	ts.Accumulate(schema.SpawnBytes("Bytes"))
+	ts.Accumulate(schema.SpawnLink("Link"))
+	ts.Accumulate(schema.SpawnList("List__PBLink", "PBLink", false))
+	ts.Accumulate(schema.SpawnStruct("PBNode",
+		[]schema.StructField{
+			schema.SpawnStructField("Links", "List__PBLink", false, false),
+			schema.SpawnStructField("Data", "Bytes", true, false),
+		},
+		schema.SpawnStructRepresentationMap(nil),
+	))
+	ts.Accumulate(schema.SpawnStruct("PBLink",
+		[]schema.StructField{
+			schema.SpawnStructField("Hash", "Link", false, false),
+			schema.SpawnStructField("Name", "String", true, false),
+			schema.SpawnStructField("Tsize", "Int", true, false),
+		},
+		schema.SpawnStructRepresentationMap(nil),
+	))
+	return &ts
+}
@@ -0,0 +1,202 @@
+package dagpb
+
+import (
+	"math"
+
+	cid "github.com/ipfs/go-cid"
+
+	ipld "github.com/ipld/go-ipld-prime"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+)
+
+// pbLink holds a decoded link until it's assembled.
+type pbLink struct {
+	hash     cid.Cid
+	name     string
+	hasName  bool
+	tsize    int64
+	hasTsize bool
+}
+
+// Unmarshal decodes a DAG-PB block, and assembles it into the given NodeAssembler,
+// in the shape described in the package docs.
+//
+// The whole block is parsed before anything is assembled,
+// so invalid blocks are rejected without having assembled anything.
+// The assembled Data bytes share memory with the data parameter.
+func Unmarshal(na ipld.NodeAssembler, data []byte) error {
+	var links []pbLink
+	var pbData []byte
+	var hasData bool
+	for len(data) > 0 {
+		field, wireType, n, err := decodeKey(data)
+		if err != nil {
+			return err
+		}
+		data = data[n:]
+		switch field {
+		case fieldPBNodeData, fieldPBNodeLinks:
+			if wireType != wireTypeBytes {
+				return invalid("PBNode field %d has wire type %d, not %d", field, wireType, wireTypeBytes)
+			}
+		default:
+			return invalid("unknown PBNode field %d", field)
+		}
+		chunk, n, err := decodeBytes(data)
+		if err != nil {
+			return err
+		}
+		data = data[n:]
+		if hasData {
+			if field == fieldPBNodeData {
+				return invalid("repeated Data field")
+			}
+			return invalid("Links field after Data field")
+		}
+		switch field {
+		case fieldPBNodeData:
+			pbData, hasData = chunk, true
+		case fieldPBNodeLinks:
+			link, err := unmarshalLink(chunk)
+			if err != nil {
+				return err
+			}
+			links = append(links, link)
+		}
+	}
+
+	// Parsing is done; assemble.
+	mapLen := 1
+	if hasData {
+		mapLen = 2
+	}
+	ma, err := na.BeginMap(mapLen)
+	if err != nil {
+		return err
+	}
+	va, err := ma.AssembleEntry("Links")
+	if err != nil {
+		return err
+	}
+	la, err := va.BeginList(len(links))
+	if err != nil {
+		return err
+	}
+	for _, link := range links {
+		if err := assembleLink(la.AssembleValue(), link); err != nil {
+			return err
+		}
+	}
+	if err := la.Finish(); err != nil {
+		return err
+	}
+	if hasData {
+		va, err := ma.AssembleEntry("Data")
+		if err != nil {
+			return err
+		}
+		if err := va.AssignBytes(pbData); err != nil {
+			return err
+		}
+	}
+	return ma.Finish()
+}
+
+func unmarshalLink(data []byte) (pbLink, error) {
+	var link pbLink
+	var hasHash bool
+	lastField := 0
+	for len(data) > 0 {
+		field, wireType, n, err := decodeKey(data)
+		if err != nil {
+			return link, err
+		}
+		data = data[n:]
+		switch field {
+		case fieldPBLinkHash, fieldPBLinkName, fieldPBLinkTsize:
+			if field <= lastField {
+				return link, invalid("PBLink field %d is repeated or out of order", field)
+			}
+		default:
+			return link, invalid("unknown PBLink field %d", field)
+		}
+		lastField = field
+		switch field {
+		case fieldPBLinkHash, fieldPBLinkName:
+			if wireType != wireTypeBytes {
+				return link, invalid("PBLink field %d has wire type %d, not %d", field, wireType, wireTypeBytes)
+			}
+			chunk, n, err := decodeBytes(data)
+			if err != nil {
+				return link, err
+			}
+			data = data[n:]
+			if field == fieldPBLinkHash {
+				c, err := cid.Cast(chunk)
+				if err != nil {
+					return link, invalid("PBLink Hash is not a CID: %s", err)
+				}
+				link.hash, hasHash = c, true
+			} else {
+				link.name, link.hasName = string(chunk), true
+			}
+		case fieldPBLinkTsize:
+			if wireType != wireTypeVarint {
+				return link, invalid("PBLink field %d has wire type %d, not %d", field, wireType, wireTypeVarint)
+			}
+			v, n, err := decodeVarint(data)
+			if err != nil {
+				return link, err
+			}
+			data = data[n:]
+			if v > math.MaxInt64 {
+				return link, invalid("PBLink Tsize %d is too large", v)
+			}
+			link.tsize, link.hasTsize = int64(v), true
+		}
+	}
+	if !hasHash {
+		return link, invalid("PBLink has no Hash")
+	}
+	return link, nil
+}
+
+func assembleLink(na ipld.NodeAssembler, link pbLink) error {
+	mapLen := 1
+	if link.hasName {
+		mapLen++
+	}
+	if link.hasTsize {
+		mapLen++
+	}
+	ma, err := na.BeginMap(mapLen)
+	if err != nil {
+		return err
+	}
+	va, err := ma.AssembleEntry("Hash")
+	if err != nil {
+		return err
+	}
+	if err := va.AssignLink(cidlink.Link{Cid: link.hash}); err != nil {
+		return err
+	}
+	if link.hasName {
+		va, err := ma.AssembleEntry("Name")
+		if err != nil {
+			return err
+		}
+		if err := va.AssignString(link.name); err != nil {
+			return err
+		}
+	}
+	if link.hasTsize {
+		va, err := ma.AssembleEntry("Tsize")
+		if err != nil {
+			return err
+		}
+		if err := va.AssignInt(link.tsize); err != nil {
+			return err
+		}
+	}
+	return ma.Finish()
+}
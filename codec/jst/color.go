@@ -4,6 +4,12 @@ type Color struct {
 	Enabled      bool
 	KeyHighlight []byte
 	PlainValue   []byte
+
+	// KeyColors overrides KeyHighlight for specific map keys.
+	KeyColors map[string][]byte
+	// ValueColors overrides PlainValue for the values of entries with specific map keys.
+	// (Tables are never colored as a whole; the keys and values within them are.)
+	ValueColors map[string][]byte
 }
 
 func (c *Color) initDefaults() {
@@ -17,3 +23,17 @@ func (c *Color) initDefaults() {
 		c.PlainValue = []byte("\033[1;34m")
 	}
 }
+
+func (c *Color) keyColor(k string) []byte {
+	if color, ok := c.KeyColors[k]; ok {
+		return color
+	}
+	return c.KeyHighlight
+}
+
+func (c *Color) valueColor(k string) []byte {
+	if color, ok := c.ValueColors[k]; ok {
+		return color
+	}
+	return c.PlainValue
+}
@@ -7,7 +7,7 @@
 	  - Every time there's a list, and the first entry is a map, we'll try to treat it as a table.
 	  - Every time a map in that list starts with the same first key as the first map did, it's a table row.
 	  - Every thing that's a table row will be buffered, and we try to fit each key into a table column.
-	    - (FUTURE) You can manually specify keys that should be excluded from columns; these will be shifted to the end and packed tightly.
+	    - You can manually specify keys that should be excluded from columns (Config.ExcludeFromColumns); these will be shifted to the end and packed tightly.
 	  - We'll store the size of the widest value for each column.  We'll need to do this over every row so we can align output spacing.
 	  - If there's a map in the list that doesn't start with the same first key, it's a table exclusion, and gets formatted densely.
 	  - If a map has a value that's a list, we attempt to apply this whole ruleset over again from the top.
@@ -15,7 +15,8 @@
 
 	Maps can also be turned into column-aligned tables:
 
-	  - You have to use additional configuration to engage this: by default, only lists trigger table mode.
+	  - You have to use additional configuration to engage this (Config.MapsAsTables): by default, only lists trigger table mode.
+	  - Every time there's a map, and the first value is a map, we'll try to treat it as a table.
 	  - The search for table rows begins anew with each map value.  The map keys form a defacto first column.
 	  - Thereafter, all the rules for handling each table row is the same the rules described above for lists.
 
@@ -23,7 +24,7 @@
 
 	  - Defaults include colorizing map keys vs values, and optionally colorizing column names distinctly from other keys.
 	  - These colorations operate by ANSI codes (e.g., they work in terminals).  The palette is accordingly limited.
-	  - (FUTURE) You can override colors of specific keys and values.
+	  - You can override colors of specific keys, and of the values of specific keys (Color.KeyColors and Color.ValueColors).
 
 	The overall nature of detecting traits of the data (particularly, size) means JSON Tables cannot be created streamingly;
 	we have to process the entire structure first, and only then can we begin to output correctly aligned data.
@@ -94,6 +95,7 @@ type table struct {
 	cols        []columnName
 	colSize     map[columnName]int // max rendered value width
 	ownLine     []columnName
+	mapKeySize  int // max rendered width of the keys of the maps that are this table (only used if the table is a map).
 }
 
 type entryStyle uint8
@@ -108,9 +110,18 @@ type Config struct {
 	Indent []byte
 	Color  Color
 
+	// ExcludeFromColumns lists map keys which should never be aligned into table columns.
+	// Entries with these keys are shifted to the end of their row, after all the columns, and packed tightly.
+	// (Useful for long or wildly varying values, like comments or error messages, which would otherwise push every other row's columns far apart.)
+	ExcludeFromColumns []string
+
+	// MapsAsTables makes maps eligible to be tables, as well as lists:
+	// a map is considered a table if its first value is a map, and then each of its values that are maps are rows.
+	// The map keys are aligned as if they were a first column.
+	MapsAsTables bool
+
 	// FUTURE: selectors and other forms of specification can override where tables appear, what their tableGroupID is, and so on.
 	// FUTURE: whether to emit trailing commas unconditionally, even on the last elements of maps and lists.
-	// FUTURE: list of keys to exclude from column alignment efforts.
 	// FUTURE: fixed column widths (would even potentially enable streaming operation!  (probably won't on first draft though; makes many codepaths diverge)).
 	// FUTURE: additional coloration cues (could be from selectors, or take cues from schema types).
 	// ..... etc ......
@@ -152,6 +163,16 @@ func (tab *table) GetsOwnLine(cn columnName) {
 	tab.entryStyles[cn] = entryStyle_ownLine
 	tab.ownLine = append(tab.ownLine, cn)
 }
+func (tab *table) GetsTrailing(cn columnName) {
+	switch tab.entryStyles[cn] {
+	case entryStyle_trailing, entryStyle_ownLine:
+		return
+	}
+	tab.entryStyles[cn] = entryStyle_trailing
+}
+func (tab *table) MapKeyObserved(size int) {
+	tab.mapKeySize = max(size, tab.mapKeySize)
+}
 func (tab *table) Finalize() {
 	// Drop all entries in tab.cols that ended up with a different entrystyle.
 	//  (This happens when something gets observed as a column first, but forced into ownLine mode by a subtable in a subsequent row.)
@@ -165,11 +186,8 @@ func (tab *table) Finalize() {
 
 	// Compute all the column key sizes.
 	tab.keySize = make(map[columnName]int, len(cols))
-	var buf bytes.Buffer
 	for _, cn := range cols {
-		buf.Reset()
-		dagjson.Marshal(basicnode.NewString(string(cn)), json.NewEncoder(&buf, json.EncodeOptions{})) // FIXME this would be a lot less irritating if we had more plumbing access to the json encoding -- we want to encode exactly one string into a buffer, it literally can't error.
-		tab.keySize[cn] = buf.Len()                                                                   // FIXME this is ignoring charsets, renderable glyphs, etc at present.
+		tab.keySize[cn] = stringSize(string(cn))
 	}
 }
 
@@ -204,8 +222,23 @@ func peekMightBeTable(ctx *state, n ipld.Node) (bool, tableGroupID) {
 	// FUTURE: the ctx.cfg can also override what the tableGroupID is.
 	switch n.ReprKind() {
 	case ipld.ReprKind_Map:
-		// TODO: maps can definitely be tables!  but gonna come back to this.  and by default, they're not.
-		return false, ""
+		if !ctx.cfg.MapsAsTables {
+			return false, ""
+		}
+		if n.Length() < 1 {
+			return false, ""
+		}
+		_, n0, err := n.MapIterator().Next()
+		if err != nil {
+			return false, ""
+		}
+		if n0.ReprKind() != ipld.ReprKind_Map {
+			return false, ""
+		}
+		if n0.Length() < 1 {
+			return false, ""
+		}
+		return true, tableGroupID(mustFirstKeyAsString(n0))
 	case ipld.ReprKind_List:
 		if n.Length() < 1 {
 			return false, ""
@@ -230,7 +263,7 @@ func peekMightBeTable(ctx *state, n ipld.Node) (bool, tableGroupID) {
 func stride(ctx *state, n ipld.Node) error {
 	switch n.ReprKind() {
 	case ipld.ReprKind_Map:
-		panic("todo")
+		return strideMap(ctx, n)
 	case ipld.ReprKind_List:
 		return strideList(ctx, n)
 	default:
@@ -255,26 +288,38 @@ func strideList(ctx *state, listNode ipld.Node) error {
 		if !tab.IsRow(row) {
 			continue
 		}
-		rowItr := row.MapIterator()
-		for !rowItr.Done() {
-			k, v, err := rowItr.Next()
-			// TODO grow ctx.path
-			if err != nil {
-				return recordErrorPosition(ctx, err)
+		if err := strideRow(ctx, tab, row); err != nil {
+			return err
+		}
+	}
+	tab.Finalize()
+	return nil
+}
+
+func strideMap(ctx *state, mapNode ipld.Node) error {
+	isTable, tgid := peekMightBeTable(ctx, mapNode)
+	if !isTable {
+		return nil
+	}
+	tab := ctx.Table(tgid)
+	mapItr := mapNode.MapIterator()
+	for !mapItr.Done() {
+		k, v, err := mapItr.Next()
+		// TODO grow ctx.path
+		if err != nil {
+			return recordErrorPosition(ctx, err)
+		}
+		ks, _ := k.AsString()
+		tab.MapKeyObserved(stringSize(ks))
+		switch {
+		case tab.IsRow(v):
+			if err := strideRow(ctx, tab, v); err != nil {
+				return err
 			}
-			ks, _ := k.AsString()
-			if vIsTable, _ := peekMightBeTable(ctx, v); vIsTable {
-				tab.GetsOwnLine(columnName(ks))
-				stride(ctx, v) // i do believe this results in calling peekMightBeTable repeatedly; could refactor to improve; but doesn't affect correctness.
-			} else {
-				if tab.entryStyles[columnName(ks)] != entryStyle_trailing {
-					ctx.spare.Reset()
-					if err := marshalPlain(ctx, v, &ctx.spare); err != nil {
-						return err
-					}
-					computedSize := ctx.spare.Len() // FIXME this is ignoring charsets, renderable glyphs, etc at present.
-					tab.ColumnObserved(columnName(ks), computedSize)
-				}
+		case v.ReprKind() != ipld.ReprKind_Map:
+			// Not a row, but might contain tables of its own.
+			if err := stride(ctx, v); err != nil {
+				return err
 			}
 		}
 	}
@@ -282,10 +327,39 @@ func strideList(ctx *state, listNode ipld.Node) error {
 	return nil
 }
 
+func strideRow(ctx *state, tab *table, row ipld.Node) error {
+	rowItr := row.MapIterator()
+	for !rowItr.Done() {
+		k, v, err := rowItr.Next()
+		// TODO grow ctx.path
+		if err != nil {
+			return recordErrorPosition(ctx, err)
+		}
+		ks, _ := k.AsString()
+		if vIsTable, _ := peekMightBeTable(ctx, v); vIsTable {
+			tab.GetsOwnLine(columnName(ks))
+			stride(ctx, v) // i do believe this results in calling peekMightBeTable repeatedly; could refactor to improve; but doesn't affect correctness.
+		} else {
+			if ctx.cfg.excludedFromColumns(ks) {
+				tab.GetsTrailing(columnName(ks))
+			}
+			if tab.entryStyles[columnName(ks)] != entryStyle_trailing {
+				ctx.spare.Reset()
+				if err := marshalPlain(ctx, v, &ctx.spare); err != nil {
+					return err
+				}
+				computedSize := ctx.spare.Len() // FIXME this is ignoring charsets, renderable glyphs, etc at present.
+				tab.ColumnObserved(columnName(ks), computedSize)
+			}
+		}
+	}
+	return nil
+}
+
 func marshal(ctx *state, n ipld.Node, w io.Writer) error {
 	switch n.ReprKind() {
 	case ipld.ReprKind_Map:
-		panic("todo")
+		return marshalMap(ctx, n, w)
 	case ipld.ReprKind_List:
 		return marshalList(ctx, n, w)
 	default:
@@ -321,7 +395,12 @@ func marshalList(ctx *state, listNode ipld.Node, w io.Writer) error {
 		if err != nil {
 			return recordErrorPosition(ctx, err)
 		}
-		if err := marshalListValue(ctx, tab, row, w); err != nil {
+		if row.ReprKind() != ipld.ReprKind_Map {
+			// TODO make this a lot more open... scalars aren't exactly "rows" for example but we can surely print them just fine.
+			panic("table rows can only be maps at present")
+		}
+		w.Write(bytes.Repeat(ctx.cfg.Indent, ctx.indent))
+		if err := marshalRow(ctx, tab, row, w); err != nil {
 			return err
 		}
 		if !listItr.Done() {
@@ -334,12 +413,51 @@ func marshalList(ctx *state, listNode ipld.Node, w io.Writer) error {
 	w.Write([]byte{']'})
 	return nil
 }
-func marshalListValue(ctx *state, tab *table, row ipld.Node, w io.Writer) error {
-	if row.ReprKind() != ipld.ReprKind_Map {
-		// TODO make this a lot more open... scalars aren't exactly "rows" for example but we can surely print them just fine.
-		panic("table rows can only be maps at present")
+
+func marshalMap(ctx *state, mapNode ipld.Node, w io.Writer) error {
+	isTab, tgid := peekMightBeTable(ctx, mapNode)
+	if !isTab {
+		return marshalPlain(ctx, mapNode, w)
+	}
+	tab := ctx.Table(tgid)
+	ctx.indent++
+	w.Write([]byte{'{', '\n'})
+	mapItr := mapNode.MapIterator()
+	for !mapItr.Done() {
+		k, v, err := mapItr.Next()
+		// TODO grow ctx.path
+		if err != nil {
+			return recordErrorPosition(ctx, err)
+		}
+		ks, _ := k.AsString()
+		w.Write(bytes.Repeat(ctx.cfg.Indent, ctx.indent))
+		if err := emitKey(ctx, k, w); err != nil {
+			return err
+		}
+		// The keys are the first column: pad them out so the rows line up.
+		w.Write(bytes.Repeat([]byte{' '}, tab.mapKeySize-stringSize(ks)))
+		if v.ReprKind() == ipld.ReprKind_Map {
+			err = marshalRow(ctx, tab, v, w)
+		} else {
+			err = emitValue(ctx, ks, v, w)
+		}
+		if err != nil {
+			return err
+		}
+		if !mapItr.Done() {
+			w.Write([]byte{','})
+		}
+		w.Write([]byte{'\n'})
 	}
+	ctx.indent--
 	w.Write(bytes.Repeat(ctx.cfg.Indent, ctx.indent))
+	w.Write([]byte{'}'})
+	return nil
+}
+
+// marshalRow emits a map as a row of the table.
+// The caller is responsible for the indentation before it, and anything after it.
+func marshalRow(ctx *state, tab *table, row ipld.Node, w io.Writer) error {
 	w.Write([]byte{'{'})
 
 	// Flow here goes by the table notes rather than the data!  Mostly.
@@ -355,7 +473,7 @@ func marshalListValue(ctx *state, tab *table, row ipld.Node, w io.Writer) error
 	// Stage 0 -- looking ahead for where we can rest.
 	lastColThisRow := -1
 	lastOwnLineThisRow := -1
-	haveTrailingThisRow := false
+	trailingThisRow := 0
 	for rowItr := row.MapIterator(); !rowItr.Done(); {
 		k, _, err := rowItr.Next()
 		// TODO this is fine example of where we want to "grow ctx.path"... *very* temporarily
@@ -367,11 +485,16 @@ func marshalListValue(ctx *state, tab *table, row ipld.Node, w io.Writer) error
 		case entryStyle_column:
 			lastColThisRow = max(lastColThisRow, indexOf(tab.cols, columnName(ks)))
 		case entryStyle_trailing, 0:
-			haveTrailingThisRow = true
+			trailingThisRow++
 		case entryStyle_ownLine:
 			lastOwnLineThisRow = max(lastOwnLineThisRow, indexOf(tab.ownLine, columnName(ks)))
 		}
 	}
+	haveTrailingThisRow := trailingThisRow > 0
+	if haveTrailingThisRow {
+		// Trailing entries go after all the columns, so they line up too.
+		lastColThisRow = len(tab.cols) - 1
+	}
 
 	// Stage 1 -- emitting regular columns.
 	for i, col := range tab.cols {
@@ -397,7 +520,10 @@ func marshalListValue(ctx *state, tab *table, row ipld.Node, w io.Writer) error
 			return err
 		}
 		computedSize := ctx.spare.Len() // FIXME this is ignoring charsets, renderable glyphs, etc at present.
+		color := ctx.cfg.Color.valueColor(string(col))
+		beginColor(ctx, color, w)
 		w.Write(ctx.spare.Bytes())
+		endColor(ctx, color, w)
 		// Emit separator.
 		//  - comma if there's more columns, or trailing entries, or any ownline entries;
 		//  - spacing if there's more columns, or trailing entries.
@@ -412,6 +538,7 @@ func marshalListValue(ctx *state, tab *table, row ipld.Node, w io.Writer) error
 
 	// Stage 2 -- emitting trailing entries.
 	if haveTrailingThisRow {
+		i := 0
 		rowItr := row.MapIterator()
 		for !rowItr.Done() {
 			k, v, err := rowItr.Next()
@@ -427,10 +554,19 @@ func marshalListValue(ctx *state, tab *table, row ipld.Node, w io.Writer) error
 			if err := emitKey(ctx, k, w); err != nil {
 				return err
 			}
-			if err := marshal(ctx, v, w); err != nil {
+			if err := emitValue(ctx, ks, v, w); err != nil {
 				return err
 			}
-			w.Write([]byte{','}) // FIXME: you know, the maybe-trailing shenanigans.
+			// Emit separator.
+			//  - comma and a space if there's more trailing entries;
+			//  - just a comma if there's ownline entries.
+			i++
+			switch {
+			case i < trailingThisRow:
+				w.Write([]byte{',', ' '})
+			case lastOwnLineThisRow >= 0:
+				w.Write([]byte{','})
+			}
 		}
 	}
 
@@ -452,7 +588,7 @@ func marshalListValue(ctx *state, tab *table, row ipld.Node, w io.Writer) error
 		if err != nil {
 			return recordErrorPosition(ctx, err)
 		}
-		if err := marshal(ctx, v, w); err != nil { // whole recursion.  can even have sub-tables.
+		if err := emitValue(ctx, string(col), v, w); err != nil { // whole recursion.  can even have sub-tables.
 			return err
 		}
 		if i < lastOwnLineThisRow {
@@ -467,16 +603,50 @@ func marshalListValue(ctx *state, tab *table, row ipld.Node, w io.Writer) error
 }
 
 func emitKey(ctx *state, k ipld.Node, w io.Writer) error {
-	if ctx.cfg.Color.Enabled {
-		w.Write(ctx.cfg.Color.KeyHighlight)
-	}
+	ks, _ := k.AsString()
+	color := ctx.cfg.Color.keyColor(ks)
+	beginColor(ctx, color, w)
 	if err := dagjson.Marshal(k, json.NewEncoder(w, json.EncodeOptions{})); err != nil {
 		return recordErrorPosition(ctx, err)
 	}
-	if ctx.cfg.Color.Enabled {
-		w.Write([]byte("\033[0m"))
-	}
+	endColor(ctx, color, w)
 	w.Write([]byte{':'})
 	w.Write([]byte{' '}) // FUTURE: this should be configurable
 	return nil
 }
+
+// emitValue emits the value of the entry with key k.
+// Tables are recursed into; anything else is emitted plainly, in the value's color.
+func emitValue(ctx *state, k string, v ipld.Node, w io.Writer) error {
+	if isTable, _ := peekMightBeTable(ctx, v); isTable {
+		return marshal(ctx, v, w)
+	}
+	color := ctx.cfg.Color.valueColor(k)
+	beginColor(ctx, color, w)
+	if err := marshalPlain(ctx, v, w); err != nil {
+		return err
+	}
+	endColor(ctx, color, w)
+	return nil
+}
+
+func beginColor(ctx *state, color []byte, w io.Writer) {
+	if ctx.cfg.Color.Enabled {
+		w.Write(color)
+	}
+}
+
+func endColor(ctx *state, color []byte, w io.Writer) {
+	if ctx.cfg.Color.Enabled && len(color) > 0 {
+		w.Write([]byte("\033[0m"))
+	}
+}
+
+func (cfg *Config) excludedFromColumns(k string) bool {
+	for _, ex := range cfg.ExcludeFromColumns {
+		if ex == k {
+			return true
+		}
+	}
+	return false
+}
@@ -73,7 +73,72 @@ func TestAbsentColumn(t *testing.T) {
 }
 
 func TestTrailing(t *testing.T) {
-	// FUTURE
+	fixture := Dedent(`
+		[
+		  {"path": "./foo",   "status": "changed",             "comment": "this one is long, and would push everything else apart"},
+		  {"path": "./bazzz", "status": "green"},
+		  {"path": "./q",     "status": "lit",     "extra": 1, "comment": "short", "note": "also excluded"}
+		]`)
+	nb := basicnode.Prototype.Any.NewBuilder()
+	Require(t, dagjson.Decoder(nb, strings.NewReader(fixture)), ShouldEqual, nil)
+	n := nb.Build()
+
+	var buf bytes.Buffer
+	Wish(t, MarshalConfigured(Config{
+		Indent:             []byte{' ', ' '},
+		ExcludeFromColumns: []string{"comment", "note"},
+	}, n, &buf), ShouldEqual, nil)
+	Wish(t, buf.String(), ShouldEqual, fixture)
+}
+
+func TestMapsAsTables(t *testing.T) {
+	fixture := Dedent(`
+		{
+		  "foo":   {"path": "./foo",   "status": "changed"},
+		  "bazzz": {"path": "./bazzz", "status": "green",
+		    "subtable": [
+		      {"frob": "zozzle", "zim": "boink"},
+		      {"frob": "narf",   "zim": "zamf"}
+		    ]},
+		  "q":     12
+		}`)
+	nb := basicnode.Prototype.Any.NewBuilder()
+	Require(t, dagjson.Decoder(nb, strings.NewReader(fixture)), ShouldEqual, nil)
+	n := nb.Build()
+
+	t.Run("enabled", func(t *testing.T) {
+		var buf bytes.Buffer
+		Wish(t, MarshalConfigured(Config{
+			Indent:       []byte{' ', ' '},
+			MapsAsTables: true,
+		}, n, &buf), ShouldEqual, nil)
+		Wish(t, buf.String(), ShouldEqual, fixture)
+	})
+	t.Run("disabled", func(t *testing.T) {
+		var buf bytes.Buffer
+		Wish(t, Marshal(n, &buf), ShouldEqual, nil)
+		Wish(t, buf.String(), ShouldEqual, `{"foo":{"path":"./foo","status":"changed"},"bazzz":{"path":"./bazzz","status":"green","subtable":[{"frob":"zozzle","zim":"boink"},{"frob":"narf","zim":"zamf"}]},"q":12}`)
+	})
+}
+
+func TestColorOverrides(t *testing.T) {
+	nb := basicnode.Prototype.Any.NewBuilder()
+	Require(t, dagjson.Decoder(nb, strings.NewReader(`[{"path": "./foo", "status": "changed"}, {"path": "./bazzz", "status": "green"}]`)), ShouldEqual, nil)
+	n := nb.Build()
+
+	var buf bytes.Buffer
+	Wish(t, MarshalConfigured(Config{
+		Indent: []byte{' ', ' '},
+		Color: Color{
+			Enabled:     true,
+			KeyColors:   map[string][]byte{"status": []byte("\033[33m")},
+			ValueColors: map[string][]byte{"status": []byte("\033[31m")},
+		},
+	}, n, &buf), ShouldEqual, nil)
+	Wish(t, buf.String(), ShouldEqual, "[\n"+
+		"  {\033[32m\"path\"\033[0m: \033[1;34m\"./foo\"\033[0m,   \033[33m\"status\"\033[0m: \033[31m\"changed\"\033[0m},\n"+
+		"  {\033[32m\"path\"\033[0m: \033[1;34m\"./bazzz\"\033[0m, \033[33m\"status\"\033[0m: \033[31m\"green\"\033[0m}\n"+
+		"]")
 }
 
 func TestSubTables(t *testing.T) {
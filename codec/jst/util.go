@@ -1,10 +1,15 @@
 package jst
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 
+	"github.com/polydawn/refmt/json"
+
 	ipld "github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/codec/dagjson"
+	basicnode "github.com/ipld/go-ipld-prime/node/basic"
 )
 
 func max(a, b int) int { // honestly golang
@@ -27,6 +32,13 @@ func mustFirstKeyAsString(mapNode ipld.Node) string {
 	return ks
 }
 
+// stringSize returns the size of a string when it's rendered as JSON.
+func stringSize(s string) int {
+	var buf bytes.Buffer
+	dagjson.Marshal(basicnode.NewString(s), json.NewEncoder(&buf, json.EncodeOptions{})) // FIXME this would be a lot less irritating if we had more plumbing access to the json encoding -- we want to encode exactly one string into a buffer, it literally can't error.
+	return buf.Len()                                                                     // FIXME this is ignoring charsets, renderable glyphs, etc at present.
+}
+
 func indexOf(list []columnName, cn columnName) int {
 	for i, v := range list {
 		if v == cn {
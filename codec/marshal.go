@@ -2,6 +2,7 @@ package codec
 
 import (
 	"fmt"
+	"math"
 
 	"github.com/polydawn/refmt/shared"
 	"github.com/polydawn/refmt/tok"
@@ -91,12 +92,20 @@ func marshal(n ipld.Node, tk *tok.Token, sink shared.TokenSink) error {
 		_, err = sink.Step(tk)
 		return err
 	case ipld.ReprKind_Int:
+		if un, ok := n.(ipld.UintNode); ok {
+			if v, err := un.AsUint(); err == nil && v > math.MaxInt64 {
+				tk.Type = tok.TUint
+				tk.Uint = v
+				_, err = sink.Step(tk)
+				return err
+			}
+		}
 		v, err := n.AsInt()
 		if err != nil {
 			return err
 		}
 		tk.Type = tok.TInt
-		tk.Int = int64(v)
+		tk.Int = v
 		_, err = sink.Step(tk)
 		return err
 	case ipld.ReprKind_Float:
@@ -0,0 +1,63 @@
+// Package raw implements the "raw" multicodec (0x55), in which a block's
+// content is simply bytes, with no further structure.
+//
+// Decoding a raw block always yields a single bytes node;
+// only bytes nodes can be encoded.
+//
+// Importing this package registers the codec with cidlink,
+// so links with the raw multicodec can be loaded and built.
+package raw
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	ipld "github.com/ipld/go-ipld-prime"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+)
+
+var (
+	_ cidlink.MulticodecDecoder = Decoder
+	_ cidlink.MulticodecEncoder = Encoder
+)
+
+func init() {
+	cidlink.RegisterMulticodecDecoder(0x55, Decoder)
+	cidlink.RegisterMulticodecEncoder(0x55, Encoder)
+}
+
+// byteAccessor is a reader interface that can access underlying bytes.
+type byteAccessor interface {
+	Bytes() []byte
+}
+
+// Decoder assigns the entire content of the reader to the assembler, as bytes.
+//
+// If the reader exposes its underlying bytes with a `Bytes() []byte` method
+// (as *bytes.Buffer does), those are used directly, without copying;
+// in that case, the reader isn't advanced,
+// and the bytes must not be modified for as long as the resulting node is in use.
+func Decoder(na ipld.NodeAssembler, r io.Reader) error {
+	if buf, ok := r.(byteAccessor); ok {
+		return na.AssignBytes(buf.Bytes())
+	}
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	return na.AssignBytes(data)
+}
+
+// Encoder writes the content of a bytes node.  Nodes of any other kind are rejected.
+func Encoder(n ipld.Node, w io.Writer) error {
+	if n.ReprKind() != ipld.ReprKind_Bytes {
+		return fmt.Errorf("raw codec can only encode bytes, but node is of kind %s", n.ReprKind())
+	}
+	data, err := n.AsBytes()
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
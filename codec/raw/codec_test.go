@@ -0,0 +1,83 @@
+package raw
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	cid "github.com/ipfs/go-cid"
+	. "github.com/warpfork/go-wish"
+
+	ipld "github.com/ipld/go-ipld-prime"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	basicnode "github.com/ipld/go-ipld-prime/node/basic"
+)
+
+func TestRoundtrip(t *testing.T) {
+	data := []byte("some opaque \x00\x01\x02 content")
+	n := basicnode.NewBytes(data)
+
+	t.Run("encoding", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := Encoder(n, &buf)
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, buf.Bytes(), ShouldEqual, data)
+	})
+	t.Run("encoding other kinds is rejected", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := Encoder(basicnode.NewString("nope"), &buf)
+		Wish(t, err.Error(), ShouldEqual, "raw codec can only encode bytes, but node is of kind string")
+		Wish(t, buf.Len(), ShouldEqual, 0)
+	})
+	t.Run("decoding", func(t *testing.T) {
+		nb := basicnode.Prototype.Any.NewBuilder()
+		err := Decoder(nb, strings.NewReader(string(data)))
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, nb.Build(), ShouldEqual, n)
+	})
+	t.Run("decoding without copying", func(t *testing.T) {
+		buf := bytes.NewBuffer(data)
+		nb := basicnode.Prototype.Any.NewBuilder()
+		err := Decoder(nb, buf)
+		Require(t, err, ShouldEqual, nil)
+		bs, err := nb.Build().AsBytes()
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, &bs[0] == &data[0], ShouldEqual, true)
+	})
+	t.Run("decoding empty", func(t *testing.T) {
+		nb := basicnode.Prototype.Any.NewBuilder()
+		err := Decoder(nb, strings.NewReader(""))
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, nb.Build().ReprKind(), ShouldEqual, ipld.ReprKind_Bytes)
+	})
+}
+
+func TestRoundtripCidlink(t *testing.T) {
+	lb := cidlink.LinkBuilder{Prefix: cid.Prefix{
+		Version:  1,
+		Codec:    0x55,
+		MhType:   0x12,
+		MhLength: 32,
+	}}
+	n := basicnode.NewBytes([]byte("leaf"))
+
+	buf := bytes.Buffer{}
+	lnk, err := lb.Build(context.Background(), ipld.LinkContext{}, n,
+		func(ipld.LinkContext) (io.Writer, ipld.StoreCommitter, error) {
+			return &buf, func(lnk ipld.Link) error { return nil }, nil
+		},
+	)
+	Require(t, err, ShouldEqual, nil)
+	Wish(t, lnk.String(), ShouldEqual, "bafkreie7selb6q2dhze2nxtnw2anph3acwps4swjc4tcdijiizbicwcebm")
+
+	nb := basicnode.Prototype.Any.NewBuilder()
+	err = lnk.Load(context.Background(), ipld.LinkContext{}, nb,
+		func(lnk ipld.Link, _ ipld.LinkContext) (io.Reader, error) {
+			return bytes.NewReader(buf.Bytes()), nil
+		},
+	)
+	Require(t, err, ShouldEqual, nil)
+	Wish(t, nb.Build(), ShouldEqual, n)
+}
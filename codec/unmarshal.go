@@ -141,9 +141,14 @@ func unmarshal(na ipld.NodeAssembler, tokSrc shared.TokenSource, tk *tok.Token)
 	case tok.TBool:
 		return na.AssignBool(tk.Bool)
 	case tok.TInt:
-		return na.AssignInt(int(tk.Int)) // FIXME overflow check
+		return na.AssignInt(tk.Int)
 	case tok.TUint:
-		return na.AssignInt(int(tk.Uint)) // FIXME overflow check
+		if tk.Uint > math.MaxInt64 {
+			// dagcbor and dagjson handle these with basicnode.NewUint,
+			// but this package can't import basicnode (its tests use us).
+			return fmt.Errorf("integer %d overflows int64", tk.Uint)
+		}
+		return na.AssignInt(int64(tk.Uint))
 	case tok.TFloat64:
 		return na.AssignFloat(tk.Float64)
 	default:
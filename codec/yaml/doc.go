@@ -0,0 +1,34 @@
+/*
+	The yaml package converts between IPLD Data Model nodes and YAML,
+	for data that people edit by hand (configuration, for example).
+
+	YAML has no links or bytes, so the same conventions as DAG-JSON are used for them:
+	a link is a map with the single key "/", and the CID as a string;
+	bytes are a map with the single key "/", holding a map with the single key
+	"bytes", and the bytes in base64 (standard alphabet, no padding):
+
+		link:
+		  /: bafyreihdwdcefgh4dqkjv67uzcmw7ojee6xedzdetojuzjevtenxquvyku
+		data:
+		  /:
+		    bytes: aGVsbG8
+
+	Maps are written in their iteration order, and read in the order they're written,
+	so data keeps its order through a round trip.
+	Every kind of the Data Model survives a round trip, so a YAML file can be
+	turned into (for example) a DAG-CBOR block, and back, without losing anything.
+	(Comments and formatting aren't part of the Data Model, so they don't survive.)
+
+	When reading, YAML's own types map onto the Data Model in the obvious way;
+	in addition, anchors and aliases are expanded, and "!!binary" values become bytes.
+	(Expansion is limited: a document whose aliases expand to more than about
+	10MiB of data is rejected with ErrAllocationBudgetExceeded.)
+	Map keys must be scalars; their text is used as the key, whatever their type.
+	Anything else that YAML can express but the Data Model can't (such as
+	"!!timestamp" values, which are read as strings) is handled as a string where
+	possible, and rejected otherwise.
+
+	This isn't a multicodec: there's no multicodec code for YAML,
+	so it isn't registered with cidlink.
+*/
+package yaml
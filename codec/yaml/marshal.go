@@ -0,0 +1,168 @@
+package yaml
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	yamlv3 "gopkg.in/yaml.v3"
+
+	ipld "github.com/ipld/go-ipld-prime"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+)
+
+// Marshal writes a node to the writer as a YAML document.
+func Marshal(n ipld.Node, w io.Writer) error {
+	doc, err := marshal(n)
+	if err != nil {
+		return err
+	}
+	enc := yamlv3.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+func marshal(n ipld.Node) (*yamlv3.Node, error) {
+	switch n.ReprKind() {
+	case ipld.ReprKind_Invalid:
+		return nil, fmt.Errorf("cannot traverse a node that is absent")
+	case ipld.ReprKind_Null:
+		return scalar("!!null", "null"), nil
+	case ipld.ReprKind_Map:
+		y := &yamlv3.Node{Kind: yamlv3.MappingNode, Tag: "!!map"}
+		for itr := n.MapIterator(); !itr.Done(); {
+			k, v, err := itr.Next()
+			if err != nil {
+				return nil, err
+			}
+			ks, err := k.AsString()
+			if err != nil {
+				return nil, err
+			}
+			yv, err := marshal(v)
+			if err != nil {
+				return nil, err
+			}
+			y.Content = append(y.Content, str(ks), yv)
+		}
+		return y, nil
+	case ipld.ReprKind_List:
+		y := &yamlv3.Node{Kind: yamlv3.SequenceNode, Tag: "!!seq"}
+		for itr := n.ListIterator(); !itr.Done(); {
+			_, v, err := itr.Next()
+			if err != nil {
+				return nil, err
+			}
+			yv, err := marshal(v)
+			if err != nil {
+				return nil, err
+			}
+			y.Content = append(y.Content, yv)
+		}
+		return y, nil
+	case ipld.ReprKind_Bool:
+		v, err := n.AsBool()
+		if err != nil {
+			return nil, err
+		}
+		return scalar("!!bool", strconv.FormatBool(v)), nil
+	case ipld.ReprKind_Int:
+		if un, ok := n.(ipld.UintNode); ok {
+			if v, err := un.AsUint(); err == nil && v > math.MaxInt64 {
+				return scalar("!!int", strconv.FormatUint(v, 10)), nil
+			}
+		}
+		v, err := n.AsInt()
+		if err != nil {
+			return nil, err
+		}
+		return scalar("!!int", strconv.FormatInt(v, 10)), nil
+	case ipld.ReprKind_Float:
+		v, err := n.AsFloat()
+		if err != nil {
+			return nil, err
+		}
+		return scalar("!!float", formatFloat(v)), nil
+	case ipld.ReprKind_String:
+		v, err := n.AsString()
+		if err != nil {
+			return nil, err
+		}
+		return str(v), nil
+	case ipld.ReprKind_Bytes:
+		v, err := n.AsBytes()
+		if err != nil {
+			return nil, err
+		}
+		return reserved(&yamlv3.Node{
+			Kind: yamlv3.MappingNode,
+			Tag:  "!!map",
+			Content: []*yamlv3.Node{
+				str("bytes"),
+				str(base64.RawStdEncoding.EncodeToString(v)),
+			},
+		}), nil
+	case ipld.ReprKind_Link:
+		v, err := n.AsLink()
+		if err != nil {
+			return nil, err
+		}
+		switch lnk := v.(type) {
+		case cidlink.Link:
+			return reserved(str(lnk.Cid.String())), nil
+		default:
+			return nil, fmt.Errorf("schemafree link emission only supported by this codec for CID type links")
+		}
+	default:
+		panic("unreachable")
+	}
+}
+
+func scalar(tag, value string) *yamlv3.Node {
+	return &yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: tag, Value: value}
+}
+
+// str makes a string scalar.
+// The yaml encoder quotes strings which would otherwise read back as something else,
+// such as "123" or "true" -- but not "<<", which would read back as a merge key,
+// so that one is quoted here.
+func str(value string) *yamlv3.Node {
+	y := scalar("!!str", value)
+	if value == "<<" {
+		y.Style = yamlv3.DoubleQuotedStyle
+	}
+	return y
+}
+
+// reserved wraps a node in a map with the single key "/".
+func reserved(y *yamlv3.Node) *yamlv3.Node {
+	return &yamlv3.Node{
+		Kind:    yamlv3.MappingNode,
+		Tag:     "!!map",
+		Content: []*yamlv3.Node{str("/"), y},
+	}
+}
+
+// formatFloat formats a float so that it reads back as a float:
+// whole numbers get a ".0", so they aren't mistaken for ints.
+func formatFloat(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return ".nan"
+	case math.IsInf(f, 1):
+		return ".inf"
+	case math.IsInf(f, -1):
+		return "-.inf"
+	}
+	s := strconv.FormatFloat(f, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".e") {
+		s += ".0"
+	}
+	return s
+}
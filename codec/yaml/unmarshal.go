@@ -0,0 +1,211 @@
+package yaml
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	cid "github.com/ipfs/go-cid"
+	yamlv3 "gopkg.in/yaml.v3"
+
+	ipld "github.com/ipld/go-ipld-prime"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	basicnode "github.com/ipld/go-ipld-prime/node/basic"
+)
+
+// ErrAllocationBudgetExceeded is returned by Unmarshal when the data would be too large once its aliases are expanded.
+var ErrAllocationBudgetExceeded = errors.New("message structure demanded too many resources to process")
+
+// allocationBudget limits how much data Unmarshal will feed into a NodeAssembler,
+// counted *roughly* in bytes, as the other codecs count theirs.
+// The YAML document itself is already limited by the size of its input;
+// but aliases can refer to anchors which themselves contain aliases,
+// so a small document can expand exponentially (the "billion laughs" attack).
+const allocationBudget = 1048576 * 10
+
+const (
+	mapEntryGasScore  = 8
+	listEntryGasScore = 4
+)
+
+// Unmarshal reads a YAML document from the reader, and feeds its data into a NodeAssembler.
+//
+// The reader must contain a single document; a second document is an error.
+// Aliases are expanded, up to a limit on the size of the expanded data:
+// past it, Unmarshal halts with ErrAllocationBudgetExceeded.
+func Unmarshal(na ipld.NodeAssembler, r io.Reader) error {
+	dec := yamlv3.NewDecoder(r)
+	var doc yamlv3.Node
+	if err := dec.Decode(&doc); err != nil {
+		if err == io.EOF {
+			return fmt.Errorf("unexpected eof")
+		}
+		return err
+	}
+	var more yamlv3.Node
+	if err := dec.Decode(&more); err != io.EOF {
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("unexpected content after end of yaml document")
+	}
+	if len(doc.Content) != 1 {
+		return fmt.Errorf("unexpected eof")
+	}
+	st := &unmarshalState{gas: allocationBudget}
+	return st.unmarshal(na, doc.Content[0])
+}
+
+type unmarshalState struct {
+	gas int // decremented as we assemble data; see allocationBudget.
+}
+
+// spend decrements the gas budget, and errors if it's exceeded.
+func (st *unmarshalState) spend(gas int) error {
+	st.gas -= gas
+	if st.gas < 0 {
+		return ErrAllocationBudgetExceeded
+	}
+	return nil
+}
+
+func (st *unmarshalState) unmarshal(na ipld.NodeAssembler, y *yamlv3.Node) error {
+	switch y.Kind {
+	case yamlv3.AliasNode:
+		return st.unmarshal(na, y.Alias)
+	case yamlv3.MappingNode:
+		if ok, err := st.unmarshalReserved(na, y); ok || err != nil {
+			return err
+		}
+		ma, err := na.BeginMap(len(y.Content) / 2)
+		if err != nil {
+			return err
+		}
+		for i := 0; i < len(y.Content); i += 2 {
+			k := deref(y.Content[i])
+			if k.Kind != yamlv3.ScalarNode {
+				return fmt.Errorf("line %d: map keys must be scalars", k.Line)
+			}
+			if err := st.spend(mapEntryGasScore + len(k.Value)); err != nil {
+				return err
+			}
+			va, err := ma.AssembleEntry(k.Value)
+			if err != nil {
+				return err
+			}
+			if err := st.unmarshal(va, y.Content[i+1]); err != nil {
+				return err
+			}
+		}
+		return ma.Finish()
+	case yamlv3.SequenceNode:
+		la, err := na.BeginList(len(y.Content))
+		if err != nil {
+			return err
+		}
+		for _, v := range y.Content {
+			if err := st.spend(listEntryGasScore); err != nil {
+				return err
+			}
+			if err := st.unmarshal(la.AssembleValue(), v); err != nil {
+				return err
+			}
+		}
+		return la.Finish()
+	case yamlv3.ScalarNode:
+		if err := st.spend(len(y.Value)); err != nil {
+			return err
+		}
+		return unmarshalScalar(na, y)
+	default:
+		return fmt.Errorf("line %d: unexpected yaml node", y.Line)
+	}
+}
+
+func unmarshalScalar(na ipld.NodeAssembler, y *yamlv3.Node) error {
+	switch y.ShortTag() {
+	case "!!null":
+		return na.AssignNull()
+	case "!!bool":
+		var v bool
+		if err := y.Decode(&v); err != nil {
+			return err
+		}
+		return na.AssignBool(v)
+	case "!!int":
+		var v int64
+		if err := y.Decode(&v); err == nil {
+			return na.AssignInt(v)
+		}
+		var u uint64
+		if err := y.Decode(&u); err != nil {
+			return err
+		}
+		return na.AssignNode(basicnode.NewUint(u))
+	case "!!float":
+		var v float64
+		if err := y.Decode(&v); err != nil {
+			return err
+		}
+		return na.AssignFloat(v)
+	case "!!binary":
+		// Binary scalars may be folded over several lines, and are padded.
+		v, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(y.Value), ""))
+		if err != nil {
+			return fmt.Errorf("line %d: invalid base64 in !!binary: %s", y.Line, err)
+		}
+		return na.AssignBytes(v)
+	case "!!str", "!!timestamp":
+		return na.AssignString(y.Value)
+	default:
+		return fmt.Errorf("line %d: unsupported yaml tag %s", y.Line, y.Tag)
+	}
+}
+
+// unmarshalReserved handles the maps with the single key "/", for links and bytes.
+// If the map isn't one of those, it returns false, and the map should be handled as a map.
+func (st *unmarshalState) unmarshalReserved(na ipld.NodeAssembler, y *yamlv3.Node) (bool, error) {
+	if len(y.Content) != 2 {
+		return false, nil
+	}
+	if k := deref(y.Content[0]); k.Kind != yamlv3.ScalarNode || k.Value != "/" {
+		return false, nil
+	}
+	v := deref(y.Content[1])
+	switch {
+	case v.Kind == yamlv3.ScalarNode && v.ShortTag() == "!!str":
+		if err := st.spend(len(v.Value)); err != nil {
+			return true, err
+		}
+		// Same as dag-json: if it *doesn't* parse as a CID, we treat this as an error.
+		c, err := cid.Decode(v.Value)
+		if err != nil {
+			return true, err
+		}
+		return true, na.AssignLink(cidlink.Link{c})
+	case v.Kind == yamlv3.MappingNode && len(v.Content) == 2:
+		k, b := deref(v.Content[0]), deref(v.Content[1])
+		if k.Kind != yamlv3.ScalarNode || k.Value != "bytes" || b.Kind != yamlv3.ScalarNode || b.ShortTag() != "!!str" {
+			return false, nil
+		}
+		if err := st.spend(len(b.Value)); err != nil {
+			return true, err
+		}
+		bs, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(b.Value, "="))
+		if err != nil {
+			return true, fmt.Errorf("line %d: invalid base64 in bytes: %s", b.Line, err)
+		}
+		return true, na.AssignBytes(bs)
+	}
+	return false, nil
+}
+
+// deref follows aliases to the node they refer to.
+func deref(y *yamlv3.Node) *yamlv3.Node {
+	for y.Kind == yamlv3.AliasNode {
+		y = y.Alias
+	}
+	return y
+}
@@ -0,0 +1,140 @@
+package yaml
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	cid "github.com/ipfs/go-cid"
+	. "github.com/warpfork/go-wish"
+
+	ipld "github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/codec/dagcbor"
+	"github.com/ipld/go-ipld-prime/fluent"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	"github.com/ipld/go-ipld-prime/must"
+	basicnode "github.com/ipld/go-ipld-prime/node/basic"
+)
+
+func mustCid(s string) cid.Cid {
+	c, err := cid.Decode(s)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+var n = fluent.MustBuildMap(basicnode.Prototype.Map, 10, func(na fluent.MapAssembler) {
+	na.AssembleEntry("name").AssignString("olde string")
+	na.AssembleEntry("looks-like-a-number").AssignString("123")
+	na.AssembleEntry("<<").AssignString("<<")
+	na.AssembleEntry("count").AssignInt(-7)
+	na.AssembleEntry("big").AssignNode(basicnode.NewUint(math.MaxUint64))
+	na.AssembleEntry("ratio").AssignFloat(2)
+	na.AssembleEntry("enabled").AssignBool(true)
+	na.AssembleEntry("nothing").AssignNull()
+	na.AssembleEntry("list").CreateList(2, func(na fluent.ListAssembler) {
+		na.AssembleValue().AssignBytes([]byte("hello"))
+		na.AssembleValue().AssignLink(cidlink.Link{mustCid("bafyreihdwdcefgh4dqkjv67uzcmw7ojee6xedzdetojuzjevtenxquvyku")})
+	})
+	na.AssembleEntry("a").CreateMap(0, func(na fluent.MapAssembler) {})
+})
+
+var serial = `name: olde string
+looks-like-a-number: "123"
+"<<": "<<"
+count: -7
+big: 18446744073709551615
+ratio: 2.0
+enabled: true
+nothing: null
+list:
+  - /:
+      bytes: aGVsbG8
+  - /: bafyreihdwdcefgh4dqkjv67uzcmw7ojee6xedzdetojuzjevtenxquvyku
+a: {}
+`
+
+func TestRoundtrip(t *testing.T) {
+	t.Run("encoding", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := Marshal(n, &buf)
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, buf.String(), ShouldEqual, serial)
+	})
+	t.Run("decoding", func(t *testing.T) {
+		nb := basicnode.Prototype.Any.NewBuilder()
+		err := Unmarshal(nb, strings.NewReader(serial))
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, nb.Build(), ShouldEqual, n)
+	})
+	t.Run("through dag-cbor", func(t *testing.T) {
+		nb := basicnode.Prototype.Any.NewBuilder()
+		Require(t, Unmarshal(nb, strings.NewReader(serial)), ShouldEqual, nil)
+		var block bytes.Buffer
+		Require(t, dagcbor.Encoder(nb.Build(), &block), ShouldEqual, nil)
+		nb = basicnode.Prototype.Any.NewBuilder()
+		Require(t, dagcbor.Decoder(nb, &block), ShouldEqual, nil)
+		var buf bytes.Buffer
+		Require(t, Marshal(nb.Build(), &buf), ShouldEqual, nil)
+		Wish(t, buf.String(), ShouldEqual, serial)
+	})
+}
+
+func TestUnmarshalHandwritten(t *testing.T) {
+	decode := func(serial string) (ipld.Node, error) {
+		nb := basicnode.Prototype.Any.NewBuilder()
+		if err := Unmarshal(nb, strings.NewReader(serial)); err != nil {
+			return nil, err
+		}
+		return nb.Build(), nil
+	}
+	t.Run("comments, flow style, and aliases", func(t *testing.T) {
+		n, err := decode(`
+# defaults, shared below.
+base: &base {retries: 3, verbose: false}
+prod: *base
+ports: [80, 443]
+`)
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, must.Int(must.Node(must.Node(n.LookupByString("prod")).LookupByString("retries"))), ShouldEqual, int64(3))
+		Wish(t, must.Int(must.Node(must.Node(n.LookupByString("ports")).LookupByIndex(1))), ShouldEqual, int64(443))
+	})
+	t.Run("binary", func(t *testing.T) {
+		n, err := decode("data: !!binary aGVsbG8=\n")
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, n, ShouldEqual, fluent.MustBuildMap(basicnode.Prototype.Map, 1, func(na fluent.MapAssembler) {
+			na.AssembleEntry("data").AssignBytes([]byte("hello"))
+		}))
+	})
+	t.Run("invalid link", func(t *testing.T) {
+		_, err := decode("/: not-a-cid\n")
+		Wish(t, err != nil, ShouldEqual, true)
+	})
+	t.Run("almost reserved", func(t *testing.T) {
+		n, err := decode("/: {bytes: aGVsbG8, more: 1}\n")
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, n.ReprKind(), ShouldEqual, ipld.ReprKind_Map)
+		Wish(t, must.Node(n.LookupByString("/")).Length(), ShouldEqual, 2)
+	})
+	t.Run("repeated key", func(t *testing.T) {
+		_, err := decode("a: 1\na: 2\n")
+		Wish(t, err != nil, ShouldEqual, true)
+	})
+	t.Run("more than one document", func(t *testing.T) {
+		_, err := decode("a: 1\n---\nb: 2\n")
+		Wish(t, err != nil, ShouldEqual, true)
+	})
+	t.Run("billion laughs", func(t *testing.T) {
+		// Each level is a list of ten aliases to the level before it,
+		// so the last one would expand to 10^9 strings.
+		serial := `a0: &a0 "lol"` + "\n"
+		for i := 1; i <= 9; i++ {
+			serial += fmt.Sprintf("a%d: &a%d [", i, i) + strings.Repeat(fmt.Sprintf("*a%d, ", i-1), 9) + fmt.Sprintf("*a%d]\n", i-1)
+		}
+		_, err := decode(serial)
+		Wish(t, err, ShouldEqual, ErrAllocationBudgetExceeded)
+	})
+}
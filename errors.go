@@ -2,6 +2,7 @@ package ipld
 
 import (
 	"fmt"
+	"strings"
 )
 
 // ErrWrongKind may be returned from functions on the Node interface when
@@ -152,6 +153,78 @@ func (e ErrIteratorOverread) Error() string {
 
 type ErrCannotBeNull struct{} // Review: arguably either ErrInvalidKindForNodePrototype.
 
-type ErrMissingRequiredField struct{}     // only possible for typed nodes -- specifically, struct types.
+// ErrMissingRequiredField is returned when calling 'Finish' on a NodeAssembler
+// for a struct that doesn't have all its required fields set.
+//
+// This is only possible for typed nodes -- specifically, struct types.
+type ErrMissingRequiredField struct {
+	// TypeName will indicate the named type of the struct.
+	TypeName string
+
+	// Missing lists the names of the fields that weren't set.
+	Missing []string
+}
+
+func (e ErrMissingRequiredField) Error() string {
+	return fmt.Sprintf("missing required fields for %s: %s", e.TypeName, strings.Join(e.Missing, ", "))
+}
+
 type ErrListOverrun struct{}              // only possible for typed nodes -- specifically, struct types with list (aka tuple) representations.
 type ErrInvalidUnionDiscriminant struct{} // only possible for typed nodes -- specifically, union types.
+
+// ErrBlockNotFound is returned by a Loader when it has no data for the Link.
+//
+// Loaders should return this, rather than an error of their own,
+// so that callers can tell a missing block apart from other failures
+// (for example, to fetch it from somewhere else and retry).
+// Link.Load and the traversal package pass it through unchanged (or wrapped,
+// in which case it can be found with errors.As).
+type ErrBlockNotFound struct {
+	Link Link
+}
+
+func (e ErrBlockNotFound) Error() string {
+	return fmt.Sprintf("block not found: %s", e.Link)
+}
+
+// ErrHashMismatch is returned by Link.Load when the data the Loader provided
+// doesn't hash to the Link being loaded.
+//
+// Actual is the Link the data does hash to.
+type ErrHashMismatch struct {
+	Expected Link
+	Actual   Link
+}
+
+func (e ErrHashMismatch) Error() string {
+	return fmt.Sprintf("hash mismatch!  %q (actual) != %q (expected)", e.Actual, e.Expected)
+}
+
+// ErrUnknownCodec is returned by Link.Load when there's no decoder
+// for the codec the Link says its data is in.
+//
+// Codec is the multicodec code from the Link.
+type ErrUnknownCodec struct {
+	Codec uint64
+}
+
+func (e ErrUnknownCodec) Error() string {
+	return fmt.Sprintf("no decoder registered for multicodec %d", e.Codec)
+}
+
+// ErrDecode is returned by Link.Load when the data for the Link was loaded
+// (and matched its hash), but couldn't be decoded.
+//
+// Reason is the error from the decoder (or the NodeAssembler).
+type ErrDecode struct {
+	Link   Link
+	Reason error
+}
+
+func (e ErrDecode) Error() string {
+	return fmt.Sprintf("could not decode block %s: %s", e.Link, e.Reason)
+}
+
+func (e ErrDecode) Unwrap() error {
+	return e.Reason
+}
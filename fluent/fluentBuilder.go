@@ -39,7 +39,7 @@ type NodeAssembler interface {
 	CreateList(sizeHint int, fn func(ListAssembler))
 	AssignNull()
 	AssignBool(bool)
-	AssignInt(int)
+	AssignInt(int64)
 	AssignFloat(float64)
 	AssignString(string)
 	AssignBytes([]byte)
@@ -109,7 +109,7 @@ func (fna *nodeAssembler) AssignBool(v bool) {
 		panic(Error{err})
 	}
 }
-func (fna *nodeAssembler) AssignInt(v int) {
+func (fna *nodeAssembler) AssignInt(v int64) {
 	if err := fna.na.AssignInt(v); err != nil {
 		panic(Error{err})
 	}
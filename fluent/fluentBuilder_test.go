@@ -68,6 +68,6 @@ func TestBuild(t *testing.T) {
 		Wish(t, n.Length(), ShouldEqual, 1)
 		n = must.Node(n.LookupByIndex(0))
 		Wish(t, n.ReprKind(), ShouldEqual, ipld.ReprKind_Int)
-		Wish(t, must.Int(n), ShouldEqual, 2)
+		Wish(t, must.Int(n), ShouldEqual, int64(2))
 	})
 }
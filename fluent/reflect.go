@@ -2,6 +2,7 @@ package fluent
 
 import (
 	"fmt"
+	"math"
 	"reflect"
 	"sort"
 
@@ -173,7 +174,7 @@ func (rcfg Reflector) ReflectIntoAssembler(na ipld.NodeAssembler, i interface{})
 	case []byte:
 		return na.AssignBytes(x)
 	case int:
-		return na.AssignInt(x)
+		return na.AssignInt(int64(x))
 	case nil:
 		return na.AssignNull()
 	}
@@ -183,9 +184,13 @@ func (rcfg Reflector) ReflectIntoAssembler(na ipld.NodeAssembler, i interface{})
 	case reflect.Bool:
 		return na.AssignBool(rv.Bool())
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		return na.AssignInt(int(rv.Int()))
+		return na.AssignInt(rv.Int())
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		return na.AssignInt(int(rv.Uint()))
+		if rv.Uint() > math.MaxInt64 {
+			// This would need basicnode.NewUint, but we can't import basicnode (its tests use us).
+			return fmt.Errorf("fluent.Reflect: integer %d overflows int64", rv.Uint())
+		}
+		return na.AssignInt(int64(rv.Uint()))
 	case reflect.Float32, reflect.Float64:
 		return na.AssignFloat(rv.Float())
 	case reflect.String:
@@ -85,6 +85,9 @@ type LinkBuilder interface {
 // is used by systems in the traversal package; most Loader implementations
 // should also work fine when given the zero value of LinkContext.
 //
+// If there's no data for the Link, Loaders should return ErrBlockNotFound,
+// so that callers can tell that apart from other failures.
+//
 // Loaders are implicitly coupled to a Link implementation and have some
 // "extra" knowledge of the concrete Link type.  This necessary since there is
 // no mandated standard for how to serially represent Link itself, and such
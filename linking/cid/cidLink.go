@@ -8,6 +8,7 @@ import (
 
 	cid "github.com/ipfs/go-cid"
 	ipld "github.com/ipld/go-ipld-prime"
+	mh "github.com/multiformats/go-multihash"
 )
 
 var (
@@ -25,48 +26,54 @@ type byteAccesor interface {
 }
 
 func (lnk Link) Load(ctx context.Context, lnkCtx ipld.LinkContext, na ipld.NodeAssembler, loader ipld.Loader) error {
+	mcDecoder, exists := multicodecDecodeTable[lnk.Prefix().Codec]
+	if !exists {
+		return ipld.ErrUnknownCodec{Codec: lnk.Prefix().Codec}
+	}
+	// Identity-hashed CIDs contain their content: decode it from there.
+	//  The loader isn't needed, and there's no hash to check.
+	if lnk.Prefix().MhType == mh.IDENTITY {
+		dec, err := mh.Decode(lnk.Hash())
+		if err != nil {
+			return err
+		}
+		if err := mcDecoder(na, bytes.NewReader(dec.Digest)); err != nil {
+			return ipld.ErrDecode{Link: lnk, Reason: err}
+		}
+		return nil
+	}
 	// Open the byte reader.
 	r, err := loader(lnk, lnkCtx)
 	if err != nil {
 		return err
 	}
 	// Tee into hash checking and unmarshalling.
-	mcDecoder, exists := multicodecDecodeTable[lnk.Prefix().Codec]
-	if !exists {
-		return fmt.Errorf("no decoder registered for multicodec %d", lnk.Prefix().Codec)
-	}
-	var hasherBytes []byte
+	hasher := newHasher(lnk.Prefix())
 	var decodeErr error
 	byteBuf, ok := r.(byteAccesor)
 	if ok {
-		hasherBytes = byteBuf.Bytes()
+		hasher.Write(byteBuf.Bytes())
 		decodeErr = mcDecoder(na, r)
 	} else {
-		var hasher bytes.Buffer // multihash only exports bulk use, which is... really inefficient and should be fixed.
-		decodeErr = mcDecoder(na, io.TeeReader(r, &hasher))
+		decodeErr = mcDecoder(na, io.TeeReader(r, hasher))
 		// Error checking order here is tricky.
-		//  If decoding errored out, we should still run the reader to the end, to check the hash.
-		//  (We still don't implement this by running the hash to the end first, because that would increase the high-water memory requirement.)
-		//   ((Which we experience right now anyway because multihash's interface is silly, but we're acting as if that's fixed or will be soon.))
+		//  Whether or not decoding errored out, we should still run the reader to the end, to check the hash.
+		//  (Hashing is incremental, so this doesn't cost memory -- unless the multihash function has no hasher registered.)
 		//  If the hash is rejected, we should return that error (and even if there was a decodeErr, it becomes irrelevant).
-		if decodeErr != nil {
-			_, err := io.Copy(&hasher, r)
-			if err != nil {
-				return err
-			}
+		if _, err := io.Copy(hasher, r); err != nil {
+			return err
 		}
-		hasherBytes = hasher.Bytes()
 	}
 
-	cid, err := lnk.Prefix().Sum(hasherBytes)
+	cid, err := hasher.Sum()
 	if err != nil {
 		return err
 	}
 	if cid != lnk.Cid {
-		return fmt.Errorf("hash mismatch!  %q (actual) != %q (expected)", cid, lnk.Cid)
+		return ipld.ErrHashMismatch{Expected: lnk, Actual: Link{cid}}
 	}
 	if decodeErr != nil {
-		return decodeErr
+		return ipld.ErrDecode{Link: lnk, Reason: decodeErr}
 	}
 	return nil
 }
@@ -92,13 +99,13 @@ func (lb LinkBuilder) Build(ctx context.Context, lnkCtx ipld.LinkContext, node i
 	if !exists {
 		return nil, fmt.Errorf("no encoder registered for multicodec %d", lb.Prefix.Codec)
 	}
-	var hasher bytes.Buffer // multihash-via-cid only exports bulk use, which is... really inefficient and should be fixed.
-	w = io.MultiWriter(&hasher, w)
+	hasher := newHasher(lb.Prefix)
+	w = io.MultiWriter(hasher, w)
 	err = mcEncoder(node, w)
 	if err != nil {
 		return nil, err
 	}
-	cid, err := lb.Prefix.Sum(hasher.Bytes())
+	cid, err := hasher.Sum()
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,69 @@
+package cidlink
+
+import (
+	"bytes"
+	"fmt"
+	"hash"
+
+	cid "github.com/ipfs/go-cid"
+	mh "github.com/multiformats/go-multihash"
+)
+
+// hasher is an io.Writer that computes the CID of everything written to it.
+//
+// If there's a hash.Hash registered for the multihash function, the data
+// is hashed as it's written, so memory use stays constant however much
+// is written.  Otherwise, it's buffered, and handed to cid.Prefix.Sum at the end.
+type hasher struct {
+	prefix cid.Prefix
+	h      hash.Hash
+	buf    bytes.Buffer
+}
+
+func newHasher(prefix cid.Prefix) *hasher {
+	hr := &hasher{prefix: prefix}
+	if fn, exists := multihashHasherTable[prefix.MhType]; exists {
+		hr.h = fn()
+	}
+	return hr
+}
+
+func (hr *hasher) Write(b []byte) (int, error) {
+	if hr.h == nil {
+		return hr.buf.Write(b)
+	}
+	return hr.h.Write(b)
+}
+
+// Sum returns the CID of the data written so far.
+// It behaves like cid.Prefix.Sum, including its checks of the prefix.
+func (hr *hasher) Sum() (cid.Cid, error) {
+	if hr.h == nil {
+		return hr.prefix.Sum(hr.buf.Bytes())
+	}
+	p := hr.prefix
+	if p.Version == 0 && (p.MhType != mh.SHA2_256 || (p.MhLength != 32 && p.MhLength != -1)) {
+		return cid.Undef, fmt.Errorf("invalid v0 prefix")
+	}
+	digest := hr.h.Sum(nil)
+	switch {
+	case p.MhLength < 0:
+		// Default length: the whole digest.
+	case p.MhLength > len(digest):
+		return cid.Undef, mh.ErrLenTooLarge
+	default:
+		digest = digest[:p.MhLength]
+	}
+	mhash, err := mh.Encode(digest, p.MhType)
+	if err != nil {
+		return cid.Undef, err
+	}
+	switch p.Version {
+	case 0:
+		return cid.NewCidV0(mhash), nil
+	case 1:
+		return cid.NewCidV1(p.Codec, mhash), nil
+	default:
+		return cid.Undef, fmt.Errorf("invalid cid version")
+	}
+}
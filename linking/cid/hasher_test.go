@@ -0,0 +1,54 @@
+package cidlink
+
+import (
+	"bytes"
+	"testing"
+
+	cid "github.com/ipfs/go-cid"
+	mh "github.com/multiformats/go-multihash"
+	. "github.com/warpfork/go-wish"
+)
+
+func TestHasher(t *testing.T) {
+	data := bytes.Repeat([]byte("some data to hash, in pieces; "), 1000)
+	check := func(t *testing.T, p cid.Prefix) {
+		hr := newHasher(p)
+		for b := data; len(b) > 0; {
+			n := len(b)
+			if n > 4096 {
+				n = 4096
+			}
+			hr.Write(b[:n])
+			b = b[n:]
+		}
+		actual, err := hr.Sum()
+		expected, expectedErr := p.Sum(data)
+		Wish(t, err, ShouldEqual, expectedErr)
+		Wish(t, actual, ShouldEqual, expected)
+	}
+	t.Run("registered hashers agree with go-multihash", func(t *testing.T) {
+		for code := range multihashHasherTable {
+			t.Run(mh.Codes[code], func(t *testing.T) {
+				check(t, cid.Prefix{Version: 1, Codec: 0x71, MhType: code, MhLength: -1})
+			})
+		}
+	})
+	t.Run("truncated", func(t *testing.T) {
+		check(t, cid.Prefix{Version: 1, Codec: 0x71, MhType: mh.SHA3_224, MhLength: 4})
+	})
+	t.Run("too long", func(t *testing.T) {
+		hr := newHasher(cid.Prefix{Version: 1, Codec: 0x71, MhType: mh.SHA2_256, MhLength: 33})
+		_, err := hr.Sum()
+		Wish(t, err, ShouldEqual, mh.ErrLenTooLarge)
+	})
+	t.Run("cidv0", func(t *testing.T) {
+		check(t, cid.Prefix{Version: 0, Codec: 0x70, MhType: mh.SHA2_256, MhLength: 32})
+		check(t, cid.Prefix{Version: 0, Codec: 0x70, MhType: mh.SHA2_512, MhLength: 64})
+	})
+	t.Run("unregistered functions fall back to buffering", func(t *testing.T) {
+		for _, code := range []uint64{mh.DBL_SHA2_256, mh.MURMUR3_128, mh.SHAKE_256} {
+			Wish(t, newHasher(cid.Prefix{MhType: code}).h, ShouldEqual, nil)
+			check(t, cid.Prefix{Version: 1, Codec: 0x71, MhType: code, MhLength: -1})
+		}
+	})
+}
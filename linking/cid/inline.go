@@ -0,0 +1,105 @@
+package cidlink
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	cid "github.com/ipfs/go-cid"
+	ipld "github.com/ipld/go-ipld-prime"
+	mh "github.com/multiformats/go-multihash"
+)
+
+var _ ipld.LinkBuilder = InliningLinkBuilder{}
+
+// InliningLinkBuilder is a LinkBuilder which inlines small blocks into
+// their links, instead of storing them: if the encoded block is no larger
+// than MaxInlineSize bytes, the link is a CID with the identity multihash,
+// whose "digest" is the block itself, and the Storer isn't used at all.
+// Larger blocks are hashed and stored as usual, according to the Prefix.
+//
+// Link.Load decodes identity-hashed CIDs straight from the CID,
+// without using the Loader, so inlined links load without storage too.
+//
+// Inlined links are always CIDv1 (there's no such thing as an inlined CIDv0),
+// and keep the Prefix's codec.
+// Blocks are buffered only up to MaxInlineSize; past that, they're streamed
+// to the Storer as usual.
+type InliningLinkBuilder struct {
+	LinkBuilder
+	MaxInlineSize int
+}
+
+func (lb InliningLinkBuilder) Build(ctx context.Context, lnkCtx ipld.LinkContext, node ipld.Node, storer ipld.Storer) (ipld.Link, error) {
+	mcEncoder, exists := multicodecEncodeTable[lb.Prefix.Codec]
+	if !exists {
+		return nil, fmt.Errorf("no encoder registered for multicodec %d", lb.Prefix.Codec)
+	}
+	// Marshal into a buffer, until it's clear the block is too large to inline;
+	//  then open the storer, and tee into it and the hasher from there on.
+	var hasher *hasher
+	var commit ipld.StoreCommitter
+	w := &inliningWriter{
+		limit: lb.MaxInlineSize,
+		spill: func() (io.Writer, error) {
+			w, c, err := storer(lnkCtx)
+			if err != nil {
+				return nil, err
+			}
+			hasher, commit = newHasher(lb.Prefix), c
+			return io.MultiWriter(hasher, w), nil
+		},
+	}
+	if err := mcEncoder(node, w); err != nil {
+		return nil, err
+	}
+	if commit == nil {
+		cid, err := cid.Prefix{
+			Version:  1,
+			Codec:    lb.Prefix.Codec,
+			MhType:   mh.IDENTITY,
+			MhLength: -1,
+		}.Sum(w.buf.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		return Link{cid}, nil
+	}
+	cid, err := hasher.Sum()
+	if err != nil {
+		return nil, err
+	}
+	lnk := Link{cid}
+	if err := commit(lnk); err != nil {
+		return lnk, err
+	}
+	return lnk, nil
+}
+
+// inliningWriter buffers up to limit bytes.
+// If more than that is written, it calls spill (once) to get another writer,
+// and passes everything to that instead, starting with what it had buffered.
+type inliningWriter struct {
+	limit int
+	buf   bytes.Buffer
+	spill func() (io.Writer, error)
+	w     io.Writer // set once spilled.
+}
+
+func (iw *inliningWriter) Write(b []byte) (int, error) {
+	if iw.w == nil {
+		if iw.buf.Len()+len(b) <= iw.limit {
+			return iw.buf.Write(b)
+		}
+		w, err := iw.spill()
+		if err != nil {
+			return 0, err
+		}
+		if _, err := w.Write(iw.buf.Bytes()); err != nil {
+			return 0, err
+		}
+		iw.w = w
+	}
+	return iw.w.Write(b)
+}
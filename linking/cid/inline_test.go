@@ -0,0 +1,72 @@
+package cidlink_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	cid "github.com/ipfs/go-cid"
+	mh "github.com/multiformats/go-multihash"
+	. "github.com/warpfork/go-wish"
+
+	ipld "github.com/ipld/go-ipld-prime"
+	_ "github.com/ipld/go-ipld-prime/codec/dagcbor"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	basicnode "github.com/ipld/go-ipld-prime/node/basic"
+	"github.com/ipld/go-ipld-prime/storage"
+)
+
+func TestInlining(t *testing.T) {
+	lb := cidlink.InliningLinkBuilder{
+		LinkBuilder: cidlink.LinkBuilder{Prefix: cid.Prefix{
+			Version:  1,
+			Codec:    0x71,
+			MhType:   mh.SHA2_256,
+			MhLength: 32,
+		}},
+		MaxInlineSize: 16,
+	}
+	noStorer := func(ipld.LinkContext) (io.Writer, ipld.StoreCommitter, error) {
+		return nil, nil, fmt.Errorf("storer shouldn't be used")
+	}
+	noLoader := func(ipld.Link, ipld.LinkContext) (io.Reader, error) {
+		return nil, fmt.Errorf("loader shouldn't be used")
+	}
+	ctx := context.Background()
+
+	t.Run("small blocks are inlined", func(t *testing.T) {
+		n := basicnode.NewString("tiny") // 5 bytes of cbor.
+		lnk, err := lb.Build(ctx, ipld.LinkContext{}, n, noStorer)
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, lnk.(cidlink.Link).Prefix(), ShouldEqual, cid.Prefix{Version: 1, Codec: 0x71, MhType: mh.IDENTITY, MhLength: 5})
+
+		nb := basicnode.Prototype.Any.NewBuilder()
+		Require(t, lnk.Load(ctx, ipld.LinkContext{}, nb, noLoader), ShouldEqual, nil)
+		Wish(t, nb.Build(), ShouldEqual, n)
+	})
+	t.Run("the limit is inclusive", func(t *testing.T) {
+		n := basicnode.NewString(strings.Repeat("x", 15)) // 16 bytes of cbor.
+		lnk, err := lb.Build(ctx, ipld.LinkContext{}, n, noStorer)
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, lnk.(cidlink.Link).Prefix().MhType, ShouldEqual, uint64(mh.IDENTITY))
+	})
+	t.Run("larger blocks are stored", func(t *testing.T) {
+		n := basicnode.NewString(strings.Repeat("x", 16)) // 17 bytes of cbor.
+		store := storage.MemoryStore{}
+		lnk, err := lb.Build(ctx, ipld.LinkContext{}, n, store.Storer)
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, lnk.(cidlink.Link).Prefix(), ShouldEqual, lb.Prefix)
+		Wish(t, store.Len(), ShouldEqual, 1)
+
+		// The same as a plain LinkBuilder would make.
+		plain, err := lb.LinkBuilder.Build(ctx, ipld.LinkContext{}, n, store.Storer)
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, lnk, ShouldEqual, plain)
+
+		nb := basicnode.Prototype.Any.NewBuilder()
+		Require(t, lnk.Load(ctx, ipld.LinkContext{}, nb, store.Loader), ShouldEqual, nil)
+		Wish(t, nb.Build(), ShouldEqual, n)
+	})
+}
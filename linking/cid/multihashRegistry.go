@@ -0,0 +1,63 @@
+package cidlink
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"hash"
+
+	mh "github.com/multiformats/go-multihash"
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/blake2s"
+	"golang.org/x/crypto/sha3"
+)
+
+// MultihashHasherTable maps multihash codes to the functions which make
+// a hash.Hash for them.
+type MultihashHasherTable map[uint64]func() hash.Hash
+
+var multihashHasherTable MultihashHasherTable
+
+func init() {
+	multihashHasherTable = MultihashHasherTable{
+		mh.SHA1:       sha1.New,
+		mh.MD5:        md5.New,
+		mh.SHA2_256:   sha256.New,
+		mh.SHA2_512:   sha512.New,
+		mh.SHA3_224:   sha3.New224,
+		mh.SHA3_256:   sha3.New256,
+		mh.SHA3_384:   sha3.New384,
+		mh.SHA3_512:   sha3.New512,
+		mh.KECCAK_256: sha3.NewLegacyKeccak256,
+		mh.KECCAK_512: sha3.NewLegacyKeccak512,
+		mh.BLAKE2S_MAX: func() hash.Hash { // blake2s-256: the only size of blake2s that go-multihash supports.
+			h, _ := blake2s.New256(nil)
+			return h
+		},
+	}
+	for c := uint64(mh.BLAKE2B_MIN); c <= mh.BLAKE2B_MAX; c++ {
+		size := int(c - mh.BLAKE2B_MIN + 1)
+		multihashHasherTable[c] = func() hash.Hash {
+			h, _ := blake2b.New(size, nil)
+			return h
+		}
+	}
+}
+
+// RegisterMultihashHasher is used to register multihash functions,
+// so that Link.Load and LinkBuilder.Build can hash incrementally with them.
+// It adjusts a global registry and may only be used at program init time;
+// it is meant to provide a plugin system, not a configuration mechanism.
+//
+// Multihash functions with no hasher registered still work, as long as
+// go-multihash supports them; but the whole block is buffered to hash it.
+// (The identity multihash is always handled that way: it has to be.)
+func RegisterMultihashHasher(code uint64, fn func() hash.Hash) {
+	_, exists := multihashHasherTable[code]
+	if exists {
+		panic(fmt.Errorf("multihash hasher already registered for %x", code))
+	}
+	multihashHasherTable[code] = fn
+}
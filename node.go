@@ -142,7 +142,7 @@ type Node interface {
 
 	IsNull() bool
 	AsBool() (bool, error)
-	AsInt() (int, error)
+	AsInt() (int64, error)
 	AsFloat() (float64, error)
 	AsString() (string, error)
 	AsBytes() ([]byte, error)
@@ -207,6 +207,21 @@ type NodePrototypeSupportingAmend interface {
 	// FUTURE: consider putting this (and others like it) in a `feature` package, if there begin to be enough of them and docs get crowded.
 }
 
+// UintNode is a feature-detection interface for int-kinded nodes which
+// can hold unsigned values beyond the range of int64.
+// (IPLD's integers are as wide as the codecs allow; DAG-CBOR, for example,
+// can encode anything from -2^64 to 2^64-1, so AsInt alone can't always tell the whole story.)
+//
+// For such nodes, AsInt returns an error if the value doesn't fit in an int64,
+// and AsUint returns an error if the value is negative.
+//
+// Codecs check for this interface when encoding int-kinded nodes, and when decoding,
+// assign values too large for AssignInt by way of AssignNode (see basicnode.NewUint).
+type UintNode interface {
+	Node
+	AsUint() (uint64, error)
+}
+
 // MapIterator is an interface for traversing map nodes.
 // Sequential calls to Next() will yield key-value pairs;
 // Done() describes whether iteration should continue.
@@ -108,7 +108,7 @@ func (nb *anyBuilder) AssignBool(v bool) error {
 	nb.scalarNode = NewBool(v)
 	return nil
 }
-func (nb *anyBuilder) AssignInt(v int) error {
+func (nb *anyBuilder) AssignInt(v int64) error {
 	if nb.kind != ipld.ReprKind_Invalid {
 		panic("misuse")
 	}
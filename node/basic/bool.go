@@ -55,7 +55,7 @@ func (plainBool) IsNull() bool {
 func (n plainBool) AsBool() (bool, error) {
 	return bool(n), nil
 }
-func (plainBool) AsInt() (int, error) {
+func (plainBool) AsInt() (int64, error) {
 	return mixins.Bool{"bool"}.AsInt()
 }
 func (plainBool) AsFloat() (float64, error) {
@@ -116,7 +116,7 @@ func (na *plainBool__Assembler) AssignBool(v bool) error {
 	*na.w = plainBool(v)
 	return nil
 }
-func (plainBool__Assembler) AssignInt(int) error {
+func (plainBool__Assembler) AssignInt(int64) error {
 	return mixins.BoolAssembler{"bool"}.AssignInt(0)
 }
 func (plainBool__Assembler) AssignFloat(float64) error {
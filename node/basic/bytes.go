@@ -55,7 +55,7 @@ func (plainBytes) IsNull() bool {
 func (plainBytes) AsBool() (bool, error) {
 	return mixins.Bytes{"bytes"}.AsBool()
 }
-func (plainBytes) AsInt() (int, error) {
+func (plainBytes) AsInt() (int64, error) {
 	return mixins.Bytes{"bytes"}.AsInt()
 }
 func (plainBytes) AsFloat() (float64, error) {
@@ -115,7 +115,7 @@ func (plainBytes__Assembler) AssignNull() error {
 func (plainBytes__Assembler) AssignBool(bool) error {
 	return mixins.BytesAssembler{"bytes"}.AssignBool(false)
 }
-func (plainBytes__Assembler) AssignInt(int) error {
+func (plainBytes__Assembler) AssignInt(int64) error {
 	return mixins.BytesAssembler{"bytes"}.AssignInt(0)
 }
 func (plainBytes__Assembler) AssignFloat(float64) error {
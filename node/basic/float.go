@@ -55,7 +55,7 @@ func (plainFloat) IsNull() bool {
 func (plainFloat) AsBool() (bool, error) {
 	return mixins.Float{"float"}.AsBool()
 }
-func (plainFloat) AsInt() (int, error) {
+func (plainFloat) AsInt() (int64, error) {
 	return mixins.Float{"float"}.AsInt()
 }
 func (n plainFloat) AsFloat() (float64, error) {
@@ -115,7 +115,7 @@ func (plainFloat__Assembler) AssignNull() error {
 func (plainFloat__Assembler) AssignBool(bool) error {
 	return mixins.FloatAssembler{"float"}.AssignBool(false)
 }
-func (plainFloat__Assembler) AssignInt(int) error {
+func (plainFloat__Assembler) AssignInt(int64) error {
 	return mixins.FloatAssembler{"float"}.AssignInt(0)
 }
 func (na *plainFloat__Assembler) AssignFloat(v float64) error {
@@ -12,13 +12,13 @@ var (
 	_ ipld.NodeAssembler = &plainInt__Assembler{}
 )
 
-func NewInt(value int) ipld.Node {
+func NewInt(value int64) ipld.Node {
 	v := plainInt(value)
 	return &v
 }
 
 // plainInt is a simple boxed int that complies with ipld.Node.
-type plainInt int
+type plainInt int64
 
 // -- Node interface methods -->
 
@@ -55,8 +55,8 @@ func (plainInt) IsNull() bool {
 func (plainInt) AsBool() (bool, error) {
 	return mixins.Int{"int"}.AsBool()
 }
-func (n plainInt) AsInt() (int, error) {
-	return int(n), nil
+func (n plainInt) AsInt() (int64, error) {
+	return int64(n), nil
 }
 func (plainInt) AsFloat() (float64, error) {
 	return mixins.Int{"int"}.AsFloat()
@@ -115,7 +115,7 @@ func (plainInt__Assembler) AssignNull() error {
 func (plainInt__Assembler) AssignBool(bool) error {
 	return mixins.IntAssembler{"int"}.AssignBool(false)
 }
-func (na *plainInt__Assembler) AssignInt(v int) error {
+func (na *plainInt__Assembler) AssignInt(v int64) error {
 	*na.w = plainInt(v)
 	return nil
 }
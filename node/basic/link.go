@@ -56,7 +56,7 @@ func (plainLink) IsNull() bool {
 func (plainLink) AsBool() (bool, error) {
 	return mixins.Link{"link"}.AsBool()
 }
-func (plainLink) AsInt() (int, error) {
+func (plainLink) AsInt() (int64, error) {
 	return mixins.Link{"link"}.AsInt()
 }
 func (plainLink) AsFloat() (float64, error) {
@@ -116,7 +116,7 @@ func (plainLink__Assembler) AssignNull() error {
 func (plainLink__Assembler) AssignBool(bool) error {
 	return mixins.LinkAssembler{"link"}.AssignBool(false)
 }
-func (plainLink__Assembler) AssignInt(int) error {
+func (plainLink__Assembler) AssignInt(int64) error {
 	return mixins.LinkAssembler{"link"}.AssignInt(0)
 }
 func (plainLink__Assembler) AssignFloat(float64) error {
@@ -61,7 +61,7 @@ func (plainList) IsNull() bool {
 func (plainList) AsBool() (bool, error) {
 	return mixins.List{"list"}.AsBool()
 }
-func (plainList) AsInt() (int, error) {
+func (plainList) AsInt() (int64, error) {
 	return mixins.List{"list"}.AsInt()
 }
 func (plainList) AsFloat() (float64, error) {
@@ -165,7 +165,7 @@ func (plainList__Assembler) AssignNull() error {
 func (plainList__Assembler) AssignBool(bool) error {
 	return mixins.ListAssembler{"list"}.AssignBool(false)
 }
-func (plainList__Assembler) AssignInt(int) error {
+func (plainList__Assembler) AssignInt(int64) error {
 	return mixins.ListAssembler{"list"}.AssignInt(0)
 }
 func (plainList__Assembler) AssignFloat(float64) error {
@@ -270,7 +270,7 @@ func (lva *plainList__ValueAssembler) AssignBool(v bool) error {
 	vb := plainBool(v)
 	return lva.AssignNode(&vb)
 }
-func (lva *plainList__ValueAssembler) AssignInt(v int) error {
+func (lva *plainList__ValueAssembler) AssignInt(v int64) error {
 	vb := plainInt(v)
 	return lva.AssignNode(&vb)
 }
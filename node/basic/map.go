@@ -71,7 +71,7 @@ func (plainMap) IsNull() bool {
 func (plainMap) AsBool() (bool, error) {
 	return mixins.Map{"map"}.AsBool()
 }
-func (plainMap) AsInt() (int, error) {
+func (plainMap) AsInt() (int64, error) {
 	return mixins.Map{"map"}.AsInt()
 }
 func (plainMap) AsFloat() (float64, error) {
@@ -181,7 +181,7 @@ func (plainMap__Assembler) AssignNull() error {
 func (plainMap__Assembler) AssignBool(bool) error {
 	return mixins.MapAssembler{"map"}.AssignBool(false)
 }
-func (plainMap__Assembler) AssignInt(int) error {
+func (plainMap__Assembler) AssignInt(int64) error {
 	return mixins.MapAssembler{"map"}.AssignInt(0)
 }
 func (plainMap__Assembler) AssignFloat(float64) error {
@@ -316,7 +316,7 @@ func (plainMap__KeyAssembler) AssignNull() error {
 func (plainMap__KeyAssembler) AssignBool(bool) error {
 	return mixins.StringAssembler{"string"}.AssignBool(false)
 }
-func (plainMap__KeyAssembler) AssignInt(int) error {
+func (plainMap__KeyAssembler) AssignInt(int64) error {
 	return mixins.StringAssembler{"string"}.AssignInt(0)
 }
 func (plainMap__KeyAssembler) AssignFloat(float64) error {
@@ -382,7 +382,7 @@ func (mva *plainMap__ValueAssembler) AssignBool(v bool) error {
 	vb := plainBool(v)
 	return mva.AssignNode(&vb)
 }
-func (mva *plainMap__ValueAssembler) AssignInt(v int) error {
+func (mva *plainMap__ValueAssembler) AssignInt(v int64) error {
 	vb := plainInt(v)
 	return mva.AssignNode(&vb)
 }
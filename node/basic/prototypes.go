@@ -19,6 +19,7 @@ type prototype struct {
 	List   Prototype__List
 	Bool   Prototype__Bool
 	Int    Prototype__Int
+	Uint   Prototype__Uint
 	Float  Prototype__Float
 	String Prototype__String
 	Bytes  Prototype__Bytes
@@ -60,7 +60,7 @@ func (plainString) IsNull() bool {
 func (plainString) AsBool() (bool, error) {
 	return mixins.String{"string"}.AsBool()
 }
-func (plainString) AsInt() (int, error) {
+func (plainString) AsInt() (int64, error) {
 	return mixins.String{"string"}.AsInt()
 }
 func (plainString) AsFloat() (float64, error) {
@@ -120,7 +120,7 @@ func (plainString__Assembler) AssignNull() error {
 func (plainString__Assembler) AssignBool(bool) error {
 	return mixins.StringAssembler{"string"}.AssignBool(false)
 }
-func (plainString__Assembler) AssignInt(int) error {
+func (plainString__Assembler) AssignInt(int64) error {
 	return mixins.StringAssembler{"string"}.AssignInt(0)
 }
 func (plainString__Assembler) AssignFloat(float64) error {
@@ -0,0 +1,177 @@
+package basicnode
+
+import (
+	"fmt"
+	"math"
+
+	ipld "github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/node/mixins"
+)
+
+var (
+	_ ipld.Node          = plainUint(0)
+	_ ipld.UintNode      = plainUint(0)
+	_ ipld.NodePrototype = Prototype__Uint{}
+	_ ipld.NodeBuilder   = &plainUint__Builder{}
+	_ ipld.NodeAssembler = &plainUint__Assembler{}
+)
+
+// NewUint creates an int-kinded node which can hold any uint64,
+// including values beyond the range of int64 (which NewInt can't hold).
+// The node implements ipld.UintNode; its AsInt method returns an error
+// if the value is larger than math.MaxInt64.
+//
+// There's no NodeAssembler method for uint64s: to assemble one,
+// use NewUint and AssignNode.  (Codecs do this for any integer too large for AssignInt.)
+// Prototype.Any stores the node as-is, and so does Prototype.Uint, which is the
+// node's own prototype; Prototype__Int can only hold values which fit in an int64.
+func NewUint(value uint64) ipld.Node {
+	v := plainUint(value)
+	return &v
+}
+
+// plainUint is a simple boxed uint64 that complies with ipld.Node and ipld.UintNode.
+type plainUint uint64
+
+// -- Node interface methods -->
+
+func (plainUint) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_Int
+}
+func (plainUint) LookupByString(string) (ipld.Node, error) {
+	return mixins.Int{"int"}.LookupByString("")
+}
+func (plainUint) LookupByNode(key ipld.Node) (ipld.Node, error) {
+	return mixins.Int{"int"}.LookupByNode(nil)
+}
+func (plainUint) LookupByIndex(idx int) (ipld.Node, error) {
+	return mixins.Int{"int"}.LookupByIndex(0)
+}
+func (plainUint) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	return mixins.Int{"int"}.LookupBySegment(seg)
+}
+func (plainUint) MapIterator() ipld.MapIterator {
+	return nil
+}
+func (plainUint) ListIterator() ipld.ListIterator {
+	return nil
+}
+func (plainUint) Length() int {
+	return -1
+}
+func (plainUint) IsAbsent() bool {
+	return false
+}
+func (plainUint) IsNull() bool {
+	return false
+}
+func (plainUint) AsBool() (bool, error) {
+	return mixins.Int{"int"}.AsBool()
+}
+func (n plainUint) AsInt() (int64, error) {
+	if n > math.MaxInt64 {
+		return 0, fmt.Errorf("integer %d overflows int64", uint64(n))
+	}
+	return int64(n), nil
+}
+func (n plainUint) AsUint() (uint64, error) {
+	return uint64(n), nil
+}
+func (plainUint) AsFloat() (float64, error) {
+	return mixins.Int{"int"}.AsFloat()
+}
+func (plainUint) AsString() (string, error) {
+	return mixins.Int{"int"}.AsString()
+}
+func (plainUint) AsBytes() ([]byte, error) {
+	return mixins.Int{"int"}.AsBytes()
+}
+func (plainUint) AsLink() (ipld.Link, error) {
+	return mixins.Int{"int"}.AsLink()
+}
+func (plainUint) Prototype() ipld.NodePrototype {
+	return Prototype__Uint{}
+}
+
+// -- NodePrototype -->
+
+// Prototype__Uint builds the same nodes as NewUint.
+// Its assemblers accept any int which isn't negative:
+// through AssignInt, or through AssignNode, which keeps the full range of uint64
+// when given an ipld.UintNode.
+type Prototype__Uint struct{}
+
+func (Prototype__Uint) NewBuilder() ipld.NodeBuilder {
+	var w plainUint
+	return &plainUint__Builder{plainUint__Assembler{w: &w}}
+}
+
+// -- NodeBuilder -->
+
+type plainUint__Builder struct {
+	plainUint__Assembler
+}
+
+func (nb *plainUint__Builder) Build() ipld.Node {
+	return nb.w
+}
+func (nb *plainUint__Builder) Reset() {
+	var w plainUint
+	*nb = plainUint__Builder{plainUint__Assembler{w: &w}}
+}
+
+// -- NodeAssembler -->
+
+type plainUint__Assembler struct {
+	w *plainUint
+}
+
+func (plainUint__Assembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	return mixins.IntAssembler{"int"}.BeginMap(0)
+}
+func (plainUint__Assembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.IntAssembler{"int"}.BeginList(0)
+}
+func (plainUint__Assembler) AssignNull() error {
+	return mixins.IntAssembler{"int"}.AssignNull()
+}
+func (plainUint__Assembler) AssignBool(bool) error {
+	return mixins.IntAssembler{"int"}.AssignBool(false)
+}
+func (na *plainUint__Assembler) AssignInt(v int64) error {
+	if v < 0 {
+		return fmt.Errorf("integer %d is negative, so can't be held as a uint64", v)
+	}
+	*na.w = plainUint(v)
+	return nil
+}
+func (plainUint__Assembler) AssignFloat(float64) error {
+	return mixins.IntAssembler{"int"}.AssignFloat(0)
+}
+func (plainUint__Assembler) AssignString(string) error {
+	return mixins.IntAssembler{"int"}.AssignString("")
+}
+func (plainUint__Assembler) AssignBytes([]byte) error {
+	return mixins.IntAssembler{"int"}.AssignBytes(nil)
+}
+func (plainUint__Assembler) AssignLink(ipld.Link) error {
+	return mixins.IntAssembler{"int"}.AssignLink(nil)
+}
+func (na *plainUint__Assembler) AssignNode(v ipld.Node) error {
+	if un, ok := v.(ipld.UintNode); ok {
+		v2, err := un.AsUint()
+		if err != nil {
+			return err
+		}
+		*na.w = plainUint(v2)
+		return nil
+	}
+	if v2, err := v.AsInt(); err != nil {
+		return err
+	} else {
+		return na.AssignInt(v2)
+	}
+}
+func (plainUint__Assembler) Prototype() ipld.NodePrototype {
+	return Prototype__Uint{}
+}
@@ -0,0 +1,38 @@
+package basicnode
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/warpfork/go-wish"
+
+	ipld "github.com/ipld/go-ipld-prime"
+)
+
+func TestUint(t *testing.T) {
+	t.Run("rebuilding through the node's prototype keeps the value", func(t *testing.T) {
+		n := NewUint(math.MaxUint64)
+		nb := n.Prototype().NewBuilder()
+		Require(t, nb.AssignNode(n), ShouldEqual, nil)
+		u, err := nb.Build().(ipld.UintNode).AsUint()
+		Wish(t, err, ShouldEqual, nil)
+		Wish(t, u, ShouldEqual, uint64(math.MaxUint64))
+	})
+	t.Run("ints that aren't negative are accepted", func(t *testing.T) {
+		nb := Prototype.Uint.NewBuilder()
+		Require(t, nb.AssignInt(7), ShouldEqual, nil)
+		Wish(t, nb.Build(), ShouldEqual, NewUint(7))
+		nb.Reset()
+		Require(t, nb.AssignNode(NewInt(8)), ShouldEqual, nil)
+		Wish(t, nb.Build(), ShouldEqual, NewUint(8))
+	})
+	t.Run("negative ints are rejected", func(t *testing.T) {
+		nb := Prototype.Uint.NewBuilder()
+		Wish(t, nb.AssignInt(-1).Error(), ShouldEqual, "integer -1 is negative, so can't be held as a uint64")
+		Wish(t, nb.AssignNode(NewInt(-1)).Error(), ShouldEqual, "integer -1 is negative, so can't be held as a uint64")
+	})
+	t.Run("other kinds are rejected", func(t *testing.T) {
+		nb := Prototype.Uint.NewBuilder()
+		Wish(t, nb.AssignString("1"), ShouldBeSameTypeAs, ipld.ErrWrongKind{})
+	})
+}
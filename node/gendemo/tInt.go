@@ -8,13 +8,13 @@ import (
 	"github.com/ipld/go-ipld-prime/schema"
 )
 
-type _Int struct{ x int }
+type _Int struct{ x int64 }
 type Int = *_Int
 
-func (n Int) Int() int {
+func (n Int) Int() int64 {
 	return n.x
 }
-func (_Int__Prototype) FromInt(v int) (Int, error) {
+func (_Int__Prototype) FromInt(v int64) (Int, error) {
 	n := _Int{v}
 	return &n, nil
 }
@@ -89,7 +89,7 @@ func (Int) IsNull() bool {
 func (Int) AsBool() (bool, error) {
 	return mixins.Int{"gendemo.Int"}.AsBool()
 }
-func (n Int) AsInt() (int, error) {
+func (n Int) AsInt() (int64, error) {
 	return n.x, nil
 }
 func (Int) AsFloat() (float64, error) {
@@ -159,7 +159,7 @@ func (na *_Int__Assembler) AssignNull() error {
 func (_Int__Assembler) AssignBool(bool) error {
 	return mixins.IntAssembler{"gendemo.Int"}.AssignBool(false)
 }
-func (na *_Int__Assembler) AssignInt(v int) error {
+func (na *_Int__Assembler) AssignInt(v int64) error {
 	switch *na.m {
 	case schema.Maybe_Value, schema.Maybe_Null:
 		panic("invalid state: cannot assign into assembler that's already finished")
@@ -139,7 +139,7 @@ func (Map__String__Msg3) IsNull() bool {
 func (Map__String__Msg3) AsBool() (bool, error) {
 	return mixins.Map{"gendemo.Map__String__Msg3"}.AsBool()
 }
-func (Map__String__Msg3) AsInt() (int, error) {
+func (Map__String__Msg3) AsInt() (int64, error) {
 	return mixins.Map{"gendemo.Map__String__Msg3"}.AsInt()
 }
 func (Map__String__Msg3) AsFloat() (float64, error) {
@@ -235,7 +235,7 @@ func (na *_Map__String__Msg3__Assembler) AssignNull() error {
 func (_Map__String__Msg3__Assembler) AssignBool(bool) error {
 	return mixins.MapAssembler{"gendemo.Map__String__Msg3"}.AssignBool(false)
 }
-func (_Map__String__Msg3__Assembler) AssignInt(int) error {
+func (_Map__String__Msg3__Assembler) AssignInt(int64) error {
 	return mixins.MapAssembler{"gendemo.Map__String__Msg3"}.AssignInt(0)
 }
 func (_Map__String__Msg3__Assembler) AssignFloat(float64) error {
@@ -481,7 +481,7 @@ func (_Map__String__Msg3__Repr) IsNull() bool {
 func (_Map__String__Msg3__Repr) AsBool() (bool, error) {
 	return mixins.Map{"gendemo.Map__String__Msg3.Repr"}.AsBool()
 }
-func (_Map__String__Msg3__Repr) AsInt() (int, error) {
+func (_Map__String__Msg3__Repr) AsInt() (int64, error) {
 	return mixins.Map{"gendemo.Map__String__Msg3.Repr"}.AsInt()
 }
 func (_Map__String__Msg3__Repr) AsFloat() (float64, error) {
@@ -577,7 +577,7 @@ func (na *_Map__String__Msg3__ReprAssembler) AssignNull() error {
 func (_Map__String__Msg3__ReprAssembler) AssignBool(bool) error {
 	return mixins.MapAssembler{"gendemo.Map__String__Msg3.Repr"}.AssignBool(false)
 }
-func (_Map__String__Msg3__ReprAssembler) AssignInt(int) error {
+func (_Map__String__Msg3__ReprAssembler) AssignInt(int64) error {
 	return mixins.MapAssembler{"gendemo.Map__String__Msg3.Repr"}.AssignInt(0)
 }
 func (_Map__String__Msg3__ReprAssembler) AssignFloat(float64) error {
@@ -143,7 +143,7 @@ func (Msg3) IsNull() bool {
 func (Msg3) AsBool() (bool, error) {
 	return mixins.Map{"gendemo.Msg3"}.AsBool()
 }
-func (Msg3) AsInt() (int, error) {
+func (Msg3) AsInt() (int64, error) {
 	return mixins.Map{"gendemo.Msg3"}.AsInt()
 }
 func (Msg3) AsFloat() (float64, error) {
@@ -247,7 +247,7 @@ func (na *_Msg3__Assembler) AssignNull() error {
 func (_Msg3__Assembler) AssignBool(bool) error {
 	return mixins.MapAssembler{"gendemo.Msg3"}.AssignBool(false)
 }
-func (_Msg3__Assembler) AssignInt(int) error {
+func (_Msg3__Assembler) AssignInt(int64) error {
 	return mixins.MapAssembler{"gendemo.Msg3"}.AssignInt(0)
 }
 func (_Msg3__Assembler) AssignFloat(float64) error {
@@ -479,7 +479,7 @@ func (na *_Msg3__KeyAssembler) AssignNull() error {
 func (_Msg3__KeyAssembler) AssignBool(bool) error {
 	return mixins.StringAssembler{"gendemo.Msg3.KeyAssembler"}.AssignBool(false)
 }
-func (_Msg3__KeyAssembler) AssignInt(int) error {
+func (_Msg3__KeyAssembler) AssignInt(int64) error {
 	return mixins.StringAssembler{"gendemo.Msg3.KeyAssembler"}.AssignInt(0)
 }
 func (_Msg3__KeyAssembler) AssignFloat(float64) error {
@@ -624,7 +624,7 @@ func (_Msg3__Repr) IsNull() bool {
 func (_Msg3__Repr) AsBool() (bool, error) {
 	return mixins.Map{"gendemo.Msg3.Repr"}.AsBool()
 }
-func (_Msg3__Repr) AsInt() (int, error) {
+func (_Msg3__Repr) AsInt() (int64, error) {
 	return mixins.Map{"gendemo.Msg3.Repr"}.AsInt()
 }
 func (_Msg3__Repr) AsFloat() (float64, error) {
@@ -720,7 +720,7 @@ func (na *_Msg3__ReprAssembler) AssignNull() error {
 func (_Msg3__ReprAssembler) AssignBool(bool) error {
 	return mixins.MapAssembler{"gendemo.Msg3.Repr"}.AssignBool(false)
 }
-func (_Msg3__ReprAssembler) AssignInt(int) error {
+func (_Msg3__ReprAssembler) AssignInt(int64) error {
 	return mixins.MapAssembler{"gendemo.Msg3.Repr"}.AssignInt(0)
 }
 func (_Msg3__ReprAssembler) AssignFloat(float64) error {
@@ -949,7 +949,7 @@ func (na *_Msg3__ReprKeyAssembler) AssignNull() error {
 func (_Msg3__ReprKeyAssembler) AssignBool(bool) error {
 	return mixins.StringAssembler{"gendemo.Msg3.Repr.KeyAssembler"}.AssignBool(false)
 }
-func (_Msg3__ReprKeyAssembler) AssignInt(int) error {
+func (_Msg3__ReprKeyAssembler) AssignInt(int64) error {
 	return mixins.StringAssembler{"gendemo.Msg3.Repr.KeyAssembler"}.AssignInt(0)
 }
 func (_Msg3__ReprKeyAssembler) AssignFloat(float64) error {
@@ -93,7 +93,7 @@ func (String) IsNull() bool {
 func (String) AsBool() (bool, error) {
 	return mixins.String{"gendemo.String"}.AsBool()
 }
-func (String) AsInt() (int, error) {
+func (String) AsInt() (int64, error) {
 	return mixins.String{"gendemo.String"}.AsInt()
 }
 func (String) AsFloat() (float64, error) {
@@ -163,7 +163,7 @@ func (na *_String__Assembler) AssignNull() error {
 func (_String__Assembler) AssignBool(bool) error {
 	return mixins.StringAssembler{"gendemo.String"}.AssignBool(false)
 }
-func (_String__Assembler) AssignInt(int) error {
+func (_String__Assembler) AssignInt(int64) error {
 	return mixins.StringAssembler{"gendemo.String"}.AssignInt(0)
 }
 func (_String__Assembler) AssignFloat(float64) error {
@@ -49,7 +49,7 @@ func (Bool) IsAbsent() bool {
 func (Bool) IsNull() bool {
 	return false
 }
-func (x Bool) AsInt() (int, error) {
+func (x Bool) AsInt() (int64, error) {
 	return 0, ipld.ErrWrongKind{TypeName: x.TypeName, MethodName: "AsInt", AppropriateKind: ipld.ReprKindSet_JustInt, ActualKind: ipld.ReprKind_Bool}
 }
 func (x Bool) AsFloat() (float64, error) {
@@ -80,7 +80,7 @@ func (x BoolAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
 func (x BoolAssembler) AssignNull() error {
 	return ipld.ErrWrongKind{TypeName: x.TypeName, MethodName: "AssignNull", AppropriateKind: ipld.ReprKindSet_JustNull, ActualKind: ipld.ReprKind_Bool}
 }
-func (x BoolAssembler) AssignInt(int) error {
+func (x BoolAssembler) AssignInt(int64) error {
 	return ipld.ErrWrongKind{TypeName: x.TypeName, MethodName: "AssignInt", AppropriateKind: ipld.ReprKindSet_JustInt, ActualKind: ipld.ReprKind_Bool}
 }
 func (x BoolAssembler) AssignFloat(float64) error {
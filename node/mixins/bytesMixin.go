@@ -52,7 +52,7 @@ func (Bytes) IsNull() bool {
 func (x Bytes) AsBool() (bool, error) {
 	return false, ipld.ErrWrongKind{TypeName: x.TypeName, MethodName: "AsBool", AppropriateKind: ipld.ReprKindSet_JustBool, ActualKind: ipld.ReprKind_Bytes}
 }
-func (x Bytes) AsInt() (int, error) {
+func (x Bytes) AsInt() (int64, error) {
 	return 0, ipld.ErrWrongKind{TypeName: x.TypeName, MethodName: "AsInt", AppropriateKind: ipld.ReprKindSet_JustInt, ActualKind: ipld.ReprKind_Bytes}
 }
 func (x Bytes) AsFloat() (float64, error) {
@@ -83,7 +83,7 @@ func (x BytesAssembler) AssignNull() error {
 func (x BytesAssembler) AssignBool(bool) error {
 	return ipld.ErrWrongKind{TypeName: x.TypeName, MethodName: "AssignBool", AppropriateKind: ipld.ReprKindSet_JustBool, ActualKind: ipld.ReprKind_Bytes}
 }
-func (x BytesAssembler) AssignInt(int) error {
+func (x BytesAssembler) AssignInt(int64) error {
 	return ipld.ErrWrongKind{TypeName: x.TypeName, MethodName: "AssignInt", AppropriateKind: ipld.ReprKindSet_JustInt, ActualKind: ipld.ReprKind_Bytes}
 }
 func (x BytesAssembler) AssignFloat(float64) error {
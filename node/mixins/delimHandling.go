@@ -31,3 +31,27 @@ func SplitExact(s string, sep string, count int) ([]string, error) {
 	}
 	return ss, nil
 }
+
+// SplitPairs splits a string into key-value pairs: entries are separated
+// by 'entrySep', and within each entry, the key is separated from the value
+// by the first occurrence of 'innerSep'.  It will error if any entry
+// doesn't contain 'innerSep'.  An empty string yields no pairs.
+//
+// SplitPairs is used by the 'stringpairs' representation for structs.
+// It doesn't check the keys in any way; repeated or unknown keys
+// are for the caller to reject.
+func SplitPairs(s string, innerSep string, entrySep string) ([][2]string, error) {
+	if s == "" {
+		return nil, nil
+	}
+	entries := strings.Split(s, entrySep)
+	pairs := make([][2]string, len(entries))
+	for i, entry := range entries {
+		kv := strings.SplitN(entry, innerSep, 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("entry %q does not contain the delimiter %q", entry, innerSep)
+		}
+		pairs[i] = [2]string{kv[0], kv[1]}
+	}
+	return pairs, nil
+}
@@ -52,7 +52,7 @@ func (Float) IsNull() bool {
 func (x Float) AsBool() (bool, error) {
 	return false, ipld.ErrWrongKind{TypeName: x.TypeName, MethodName: "AsBool", AppropriateKind: ipld.ReprKindSet_JustBool, ActualKind: ipld.ReprKind_Float}
 }
-func (x Float) AsInt() (int, error) {
+func (x Float) AsInt() (int64, error) {
 	return 0, ipld.ErrWrongKind{TypeName: x.TypeName, MethodName: "AsInt", AppropriateKind: ipld.ReprKindSet_JustInt, ActualKind: ipld.ReprKind_Float}
 }
 func (x Float) AsString() (string, error) {
@@ -83,7 +83,7 @@ func (x FloatAssembler) AssignNull() error {
 func (x FloatAssembler) AssignBool(bool) error {
 	return ipld.ErrWrongKind{TypeName: x.TypeName, MethodName: "AssignBool", AppropriateKind: ipld.ReprKindSet_JustBool, ActualKind: ipld.ReprKind_Float}
 }
-func (x FloatAssembler) AssignInt(int) error {
+func (x FloatAssembler) AssignInt(int64) error {
 	return ipld.ErrWrongKind{TypeName: x.TypeName, MethodName: "AssignInt", AppropriateKind: ipld.ReprKindSet_JustInt, ActualKind: ipld.ReprKind_Float}
 }
 func (x FloatAssembler) AssignString(string) error {
@@ -52,7 +52,7 @@ func (Link) IsNull() bool {
 func (x Link) AsBool() (bool, error) {
 	return false, ipld.ErrWrongKind{TypeName: x.TypeName, MethodName: "AsBool", AppropriateKind: ipld.ReprKindSet_JustBool, ActualKind: ipld.ReprKind_Link}
 }
-func (x Link) AsInt() (int, error) {
+func (x Link) AsInt() (int64, error) {
 	return 0, ipld.ErrWrongKind{TypeName: x.TypeName, MethodName: "AsInt", AppropriateKind: ipld.ReprKindSet_JustInt, ActualKind: ipld.ReprKind_Link}
 }
 func (x Link) AsFloat() (float64, error) {
@@ -83,7 +83,7 @@ func (x LinkAssembler) AssignNull() error {
 func (x LinkAssembler) AssignBool(bool) error {
 	return ipld.ErrWrongKind{TypeName: x.TypeName, MethodName: "AssignBool", AppropriateKind: ipld.ReprKindSet_JustBool, ActualKind: ipld.ReprKind_Link}
 }
-func (x LinkAssembler) AssignInt(int) error {
+func (x LinkAssembler) AssignInt(int64) error {
 	return ipld.ErrWrongKind{TypeName: x.TypeName, MethodName: "AssignInt", AppropriateKind: ipld.ReprKindSet_JustInt, ActualKind: ipld.ReprKind_Link}
 }
 func (x LinkAssembler) AssignFloat(float64) error {
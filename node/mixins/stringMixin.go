@@ -52,7 +52,7 @@ func (String) IsNull() bool {
 func (x String) AsBool() (bool, error) {
 	return false, ipld.ErrWrongKind{TypeName: x.TypeName, MethodName: "AsBool", AppropriateKind: ipld.ReprKindSet_JustBool, ActualKind: ipld.ReprKind_String}
 }
-func (x String) AsInt() (int, error) {
+func (x String) AsInt() (int64, error) {
 	return 0, ipld.ErrWrongKind{TypeName: x.TypeName, MethodName: "AsInt", AppropriateKind: ipld.ReprKindSet_JustInt, ActualKind: ipld.ReprKind_String}
 }
 func (x String) AsFloat() (float64, error) {
@@ -83,7 +83,7 @@ func (x StringAssembler) AssignNull() error {
 func (x StringAssembler) AssignBool(bool) error {
 	return ipld.ErrWrongKind{TypeName: x.TypeName, MethodName: "AssignBool", AppropriateKind: ipld.ReprKindSet_JustBool, ActualKind: ipld.ReprKind_String}
 }
-func (x StringAssembler) AssignInt(int) error {
+func (x StringAssembler) AssignInt(int64) error {
 	return ipld.ErrWrongKind{TypeName: x.TypeName, MethodName: "AssignInt", AppropriateKind: ipld.ReprKindSet_JustInt, ActualKind: ipld.ReprKind_String}
 }
 func (x StringAssembler) AssignFloat(float64) error {
@@ -19,19 +19,19 @@ func SpecTestMapStrInt(t *testing.T, np ipld.NodePrototype) {
 			Wish(t, err, ShouldEqual, nil)
 			v2, err := v.AsInt()
 			Wish(t, err, ShouldEqual, nil)
-			Wish(t, v2, ShouldEqual, 1)
+			Wish(t, v2, ShouldEqual, int64(1))
 
 			v, err = n.LookupByString("waga")
 			Wish(t, err, ShouldEqual, nil)
 			v2, err = v.AsInt()
 			Wish(t, err, ShouldEqual, nil)
-			Wish(t, v2, ShouldEqual, 3)
+			Wish(t, v2, ShouldEqual, int64(3))
 
 			v, err = n.LookupByString("woot")
 			Wish(t, err, ShouldEqual, nil)
 			v2, err = v.AsInt()
 			Wish(t, err, ShouldEqual, nil)
-			Wish(t, v2, ShouldEqual, 2)
+			Wish(t, v2, ShouldEqual, int64(2))
 		})
 		t.Run("reads via iteration", func(t *testing.T) {
 			itr := n.MapIterator()
@@ -44,7 +44,7 @@ func SpecTestMapStrInt(t *testing.T, np ipld.NodePrototype) {
 			Wish(t, k2, ShouldEqual, "whee")
 			v2, err := v.AsInt()
 			Wish(t, err, ShouldEqual, nil)
-			Wish(t, v2, ShouldEqual, 1)
+			Wish(t, v2, ShouldEqual, int64(1))
 
 			Wish(t, itr.Done(), ShouldEqual, false)
 			k, v, err = itr.Next()
@@ -54,7 +54,7 @@ func SpecTestMapStrInt(t *testing.T, np ipld.NodePrototype) {
 			Wish(t, k2, ShouldEqual, "woot")
 			v2, err = v.AsInt()
 			Wish(t, err, ShouldEqual, nil)
-			Wish(t, v2, ShouldEqual, 2)
+			Wish(t, v2, ShouldEqual, int64(2))
 
 			Wish(t, itr.Done(), ShouldEqual, false)
 			k, v, err = itr.Next()
@@ -64,7 +64,7 @@ func SpecTestMapStrInt(t *testing.T, np ipld.NodePrototype) {
 			Wish(t, k2, ShouldEqual, "waga")
 			v2, err = v.AsInt()
 			Wish(t, err, ShouldEqual, nil)
-			Wish(t, v2, ShouldEqual, 3)
+			Wish(t, v2, ShouldEqual, int64(3))
 
 			Wish(t, itr.Done(), ShouldEqual, true)
 			k, v, err = itr.Next()
@@ -129,7 +129,7 @@ func SpecTestMapStrInt(t *testing.T, np ipld.NodePrototype) {
 		Wish(t, err, ShouldEqual, nil)
 		v2, err := v.AsInt()
 		Wish(t, err, ShouldEqual, nil)
-		Wish(t, v2, ShouldEqual, 1)
+		Wish(t, v2, ShouldEqual, int64(1))
 	})
 	t.Run("builder reset works", func(t *testing.T) {
 		// TODO
@@ -177,12 +177,12 @@ func SpecTestMapStrMapStrInt(t *testing.T, np ipld.NodePrototype) {
 			Wish(t, err, ShouldEqual, nil)
 			v3, err := v2.AsInt()
 			Wish(t, err, ShouldEqual, nil)
-			Wish(t, v3, ShouldEqual, 3)
+			Wish(t, v3, ShouldEqual, int64(3))
 			v2, err = v.LookupByString("m2k2")
 			Wish(t, err, ShouldEqual, nil)
 			v3, err = v2.AsInt()
 			Wish(t, err, ShouldEqual, nil)
-			Wish(t, v3, ShouldEqual, 4)
+			Wish(t, v3, ShouldEqual, int64(4))
 		})
 	})
 }
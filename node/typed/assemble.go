@@ -0,0 +1,670 @@
+package typednode
+
+import (
+	"fmt"
+
+	ipld "github.com/ipld/go-ipld-prime"
+	basicnode "github.com/ipld/go-ipld-prime/node/basic"
+	"github.com/ipld/go-ipld-prime/node/mixins"
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+// assembler is the NodeAssembler for a value of any type,
+// at either the type level or the representation level.
+//
+// The finished value is handed to the 'done' func, which is how
+// recursive assemblers collect their children, and builders their result.
+// Each assembler can only finish once: using it again afterwards panics.
+type assembler struct {
+	typ      schema.Type
+	repr     bool                  // if true, data is accepted in the form of the type's representation.
+	nullable bool                  // if true, AssignNull is acceptable (for nullable map and list values, and struct fields).
+	done     func(ipld.Node) error // receives the finished value: a *_node, or ipld.Null.
+}
+
+type builder struct {
+	assembler
+	result ipld.Node
+}
+
+func newBuilder(typ schema.Type, repr bool) *builder {
+	b := &builder{}
+	b.assembler = assembler{typ: typ, repr: repr, done: b.setResult}
+	return b
+}
+
+func (b *builder) setResult(n ipld.Node) error {
+	b.result = n
+	return nil
+}
+
+func (b *builder) Build() ipld.Node {
+	if b.result == nil {
+		panic("misuse: Build called before assembly was finished")
+	}
+	return b.result
+}
+
+func (b *builder) Reset() {
+	b.result = nil
+	b.done = b.setResult
+}
+
+// finish hands the finished value to the 'done' func.
+// If that succeeds, the assembler is expired.
+func (a *assembler) finish(v ipld.Node) error {
+	if a.done == nil {
+		panic("misuse: assembler used again after it was finished")
+	}
+	if err := a.done(v); err != nil {
+		return err
+	}
+	a.done = nil
+	return nil
+}
+
+// child returns an assembler for a child value, at the same level as this one.
+func (a *assembler) child(typ schema.Type, nullable bool, done func(ipld.Node) error) *assembler {
+	return &assembler{typ, a.repr, nullable, done}
+}
+
+func (a *assembler) typeName() string {
+	if a.repr {
+		return string(a.typ.Name()) + ".Repr"
+	}
+	return string(a.typ.Name())
+}
+
+func (a *assembler) wrongKind(method string, appropriate ipld.ReprKindSet) error {
+	actual := a.typ.Kind().ActsLike()
+	if a.repr {
+		actual = a.typ.RepresentationBehavior()
+	}
+	return ipld.ErrWrongKind{TypeName: a.typeName(), MethodName: method, AppropriateKind: appropriate, ActualKind: actual}
+}
+
+// kindedMember returns the assembler for the member of a kinded union
+// with the given representation kind -- if this is assembling the
+// representation of a kinded union; otherwise it returns false.
+func (a *assembler) kindedMember(k ipld.ReprKind) (ipld.NodeAssembler, bool) {
+	if !a.repr {
+		return nil, false
+	}
+	t, ok := a.typ.(*schema.TypeUnion)
+	if !ok {
+		return nil, false
+	}
+	r, ok := t.RepresentationStrategy().(schema.UnionRepresentation_Kinded)
+	if !ok {
+		return nil, false
+	}
+	mn := r.GetMember(k)
+	if mn == "" {
+		return errorAssembler{schema.ErrNotUnionStructure{TypeName: a.typeName(), Detail: fmt.Sprintf("no member has representation kind %s", k)}}, true
+	}
+	return &assembler{typ: t.TypeSystem().TypeByName(string(mn)), repr: true, done: func(v ipld.Node) error {
+		return a.finish(&_node{typ: t, member: v.(*_node)})
+	}}, true
+}
+
+func (a *assembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	if sizeHint < 0 {
+		sizeHint = 0
+	}
+	if ma, ok := a.kindedMember(ipld.ReprKind_Map); ok {
+		return ma.BeginMap(sizeHint)
+	}
+	switch t := a.typ.(type) {
+	case *schema.TypeMap:
+		return &mapAssembler{a, t, &_node{typ: t, index: make(map[string]int, sizeHint)}}, nil
+	case *schema.TypeStruct:
+		if !a.repr {
+			return newStructAssembler(a, t, schema.StructField.Name), nil
+		}
+		if r, ok := t.RepresentationStrategy().(schema.StructRepresentation_Map); ok {
+			return newStructAssembler(a, t, r.GetFieldKey), nil
+		}
+	case *schema.TypeUnion:
+		if !a.repr {
+			return &unionAssembler{a: a, t: t, keyOf: memberName}, nil
+		}
+		switch r := t.RepresentationStrategy().(type) {
+		case schema.UnionRepresentation_Keyed:
+			return &unionAssembler{a: a, t: t, keyOf: r.GetDiscriminant}, nil
+		case schema.UnionRepresentation_Envelope, schema.UnionRepresentation_Inline:
+			return a.beginBufferedUnion(t, sizeHint)
+		}
+	}
+	return nil, a.wrongKind("BeginMap", ipld.ReprKindSet_JustMap)
+}
+
+func (a *assembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	if sizeHint < 0 {
+		sizeHint = 0
+	}
+	if ma, ok := a.kindedMember(ipld.ReprKind_List); ok {
+		return ma.BeginList(sizeHint)
+	}
+	switch t := a.typ.(type) {
+	case *schema.TypeList:
+		return &listAssembler{a, t, &_node{typ: t, values: make([]ipld.Node, 0, sizeHint)}}, nil
+	case *schema.TypeStruct:
+		if _, ok := t.RepresentationStrategy().(schema.StructRepresentation_Tuple); ok && a.repr {
+			return &tupleAssembler{a, t, make([]ipld.Node, 0, len(t.Fields()))}, nil
+		}
+	}
+	return nil, a.wrongKind("BeginList", ipld.ReprKindSet_JustList)
+}
+
+func (a *assembler) AssignNull() error {
+	if a.nullable {
+		return a.finish(ipld.Null)
+	}
+	if ma, ok := a.kindedMember(ipld.ReprKind_Null); ok {
+		return ma.AssignNull()
+	}
+	return a.wrongKind("AssignNull", ipld.ReprKindSet_JustNull)
+}
+
+func (a *assembler) AssignBool(v bool) error {
+	if ma, ok := a.kindedMember(ipld.ReprKind_Bool); ok {
+		return ma.AssignBool(v)
+	}
+	if _, ok := a.typ.(*schema.TypeBool); !ok {
+		return a.wrongKind("AssignBool", ipld.ReprKindSet_JustBool)
+	}
+	return a.finish(&_node{typ: a.typ, scalar: basicnode.NewBool(v)})
+}
+
+func (a *assembler) AssignInt(v int64) error {
+	if ma, ok := a.kindedMember(ipld.ReprKind_Int); ok {
+		return ma.AssignInt(v)
+	}
+	if _, ok := a.typ.(*schema.TypeInt); !ok {
+		return a.wrongKind("AssignInt", ipld.ReprKindSet_JustInt)
+	}
+	return a.finish(&_node{typ: a.typ, scalar: basicnode.NewInt(v)})
+}
+
+func (a *assembler) AssignFloat(v float64) error {
+	if ma, ok := a.kindedMember(ipld.ReprKind_Float); ok {
+		return ma.AssignFloat(v)
+	}
+	if _, ok := a.typ.(*schema.TypeFloat); !ok {
+		return a.wrongKind("AssignFloat", ipld.ReprKindSet_JustFloat)
+	}
+	return a.finish(&_node{typ: a.typ, scalar: basicnode.NewFloat(v)})
+}
+
+func (a *assembler) AssignString(v string) error {
+	if ma, ok := a.kindedMember(ipld.ReprKind_String); ok {
+		return ma.AssignString(v)
+	}
+	switch t := a.typ.(type) {
+	case *schema.TypeString:
+		return a.finish(&_node{typ: t, scalar: basicnode.NewString(v)})
+	case *schema.TypeEnum:
+		for _, m := range t.Members() {
+			if m == v {
+				return a.finish(&_node{typ: t, scalar: basicnode.NewString(v)})
+			}
+		}
+		return schema.ErrNotEnumMember{TypeName: a.typeName(), Value: v}
+	case *schema.TypeStruct:
+		if a.repr {
+			switch r := t.RepresentationStrategy().(type) {
+			case schema.StructRepresentation_Stringjoin:
+				return a.assignStringjoin(t, r, v)
+			case schema.StructRepresentation_StringPairs:
+				return a.assignStringPairs(t, r, v)
+			}
+		}
+	}
+	return a.wrongKind("AssignString", ipld.ReprKindSet_JustString)
+}
+
+func (a *assembler) AssignBytes(v []byte) error {
+	if ma, ok := a.kindedMember(ipld.ReprKind_Bytes); ok {
+		return ma.AssignBytes(v)
+	}
+	if _, ok := a.typ.(*schema.TypeBytes); !ok {
+		return a.wrongKind("AssignBytes", ipld.ReprKindSet_JustBytes)
+	}
+	return a.finish(&_node{typ: a.typ, scalar: basicnode.NewBytes(v)})
+}
+
+func (a *assembler) AssignLink(v ipld.Link) error {
+	if ma, ok := a.kindedMember(ipld.ReprKind_Link); ok {
+		return ma.AssignLink(v)
+	}
+	if _, ok := a.typ.(*schema.TypeLink); !ok {
+		return a.wrongKind("AssignLink", ipld.ReprKindSet_JustLink)
+	}
+	return a.finish(&_node{typ: a.typ, scalar: basicnode.NewLink(v)})
+}
+
+func (a *assembler) AssignNode(v ipld.Node) error {
+	// If it's already a node of this type: shortcut.
+	//  (Nodes are immutable, so there's no need to copy.)
+	switch v2 := v.(type) {
+	case *_node:
+		if v2.typ == a.typ {
+			return a.finish(v2)
+		}
+	case *_nodeRepr:
+		if v2.n.typ == a.typ {
+			return a.finish(v2.n)
+		}
+	}
+	return copyInto(a, v)
+}
+
+func (a *assembler) Prototype() ipld.NodePrototype {
+	return prototypeFor(a.typ, a.repr)
+}
+
+// copyInto feeds the content of any node into an assembler.
+// Children are copied with AssignNode, so they still get a chance at shortcuts.
+func copyInto(na ipld.NodeAssembler, n ipld.Node) error {
+	switch n.ReprKind() {
+	case ipld.ReprKind_Null:
+		return na.AssignNull()
+	case ipld.ReprKind_Bool:
+		v, err := n.AsBool()
+		if err != nil {
+			return err
+		}
+		return na.AssignBool(v)
+	case ipld.ReprKind_Int:
+		v, err := n.AsInt()
+		if err != nil {
+			return err
+		}
+		return na.AssignInt(v)
+	case ipld.ReprKind_Float:
+		v, err := n.AsFloat()
+		if err != nil {
+			return err
+		}
+		return na.AssignFloat(v)
+	case ipld.ReprKind_String:
+		v, err := n.AsString()
+		if err != nil {
+			return err
+		}
+		return na.AssignString(v)
+	case ipld.ReprKind_Bytes:
+		v, err := n.AsBytes()
+		if err != nil {
+			return err
+		}
+		return na.AssignBytes(v)
+	case ipld.ReprKind_Link:
+		v, err := n.AsLink()
+		if err != nil {
+			return err
+		}
+		return na.AssignLink(v)
+	case ipld.ReprKind_Map:
+		ma, err := na.BeginMap(n.Length())
+		if err != nil {
+			return err
+		}
+		for itr := n.MapIterator(); !itr.Done(); {
+			k, v, err := itr.Next()
+			if err != nil {
+				return err
+			}
+			if v.IsAbsent() {
+				continue
+			}
+			if err := ma.AssembleKey().AssignNode(k); err != nil {
+				return err
+			}
+			if err := ma.AssembleValue().AssignNode(v); err != nil {
+				return err
+			}
+		}
+		return ma.Finish()
+	case ipld.ReprKind_List:
+		la, err := na.BeginList(n.Length())
+		if err != nil {
+			return err
+		}
+		for itr := n.ListIterator(); !itr.Done(); {
+			_, v, err := itr.Next()
+			if err != nil {
+				return err
+			}
+			if err := la.AssembleValue().AssignNode(v); err != nil {
+				return err
+			}
+		}
+		return la.Finish()
+	default:
+		return fmt.Errorf("cannot copy a node of kind %s", n.ReprKind())
+	}
+}
+
+type mapAssembler struct {
+	a *assembler
+	t *schema.TypeMap
+	n *_node
+}
+
+func (ma *mapAssembler) AssembleKey() ipld.NodeAssembler {
+	return ma.a.child(ma.t.KeyType(), false, ma.addKey)
+}
+
+func (ma *mapAssembler) addKey(k ipld.Node) error {
+	kn := k.(*_node)
+	ks := reprString(kn)
+	if _, exists := ma.n.index[ks]; exists {
+		return ipld.ErrRepeatedMapKey{Key: kn}
+	}
+	ma.n.index[ks] = len(ma.n.keys)
+	ma.n.keys = append(ma.n.keys, kn)
+	return nil
+}
+
+func (ma *mapAssembler) AssembleValue() ipld.NodeAssembler {
+	return ma.a.child(ma.t.ValueType(), ma.t.ValueIsNullable(), func(v ipld.Node) error {
+		ma.n.values = append(ma.n.values, v)
+		return nil
+	})
+}
+
+func (ma *mapAssembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+	// Keys given as strings are always parsed as the key type's representation.
+	ka := &assembler{typ: ma.t.KeyType(), repr: true, done: ma.addKey}
+	if err := ka.AssignString(k); err != nil {
+		return nil, err
+	}
+	return ma.AssembleValue(), nil
+}
+
+func (ma *mapAssembler) Finish() error {
+	return ma.a.finish(ma.n)
+}
+
+func (ma *mapAssembler) KeyPrototype() ipld.NodePrototype {
+	return prototypeFor(ma.t.KeyType(), ma.a.repr)
+}
+
+func (ma *mapAssembler) ValuePrototype(k string) ipld.NodePrototype {
+	return prototypeFor(ma.t.ValueType(), ma.a.repr)
+}
+
+type listAssembler struct {
+	a *assembler
+	t *schema.TypeList
+	n *_node
+}
+
+func (la *listAssembler) AssembleValue() ipld.NodeAssembler {
+	return la.a.child(la.t.ValueType(), la.t.ValueIsNullable(), func(v ipld.Node) error {
+		la.n.values = append(la.n.values, v)
+		return nil
+	})
+}
+
+func (la *listAssembler) Finish() error {
+	return la.a.finish(la.n)
+}
+
+func (la *listAssembler) ValuePrototype(idx int) ipld.NodePrototype {
+	return prototypeFor(la.t.ValueType(), la.a.repr)
+}
+
+// structAssembler handles structs at the type level, and structs with map representations.
+// The only difference is what keys the fields are found under.
+type structAssembler struct {
+	a      *assembler
+	t      *schema.TypeStruct
+	keys   map[string]int // from the keys accepted to field indexes.
+	values []ipld.Node    // one per field; nil until the field is assembled.
+	cur    int            // index of the field whose key was just assembled, or -1.
+}
+
+func newStructAssembler(a *assembler, t *schema.TypeStruct, keyOf func(schema.StructField) string) *structAssembler {
+	fields := t.Fields()
+	sa := &structAssembler{a: a, t: t, keys: make(map[string]int, len(fields)), values: make([]ipld.Node, len(fields)), cur: -1}
+	for i, f := range fields {
+		sa.keys[keyOf(f)] = i
+	}
+	return sa
+}
+
+func (sa *structAssembler) AssembleKey() ipld.NodeAssembler {
+	return &keyAssembler{mixins.StringAssembler{TypeName: "string"}, sa.setKey}
+}
+
+func (sa *structAssembler) setKey(k string) error {
+	i, ok := sa.keys[k]
+	if !ok {
+		return ipld.ErrInvalidKey{TypeName: sa.a.typeName(), Key: basicnode.NewString(k)}
+	}
+	if sa.values[i] != nil {
+		return ipld.ErrRepeatedMapKey{Key: basicnode.NewString(k)}
+	}
+	sa.cur = i
+	return nil
+}
+
+func (sa *structAssembler) AssembleValue() ipld.NodeAssembler {
+	if sa.cur < 0 {
+		panic("misuse: AssembleValue called without assembling a key first")
+	}
+	i := sa.cur
+	sa.cur = -1
+	f := sa.t.Fields()[i]
+	return sa.a.child(f.Type(), f.IsNullable(), func(v ipld.Node) error {
+		sa.values[i] = v
+		return nil
+	})
+}
+
+func (sa *structAssembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+	if err := sa.setKey(k); err != nil {
+		return nil, err
+	}
+	return sa.AssembleValue(), nil
+}
+
+func (sa *structAssembler) Finish() error {
+	if err := fillMissing(sa.a, sa.t, sa.values); err != nil {
+		return err
+	}
+	return sa.a.finish(&_node{typ: sa.t, values: sa.values})
+}
+
+func (sa *structAssembler) KeyPrototype() ipld.NodePrototype {
+	return basicnode.Prototype.String
+}
+
+func (sa *structAssembler) ValuePrototype(k string) ipld.NodePrototype {
+	if i, ok := sa.keys[k]; ok {
+		return prototypeFor(sa.t.Fields()[i].Type(), sa.a.repr)
+	}
+	return basicnode.Prototype.Any
+}
+
+// fillMissing fills in the struct fields that weren't assembled:
+// with their implicit value, if they have one; or as absent, if they're optional.
+// If any other fields are missing, it's an error.
+// Implicits belong to the representation, so they're only used when assembling the representation.
+func fillMissing(a *assembler, t *schema.TypeStruct, values []ipld.Node) error {
+	var getImplicit func(schema.StructField) schema.ImplicitValue
+	if a.repr {
+		switch r := t.RepresentationStrategy().(type) {
+		case schema.StructRepresentation_Map:
+			getImplicit = r.GetImplicit
+		case schema.StructRepresentation_Tuple:
+			getImplicit = r.GetImplicit
+		}
+	}
+	var missing []string
+	for i, f := range t.Fields() {
+		if values[i] != nil {
+			continue
+		}
+		var implicit schema.ImplicitValue
+		if getImplicit != nil {
+			implicit = getImplicit(f)
+		}
+		switch iv := implicit.(type) {
+		case schema.ImplicitValue_String:
+			fb := newBuilder(f.Type(), true)
+			if err := fb.AssignString(iv.String()); err != nil {
+				return err
+			}
+			values[i] = fb.Build()
+		case schema.ImplicitValue_Int:
+			fb := newBuilder(f.Type(), true)
+			if err := fb.AssignInt(iv.Int()); err != nil {
+				return err
+			}
+			values[i] = fb.Build()
+		case schema.ImplicitValue_EmptyList:
+			fb := newBuilder(f.Type(), true)
+			la, err := fb.BeginList(0)
+			if err != nil {
+				return err
+			}
+			if err := la.Finish(); err != nil {
+				return err
+			}
+			values[i] = fb.Build()
+		case schema.ImplicitValue_EmptyMap:
+			fb := newBuilder(f.Type(), true)
+			ma, err := fb.BeginMap(0)
+			if err != nil {
+				return err
+			}
+			if err := ma.Finish(); err != nil {
+				return err
+			}
+			values[i] = fb.Build()
+		default:
+			if !f.IsOptional() {
+				missing = append(missing, f.Name())
+				continue
+			}
+			values[i] = ipld.Absent
+		}
+	}
+	if len(missing) > 0 {
+		return ipld.ErrMissingRequiredField{TypeName: a.typeName(), Missing: missing}
+	}
+	return nil
+}
+
+// unionAssembler handles unions at the type level, and unions with keyed representations.
+// The only difference is what keys the members are found under.
+type unionAssembler struct {
+	a      *assembler
+	t      *schema.TypeUnion
+	keyOf  func(schema.Type) string
+	cur    schema.Type // the member whose key was assembled.
+	member *_node
+}
+
+func memberName(t schema.Type) string {
+	return string(t.Name())
+}
+
+func (ua *unionAssembler) AssembleKey() ipld.NodeAssembler {
+	return &keyAssembler{mixins.StringAssembler{TypeName: "string"}, ua.setKey}
+}
+
+func (ua *unionAssembler) setKey(k string) error {
+	if ua.cur != nil {
+		return schema.ErrNotUnionStructure{TypeName: ua.a.typeName(), Detail: "a union can only have one entry"}
+	}
+	for _, m := range ua.t.Members() {
+		if ua.keyOf(m) == k {
+			ua.cur = m
+			return nil
+		}
+	}
+	return ipld.ErrInvalidKey{TypeName: ua.a.typeName(), Key: basicnode.NewString(k)}
+}
+
+func (ua *unionAssembler) AssembleValue() ipld.NodeAssembler {
+	if ua.cur == nil {
+		panic("misuse: AssembleValue called without assembling a key first")
+	}
+	return ua.a.child(ua.cur, false, func(v ipld.Node) error {
+		ua.member = v.(*_node)
+		return nil
+	})
+}
+
+func (ua *unionAssembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+	if err := ua.setKey(k); err != nil {
+		return nil, err
+	}
+	return ua.AssembleValue(), nil
+}
+
+func (ua *unionAssembler) Finish() error {
+	if ua.member == nil {
+		return schema.ErrNotUnionStructure{TypeName: ua.a.typeName(), Detail: "a union must have one entry"}
+	}
+	return ua.a.finish(&_node{typ: ua.t, member: ua.member})
+}
+
+func (ua *unionAssembler) KeyPrototype() ipld.NodePrototype {
+	return basicnode.Prototype.String
+}
+
+func (ua *unionAssembler) ValuePrototype(k string) ipld.NodePrototype {
+	for _, m := range ua.t.Members() {
+		if ua.keyOf(m) == k {
+			return prototypeFor(m, ua.a.repr)
+		}
+	}
+	return basicnode.Prototype.Any
+}
+
+// keyAssembler accepts only strings,
+// and hands them to a func which decides whether they're acceptable keys.
+type keyAssembler struct {
+	mixins.StringAssembler
+	accept func(string) error
+}
+
+func (ka *keyAssembler) AssignString(s string) error {
+	return ka.accept(s)
+}
+
+func (ka *keyAssembler) AssignNode(v ipld.Node) error {
+	s, err := v.AsString()
+	if err != nil {
+		return err
+	}
+	return ka.accept(s)
+}
+
+func (ka *keyAssembler) Prototype() ipld.NodePrototype {
+	return basicnode.Prototype.String
+}
+
+// errorAssembler returns the same error from every method.
+// It's used where the interfaces leave no other way to return an error:
+// for example, from ListAssembler.AssembleValue.
+type errorAssembler struct {
+	err error
+}
+
+func (ea errorAssembler) BeginMap(int) (ipld.MapAssembler, error)   { return nil, ea.err }
+func (ea errorAssembler) BeginList(int) (ipld.ListAssembler, error) { return nil, ea.err }
+func (ea errorAssembler) AssignNull() error                         { return ea.err }
+func (ea errorAssembler) AssignBool(bool) error                     { return ea.err }
+func (ea errorAssembler) AssignInt(int64) error                     { return ea.err }
+func (ea errorAssembler) AssignFloat(float64) error                 { return ea.err }
+func (ea errorAssembler) AssignString(string) error                 { return ea.err }
+func (ea errorAssembler) AssignBytes([]byte) error                  { return ea.err }
+func (ea errorAssembler) AssignLink(ipld.Link) error                { return ea.err }
+func (ea errorAssembler) AssignNode(ipld.Node) error                { return ea.err }
+func (ea errorAssembler) Prototype() ipld.NodePrototype             { return basicnode.Prototype.Any }
@@ -0,0 +1,204 @@
+package typednode
+
+import (
+	"fmt"
+	"strings"
+
+	ipld "github.com/ipld/go-ipld-prime"
+	basicnode "github.com/ipld/go-ipld-prime/node/basic"
+	"github.com/ipld/go-ipld-prime/node/mixins"
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+// This file holds the assembly logic that only exists at the representation level:
+// structs with tuple, stringjoin, and stringpairs representations,
+// and unions with envelope and inline representations.
+
+func (a *assembler) assignStringjoin(t *schema.TypeStruct, r schema.StructRepresentation_Stringjoin, s string) error {
+	fields := t.Fields()
+	parts, err := mixins.SplitExact(s, r.GetDelim(), len(fields))
+	if err != nil {
+		return ipld.ErrUnmatchable{TypeName: a.typeName(), Reason: err}
+	}
+	values := make([]ipld.Node, len(fields))
+	for i, f := range fields {
+		fb := newBuilder(f.Type(), true)
+		if err := fb.AssignString(parts[i]); err != nil {
+			return ipld.ErrUnmatchable{TypeName: a.typeName(), Reason: err}
+		}
+		values[i] = fb.Build()
+	}
+	return a.finish(&_node{typ: t, values: values})
+}
+
+func (a *assembler) assignStringPairs(t *schema.TypeStruct, r schema.StructRepresentation_StringPairs, s string) error {
+	fields := t.Fields()
+	values := make([]ipld.Node, len(fields))
+	if s != "" {
+		for _, entry := range strings.Split(s, r.GetEntryDelim()) {
+			kv, err := mixins.SplitExact(entry, r.GetInnerDelim(), 2)
+			if err != nil {
+				return ipld.ErrUnmatchable{TypeName: a.typeName(), Reason: err}
+			}
+			i := fieldIndex(fields, kv[0])
+			if i < 0 {
+				return ipld.ErrInvalidKey{TypeName: a.typeName(), Key: basicnode.NewString(kv[0])}
+			}
+			if values[i] != nil {
+				return ipld.ErrRepeatedMapKey{Key: basicnode.NewString(kv[0])}
+			}
+			fb := newBuilder(fields[i].Type(), true)
+			if err := fb.AssignString(kv[1]); err != nil {
+				return ipld.ErrUnmatchable{TypeName: a.typeName(), Reason: err}
+			}
+			values[i] = fb.Build()
+		}
+	}
+	if err := fillMissing(a, t, values); err != nil {
+		return err
+	}
+	return a.finish(&_node{typ: t, values: values})
+}
+
+func fieldIndex(fields []schema.StructField, name string) int {
+	for i, f := range fields {
+		if f.Name() == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// tupleAssembler handles structs with tuple representations.
+type tupleAssembler struct {
+	a      *assembler
+	t      *schema.TypeStruct
+	values []ipld.Node
+}
+
+func (ta *tupleAssembler) AssembleValue() ipld.NodeAssembler {
+	i := len(ta.values)
+	fields := ta.t.Fields()
+	if i >= len(fields) {
+		return errorAssembler{schema.ErrNoSuchField{Type: ta.t, Field: ipld.PathSegmentOfInt(i)}}
+	}
+	ta.values = append(ta.values, nil)
+	f := fields[i]
+	return ta.a.child(f.Type(), f.IsNullable(), func(v ipld.Node) error {
+		ta.values[i] = v
+		return nil
+	})
+}
+
+func (ta *tupleAssembler) Finish() error {
+	values := make([]ipld.Node, len(ta.t.Fields()))
+	copy(values, ta.values)
+	if err := fillMissing(ta.a, ta.t, values); err != nil {
+		return err
+	}
+	return ta.a.finish(&_node{typ: ta.t, values: values})
+}
+
+func (ta *tupleAssembler) ValuePrototype(idx int) ipld.NodePrototype {
+	if fields := ta.t.Fields(); idx >= 0 && idx < len(fields) {
+		return reprPrototype{fields[idx].Type()}
+	}
+	return basicnode.Prototype.Any
+}
+
+// bufferedMapAssembler collects a whole map before interpreting it.
+//
+// Envelope and inline unions need this: until the discriminant is seen,
+// there's no telling which member the rest of the data should be assembled as,
+// and nothing requires the discriminant to come first.
+type bufferedMapAssembler struct {
+	ipld.MapAssembler
+	finish func() error
+}
+
+func (ma *bufferedMapAssembler) Finish() error {
+	if err := ma.MapAssembler.Finish(); err != nil {
+		return err
+	}
+	return ma.finish()
+}
+
+func (a *assembler) beginBufferedUnion(t *schema.TypeUnion, sizeHint int) (ipld.MapAssembler, error) {
+	nb := basicnode.Prototype.Map.NewBuilder()
+	ma, err := nb.BeginMap(sizeHint)
+	if err != nil {
+		return nil, err
+	}
+	return &bufferedMapAssembler{ma, func() error {
+		return a.assignBufferedUnion(t, nb.Build())
+	}}, nil
+}
+
+func (a *assembler) assignBufferedUnion(t *schema.TypeUnion, m ipld.Node) error {
+	var discriminantKey string
+	var discriminantOf func(schema.Type) string
+	switch r := t.RepresentationStrategy().(type) {
+	case schema.UnionRepresentation_Envelope:
+		discriminantKey, discriminantOf = r.GetDiscriminantKey(), r.GetDiscriminant
+	case schema.UnionRepresentation_Inline:
+		discriminantKey, discriminantOf = r.GetDiscriminantKey(), r.GetDiscriminant
+	}
+
+	dn, err := m.LookupByString(discriminantKey)
+	if err != nil {
+		return schema.ErrNotUnionStructure{TypeName: a.typeName(), Detail: fmt.Sprintf("missing discriminant key %q", discriminantKey)}
+	}
+	ds, err := dn.AsString()
+	if err != nil {
+		return schema.ErrNotUnionStructure{TypeName: a.typeName(), Detail: "discriminant must be a string"}
+	}
+	var mt schema.Type
+	for _, candidate := range t.Members() {
+		if discriminantOf(candidate) == ds {
+			mt = candidate
+			break
+		}
+	}
+	if mt == nil {
+		return schema.ErrNotUnionStructure{TypeName: a.typeName(), Detail: fmt.Sprintf("unknown discriminant %q", ds)}
+	}
+
+	mb := newBuilder(mt, true)
+	switch r := t.RepresentationStrategy().(type) {
+	case schema.UnionRepresentation_Envelope:
+		content, err := m.LookupByString(r.GetContentKey())
+		if err != nil {
+			return schema.ErrNotUnionStructure{TypeName: a.typeName(), Detail: fmt.Sprintf("missing content key %q", r.GetContentKey())}
+		}
+		if m.Length() != 2 {
+			return schema.ErrNotUnionStructure{TypeName: a.typeName(), Detail: fmt.Sprintf("expected only the keys %q and %q", discriminantKey, r.GetContentKey())}
+		}
+		if err := mb.AssignNode(content); err != nil {
+			return err
+		}
+	case schema.UnionRepresentation_Inline:
+		ma, err := mb.BeginMap(m.Length() - 1)
+		if err != nil {
+			return err
+		}
+		for itr := m.MapIterator(); !itr.Done(); {
+			k, v, err := itr.Next()
+			if err != nil {
+				return err
+			}
+			if ks, _ := k.AsString(); ks == discriminantKey {
+				continue
+			}
+			if err := ma.AssembleKey().AssignNode(k); err != nil {
+				return err
+			}
+			if err := ma.AssembleValue().AssignNode(v); err != nil {
+				return err
+			}
+		}
+		if err := ma.Finish(); err != nil {
+			return err
+		}
+	}
+	return a.finish(&_node{typ: t, member: mb.Build().(*_node)})
+}
@@ -0,0 +1,27 @@
+/*
+	The typednode package provides schema.TypedNode implementations
+	that work for any schema.Type at runtime -- no codegen required.
+
+	This is useful when schemas are only known at runtime
+	(say, loaded from a file with the schema/dsl or schema/ast packages),
+	so generating and compiling Go code for each of them isn't an option.
+
+	Get started with NewPrototype:
+
+		np, err := typednode.NewPrototype(ts, "Foo")
+		// to build data at the type level:
+		nb := np.NewBuilder()
+		// or to build (or decode!) data at the representation level:
+		nb := np.Representation().NewBuilder()
+
+	Either way, the assemblers enforce the schema while the data is assembled:
+	struct fields must exist, required fields must be present,
+	kinds must match, union discriminants must be known, and so on.
+	The resulting nodes are schema.TypedNode, and their Representation()
+	presents the data according to the type's representation strategy.
+
+	Compared to codegen, this is slower, and uses more memory:
+	every value is boxed, and representation views are computed on demand.
+	If the schema is known at compile time, codegen is still the better choice.
+*/
+package typednode
@@ -0,0 +1,237 @@
+package typednode
+
+import (
+	ipld "github.com/ipld/go-ipld-prime"
+	basicnode "github.com/ipld/go-ipld-prime/node/basic"
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+// _node is the type-level view of a value of any type.
+// Which of its fields are used depends on the kind of the type.
+type _node struct {
+	typ schema.Type
+
+	scalar ipld.Node      // bool, int, float, string, bytes, link, and enum types: a basicnode holding the value.
+	values []ipld.Node    // list types: the values.  struct types: one per field, in order (ipld.Absent if an optional field is absent).
+	keys   []*_node       // map types: the keys, in order; values holds the corresponding values.
+	index  map[string]int // map types: from the representation string of each key to its position.
+	member *_node         // union types: the member value.
+}
+
+var (
+	_ ipld.Node            = &_node{}
+	_ schema.TypedNode     = &_node{}
+	_ schema.TypedLinkNode = &_node{}
+)
+
+func (n *_node) Type() schema.Type {
+	return n.typ
+}
+
+func (n *_node) Representation() ipld.Node {
+	return newRepr(n)
+}
+
+// LinkTargetNodePrototype returns the prototype for the type that a link's
+// target is expected to be, if the link type declares one,
+// or basicnode.Prototype.Any if not.
+// It's only meaningful for nodes of link types.
+func (n *_node) LinkTargetNodePrototype() ipld.NodePrototype {
+	if t, ok := n.typ.(*schema.TypeLink); ok && t.HasReferencedType() {
+		return reprPrototype{t.ReferencedType()}
+	}
+	return basicnode.Prototype.Any
+}
+
+func (n *_node) ReprKind() ipld.ReprKind {
+	return n.typ.Kind().ActsLike()
+}
+
+func (n *_node) wrongKind(method string, appropriate ipld.ReprKindSet) error {
+	return ipld.ErrWrongKind{TypeName: string(n.typ.Name()), MethodName: method, AppropriateKind: appropriate, ActualKind: n.ReprKind()}
+}
+
+func (n *_node) LookupByString(key string) (ipld.Node, error) {
+	switch t := n.typ.(type) {
+	case *schema.TypeMap:
+		if i, exists := n.index[key]; exists {
+			return n.values[i], nil
+		}
+		return nil, ipld.ErrNotExists{Segment: ipld.PathSegmentOfString(key)}
+	case *schema.TypeStruct:
+		for i, f := range t.Fields() {
+			if f.Name() == key {
+				return n.values[i], nil
+			}
+		}
+		return nil, schema.ErrNoSuchField{Type: t, Field: ipld.PathSegmentOfString(key)}
+	case *schema.TypeUnion:
+		if string(n.member.typ.Name()) == key {
+			return n.member, nil
+		}
+		for _, m := range t.Members() {
+			if string(m.Name()) == key {
+				return nil, ipld.ErrNotExists{Segment: ipld.PathSegmentOfString(key)}
+			}
+		}
+		return nil, schema.ErrNoSuchField{Type: t, Field: ipld.PathSegmentOfString(key)}
+	default:
+		return nil, n.wrongKind("LookupByString", ipld.ReprKindSet_JustMap)
+	}
+}
+
+func (n *_node) LookupByNode(key ipld.Node) (ipld.Node, error) {
+	if tk, ok := key.(schema.TypedNode); ok {
+		key = tk.Representation()
+	}
+	ks, err := key.AsString()
+	if err != nil {
+		return nil, err
+	}
+	return n.LookupByString(ks)
+}
+
+func (n *_node) LookupByIndex(idx int) (ipld.Node, error) {
+	if _, ok := n.typ.(*schema.TypeList); !ok {
+		return nil, n.wrongKind("LookupByIndex", ipld.ReprKindSet_JustList)
+	}
+	if idx < 0 || idx >= len(n.values) {
+		return nil, ipld.ErrNotExists{Segment: ipld.PathSegmentOfInt(idx)}
+	}
+	return n.values[idx], nil
+}
+
+func (n *_node) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	if _, ok := n.typ.(*schema.TypeList); ok {
+		idx, err := seg.Index()
+		if err != nil {
+			return nil, ipld.ErrInvalidSegmentForList{TypeName: string(n.typ.Name()), TroubleSegment: seg, Reason: err}
+		}
+		return n.LookupByIndex(idx)
+	}
+	return n.LookupByString(seg.String())
+}
+
+func (n *_node) MapIterator() ipld.MapIterator {
+	switch n.typ.(type) {
+	case *schema.TypeMap, *schema.TypeStruct, *schema.TypeUnion:
+		return &mapIterator{n, 0}
+	default:
+		return nil
+	}
+}
+
+func (n *_node) ListIterator() ipld.ListIterator {
+	if _, ok := n.typ.(*schema.TypeList); !ok {
+		return nil
+	}
+	return &listIterator{n, 0}
+}
+
+func (n *_node) Length() int {
+	switch n.typ.(type) {
+	case *schema.TypeMap, *schema.TypeList, *schema.TypeStruct:
+		return len(n.values)
+	case *schema.TypeUnion:
+		return 1
+	default:
+		return -1
+	}
+}
+
+func (n *_node) IsAbsent() bool {
+	return false
+}
+
+func (n *_node) IsNull() bool {
+	return false
+}
+
+func (n *_node) AsBool() (bool, error) {
+	if n.ReprKind() != ipld.ReprKind_Bool {
+		return false, n.wrongKind("AsBool", ipld.ReprKindSet_JustBool)
+	}
+	return n.scalar.AsBool()
+}
+
+func (n *_node) AsInt() (int64, error) {
+	if n.ReprKind() != ipld.ReprKind_Int {
+		return 0, n.wrongKind("AsInt", ipld.ReprKindSet_JustInt)
+	}
+	return n.scalar.AsInt()
+}
+
+func (n *_node) AsFloat() (float64, error) {
+	if n.ReprKind() != ipld.ReprKind_Float {
+		return 0, n.wrongKind("AsFloat", ipld.ReprKindSet_JustFloat)
+	}
+	return n.scalar.AsFloat()
+}
+
+func (n *_node) AsString() (string, error) {
+	if n.ReprKind() != ipld.ReprKind_String {
+		return "", n.wrongKind("AsString", ipld.ReprKindSet_JustString)
+	}
+	return n.scalar.AsString()
+}
+
+func (n *_node) AsBytes() ([]byte, error) {
+	if n.ReprKind() != ipld.ReprKind_Bytes {
+		return nil, n.wrongKind("AsBytes", ipld.ReprKindSet_JustBytes)
+	}
+	return n.scalar.AsBytes()
+}
+
+func (n *_node) AsLink() (ipld.Link, error) {
+	if n.ReprKind() != ipld.ReprKind_Link {
+		return nil, n.wrongKind("AsLink", ipld.ReprKindSet_JustLink)
+	}
+	return n.scalar.AsLink()
+}
+
+func (n *_node) Prototype() ipld.NodePrototype {
+	return Prototype{n.typ}
+}
+
+type mapIterator struct {
+	n   *_node
+	idx int
+}
+
+func (itr *mapIterator) Next() (k ipld.Node, v ipld.Node, _ error) {
+	if itr.Done() {
+		return nil, nil, ipld.ErrIteratorOverread{}
+	}
+	switch t := itr.n.typ.(type) {
+	case *schema.TypeMap:
+		k, v = itr.n.keys[itr.idx], itr.n.values[itr.idx]
+	case *schema.TypeStruct:
+		k, v = basicnode.NewString(t.Fields()[itr.idx].Name()), itr.n.values[itr.idx]
+	case *schema.TypeUnion:
+		k, v = basicnode.NewString(string(itr.n.member.typ.Name())), itr.n.member
+	}
+	itr.idx++
+	return
+}
+
+func (itr *mapIterator) Done() bool {
+	return itr.idx >= itr.n.Length()
+}
+
+type listIterator struct {
+	n   *_node
+	idx int
+}
+
+func (itr *listIterator) Next() (idx int, v ipld.Node, _ error) {
+	if itr.Done() {
+		return -1, nil, ipld.ErrIteratorOverread{}
+	}
+	idx, v = itr.idx, itr.n.values[itr.idx]
+	itr.idx++
+	return
+}
+
+func (itr *listIterator) Done() bool {
+	return itr.idx >= len(itr.n.values)
+}
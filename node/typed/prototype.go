@@ -0,0 +1,63 @@
+package typednode
+
+import (
+	"fmt"
+
+	ipld "github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+// Prototype is the ipld.NodePrototype for a schema type.
+//
+// NewBuilder returns a builder which assembles data at the type level;
+// Representation returns another NodePrototype, which assembles data
+// at the representation level (this is the one to use when decoding).
+// Either way, the nodes built are schema.TypedNode.
+type Prototype struct {
+	typ schema.Type
+}
+
+// NewPrototype returns the Prototype for the named type in the TypeSystem.
+//
+// An error is returned if there's no such type,
+// or if the TypeSystem refers to any types which aren't defined.
+func NewPrototype(ts *schema.TypeSystem, typeName schema.TypeName) (Prototype, error) {
+	typ := ts.TypeByName(string(typeName))
+	if typ == nil {
+		return Prototype{}, fmt.Errorf("typednode: no type named %s in the type system", typeName)
+	}
+	if errs := ts.ValidateGraph(); len(errs) > 0 {
+		return Prototype{}, fmt.Errorf("typednode: type system is not valid: %s", errs[0])
+	}
+	return Prototype{typ}, nil
+}
+
+// Type returns the schema.Type this prototype builds nodes for.
+func (p Prototype) Type() schema.Type {
+	return p.typ
+}
+
+func (p Prototype) NewBuilder() ipld.NodeBuilder {
+	return newBuilder(p.typ, false)
+}
+
+// Representation returns the NodePrototype for assembling data
+// at the representation level.
+func (p Prototype) Representation() ipld.NodePrototype {
+	return reprPrototype{p.typ}
+}
+
+type reprPrototype struct {
+	typ schema.Type
+}
+
+func (p reprPrototype) NewBuilder() ipld.NodeBuilder {
+	return newBuilder(p.typ, true)
+}
+
+func prototypeFor(typ schema.Type, repr bool) ipld.NodePrototype {
+	if repr {
+		return reprPrototype{typ}
+	}
+	return Prototype{typ}
+}
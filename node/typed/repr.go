@@ -0,0 +1,180 @@
+package typednode
+
+import (
+	"strings"
+
+	ipld "github.com/ipld/go-ipld-prime"
+	basicnode "github.com/ipld/go-ipld-prime/node/basic"
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+// _nodeRepr is the representation-level view of a _node.
+//
+// It delegates almost everything to a plain Data Model node
+// which is computed when the view is created.
+// That plain node is shallow: for recursive kinds, its children are
+// themselves representation views of the typed children
+// (which in turn compute their own plain node only when asked for).
+type _nodeRepr struct {
+	ipld.Node
+	n *_node
+}
+
+func newRepr(n *_node) ipld.Node {
+	return &_nodeRepr{plainRepr(n), n}
+}
+
+func (r *_nodeRepr) Prototype() ipld.NodePrototype {
+	return reprPrototype{r.n.typ}
+}
+
+// reprOf returns the representation of a child value, which may be null or absent.
+func reprOf(v ipld.Node) ipld.Node {
+	if n, ok := v.(*_node); ok {
+		return n.Representation()
+	}
+	return v
+}
+
+// plainRepr computes the representation of a node as plain Data Model.
+//
+// Errors from the basicnode assemblers aren't possible here:
+// the typed node was already checked when it was assembled,
+// so its representation must be valid.
+func plainRepr(n *_node) ipld.Node {
+	switch t := n.typ.(type) {
+	case *schema.TypeBool, *schema.TypeInt, *schema.TypeFloat, *schema.TypeString, *schema.TypeBytes, *schema.TypeLink, *schema.TypeEnum:
+		return n.scalar
+	case *schema.TypeList:
+		return plainList(n.values)
+	case *schema.TypeMap:
+		entries := make([]reprEntry, len(n.keys))
+		for i, k := range n.keys {
+			entries[i] = reprEntry{reprString(k), n.values[i]}
+		}
+		return plainMap(entries)
+	case *schema.TypeStruct:
+		switch r := t.RepresentationStrategy().(type) {
+		case schema.StructRepresentation_Map:
+			entries := make([]reprEntry, 0, len(n.values))
+			for i, f := range t.Fields() {
+				v := n.values[i]
+				if v.IsAbsent() || isImplicit(v, r.GetImplicit(f)) {
+					continue
+				}
+				entries = append(entries, reprEntry{r.GetFieldKey(f), v})
+			}
+			return plainMap(entries)
+		case schema.StructRepresentation_Tuple:
+			end := len(n.values)
+			for end > 0 && (n.values[end-1].IsAbsent() || isImplicit(n.values[end-1], r.GetImplicit(t.Fields()[end-1]))) {
+				end--
+			}
+			return plainList(n.values[:end])
+		case schema.StructRepresentation_Stringjoin:
+			parts := make([]string, len(n.values))
+			for i, v := range n.values {
+				parts[i] = reprString(v.(*_node))
+			}
+			return basicnode.NewString(strings.Join(parts, r.GetDelim()))
+		case schema.StructRepresentation_StringPairs:
+			pairs := make([]string, 0, len(n.values))
+			for i, f := range t.Fields() {
+				if v := n.values[i]; !v.IsAbsent() {
+					pairs = append(pairs, f.Name()+r.GetInnerDelim()+reprString(v.(*_node)))
+				}
+			}
+			return basicnode.NewString(strings.Join(pairs, r.GetEntryDelim()))
+		default:
+			panic("unreachable")
+		}
+	case *schema.TypeUnion:
+		switch r := t.RepresentationStrategy().(type) {
+		case schema.UnionRepresentation_Keyed:
+			return plainMap([]reprEntry{{r.GetDiscriminant(n.member.typ), n.member}})
+		case schema.UnionRepresentation_Kinded:
+			return n.member.Representation()
+		case schema.UnionRepresentation_Envelope:
+			return plainMap([]reprEntry{
+				{r.GetDiscriminantKey(), basicnode.NewString(r.GetDiscriminant(n.member.typ))},
+				{r.GetContentKey(), n.member},
+			})
+		case schema.UnionRepresentation_Inline:
+			mr := n.member.Representation()
+			entries := make([]reprEntry, 0, mr.Length()+1)
+			entries = append(entries, reprEntry{r.GetDiscriminantKey(), basicnode.NewString(r.GetDiscriminant(n.member.typ))})
+			for itr := mr.MapIterator(); !itr.Done(); {
+				k, v, _ := itr.Next()
+				ks, _ := k.AsString()
+				entries = append(entries, reprEntry{ks, v})
+			}
+			return plainMap(entries)
+		default:
+			panic("unreachable")
+		}
+	default:
+		panic("unreachable")
+	}
+}
+
+type reprEntry struct {
+	key   string
+	value ipld.Node // either a typed child (which will be presented as its representation), or a plain node.
+}
+
+func plainMap(entries []reprEntry) ipld.Node {
+	nb := basicnode.Prototype.Map.NewBuilder()
+	ma, err := nb.BeginMap(len(entries))
+	mustNotError(err)
+	for _, e := range entries {
+		va, err := ma.AssembleEntry(e.key)
+		mustNotError(err)
+		mustNotError(va.AssignNode(reprOf(e.value)))
+	}
+	mustNotError(ma.Finish())
+	return nb.Build()
+}
+
+func plainList(values []ipld.Node) ipld.Node {
+	nb := basicnode.Prototype.List.NewBuilder()
+	la, err := nb.BeginList(len(values))
+	mustNotError(err)
+	for _, v := range values {
+		mustNotError(la.AssembleValue().AssignNode(reprOf(v)))
+	}
+	mustNotError(la.Finish())
+	return nb.Build()
+}
+
+func mustNotError(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+// reprString returns the representation of a node of a type which has a string representation.
+// (That's all map key types, and the field types of stringjoin and stringpairs structs.)
+func reprString(n *_node) string {
+	s, err := n.Representation().AsString()
+	mustNotError(err)
+	return s
+}
+
+// isImplicit reports whether a field value is the same as the field's implicit value, if it has one.
+// Fields with such values are omitted from map representations, and from the end of tuple representations.
+func isImplicit(v ipld.Node, implicit schema.ImplicitValue) bool {
+	switch iv := implicit.(type) {
+	case schema.ImplicitValue_String:
+		s, err := v.AsString()
+		return err == nil && s == iv.String()
+	case schema.ImplicitValue_Int:
+		i, err := v.AsInt()
+		return err == nil && i == iv.Int()
+	case schema.ImplicitValue_EmptyList:
+		return v.ReprKind() == ipld.ReprKind_List && v.Length() == 0
+	case schema.ImplicitValue_EmptyMap:
+		return v.ReprKind() == ipld.ReprKind_Map && v.Length() == 0
+	default:
+		return false
+	}
+}
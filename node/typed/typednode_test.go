@@ -0,0 +1,351 @@
+package typednode_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/polydawn/refmt/json"
+	. "github.com/warpfork/go-wish"
+
+	ipld "github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/codec/dagjson"
+	"github.com/ipld/go-ipld-prime/fluent"
+	"github.com/ipld/go-ipld-prime/must"
+	basicnode "github.com/ipld/go-ipld-prime/node/basic"
+	"github.com/ipld/go-ipld-prime/node/tests"
+	typednode "github.com/ipld/go-ipld-prime/node/typed"
+	"github.com/ipld/go-ipld-prime/schema"
+	"github.com/ipld/go-ipld-prime/schema/dsl"
+)
+
+var testSchema = `
+	type Foo struct {
+		name String
+		count optional Int
+		note nullable String
+		tags [String]
+		color Color
+	} representation map {
+		field name alias "n"
+		field count implicit 0
+	}
+	type Defaults struct {
+		a String
+		b [String]
+		c {String:Int}
+	} representation map {
+		field b implicit []
+		field c implicit {}
+	}
+	type Color enum {
+		| Red
+		| Green
+	}
+	type Point struct {
+		x Int
+		y Int
+		z optional Int
+	} representation tuple
+	type Joined struct {
+		a String
+		b Color
+	} representation stringjoin {
+		join ":"
+	}
+	type Pairs struct {
+		a String
+		b optional String
+	} representation stringpairs {
+		innerDelim "="
+		entryDelim ","
+	}
+	type ByJoined {Joined:Int}
+	type MapStrInt {String:Int}
+	type MapStrMapStrInt {String:MapStrInt}
+	type ListString [String]
+	type Keyed union {
+		| Point "point"
+		| Joined "joined"
+	} representation keyed
+	type Kinded union {
+		| String string
+		| Point list
+	} representation kinded
+	type Env union {
+		| Point "point"
+		| Joined "joined"
+	} representation envelope {
+		discriminantKey "tag"
+		contentKey "content"
+	}
+	type Inl union {
+		| Foo "foo"
+		| Other "other"
+	} representation inline {
+		discriminantKey "tag"
+	}
+	type Other struct {
+		name String
+	}
+`
+
+func prototype(t *testing.T, typeName schema.TypeName) typednode.Prototype {
+	t.Helper()
+	ts, err := dsl.ParseBytes([]byte(testSchema))
+	Require(t, err, ShouldEqual, nil)
+	np, err := typednode.NewPrototype(ts, typeName)
+	Require(t, err, ShouldEqual, nil)
+	return np
+}
+
+// decode parses dag-json into the representation of the named type.
+func decode(t *testing.T, typeName schema.TypeName, js string) (ipld.Node, error) {
+	nb := prototype(t, typeName).Representation().NewBuilder()
+	if err := dagjson.Decoder(nb, strings.NewReader(js)); err != nil {
+		return nil, err
+	}
+	return nb.Build(), nil
+}
+
+// encode emits the representation of a typed node as dag-json.
+func encode(t *testing.T, n ipld.Node) string {
+	t.Helper()
+	var buf bytes.Buffer
+	err := dagjson.Marshal(n.(schema.TypedNode).Representation(), json.NewEncoder(&buf, json.EncodeOptions{}))
+	Require(t, err, ShouldEqual, nil)
+	return buf.String()
+}
+
+// roundtrip decodes dag-json and encodes it again.
+func roundtrip(t *testing.T, typeName schema.TypeName, js string) string {
+	t.Helper()
+	n, err := decode(t, typeName, js)
+	Require(t, err, ShouldEqual, nil)
+	return encode(t, n)
+}
+
+func decodeErr(t *testing.T, typeName schema.TypeName, js string) error {
+	t.Helper()
+	_, err := decode(t, typeName, js)
+	if err == nil {
+		t.Fatalf("expected an error decoding %s as %s", js, typeName)
+	}
+	return err
+}
+
+// invalidKey picks out the interesting parts of an ipld.ErrInvalidKey.
+// (Its message doesn't render the key usefully.)
+func invalidKey(err error) [2]string {
+	e, ok := err.(ipld.ErrInvalidKey)
+	if !ok {
+		return [2]string{"not ErrInvalidKey", err.Error()}
+	}
+	return [2]string{e.TypeName, must.String(e.Key)}
+}
+
+func TestSpecs(t *testing.T) {
+	t.Run("map", func(t *testing.T) {
+		tests.SpecTestMapStrInt(t, prototype(t, "MapStrInt"))
+		tests.SpecTestMapStrMapStrInt(t, prototype(t, "MapStrMapStrInt"))
+	})
+	t.Run("list", func(t *testing.T) {
+		tests.SpecTestListString(t, prototype(t, "ListString"))
+	})
+}
+
+func TestStructs(t *testing.T) {
+	t.Run("type level", func(t *testing.T) {
+		n := fluent.MustBuildMap(prototype(t, "Foo"), 4, func(ma fluent.MapAssembler) {
+			ma.AssembleEntry("name").AssignString("x")
+			ma.AssembleEntry("note").AssignNull()
+			ma.AssembleEntry("tags").CreateList(1, func(la fluent.ListAssembler) {
+				la.AssembleValue().AssignString("a")
+			})
+			ma.AssembleEntry("color").AssignString("Red")
+		})
+		Wish(t, n.(schema.TypedNode).Type().Name(), ShouldEqual, schema.TypeName("Foo"))
+		Wish(t, n.ReprKind(), ShouldEqual, ipld.ReprKind_Map)
+		Wish(t, n.Length(), ShouldEqual, 5)
+		// Implicits only apply to the representation; at the type level, the optional field is just absent.
+		Wish(t, must.Node(n.LookupByString("count")).IsAbsent(), ShouldEqual, true)
+		Wish(t, must.Node(n.LookupByString("note")).IsNull(), ShouldEqual, true)
+		Wish(t, encode(t, n), ShouldEqual, `{"n":"x","note":null,"tags":["a"],"color":"Red"}`)
+	})
+	t.Run("map representation", func(t *testing.T) {
+		n, err := decode(t, "Foo", `{"n": "x", "count": 2, "note": "y", "tags": [], "color": "Green"}`)
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, must.String(must.Node(n.LookupByString("name"))), ShouldEqual, "x")
+		Wish(t, must.Int(must.Node(n.LookupByString("count"))), ShouldEqual, int64(2))
+		_, err = n.LookupByString("n")
+		Wish(t, err.Error(), ShouldEqual, `no such field: Foo.n`)
+		Wish(t, encode(t, n), ShouldEqual, `{"n":"x","count":2,"note":"y","tags":[],"color":"Green"}`)
+		n, err = decode(t, "Foo", `{"n": "x", "note": "y", "tags": [], "color": "Green"}`)
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, must.Int(must.Node(n.LookupByString("count"))), ShouldEqual, int64(0))
+	})
+	t.Run("map representation with empty list and map implicits", func(t *testing.T) {
+		n, err := decode(t, "Defaults", `{"a": "x"}`)
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, must.Node(n.LookupByString("b")).ReprKind(), ShouldEqual, ipld.ReprKind_List)
+		Wish(t, must.Node(n.LookupByString("b")).Length(), ShouldEqual, 0)
+		Wish(t, must.Node(n.LookupByString("c")).ReprKind(), ShouldEqual, ipld.ReprKind_Map)
+		Wish(t, must.Node(n.LookupByString("c")).Length(), ShouldEqual, 0)
+		Wish(t, encode(t, n), ShouldEqual, `{"a":"x"}`)
+		Wish(t, roundtrip(t, "Defaults", `{"a": "x", "b": [], "c": {}}`), ShouldEqual, `{"a":"x"}`)
+		Wish(t, roundtrip(t, "Defaults", `{"a": "x", "b": ["y"], "c": {"z": 1}}`), ShouldEqual, `{"a":"x","b":["y"],"c":{"z":1}}`)
+	})
+	t.Run("map representation errors", func(t *testing.T) {
+		Wish(t, decodeErr(t, "Foo", `{"n": "x", "tags": [], "color": "Red"}`).Error(), ShouldEqual, `missing required fields for Foo.Repr: note`)
+		Wish(t, invalidKey(decodeErr(t, "Foo", `{"name": "x"}`)), ShouldEqual, [2]string{"Foo.Repr", "name"})
+		Wish(t, decodeErr(t, "Foo", `{"n": "x", "n": "y"}`), ShouldBeSameTypeAs, ipld.ErrRepeatedMapKey{})
+		Wish(t, decodeErr(t, "Foo", `{"n": 1}`).Error(), ShouldEqual, `func called on wrong kind: AssignInt called on a String.Repr node (kind: string), but only makes sense on int`)
+		Wish(t, decodeErr(t, "Foo", `{"color": "Blue"}`).Error(), ShouldEqual, `cannot match schema: "Blue" is not a member of enum Color.Repr`)
+	})
+	t.Run("tuple representation", func(t *testing.T) {
+		Wish(t, roundtrip(t, "Point", `[1, 2]`), ShouldEqual, `[1,2]`)
+		Wish(t, roundtrip(t, "Point", `[1, 2, 3]`), ShouldEqual, `[1,2,3]`)
+		n, err := decode(t, "Point", `[1, 2]`)
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, must.Node(n.LookupByString("z")).IsAbsent(), ShouldEqual, true)
+		Wish(t, decodeErr(t, "Point", `[1]`).Error(), ShouldEqual, `missing required fields for Point.Repr: y`)
+		Wish(t, decodeErr(t, "Point", `[1, 2, 3, 4]`).Error(), ShouldEqual, `no such field: Point.3`)
+	})
+	t.Run("tuple representation with implicits", func(t *testing.T) {
+		// The DSL only parses implicits for the map representation, so this type is built by hand.
+		var ts schema.TypeSystem
+		ts.Init()
+		ts.Accumulate(schema.SpawnInt("Int"))
+		ts.Accumulate(schema.SpawnString("String"))
+		ts.Accumulate(schema.SpawnStruct("Pt",
+			[]schema.StructField{
+				schema.SpawnStructField("x", "Int", false, false),
+				schema.SpawnStructField("unit", "String", false, false),
+				schema.SpawnStructField("scale", "Int", false, false),
+			},
+			schema.SpawnStructRepresentationTupleWithImplicits(map[string]schema.ImplicitValue{
+				"unit":  schema.SpawnImplicitValueString("mm"),
+				"scale": schema.SpawnImplicitValueInt(1),
+			}),
+		))
+		np, err := typednode.NewPrototype(&ts, "Pt")
+		Require(t, err, ShouldEqual, nil)
+		roundtrip := func(js string) string {
+			nb := np.Representation().NewBuilder()
+			Require(t, dagjson.Decoder(nb, strings.NewReader(js)), ShouldEqual, nil)
+			return encode(t, nb.Build())
+		}
+		Wish(t, roundtrip(`[1]`), ShouldEqual, `[1]`)
+		Wish(t, roundtrip(`[1, "mm", 1]`), ShouldEqual, `[1]`)
+		Wish(t, roundtrip(`[1, "mm", 2]`), ShouldEqual, `[1,"mm",2]`)
+		Wish(t, roundtrip(`[1, "in"]`), ShouldEqual, `[1,"in"]`)
+	})
+	t.Run("stringjoin representation", func(t *testing.T) {
+		n, err := decode(t, "Joined", `"x:Red"`)
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, n.ReprKind(), ShouldEqual, ipld.ReprKind_Map)
+		Wish(t, must.String(must.Node(n.LookupByString("b"))), ShouldEqual, "Red")
+		Wish(t, encode(t, n), ShouldEqual, `"x:Red"`)
+		Wish(t, decodeErr(t, "Joined", `"x"`).Error(), ShouldEqual, `parsing of Joined.Repr rejected: expected 1 instances of the delimiter, found 0`)
+	})
+	t.Run("stringpairs representation", func(t *testing.T) {
+		Wish(t, roundtrip(t, "Pairs", `"b=2,a=1"`), ShouldEqual, `"a=1,b=2"`)
+		Wish(t, roundtrip(t, "Pairs", `"a=1"`), ShouldEqual, `"a=1"`)
+		Wish(t, decodeErr(t, "Pairs", `"b=2"`).Error(), ShouldEqual, `missing required fields for Pairs.Repr: a`)
+		Wish(t, invalidKey(decodeErr(t, "Pairs", `"a=1,c=3"`)), ShouldEqual, [2]string{"Pairs.Repr", "c"})
+	})
+	t.Run("stringpairs with a nullable field is rejected", func(t *testing.T) {
+		// Null has no way to be expressed in the string, so a type like this can't be made at all:
+		// the DSL rejects it, and so does SpawnStruct.
+		_, err := dsl.ParseBytes([]byte(`type P struct { a String  b nullable String } representation stringpairs { innerDelim "=" entryDelim "," }`))
+		Wish(t, err.Error(), ShouldEqual, `schema dsl: invalid schema at line 1, column 27: field "b" cannot be nullable: not supported with stringpairs representation`)
+		defer func() {
+			Wish(t, recover(), ShouldEqual, "nullable is not supported on struct stringpairs representation")
+		}()
+		schema.SpawnStruct("P",
+			[]schema.StructField{
+				schema.SpawnStructField("a", "String", false, false),
+				schema.SpawnStructField("b", "String", false, true),
+			},
+			schema.SpawnStructRepresentationStringPairs("=", ","),
+		)
+	})
+}
+
+func TestMaps(t *testing.T) {
+	n, err := decode(t, "ByJoined", `{"x:Red": 1, "y:Green": 2}`)
+	Require(t, err, ShouldEqual, nil)
+	k, _, err := n.MapIterator().Next()
+	Require(t, err, ShouldEqual, nil)
+	Wish(t, k.(schema.TypedNode).Type().Name(), ShouldEqual, schema.TypeName("Joined"))
+	Wish(t, must.Int(must.Node(n.LookupByString("y:Green"))), ShouldEqual, int64(2))
+	Wish(t, must.Int(must.Node(n.LookupByNode(k))), ShouldEqual, int64(1))
+	Wish(t, encode(t, n), ShouldEqual, `{"x:Red":1,"y:Green":2}`)
+	Wish(t, decodeErr(t, "ByJoined", `{"x:Blue": 1}`).Error(), ShouldEqual, `parsing of Joined.Repr rejected: cannot match schema: "Blue" is not a member of enum Color.Repr`)
+}
+
+func TestUnions(t *testing.T) {
+	t.Run("keyed representation", func(t *testing.T) {
+		n, err := decode(t, "Keyed", `{"point": [1, 2]}`)
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, must.Int(must.Node(must.Node(n.LookupByString("Point")).LookupByString("y"))), ShouldEqual, int64(2))
+		_, err = n.LookupByString("Joined")
+		Wish(t, err, ShouldEqual, ipld.ErrNotExists{Segment: ipld.PathSegmentOfString("Joined")})
+		Wish(t, encode(t, n), ShouldEqual, `{"point":[1,2]}`)
+		Wish(t, invalidKey(decodeErr(t, "Keyed", `{"nope": [1, 2]}`)), ShouldEqual, [2]string{"Keyed.Repr", "nope"})
+		Wish(t, decodeErr(t, "Keyed", `{"point": [1, 2], "joined": "x:Red"}`).Error(), ShouldEqual, `cannot match schema: union structure constraints for Keyed.Repr caused rejection: a union can only have one entry`)
+		Wish(t, decodeErr(t, "Keyed", `{}`).Error(), ShouldEqual, `cannot match schema: union structure constraints for Keyed.Repr caused rejection: a union must have one entry`)
+	})
+	t.Run("kinded representation", func(t *testing.T) {
+		Wish(t, roundtrip(t, "Kinded", `"x"`), ShouldEqual, `"x"`)
+		Wish(t, roundtrip(t, "Kinded", `[1, 2]`), ShouldEqual, `[1,2]`)
+		n, err := decode(t, "Kinded", `"x"`)
+		Require(t, err, ShouldEqual, nil)
+		Wish(t, n.ReprKind(), ShouldEqual, ipld.ReprKind_Map)
+		Wish(t, must.String(must.Node(n.LookupByString("String"))), ShouldEqual, "x")
+		Wish(t, decodeErr(t, "Kinded", `1`).Error(), ShouldEqual, `cannot match schema: union structure constraints for Kinded.Repr caused rejection: no member has representation kind int`)
+	})
+	t.Run("envelope representation", func(t *testing.T) {
+		Wish(t, roundtrip(t, "Env", `{"content": "x:Red", "tag": "joined"}`), ShouldEqual, `{"tag":"joined","content":"x:Red"}`)
+		Wish(t, decodeErr(t, "Env", `{"tag": "nope", "content": [1, 2]}`).Error(), ShouldEqual, `cannot match schema: union structure constraints for Env.Repr caused rejection: unknown discriminant "nope"`)
+		Wish(t, decodeErr(t, "Env", `{"tag": "point", "content": [1, 2], "more": 1}`).Error(), ShouldEqual, `cannot match schema: union structure constraints for Env.Repr caused rejection: expected only the keys "tag" and "content"`)
+	})
+	t.Run("inline representation", func(t *testing.T) {
+		Wish(t, roundtrip(t, "Inl", `{"name": "x", "tag": "other"}`), ShouldEqual, `{"tag":"other","name":"x"}`)
+		Wish(t, decodeErr(t, "Inl", `{"name": "x"}`).Error(), ShouldEqual, `cannot match schema: union structure constraints for Inl.Repr caused rejection: missing discriminant key "tag"`)
+	})
+}
+
+func TestAssignNode(t *testing.T) {
+	// Typed nodes can be copied between type level and representation level builders;
+	// plain nodes are interpreted according to the builder's level.
+	np := prototype(t, "Keyed")
+	nb := np.Representation().NewBuilder()
+	Require(t, dagjson.Decoder(nb, strings.NewReader(`{"joined": "x:Red"}`)), ShouldEqual, nil)
+	n := nb.Build()
+
+	nb = np.NewBuilder()
+	Require(t, nb.AssignNode(n.(schema.TypedNode).Representation()), ShouldEqual, nil)
+	Wish(t, nb.Build() == n, ShouldEqual, true) // the same node, not a copy.
+
+	nb = np.NewBuilder()
+	Require(t, nb.AssignNode(fluent.MustBuildMap(basicnode.Prototype.Map, 1, func(ma fluent.MapAssembler) {
+		ma.AssembleEntry("Joined").CreateMap(2, func(ma fluent.MapAssembler) {
+			ma.AssembleEntry("a").AssignString("x")
+			ma.AssembleEntry("b").AssignString("Red")
+		})
+	})), ShouldEqual, nil)
+	Wish(t, encode(t, nb.Build()), ShouldEqual, `{"joined":"x:Red"}`)
+
+	// Copying data of the wrong shape is still checked.
+	nb = np.Representation().NewBuilder()
+	err := nb.AssignNode(must.Node(n.LookupByString("Joined")).(schema.TypedNode).Representation())
+	Wish(t, err.Error(), ShouldEqual, `func called on wrong kind: AssignString called on a Keyed.Repr node (kind: map), but only makes sense on string`)
+}
+
+func TestNewPrototypeErrors(t *testing.T) {
+	ts, err := dsl.ParseBytes([]byte(testSchema))
+	Require(t, err, ShouldEqual, nil)
+	_, err = typednode.NewPrototype(ts, "Nope")
+	Wish(t, err.Error(), ShouldEqual, "typednode: no type named Nope in the type system")
+}
@@ -20,7 +20,7 @@ type NodeAssembler interface {
 	BeginList(sizeHint int) (ListAssembler, error)
 	AssignNull() error
 	AssignBool(bool) error
-	AssignInt(int) error
+	AssignInt(int64) error
 	AssignFloat(float64) error
 	AssignString(string) error
 	AssignBytes([]byte) error
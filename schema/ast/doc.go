@@ -0,0 +1,22 @@
+// Package ast contains the schema-schema: the types that describe
+// IPLD Schemas themselves, as ipld.Node implementations.
+//
+// This means a schema can be loaded from (or stored as) any codec,
+// just like any other data -- for example, dag-json:
+//
+//	nb := ast.Type.Schema__Repr.NewBuilder()
+//	if err := dagjson.Decoder(nb, r); err != nil {
+//		return err
+//	}
+//	ts, errs := ast.Reify(nb.Build().(ast.Schema))
+//
+// Reify turns that data into a schema.TypeSystem,
+// which is what the rest of this library (e.g. codegen) consumes.
+//
+// Most of the code in this package is generated by gengo from the
+// declarations in gen.go; run `go generate` to update it.
+// Reify is hand-written, in reify.go.
+package ast
+
+//go:generate go run gen.go
+//go:generate gofmt -w .
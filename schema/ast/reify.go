@@ -0,0 +1,476 @@
+package ast
+
+import (
+	"fmt"
+
+	ipld "github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+// Reify builds a schema.TypeSystem from a Schema.
+//
+// Types from the prelude (Bool, String, Bytes, Int, Float, and Link)
+// are added to the TypeSystem if they're referenced but not declared.
+// Inline definitions of map and list types are accumulated as types
+// named the same way the schema DSL names them:
+// e.g. "List__String", or "Map__String__nullableFoo".
+//
+// Reify rejects schemas that don't describe a usable TypeSystem:
+// for example, ones that refer to types which aren't defined,
+// use map key types which don't have a string representation,
+// or have kinded unions with members that don't have the representation kind they're listed under.
+// It also rejects features that schema.TypeSystem can't express yet
+// (such as listpairs representations, or byteprefix unions).
+//
+// All the problems found are returned together;
+// if there are any, no TypeSystem is returned.
+func Reify(sch Schema) (*schema.TypeSystem, []error) {
+	r := reifier{
+		sch:  sch,
+		anon: make(map[schema.TypeName]struct{}),
+	}
+	r.ts.Init()
+	for i := range sch.types.t {
+		e := &sch.types.t[i]
+		name := schema.TypeName(e.k.x)
+		typ, err := r.reifyDefn(name, &e.v)
+		if err != nil {
+			r.errs = append(r.errs, err)
+			continue
+		}
+		r.ts.Accumulate(typ)
+	}
+	r.resolve()
+	if len(r.errs) > 0 {
+		return nil, r.errs
+	}
+	return &r.ts, nil
+}
+
+// reprKinds maps the strings used for representation kinds in kinded unions.
+var reprKinds = map[string]ipld.ReprKind{
+	"map":    ipld.ReprKind_Map,
+	"list":   ipld.ReprKind_List,
+	"bool":   ipld.ReprKind_Bool,
+	"int":    ipld.ReprKind_Int,
+	"float":  ipld.ReprKind_Float,
+	"string": ipld.ReprKind_String,
+	"bytes":  ipld.ReprKind_Bytes,
+	"link":   ipld.ReprKind_Link,
+}
+
+type reifier struct {
+	sch    Schema
+	ts     schema.TypeSystem
+	anon   map[schema.TypeName]struct{} // anonymous types already accumulated.
+	refs   []reference                  // every reference to a type by name, checked once all types are accumulated.
+	checks []func() error               // deferred checks which need the whole TypeSystem to be assembled first.
+	errs   []error
+}
+
+type reference struct {
+	name schema.TypeName
+	from schema.TypeName
+	as   string // describes the reference, for error messages.
+}
+
+// discriminant is one entry of a union representation's table.
+type discriminant struct {
+	key    string
+	member schema.TypeName
+}
+
+func (r *reifier) ref(name, from schema.TypeName, as string) {
+	r.refs = append(r.refs, reference{name, from, as})
+}
+
+// resolve checks that every type referenced has been defined,
+// adding any prelude types that are used but were not explicitly declared,
+// and then runs any checks that needed the complete TypeSystem.
+func (r *reifier) resolve() {
+	for _, ref := range r.refs {
+		if r.ts.TypeByName(string(ref.name)) != nil {
+			continue
+		}
+		if typ := schema.SpawnPreludeType(ref.name, ref.name); typ != nil {
+			r.ts.Accumulate(typ)
+			continue
+		}
+		r.errs = append(r.errs, fmt.Errorf("type %s refers to missing type %s (%s)", ref.from, ref.name, ref.as))
+	}
+	for _, check := range r.checks {
+		if err := check(); err != nil {
+			r.errs = append(r.errs, err)
+		}
+	}
+}
+
+// typeByName returns nil if the type doesn't exist; checks should simply pass in that case,
+// since the dangling reference will already have been reported.
+func (r *reifier) typeByName(name schema.TypeName) schema.Type {
+	return r.ts.TypeByName(string(name))
+}
+
+func (r *reifier) reifyDefn(name schema.TypeName, defn *_TypeDefn) (schema.Type, error) {
+	switch t := defn.AsInterface().(type) {
+	case *_TypeBool:
+		return schema.SpawnBool(name), nil
+	case *_TypeString:
+		return schema.SpawnString(name), nil
+	case *_TypeBytes:
+		return schema.SpawnBytes(name), nil
+	case *_TypeInt:
+		return schema.SpawnInt(name), nil
+	case *_TypeFloat:
+		return schema.SpawnFloat(name), nil
+	case *_TypeLink:
+		if t.expectedType.m != schema.Maybe_Value {
+			return schema.SpawnLink(name), nil
+		}
+		target := schema.TypeName(t.expectedType.v.x)
+		r.ref(target, name, "as link reference type")
+		return schema.SpawnLinkReference(name, target), nil
+	case *_TypeMap:
+		keyType, valueType, nullable, err := r.reifyMap(name, t)
+		if err != nil {
+			return nil, err
+		}
+		return schema.SpawnMap(name, keyType, valueType, nullable), nil
+	case *_TypeList:
+		valueType, nullable, err := r.reifyList(name, t)
+		if err != nil {
+			return nil, err
+		}
+		return schema.SpawnList(name, valueType, nullable), nil
+	case *_TypeUnion:
+		return r.reifyUnion(name, t)
+	case *_TypeStruct:
+		return r.reifyStruct(name, t)
+	case *_TypeEnum:
+		return r.reifyEnum(name, t)
+	case *_TypeCopy:
+		from := schema.TypeName(t.fromType.x)
+		src, ok := r.sch.types.m[t.fromType]
+		if !ok {
+			if typ := schema.SpawnPreludeType(from, name); typ != nil {
+				return typ, nil
+			}
+			return nil, fmt.Errorf("type %s is a copy of missing type %s", name, from)
+		}
+		if _, ok := src.AsInterface().(*_TypeCopy); ok {
+			return nil, fmt.Errorf("type %s is a copy of type %s, which is itself a copy; copies of copies are not supported", name, from)
+		}
+		return r.reifyDefn(name, src)
+	default:
+		panic("unreachable")
+	}
+}
+
+// reifyTypeRef returns the name of the type used by a field, map value, or list value.
+// If it's an inline definition, the anonymous type is accumulated as well.
+func (r *reifier) reifyTypeRef(from schema.TypeName, as string, x *_TypeNameOrInlineDefn) (schema.TypeName, error) {
+	switch t := x.AsInterface().(type) {
+	case *_TypeName:
+		name := schema.TypeName(t.x)
+		r.ref(name, from, as)
+		return name, nil
+	case *_TypeDefnInline:
+		var typ schema.Type
+		switch t2 := t.AsInterface().(type) {
+		case *_TypeMap:
+			keyType, valueType, nullable, err := r.reifyMap(from, t2)
+			if err != nil {
+				return "", err
+			}
+			typ = schema.SpawnMap(schema.AnonymousMapTypeName(keyType, valueType, nullable), keyType, valueType, nullable)
+		case *_TypeList:
+			valueType, nullable, err := r.reifyList(from, t2)
+			if err != nil {
+				return "", err
+			}
+			typ = schema.SpawnList(schema.AnonymousListTypeName(valueType, nullable), valueType, nullable)
+		default:
+			panic("unreachable")
+		}
+		if _, exists := r.sch.types.m[_TypeName{string(typ.Name())}]; exists {
+			return "", fmt.Errorf("type %s uses an anonymous type which collides with the name of declared type %s", from, typ.Name())
+		}
+		if _, exists := r.anon[typ.Name()]; !exists {
+			r.anon[typ.Name()] = struct{}{}
+			r.ts.Accumulate(typ)
+		}
+		return typ.Name(), nil
+	default:
+		panic("unreachable")
+	}
+}
+
+func isTrue(b _Bool__Maybe) bool {
+	return b.m == schema.Maybe_Value && b.v.x
+}
+
+// reifyMap returns the parts of a map type.
+// The name is the map type's own name, or for inline definitions, the name of the type they appear in.
+func (r *reifier) reifyMap(name schema.TypeName, t *_TypeMap) (keyType, valueType schema.TypeName, nullable bool, err error) {
+	switch t.representation.AsInterface().(type) {
+	case *_MapRepresentation_Map:
+		// fine.
+	case *_MapRepresentation_StringPairs:
+		return "", "", false, fmt.Errorf("type %s uses map representation stringpairs, which is not supported", name)
+	case *_MapRepresentation_ListPairs:
+		return "", "", false, fmt.Errorf("type %s uses map representation listpairs, which is not supported", name)
+	}
+	keyType = schema.TypeName(t.keyType.x)
+	r.ref(keyType, name, "as key type")
+	r.checks = append(r.checks, func() error {
+		kt := r.typeByName(keyType)
+		if kt == nil {
+			return nil
+		}
+		if kt.RepresentationBehavior() != ipld.ReprKind_String {
+			return fmt.Errorf("type %s uses %s as a map key type, but map keys must be a type with a string representation", name, keyType)
+		}
+		return nil
+	})
+	valueType, err = r.reifyTypeRef(name, "as value type", &t.valueType)
+	return keyType, valueType, isTrue(t.valueNullable), err
+}
+
+// reifyList returns the parts of a list type, in the same manner as reifyMap.
+func (r *reifier) reifyList(name schema.TypeName, t *_TypeList) (valueType schema.TypeName, nullable bool, err error) {
+	valueType, err = r.reifyTypeRef(name, "as value type", &t.valueType)
+	return valueType, isTrue(t.valueNullable), err
+}
+
+func (r *reifier) reifyStruct(name schema.TypeName, t *_TypeStruct) (schema.Type, error) {
+	fields := make([]schema.StructField, 0, len(t.fields.t))
+	for i := range t.fields.t {
+		e := &t.fields.t[i]
+		ftyp, err := r.reifyTypeRef(name, "in field "+e.k.x, &e.v.typ)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, schema.SpawnStructField(e.k.x, ftyp, isTrue(e.v.optional), isTrue(e.v.nullable)))
+	}
+
+	var repr schema.StructRepresentation
+	switch rt := t.representation.AsInterface().(type) {
+	case *_StructRepresentation_Map:
+		renames := map[string]string{}
+		implicits := map[string]schema.ImplicitValue{}
+		if rt.fields.m == schema.Maybe_Value {
+			for _, e := range rt.fields.v.t {
+				if _, exists := t.fields.m[e.k]; !exists {
+					return nil, fmt.Errorf("type %s has representation details for field %s, which does not exist", name, e.k.x)
+				}
+				if e.v.rename.m == schema.Maybe_Value {
+					renames[e.k.x] = e.v.rename.v.x
+				}
+				if e.v.implicit.m == schema.Maybe_Value {
+					switch iv := e.v.implicit.v.AsInterface().(type) {
+					case *_String:
+						implicits[e.k.x] = schema.SpawnImplicitValueString(iv.x)
+					case *_Int:
+						implicits[e.k.x] = schema.SpawnImplicitValueInt(iv.x)
+					default:
+						return nil, fmt.Errorf("type %s has an implicit value for field %s which is not a string or int; other implicit values are not supported", name, e.k.x)
+					}
+				}
+			}
+		}
+		repr = schema.SpawnStructRepresentationMapWithImplicits(renames, implicits)
+	case *_StructRepresentation_Tuple:
+		if err := checkFieldOrder(name, t, rt.fieldOrder); err != nil {
+			return nil, err
+		}
+		repr = schema.SpawnStructRepresentationTuple()
+	case *_StructRepresentation_StringJoin:
+		if err := checkFieldOrder(name, t, rt.fieldOrder); err != nil {
+			return nil, err
+		}
+		for _, f := range fields {
+			if f.IsOptional() || f.IsNullable() {
+				return nil, fmt.Errorf("type %s uses struct representation stringjoin, so field %s cannot be optional or nullable", name, f.Name())
+			}
+		}
+		repr = schema.SpawnStructRepresentationStringjoin(rt.join.x)
+	case *_StructRepresentation_StringPairs:
+		repr = schema.SpawnStructRepresentationStringPairs(rt.innerDelim.x, rt.entryDelim.x)
+	case *_StructRepresentation_ListPairs:
+		return nil, fmt.Errorf("type %s uses struct representation listpairs, which is not supported", name)
+	default:
+		panic("unreachable")
+	}
+	return schema.SpawnStruct(name, fields, repr), nil
+}
+
+// checkFieldOrder rejects any fieldOrder which isn't the order the fields were declared in:
+// schema.TypeSystem can't express any other order yet.
+func checkFieldOrder(name schema.TypeName, t *_TypeStruct, fieldOrder _List__FieldName__Maybe) error {
+	if fieldOrder.m != schema.Maybe_Value {
+		return nil
+	}
+	order := fieldOrder.v.x
+	if len(order) != len(t.fields.t) {
+		return fmt.Errorf("type %s has a fieldOrder which does not list every field exactly once", name)
+	}
+	for i := range order {
+		if order[i] != t.fields.t[i].k {
+			return fmt.Errorf("type %s has a fieldOrder which differs from the order the fields are declared in, which is not supported", name)
+		}
+	}
+	return nil
+}
+
+func (r *reifier) reifyUnion(name schema.TypeName, t *_TypeUnion) (schema.Type, error) {
+	members := make([]schema.TypeName, len(t.members.x))
+	for i, m := range t.members.x {
+		members[i] = schema.TypeName(m.x)
+		for _, prev := range members[:i] {
+			if prev == members[i] {
+				return nil, fmt.Errorf("type %s lists member %s more than once", name, prev)
+			}
+		}
+		r.ref(members[i], name, "as a member")
+	}
+
+	var repr schema.UnionRepresentation
+	switch rt := t.representation.AsInterface().(type) {
+	case *_UnionRepresentation_Keyed:
+		entries := make([]discriminant, len(rt.t))
+		for i, e := range rt.t {
+			entries[i] = discriminant{e.k.x, schema.TypeName(e.v.x)}
+		}
+		table, err := unionTable(name, members, entries)
+		if err != nil {
+			return nil, err
+		}
+		repr = schema.SpawnUnionRepresentationKeyed(table)
+	case *_UnionRepresentation_Kinded:
+		entries := make([]discriminant, len(rt.t))
+		for i, e := range rt.t {
+			entries[i] = discriminant{e.k.x, schema.TypeName(e.v.x)}
+		}
+		if _, err := unionTable(name, members, entries); err != nil {
+			return nil, err
+		}
+		table := make(map[ipld.ReprKind]schema.TypeName, len(entries))
+		for _, e := range entries {
+			k, ok := reprKinds[e.key]
+			if !ok {
+				return nil, fmt.Errorf("type %s uses %q in its kinded representation, which is not a representation kind", name, e.key)
+			}
+			table[k] = e.member
+			member := e.member
+			r.checks = append(r.checks, func() error {
+				mt := r.typeByName(member)
+				if mt == nil {
+					return nil
+				}
+				if rb := mt.RepresentationBehavior(); rb != k {
+					return fmt.Errorf("type %s has member %s listed under representation kind %s, but that member's representation kind is %s", name, member, k, describeBehavior(rb))
+				}
+				return nil
+			})
+		}
+		repr = schema.SpawnUnionRepresentationKinded(table)
+	case *_UnionRepresentation_Envelope:
+		entries := make([]discriminant, len(rt.discriminantTable.t))
+		for i, e := range rt.discriminantTable.t {
+			entries[i] = discriminant{e.k.x, schema.TypeName(e.v.x)}
+		}
+		table, err := unionTable(name, members, entries)
+		if err != nil {
+			return nil, err
+		}
+		repr = schema.SpawnUnionRepresentationEnvelope(rt.discriminantKey.x, rt.contentKey.x, table)
+	case *_UnionRepresentation_Inline:
+		entries := make([]discriminant, len(rt.discriminantTable.t))
+		for i, e := range rt.discriminantTable.t {
+			entries[i] = discriminant{e.k.x, schema.TypeName(e.v.x)}
+		}
+		table, err := unionTable(name, members, entries)
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range members {
+			member := member
+			r.checks = append(r.checks, func() error {
+				mt := r.typeByName(member)
+				if mt == nil {
+					return nil
+				}
+				st, ok := mt.(*schema.TypeStruct)
+				if !ok {
+					return fmt.Errorf("type %s uses union representation inline, so member %s must be a struct", name, member)
+				}
+				if _, ok := st.RepresentationStrategy().(schema.StructRepresentation_Map); !ok {
+					return fmt.Errorf("type %s uses union representation inline, so member %s must have a map representation", name, member)
+				}
+				return nil
+			})
+		}
+		repr = schema.SpawnUnionRepresentationInline(rt.discriminantKey.x, table)
+	case *_UnionRepresentation_BytePrefix:
+		return nil, fmt.Errorf("type %s uses union representation byteprefix, which is not supported", name)
+	default:
+		panic("unreachable")
+	}
+	return schema.SpawnUnion(name, members, repr), nil
+}
+
+// unionTable checks that a union representation's table lists every member exactly once, and nothing else.
+func unionTable(name schema.TypeName, members []schema.TypeName, entries []discriminant) (map[string]schema.TypeName, error) {
+	table := make(map[string]schema.TypeName, len(entries))
+	seen := make(map[schema.TypeName]string, len(entries))
+	for _, e := range entries {
+		isMember := false
+		for _, m := range members {
+			isMember = isMember || m == e.member
+		}
+		if !isMember {
+			return nil, fmt.Errorf("type %s has %s in its representation, but it is not a member", name, e.member)
+		}
+		if prev, exists := seen[e.member]; exists {
+			return nil, fmt.Errorf("type %s has member %s in its representation more than once (as %q and %q)", name, e.member, prev, e.key)
+		}
+		seen[e.member] = e.key
+		table[e.key] = e.member
+	}
+	for _, m := range members {
+		if _, exists := seen[m]; !exists {
+			return nil, fmt.Errorf("type %s has member %s, but it is missing from its representation", name, m)
+		}
+	}
+	return table, nil
+}
+
+func describeBehavior(rb ipld.ReprKind) string {
+	if rb == ipld.ReprKind_Invalid {
+		return "not fixed (it's a kinded union)"
+	}
+	return rb.String()
+}
+
+func (r *reifier) reifyEnum(name schema.TypeName, t *_TypeEnum) (schema.Type, error) {
+	members := make([]string, len(t.members.t))
+	for i, e := range t.members.t {
+		members[i] = e.k.x
+	}
+	switch rt := t.representation.AsInterface().(type) {
+	case *_EnumRepresentation_String:
+		for _, e := range rt.t {
+			if _, exists := t.members.m[e.k]; !exists {
+				return nil, fmt.Errorf("type %s has a representation value for %s, which is not a member", name, e.k.x)
+			}
+			if e.v.x != e.k.x {
+				return nil, fmt.Errorf("type %s has a custom representation value for member %s, which is not supported", name, e.k.x)
+			}
+		}
+	case *_EnumRepresentation_Int:
+		return nil, fmt.Errorf("type %s uses enum representation int, which is not supported", name)
+	default:
+		panic("unreachable")
+	}
+	return schema.SpawnEnum(name, members), nil
+}
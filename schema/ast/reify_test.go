@@ -0,0 +1,293 @@
+package ast
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/warpfork/go-wish"
+
+	ipld "github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/codec/dagjson"
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+func decodeSchema(t *testing.T, src string) Schema {
+	t.Helper()
+	nb := Type.Schema__Repr.NewBuilder()
+	Require(t, dagjson.Decoder(nb, strings.NewReader(src)), ShouldEqual, nil)
+	return nb.Build().(Schema)
+}
+
+func mustReify(t *testing.T, src string) *schema.TypeSystem {
+	t.Helper()
+	ts, errs := Reify(decodeSchema(t, src))
+	Require(t, errs, ShouldEqual, []error(nil))
+	Require(t, ts.ValidateGraph(), ShouldEqual, []error(nil))
+	return ts
+}
+
+func TestReifyScalarsAndRecursives(t *testing.T) {
+	ts := mustReify(t, `{"types": {
+		"B": {"bool": {}},
+		"S": {"string": {}},
+		"X": {"bytes": {}},
+		"I": {"int": {}},
+		"F": {"float": {}},
+		"L": {"link": {}},
+		"R": {"link": {"expectedType": "S"}},
+		"C": {"copy": {"fromType": "Dict"}},
+		"Strs": {"list": {
+			"valueType": "String",
+			"representation": {"list": {}}
+		}},
+		"Dict": {"map": {
+			"keyType": "String",
+			"valueType": "Int",
+			"representation": {"map": {}}
+		}},
+		"Nest": {"map": {
+			"keyType": "S",
+			"valueType": {"list": {
+				"valueType": "R",
+				"valueNullable": true,
+				"representation": {"list": {}}
+			}},
+			"representation": {"map": {}}
+		}}
+	}}`)
+	Wish(t, ts.TypeByName("B").Kind(), ShouldEqual, schema.Kind_Bool)
+	Wish(t, ts.TypeByName("S").Kind(), ShouldEqual, schema.Kind_String)
+	Wish(t, ts.TypeByName("X").Kind(), ShouldEqual, schema.Kind_Bytes)
+	Wish(t, ts.TypeByName("I").Kind(), ShouldEqual, schema.Kind_Int)
+	Wish(t, ts.TypeByName("F").Kind(), ShouldEqual, schema.Kind_Float)
+	Wish(t, ts.TypeByName("L").(*schema.TypeLink).HasReferencedType(), ShouldEqual, false)
+	Wish(t, ts.TypeByName("R").(*schema.TypeLink).ReferencedType().Name(), ShouldEqual, schema.TypeName("S"))
+	Wish(t, ts.TypeByName("Strs").(*schema.TypeList).ValueType().Name(), ShouldEqual, schema.TypeName("String"))
+	Wish(t, ts.TypeByName("Dict").(*schema.TypeMap).ValueType().Name(), ShouldEqual, schema.TypeName("Int"))
+	t.Run("copies are reified as their source type", func(t *testing.T) {
+		Wish(t, ts.TypeByName("C").(*schema.TypeMap).KeyType().Name(), ShouldEqual, schema.TypeName("String"))
+		Wish(t, ts.TypeByName("C").(*schema.TypeMap).ValueType().Name(), ShouldEqual, schema.TypeName("Int"))
+	})
+	t.Run("anonymous types are named and accumulated", func(t *testing.T) {
+		nest := ts.TypeByName("Nest").(*schema.TypeMap)
+		Wish(t, nest.ValueType().Name(), ShouldEqual, schema.TypeName("List__nullableR"))
+		Wish(t, nest.ValueType().(*schema.TypeList).ValueIsNullable(), ShouldEqual, true)
+	})
+	t.Run("prelude types are added when used", func(t *testing.T) {
+		Wish(t, ts.TypeByName("String").Kind(), ShouldEqual, schema.Kind_String)
+		Wish(t, ts.TypeByName("Int").Kind(), ShouldEqual, schema.Kind_Int)
+		Wish(t, ts.TypeByName("Bool"), ShouldEqual, nil)
+	})
+}
+
+func TestReifyStructs(t *testing.T) {
+	ts := mustReify(t, `{"types": {
+		"Foo": {"struct": {
+			"fields": {
+				"a": {"type": "String"},
+				"b": {"type": "Int", "optional": true},
+				"c": {"type": "String", "nullable": true},
+				"d": {"type": {"map": {
+					"keyType": "String",
+					"valueType": "Int",
+					"representation": {"map": {}}
+				}}}
+			},
+			"representation": {"map": {"fields": {
+				"a": {"rename": "A"},
+				"b": {"implicit": 4}
+			}}}
+		}},
+		"Tup": {"struct": {
+			"fields": {
+				"x": {"type": "Int"},
+				"y": {"type": "Int"}
+			},
+			"representation": {"tuple": {"fieldOrder": ["x", "y"]}}
+		}},
+		"Joined": {"struct": {
+			"fields": {
+				"x": {"type": "String"},
+				"y": {"type": "String"}
+			},
+			"representation": {"stringjoin": {"join": ":"}}
+		}},
+		"Pairs": {"struct": {
+			"fields": {
+				"x": {"type": "String"}
+			},
+			"representation": {"stringpairs": {"innerDelim": "=", "entryDelim": ","}}
+		}}
+	}}`)
+	foo := ts.TypeByName("Foo").(*schema.TypeStruct)
+	Wish(t, len(foo.Fields()), ShouldEqual, 4)
+	Wish(t, foo.Fields()[0].Name(), ShouldEqual, "a")
+	Wish(t, foo.Field("b").IsOptional(), ShouldEqual, true)
+	Wish(t, foo.Field("c").IsNullable(), ShouldEqual, true)
+	Wish(t, foo.Field("d").Type().Name(), ShouldEqual, schema.TypeName("Map__String__Int"))
+	fooRepr := foo.RepresentationStrategy().(schema.StructRepresentation_Map)
+	Wish(t, fooRepr.GetFieldKey(*foo.Field("a")), ShouldEqual, "A")
+	Wish(t, fooRepr.GetFieldKey(*foo.Field("b")), ShouldEqual, "b")
+	Wish(t, fooRepr.GetImplicit(*foo.Field("b")), ShouldEqual, schema.SpawnImplicitValueInt(4))
+	Wish(t, ts.TypeByName("Tup").RepresentationBehavior(), ShouldEqual, ipld.ReprKind_List)
+	Wish(t, ts.TypeByName("Joined").(*schema.TypeStruct).RepresentationStrategy().(schema.StructRepresentation_Stringjoin).GetDelim(), ShouldEqual, ":")
+	Wish(t, ts.TypeByName("Pairs").(*schema.TypeStruct).RepresentationStrategy().(schema.StructRepresentation_StringPairs).GetEntryDelim(), ShouldEqual, ",")
+}
+
+func TestReifyUnions(t *testing.T) {
+	ts := mustReify(t, `{"types": {
+		"A": {"struct": {"fields": {}, "representation": {"map": {}}}},
+		"B": {"struct": {"fields": {}, "representation": {"map": {}}}},
+		"Keyed": {"union": {
+			"members": ["A", "B"],
+			"representation": {"keyed": {"a": "A", "b": "B"}}
+		}},
+		"Kinded": {"union": {
+			"members": ["String", "A"],
+			"representation": {"kinded": {"string": "String", "map": "A"}}
+		}},
+		"Env": {"union": {
+			"members": ["A", "B"],
+			"representation": {"envelope": {
+				"discriminantKey": "tag",
+				"contentKey": "content",
+				"discriminantTable": {"a": "A", "b": "B"}
+			}}
+		}},
+		"Inl": {"union": {
+			"members": ["A", "B"],
+			"representation": {"inline": {
+				"discriminantKey": "tag",
+				"discriminantTable": {"a": "A", "b": "B"}
+			}}
+		}},
+		"E": {"enum": {
+			"members": {"Yes": {}, "No": {}},
+			"representation": {"string": {}}
+		}}
+	}}`)
+	keyed := ts.TypeByName("Keyed").(*schema.TypeUnion)
+	Wish(t, keyed.RepresentationStrategy().(schema.UnionRepresentation_Keyed).GetDiscriminant(ts.TypeByName("B")), ShouldEqual, "b")
+	kinded := ts.TypeByName("Kinded").(*schema.TypeUnion)
+	Wish(t, kinded.RepresentationStrategy().(schema.UnionRepresentation_Kinded).GetMember(ipld.ReprKind_Map), ShouldEqual, schema.TypeName("A"))
+	env := ts.TypeByName("Env").(*schema.TypeUnion).RepresentationStrategy().(schema.UnionRepresentation_Envelope)
+	Wish(t, env.GetContentKey(), ShouldEqual, "content")
+	Wish(t, env.GetDiscriminant(ts.TypeByName("A")), ShouldEqual, "a")
+	inl := ts.TypeByName("Inl").(*schema.TypeUnion).RepresentationStrategy().(schema.UnionRepresentation_Inline)
+	Wish(t, inl.GetDiscriminantKey(), ShouldEqual, "tag")
+	Wish(t, ts.TypeByName("E").(*schema.TypeEnum).Members(), ShouldEqual, []string{"Yes", "No"})
+}
+
+func TestReifyErrors(t *testing.T) {
+	for _, tcase := range []struct {
+		name string
+		src  string
+		errs []string
+	}{
+		{"dangling reference",
+			`{"types": {
+				"Foo": {"struct": {"fields": {"a": {"type": "Bar"}}, "representation": {"map": {}}}}
+			}}`,
+			[]string{"type Foo refers to missing type Bar (in field a)"},
+		},
+		{"dangling references are all reported",
+			`{"types": {
+				"Foo": {"list": {"valueType": "Bar", "representation": {"list": {}}}},
+				"Baz": {"link": {"expectedType": "Quux"}}
+			}}`,
+			[]string{
+				"type Foo refers to missing type Bar (as value type)",
+				"type Baz refers to missing type Quux (as link reference type)",
+			},
+		},
+		{"map key type without string representation",
+			`{"types": {
+				"Foo": {"map": {"keyType": "Int", "valueType": "String", "representation": {"map": {}}}}
+			}}`,
+			[]string{"type Foo uses Int as a map key type, but map keys must be a type with a string representation"},
+		},
+		{"inline map key type without string representation",
+			`{"types": {
+				"Foo": {"list": {"valueType": {"map": {"keyType": "Bar", "valueType": "String", "representation": {"map": {}}}}, "representation": {"list": {}}}},
+				"Bar": {"list": {"valueType": "String", "representation": {"list": {}}}}
+			}}`,
+			[]string{"type Foo uses Bar as a map key type, but map keys must be a type with a string representation"},
+		},
+		{"kinded union with overlapping kinds",
+			`{"types": {
+				"A": {"map": {"keyType": "String", "valueType": "String", "representation": {"map": {}}}},
+				"B": {"map": {"keyType": "String", "valueType": "Int", "representation": {"map": {}}}},
+				"U": {"union": {"members": ["A", "B"], "representation": {"kinded": {"map": "A", "list": "B"}}}}
+			}}`,
+			[]string{"type U has member B listed under representation kind list, but that member's representation kind is map"},
+		},
+		{"kinded union with a kinded union member",
+			`{"types": {
+				"V": {"union": {"members": ["String"], "representation": {"kinded": {"string": "String"}}}},
+				"U": {"union": {"members": ["V"], "representation": {"kinded": {"string": "V"}}}}
+			}}`,
+			[]string{"type U has member V listed under representation kind string, but that member's representation kind is not fixed (it's a kinded union)"},
+		},
+		{"kinded union with unknown kind",
+			`{"types": {
+				"U": {"union": {"members": ["String"], "representation": {"kinded": {"text": "String"}}}}
+			}}`,
+			[]string{`type U uses "text" in its kinded representation, which is not a representation kind`},
+		},
+		{"union member missing from representation",
+			`{"types": {
+				"U": {"union": {"members": ["String", "Int"], "representation": {"keyed": {"s": "String"}}}}
+			}}`,
+			[]string{"type U has member Int, but it is missing from its representation"},
+		},
+		{"union representation naming a non-member",
+			`{"types": {
+				"U": {"union": {"members": ["String"], "representation": {"keyed": {"s": "String", "i": "Int"}}}}
+			}}`,
+			[]string{"type U has Int in its representation, but it is not a member"},
+		},
+		{"inline union with non-struct member",
+			`{"types": {
+				"U": {"union": {"members": ["String"], "representation": {"inline": {"discriminantKey": "k", "discriminantTable": {"s": "String"}}}}}
+			}}`,
+			[]string{"type U uses union representation inline, so member String must be a struct"},
+		},
+		{"copy of copy",
+			`{"types": {
+				"A": {"string": {}},
+				"B": {"copy": {"fromType": "A"}},
+				"C": {"copy": {"fromType": "B"}}
+			}}`,
+			[]string{"type C is a copy of type B, which is itself a copy; copies of copies are not supported"},
+		},
+		{"anonymous type colliding with declared type",
+			`{"types": {
+				"Foo": {"struct": {"fields": {"a": {"type": {"list": {"valueType": "String", "representation": {"list": {}}}}}}, "representation": {"map": {}}}},
+				"List__String": {"string": {}}
+			}}`,
+			[]string{"type Foo uses an anonymous type which collides with the name of declared type List__String"},
+		},
+		{"unsupported representation",
+			`{"types": {
+				"U": {"union": {"members": ["String"], "representation": {"byteprefix": {"discriminantTable": {"String": 1}}}}}
+			}}`,
+			[]string{"type U uses union representation byteprefix, which is not supported"},
+		},
+		{"reordered tuple",
+			`{"types": {
+				"T": {"struct": {"fields": {"x": {"type": "Int"}, "y": {"type": "Int"}}, "representation": {"tuple": {"fieldOrder": ["y", "x"]}}}}
+			}}`,
+			[]string{"type T has a fieldOrder which differs from the order the fields are declared in, which is not supported"},
+		},
+	} {
+		t.Run(tcase.name, func(t *testing.T) {
+			ts, errs := Reify(decodeSchema(t, tcase.src))
+			Wish(t, ts, ShouldEqual, (*schema.TypeSystem)(nil))
+			var msgs []string
+			for _, err := range errs {
+				msgs = append(msgs, err.Error())
+			}
+			Wish(t, msgs, ShouldEqual, tcase.errs)
+		})
+	}
+}
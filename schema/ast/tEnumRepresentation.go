@@ -0,0 +1,932 @@
+package ast
+
+// Code generated by go-ipld-prime gengo.  DO NOT EDIT.
+
+import (
+	ipld "github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/node/mixins"
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+type _EnumRepresentation struct {
+	tag uint
+	x1  _EnumRepresentation_String
+	x2  _EnumRepresentation_Int
+}
+type EnumRepresentation = *_EnumRepresentation
+
+type _EnumRepresentation__iface interface {
+	_EnumRepresentation__member()
+}
+
+func (_EnumRepresentation_String) _EnumRepresentation__member() {}
+func (_EnumRepresentation_Int) _EnumRepresentation__member()    {}
+func (n _EnumRepresentation) AsInterface() _EnumRepresentation__iface {
+	switch n.tag {
+	case 1:
+		return &n.x1
+	case 2:
+		return &n.x2
+	default:
+		panic("invalid union state; how did you create this object?")
+	}
+}
+
+type _EnumRepresentation__Maybe struct {
+	m schema.Maybe
+	v EnumRepresentation
+}
+type MaybeEnumRepresentation = *_EnumRepresentation__Maybe
+
+func (m MaybeEnumRepresentation) IsNull() bool {
+	return m.m == schema.Maybe_Null
+}
+func (m MaybeEnumRepresentation) IsAbsent() bool {
+	return m.m == schema.Maybe_Absent
+}
+func (m MaybeEnumRepresentation) Exists() bool {
+	return m.m == schema.Maybe_Value
+}
+func (m MaybeEnumRepresentation) AsNode() ipld.Node {
+	switch m.m {
+	case schema.Maybe_Absent:
+		return ipld.Absent
+	case schema.Maybe_Null:
+		return ipld.Null
+	case schema.Maybe_Value:
+		return m.v
+	default:
+		panic("unreachable")
+	}
+}
+func (m MaybeEnumRepresentation) Must() EnumRepresentation {
+	if !m.Exists() {
+		panic("unbox of a maybe rejected")
+	}
+	return m.v
+}
+
+var (
+	memberName__EnumRepresentation_EnumRepresentation_String = _String{"EnumRepresentation_String"}
+	memberName__EnumRepresentation_EnumRepresentation_Int    = _String{"EnumRepresentation_Int"}
+)
+var _ ipld.Node = (EnumRepresentation)(&_EnumRepresentation{})
+var _ schema.TypedNode = (EnumRepresentation)(&_EnumRepresentation{})
+
+func (EnumRepresentation) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_Map
+}
+func (n EnumRepresentation) LookupByString(key string) (ipld.Node, error) {
+	switch key {
+	case "EnumRepresentation_String":
+		if n.tag != 1 {
+			return nil, ipld.ErrNotExists{ipld.PathSegmentOfString(key)}
+		}
+		return &n.x1, nil
+	case "EnumRepresentation_Int":
+		if n.tag != 2 {
+			return nil, ipld.ErrNotExists{ipld.PathSegmentOfString(key)}
+		}
+		return &n.x2, nil
+	default:
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+	}
+}
+func (n EnumRepresentation) LookupByNode(key ipld.Node) (ipld.Node, error) {
+	ks, err := key.AsString()
+	if err != nil {
+		return nil, err
+	}
+	return n.LookupByString(ks)
+}
+func (EnumRepresentation) LookupByIndex(idx int) (ipld.Node, error) {
+	return mixins.Map{"ast.EnumRepresentation"}.LookupByIndex(0)
+}
+func (n EnumRepresentation) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	return n.LookupByString(seg.String())
+}
+func (n EnumRepresentation) MapIterator() ipld.MapIterator {
+	return &_EnumRepresentation__MapItr{n, false}
+}
+
+type _EnumRepresentation__MapItr struct {
+	n    EnumRepresentation
+	done bool
+}
+
+func (itr *_EnumRepresentation__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+	if itr.done {
+		return nil, nil, ipld.ErrIteratorOverread{}
+	}
+	switch itr.n.tag {
+	case 1:
+		k, v = &memberName__EnumRepresentation_EnumRepresentation_String, &itr.n.x1
+	case 2:
+		k, v = &memberName__EnumRepresentation_EnumRepresentation_Int, &itr.n.x2
+	default:
+		panic("unreachable")
+	}
+	itr.done = true
+	return
+}
+func (itr *_EnumRepresentation__MapItr) Done() bool {
+	return itr.done
+}
+
+func (EnumRepresentation) ListIterator() ipld.ListIterator {
+	return nil
+}
+func (EnumRepresentation) Length() int {
+	return 1
+}
+func (EnumRepresentation) IsAbsent() bool {
+	return false
+}
+func (EnumRepresentation) IsNull() bool {
+	return false
+}
+func (EnumRepresentation) AsBool() (bool, error) {
+	return mixins.Map{"ast.EnumRepresentation"}.AsBool()
+}
+func (EnumRepresentation) AsInt() (int64, error) {
+	return mixins.Map{"ast.EnumRepresentation"}.AsInt()
+}
+func (EnumRepresentation) AsFloat() (float64, error) {
+	return mixins.Map{"ast.EnumRepresentation"}.AsFloat()
+}
+func (EnumRepresentation) AsString() (string, error) {
+	return mixins.Map{"ast.EnumRepresentation"}.AsString()
+}
+func (EnumRepresentation) AsBytes() ([]byte, error) {
+	return mixins.Map{"ast.EnumRepresentation"}.AsBytes()
+}
+func (EnumRepresentation) AsLink() (ipld.Link, error) {
+	return mixins.Map{"ast.EnumRepresentation"}.AsLink()
+}
+func (EnumRepresentation) Prototype() ipld.NodePrototype {
+	return _EnumRepresentation__Prototype{}
+}
+
+type _EnumRepresentation__Prototype struct{}
+
+func (_EnumRepresentation__Prototype) NewBuilder() ipld.NodeBuilder {
+	var nb _EnumRepresentation__Builder
+	nb.Reset()
+	return &nb
+}
+
+type _EnumRepresentation__Builder struct {
+	_EnumRepresentation__Assembler
+}
+
+func (nb *_EnumRepresentation__Builder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_EnumRepresentation__Builder) Reset() {
+	var w _EnumRepresentation
+	var m schema.Maybe
+	*nb = _EnumRepresentation__Builder{_EnumRepresentation__Assembler{w: &w, m: &m}}
+}
+
+type _EnumRepresentation__Assembler struct {
+	w     *_EnumRepresentation
+	m     *schema.Maybe
+	state maState
+
+	cm  schema.Maybe
+	ca1 _EnumRepresentation_String__Assembler
+
+	ca2 _EnumRepresentation_Int__Assembler
+	ca  uint
+}
+
+func (na *_EnumRepresentation__Assembler) reset() {
+	na.state = maState_initial
+	switch na.ca {
+	case 0:
+		return
+	case 1:
+		na.ca1.reset()
+
+	case 2:
+		na.ca2.reset()
+	default:
+		panic("unreachable")
+	}
+	na.ca = 0
+	na.cm = schema.Maybe_Absent
+}
+func (na *_EnumRepresentation__Assembler) BeginMap(int) (ipld.MapAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: it makes no sense to 'begin' twice on the same assembler!")
+	}
+	*na.m = midvalue
+	if na.w == nil {
+		na.w = &_EnumRepresentation{}
+	}
+	return na, nil
+}
+func (_EnumRepresentation__Assembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.MapAssembler{"ast.EnumRepresentation"}.BeginList(0)
+}
+func (na *_EnumRepresentation__Assembler) AssignNull() error {
+	switch *na.m {
+	case allowNull:
+		*na.m = schema.Maybe_Null
+		return nil
+	case schema.Maybe_Absent:
+		return mixins.MapAssembler{"ast.EnumRepresentation"}.AssignNull()
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+	}
+	panic("unreachable")
+}
+func (_EnumRepresentation__Assembler) AssignBool(bool) error {
+	return mixins.MapAssembler{"ast.EnumRepresentation"}.AssignBool(false)
+}
+func (_EnumRepresentation__Assembler) AssignInt(int64) error {
+	return mixins.MapAssembler{"ast.EnumRepresentation"}.AssignInt(0)
+}
+func (_EnumRepresentation__Assembler) AssignFloat(float64) error {
+	return mixins.MapAssembler{"ast.EnumRepresentation"}.AssignFloat(0)
+}
+func (_EnumRepresentation__Assembler) AssignString(string) error {
+	return mixins.MapAssembler{"ast.EnumRepresentation"}.AssignString("")
+}
+func (_EnumRepresentation__Assembler) AssignBytes([]byte) error {
+	return mixins.MapAssembler{"ast.EnumRepresentation"}.AssignBytes(nil)
+}
+func (_EnumRepresentation__Assembler) AssignLink(ipld.Link) error {
+	return mixins.MapAssembler{"ast.EnumRepresentation"}.AssignLink(nil)
+}
+func (na *_EnumRepresentation__Assembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_EnumRepresentation); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		case midvalue:
+			panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	if v.ReprKind() != ipld.ReprKind_Map {
+		return ipld.ErrWrongKind{TypeName: "ast.EnumRepresentation", MethodName: "AssignNode", AppropriateKind: ipld.ReprKindSet_JustMap, ActualKind: v.ReprKind()}
+	}
+	itr := v.MapIterator()
+	for !itr.Done() {
+		k, v, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if err := na.AssembleKey().AssignNode(k); err != nil {
+			return err
+		}
+		if err := na.AssembleValue().AssignNode(v); err != nil {
+			return err
+		}
+	}
+	return na.Finish()
+}
+func (_EnumRepresentation__Assembler) Prototype() ipld.NodePrototype {
+	return _EnumRepresentation__Prototype{}
+}
+func (ma *_EnumRepresentation__Assembler) valueFinishTidy() bool {
+	switch ma.cm {
+	case schema.Maybe_Value:
+		ma.state = maState_initial
+		return true
+	default:
+		return false
+	}
+}
+func (ma *_EnumRepresentation__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleEntry cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleEntry cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleEntry cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on for the moment, but we'll still be erroring shortly.
+	case maState_finished:
+		panic("invalid state: AssembleEntry cannot be called on an assembler that's already finished")
+	}
+	if ma.ca != 0 {
+		return nil, schema.ErrNotUnionStructure{TypeName: "ast.EnumRepresentation", Detail: "cannot add another entry -- a union can only contain one thing!"}
+	}
+	switch k {
+	case "EnumRepresentation_String":
+		ma.state = maState_midValue
+		ma.ca = 1
+		ma.w.tag = 1
+		ma.ca1.w = &ma.w.x1
+		ma.ca1.m = &ma.cm
+		return &ma.ca1, nil
+	case "EnumRepresentation_Int":
+		ma.state = maState_midValue
+		ma.ca = 2
+		ma.w.tag = 2
+		ma.ca2.w = &ma.w.x2
+		ma.ca2.m = &ma.cm
+		return &ma.ca2, nil
+	default:
+		return nil, ipld.ErrInvalidKey{TypeName: "ast.EnumRepresentation", Key: &_String{k}}
+	}
+}
+func (ma *_EnumRepresentation__Assembler) AssembleKey() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleKey cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleKey cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleKey cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on for the moment, but we'll still be erroring shortly... or rather, the keyassembler will be.
+	case maState_finished:
+		panic("invalid state: AssembleKey cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midKey
+	return (*_EnumRepresentation__KeyAssembler)(ma)
+}
+func (ma *_EnumRepresentation__Assembler) AssembleValue() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		panic("invalid state: AssembleValue cannot be called when no key is primed")
+	case maState_midKey:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		// carry on
+	case maState_midValue:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling another value")
+	case maState_finished:
+		panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midValue
+	switch ma.ca {
+	case 0:
+		ma.ca1.w = &ma.w.x1
+		ma.ca1.m = &ma.cm
+		return &ma.ca1
+	case 1:
+		ma.ca2.w = &ma.w.x2
+		ma.ca2.m = &ma.cm
+		return &ma.ca2
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_EnumRepresentation__Assembler) Finish() error {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: Finish cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		panic("invalid state: Finish cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: Finish cannot be called on an assembler that's already finished")
+	}
+	if ma.ca == 0 {
+		return schema.ErrNotUnionStructure{TypeName: "ast.EnumRepresentation", Detail: "a union must have exactly one entry (not none)!"}
+	}
+	ma.state = maState_finished
+	*ma.m = schema.Maybe_Value
+	return nil
+}
+func (ma *_EnumRepresentation__Assembler) KeyPrototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (ma *_EnumRepresentation__Assembler) ValuePrototype(k string) ipld.NodePrototype {
+	switch k {
+	case "EnumRepresentation_String":
+		return _EnumRepresentation_String__Prototype{}
+	case "EnumRepresentation_Int":
+		return _EnumRepresentation_Int__Prototype{}
+	default:
+		return nil
+	}
+}
+
+type _EnumRepresentation__KeyAssembler _EnumRepresentation__Assembler
+
+func (_EnumRepresentation__KeyAssembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	return mixins.StringAssembler{"ast.EnumRepresentation.KeyAssembler"}.BeginMap(0)
+}
+func (_EnumRepresentation__KeyAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.StringAssembler{"ast.EnumRepresentation.KeyAssembler"}.BeginList(0)
+}
+func (na *_EnumRepresentation__KeyAssembler) AssignNull() error {
+	return mixins.StringAssembler{"ast.EnumRepresentation.KeyAssembler"}.AssignNull()
+}
+func (_EnumRepresentation__KeyAssembler) AssignBool(bool) error {
+	return mixins.StringAssembler{"ast.EnumRepresentation.KeyAssembler"}.AssignBool(false)
+}
+func (_EnumRepresentation__KeyAssembler) AssignInt(int64) error {
+	return mixins.StringAssembler{"ast.EnumRepresentation.KeyAssembler"}.AssignInt(0)
+}
+func (_EnumRepresentation__KeyAssembler) AssignFloat(float64) error {
+	return mixins.StringAssembler{"ast.EnumRepresentation.KeyAssembler"}.AssignFloat(0)
+}
+func (ka *_EnumRepresentation__KeyAssembler) AssignString(k string) error {
+	if ka.state != maState_midKey {
+		panic("misuse: KeyAssembler held beyond its valid lifetime")
+	}
+	if ka.ca != 0 {
+		return schema.ErrNotUnionStructure{TypeName: "ast.EnumRepresentation", Detail: "cannot add another entry -- a union can only contain one thing!"}
+	}
+	switch k {
+	case "EnumRepresentation_String":
+		ka.ca = 1
+		ka.w.tag = 1
+		ka.state = maState_expectValue
+		return nil
+	case "EnumRepresentation_Int":
+		ka.ca = 2
+		ka.w.tag = 2
+		ka.state = maState_expectValue
+		return nil
+	default:
+		return ipld.ErrInvalidKey{TypeName: "ast.EnumRepresentation", Key: &_String{k}} // TODO: error quality: ErrInvalidUnionDiscriminant ?
+	}
+	return nil
+}
+func (_EnumRepresentation__KeyAssembler) AssignBytes([]byte) error {
+	return mixins.StringAssembler{"ast.EnumRepresentation.KeyAssembler"}.AssignBytes(nil)
+}
+func (_EnumRepresentation__KeyAssembler) AssignLink(ipld.Link) error {
+	return mixins.StringAssembler{"ast.EnumRepresentation.KeyAssembler"}.AssignLink(nil)
+}
+func (ka *_EnumRepresentation__KeyAssembler) AssignNode(v ipld.Node) error {
+	if v2, err := v.AsString(); err != nil {
+		return err
+	} else {
+		return ka.AssignString(v2)
+	}
+}
+func (_EnumRepresentation__KeyAssembler) Prototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (EnumRepresentation) Type() schema.Type {
+	return nil /*TODO:typelit*/
+}
+func (n EnumRepresentation) Representation() ipld.Node {
+	return (*_EnumRepresentation__Repr)(n)
+}
+
+type _EnumRepresentation__Repr _EnumRepresentation
+
+var (
+	memberName__EnumRepresentation_EnumRepresentation_String_serial = _String{"string"}
+	memberName__EnumRepresentation_EnumRepresentation_Int_serial    = _String{"int"}
+)
+var _ ipld.Node = &_EnumRepresentation__Repr{}
+
+func (_EnumRepresentation__Repr) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_Map
+}
+func (n *_EnumRepresentation__Repr) LookupByString(key string) (ipld.Node, error) {
+	switch key {
+	case "string":
+		if n.tag != 1 {
+			return nil, ipld.ErrNotExists{ipld.PathSegmentOfString(key)}
+		}
+		return n.x1.Representation(), nil
+	case "int":
+		if n.tag != 2 {
+			return nil, ipld.ErrNotExists{ipld.PathSegmentOfString(key)}
+		}
+		return n.x2.Representation(), nil
+	default:
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+	}
+}
+func (n *_EnumRepresentation__Repr) LookupByNode(key ipld.Node) (ipld.Node, error) {
+	ks, err := key.AsString()
+	if err != nil {
+		return nil, err
+	}
+	return n.LookupByString(ks)
+}
+func (_EnumRepresentation__Repr) LookupByIndex(idx int) (ipld.Node, error) {
+	return mixins.Map{"ast.EnumRepresentation.Repr"}.LookupByIndex(0)
+}
+func (n _EnumRepresentation__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	return n.LookupByString(seg.String())
+}
+func (n *_EnumRepresentation__Repr) MapIterator() ipld.MapIterator {
+	return &_EnumRepresentation__ReprMapItr{n, false}
+}
+
+type _EnumRepresentation__ReprMapItr struct {
+	n    *_EnumRepresentation__Repr
+	done bool
+}
+
+func (itr *_EnumRepresentation__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+	if itr.done {
+		return nil, nil, ipld.ErrIteratorOverread{}
+	}
+	switch itr.n.tag {
+	case 1:
+		k, v = &memberName__EnumRepresentation_EnumRepresentation_String_serial, itr.n.x1.Representation()
+	case 2:
+		k, v = &memberName__EnumRepresentation_EnumRepresentation_Int_serial, itr.n.x2.Representation()
+	default:
+		panic("unreachable")
+	}
+	itr.done = true
+	return
+}
+func (itr *_EnumRepresentation__ReprMapItr) Done() bool {
+	return itr.done
+}
+
+func (_EnumRepresentation__Repr) ListIterator() ipld.ListIterator {
+	return nil
+}
+func (_EnumRepresentation__Repr) Length() int {
+	return 1
+}
+func (_EnumRepresentation__Repr) IsAbsent() bool {
+	return false
+}
+func (_EnumRepresentation__Repr) IsNull() bool {
+	return false
+}
+func (_EnumRepresentation__Repr) AsBool() (bool, error) {
+	return mixins.Map{"ast.EnumRepresentation.Repr"}.AsBool()
+}
+func (_EnumRepresentation__Repr) AsInt() (int64, error) {
+	return mixins.Map{"ast.EnumRepresentation.Repr"}.AsInt()
+}
+func (_EnumRepresentation__Repr) AsFloat() (float64, error) {
+	return mixins.Map{"ast.EnumRepresentation.Repr"}.AsFloat()
+}
+func (_EnumRepresentation__Repr) AsString() (string, error) {
+	return mixins.Map{"ast.EnumRepresentation.Repr"}.AsString()
+}
+func (_EnumRepresentation__Repr) AsBytes() ([]byte, error) {
+	return mixins.Map{"ast.EnumRepresentation.Repr"}.AsBytes()
+}
+func (_EnumRepresentation__Repr) AsLink() (ipld.Link, error) {
+	return mixins.Map{"ast.EnumRepresentation.Repr"}.AsLink()
+}
+func (_EnumRepresentation__Repr) Prototype() ipld.NodePrototype {
+	return _EnumRepresentation__ReprPrototype{}
+}
+
+type _EnumRepresentation__ReprPrototype struct{}
+
+func (_EnumRepresentation__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+	var nb _EnumRepresentation__ReprBuilder
+	nb.Reset()
+	return &nb
+}
+
+type _EnumRepresentation__ReprBuilder struct {
+	_EnumRepresentation__ReprAssembler
+}
+
+func (nb *_EnumRepresentation__ReprBuilder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_EnumRepresentation__ReprBuilder) Reset() {
+	var w _EnumRepresentation
+	var m schema.Maybe
+	*nb = _EnumRepresentation__ReprBuilder{_EnumRepresentation__ReprAssembler{w: &w, m: &m}}
+}
+
+type _EnumRepresentation__ReprAssembler struct {
+	w     *_EnumRepresentation
+	m     *schema.Maybe
+	state maState
+
+	cm  schema.Maybe
+	ca1 _EnumRepresentation_String__ReprAssembler
+
+	ca2 _EnumRepresentation_Int__ReprAssembler
+	ca  uint
+}
+
+func (na *_EnumRepresentation__ReprAssembler) reset() {
+	na.state = maState_initial
+	switch na.ca {
+	case 0:
+		return
+	case 1:
+		na.ca1.reset()
+
+	case 2:
+		na.ca2.reset()
+	default:
+		panic("unreachable")
+	}
+	na.ca = 0
+	na.cm = schema.Maybe_Absent
+}
+func (na *_EnumRepresentation__ReprAssembler) BeginMap(int) (ipld.MapAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: it makes no sense to 'begin' twice on the same assembler!")
+	}
+	*na.m = midvalue
+	if na.w == nil {
+		na.w = &_EnumRepresentation{}
+	}
+	return na, nil
+}
+func (_EnumRepresentation__ReprAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.MapAssembler{"ast.EnumRepresentation.Repr"}.BeginList(0)
+}
+func (na *_EnumRepresentation__ReprAssembler) AssignNull() error {
+	switch *na.m {
+	case allowNull:
+		*na.m = schema.Maybe_Null
+		return nil
+	case schema.Maybe_Absent:
+		return mixins.MapAssembler{"ast.EnumRepresentation.Repr.Repr"}.AssignNull()
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+	}
+	panic("unreachable")
+}
+func (_EnumRepresentation__ReprAssembler) AssignBool(bool) error {
+	return mixins.MapAssembler{"ast.EnumRepresentation.Repr"}.AssignBool(false)
+}
+func (_EnumRepresentation__ReprAssembler) AssignInt(int64) error {
+	return mixins.MapAssembler{"ast.EnumRepresentation.Repr"}.AssignInt(0)
+}
+func (_EnumRepresentation__ReprAssembler) AssignFloat(float64) error {
+	return mixins.MapAssembler{"ast.EnumRepresentation.Repr"}.AssignFloat(0)
+}
+func (_EnumRepresentation__ReprAssembler) AssignString(string) error {
+	return mixins.MapAssembler{"ast.EnumRepresentation.Repr"}.AssignString("")
+}
+func (_EnumRepresentation__ReprAssembler) AssignBytes([]byte) error {
+	return mixins.MapAssembler{"ast.EnumRepresentation.Repr"}.AssignBytes(nil)
+}
+func (_EnumRepresentation__ReprAssembler) AssignLink(ipld.Link) error {
+	return mixins.MapAssembler{"ast.EnumRepresentation.Repr"}.AssignLink(nil)
+}
+func (na *_EnumRepresentation__ReprAssembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_EnumRepresentation); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		case midvalue:
+			panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	if v.ReprKind() != ipld.ReprKind_Map {
+		return ipld.ErrWrongKind{TypeName: "ast.EnumRepresentation.Repr", MethodName: "AssignNode", AppropriateKind: ipld.ReprKindSet_JustMap, ActualKind: v.ReprKind()}
+	}
+	itr := v.MapIterator()
+	for !itr.Done() {
+		k, v, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if err := na.AssembleKey().AssignNode(k); err != nil {
+			return err
+		}
+		if err := na.AssembleValue().AssignNode(v); err != nil {
+			return err
+		}
+	}
+	return na.Finish()
+}
+func (_EnumRepresentation__ReprAssembler) Prototype() ipld.NodePrototype {
+	return _EnumRepresentation__ReprPrototype{}
+}
+func (ma *_EnumRepresentation__ReprAssembler) valueFinishTidy() bool {
+	switch ma.cm {
+	case schema.Maybe_Value:
+		ma.state = maState_initial
+		return true
+	default:
+		return false
+	}
+}
+func (ma *_EnumRepresentation__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleEntry cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleEntry cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleEntry cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on for the moment, but we'll still be erroring shortly.
+	case maState_finished:
+		panic("invalid state: AssembleEntry cannot be called on an assembler that's already finished")
+	}
+	if ma.ca != 0 {
+		return nil, schema.ErrNotUnionStructure{TypeName: "ast.EnumRepresentation.Repr", Detail: "cannot add another entry -- a union can only contain one thing!"}
+	}
+	switch k {
+	case "string":
+		ma.state = maState_midValue
+		ma.ca = 1
+		ma.w.tag = 1
+		ma.ca1.w = &ma.w.x1
+		ma.ca1.m = &ma.cm
+		return &ma.ca1, nil
+	case "int":
+		ma.state = maState_midValue
+		ma.ca = 2
+		ma.w.tag = 2
+		ma.ca2.w = &ma.w.x2
+		ma.ca2.m = &ma.cm
+		return &ma.ca2, nil
+	default:
+		return nil, ipld.ErrInvalidKey{TypeName: "ast.EnumRepresentation.Repr", Key: &_String{k}}
+	}
+}
+func (ma *_EnumRepresentation__ReprAssembler) AssembleKey() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleKey cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleKey cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleKey cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on for the moment, but we'll still be erroring shortly... or rather, the keyassembler will be.
+	case maState_finished:
+		panic("invalid state: AssembleKey cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midKey
+	return (*_EnumRepresentation__ReprKeyAssembler)(ma)
+}
+func (ma *_EnumRepresentation__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		panic("invalid state: AssembleValue cannot be called when no key is primed")
+	case maState_midKey:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		// carry on
+	case maState_midValue:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling another value")
+	case maState_finished:
+		panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midValue
+	switch ma.ca {
+	case 0:
+		ma.ca1.w = &ma.w.x1
+		ma.ca1.m = &ma.cm
+		return &ma.ca1
+	case 1:
+		ma.ca2.w = &ma.w.x2
+		ma.ca2.m = &ma.cm
+		return &ma.ca2
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_EnumRepresentation__ReprAssembler) Finish() error {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: Finish cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		panic("invalid state: Finish cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: Finish cannot be called on an assembler that's already finished")
+	}
+	if ma.ca == 0 {
+		return schema.ErrNotUnionStructure{TypeName: "ast.EnumRepresentation.Repr", Detail: "a union must have exactly one entry (not none)!"}
+	}
+	ma.state = maState_finished
+	*ma.m = schema.Maybe_Value
+	return nil
+}
+func (ma *_EnumRepresentation__ReprAssembler) KeyPrototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (ma *_EnumRepresentation__ReprAssembler) ValuePrototype(k string) ipld.NodePrototype {
+	switch k {
+	case "EnumRepresentation_String":
+		return _EnumRepresentation_String__ReprPrototype{}
+	case "EnumRepresentation_Int":
+		return _EnumRepresentation_Int__ReprPrototype{}
+	default:
+		return nil
+	}
+}
+
+type _EnumRepresentation__ReprKeyAssembler _EnumRepresentation__ReprAssembler
+
+func (_EnumRepresentation__ReprKeyAssembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	return mixins.StringAssembler{"ast.EnumRepresentation.Repr.KeyAssembler"}.BeginMap(0)
+}
+func (_EnumRepresentation__ReprKeyAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.StringAssembler{"ast.EnumRepresentation.Repr.KeyAssembler"}.BeginList(0)
+}
+func (na *_EnumRepresentation__ReprKeyAssembler) AssignNull() error {
+	return mixins.StringAssembler{"ast.EnumRepresentation.Repr.KeyAssembler"}.AssignNull()
+}
+func (_EnumRepresentation__ReprKeyAssembler) AssignBool(bool) error {
+	return mixins.StringAssembler{"ast.EnumRepresentation.Repr.KeyAssembler"}.AssignBool(false)
+}
+func (_EnumRepresentation__ReprKeyAssembler) AssignInt(int64) error {
+	return mixins.StringAssembler{"ast.EnumRepresentation.Repr.KeyAssembler"}.AssignInt(0)
+}
+func (_EnumRepresentation__ReprKeyAssembler) AssignFloat(float64) error {
+	return mixins.StringAssembler{"ast.EnumRepresentation.Repr.KeyAssembler"}.AssignFloat(0)
+}
+func (ka *_EnumRepresentation__ReprKeyAssembler) AssignString(k string) error {
+	if ka.state != maState_midKey {
+		panic("misuse: KeyAssembler held beyond its valid lifetime")
+	}
+	if ka.ca != 0 {
+		return schema.ErrNotUnionStructure{TypeName: "ast.EnumRepresentation.Repr", Detail: "cannot add another entry -- a union can only contain one thing!"}
+	}
+	switch k {
+	case "string":
+		ka.ca = 1
+		ka.w.tag = 1
+		ka.state = maState_expectValue
+		return nil
+	case "int":
+		ka.ca = 2
+		ka.w.tag = 2
+		ka.state = maState_expectValue
+		return nil
+	default:
+		return ipld.ErrInvalidKey{TypeName: "ast.EnumRepresentation.Repr", Key: &_String{k}} // TODO: error quality: ErrInvalidUnionDiscriminant ?
+	}
+	return nil
+}
+func (_EnumRepresentation__ReprKeyAssembler) AssignBytes([]byte) error {
+	return mixins.StringAssembler{"ast.EnumRepresentation.Repr.KeyAssembler"}.AssignBytes(nil)
+}
+func (_EnumRepresentation__ReprKeyAssembler) AssignLink(ipld.Link) error {
+	return mixins.StringAssembler{"ast.EnumRepresentation.Repr.KeyAssembler"}.AssignLink(nil)
+}
+func (ka *_EnumRepresentation__ReprKeyAssembler) AssignNode(v ipld.Node) error {
+	if v2, err := v.AsString(); err != nil {
+		return err
+	} else {
+		return ka.AssignString(v2)
+	}
+}
+func (_EnumRepresentation__ReprKeyAssembler) Prototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
@@ -0,0 +1,759 @@
+package ast
+
+// Code generated by go-ipld-prime gengo.  DO NOT EDIT.
+
+import (
+	ipld "github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/node/mixins"
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+type _EnumRepresentation_String struct {
+	m map[_EnumValue]*_String
+	t []_EnumRepresentation_String__entry
+}
+type EnumRepresentation_String = *_EnumRepresentation_String
+type _EnumRepresentation_String__entry struct {
+	k _EnumValue
+	v _String
+}
+
+func (n *_EnumRepresentation_String) LookupMaybe(k EnumValue) MaybeString {
+	v, ok := n.m[*k]
+	if !ok {
+		return &_EnumRepresentation_String__valueAbsent
+	}
+	return &_String__Maybe{
+		m: schema.Maybe_Value,
+		v: v,
+	}
+}
+
+var _EnumRepresentation_String__valueAbsent = _String__Maybe{m: schema.Maybe_Absent}
+
+// TODO generate also a plain Lookup method that doesn't box and alloc if this type contains non-nullable values!
+type _EnumRepresentation_String__Maybe struct {
+	m schema.Maybe
+	v EnumRepresentation_String
+}
+type MaybeEnumRepresentation_String = *_EnumRepresentation_String__Maybe
+
+func (m MaybeEnumRepresentation_String) IsNull() bool {
+	return m.m == schema.Maybe_Null
+}
+func (m MaybeEnumRepresentation_String) IsAbsent() bool {
+	return m.m == schema.Maybe_Absent
+}
+func (m MaybeEnumRepresentation_String) Exists() bool {
+	return m.m == schema.Maybe_Value
+}
+func (m MaybeEnumRepresentation_String) AsNode() ipld.Node {
+	switch m.m {
+	case schema.Maybe_Absent:
+		return ipld.Absent
+	case schema.Maybe_Null:
+		return ipld.Null
+	case schema.Maybe_Value:
+		return m.v
+	default:
+		panic("unreachable")
+	}
+}
+func (m MaybeEnumRepresentation_String) Must() EnumRepresentation_String {
+	if !m.Exists() {
+		panic("unbox of a maybe rejected")
+	}
+	return m.v
+}
+
+var _ ipld.Node = (EnumRepresentation_String)(&_EnumRepresentation_String{})
+var _ schema.TypedNode = (EnumRepresentation_String)(&_EnumRepresentation_String{})
+
+func (EnumRepresentation_String) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_Map
+}
+func (n EnumRepresentation_String) LookupByString(k string) (ipld.Node, error) {
+	var k2 _EnumValue
+	if err := (_EnumValue__Prototype{}).fromString(&k2, k); err != nil {
+		return nil, err // TODO wrap in some kind of ErrInvalidKey
+	}
+	v, exists := n.m[k2]
+	if !exists {
+		return nil, ipld.ErrNotExists{ipld.PathSegmentOfString(k)}
+	}
+	return v, nil
+}
+func (n EnumRepresentation_String) LookupByNode(k ipld.Node) (ipld.Node, error) {
+	k2, ok := k.(EnumValue)
+	if !ok {
+		panic("todo invalid key type error")
+		// 'ipld.ErrInvalidKey{TypeName:"ast.EnumRepresentation_String", Key:&_String{k}}' doesn't quite cut it: need room to explain the type, and it's not guaranteed k can be turned into a string at all
+	}
+	v, exists := n.m[*k2]
+	if !exists {
+		return nil, ipld.ErrNotExists{ipld.PathSegmentOfString(k2.String())}
+	}
+	return v, nil
+}
+func (EnumRepresentation_String) LookupByIndex(idx int) (ipld.Node, error) {
+	return mixins.Map{"ast.EnumRepresentation_String"}.LookupByIndex(0)
+}
+func (n EnumRepresentation_String) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	return n.LookupByString(seg.String())
+}
+func (n EnumRepresentation_String) MapIterator() ipld.MapIterator {
+	return &_EnumRepresentation_String__MapItr{n, 0}
+}
+
+type _EnumRepresentation_String__MapItr struct {
+	n   EnumRepresentation_String
+	idx int
+}
+
+func (itr *_EnumRepresentation_String__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+	if itr.idx >= len(itr.n.t) {
+		return nil, nil, ipld.ErrIteratorOverread{}
+	}
+	x := &itr.n.t[itr.idx]
+	k = &x.k
+	v = &x.v
+	itr.idx++
+	return
+}
+func (itr *_EnumRepresentation_String__MapItr) Done() bool {
+	return itr.idx >= len(itr.n.t)
+}
+
+func (EnumRepresentation_String) ListIterator() ipld.ListIterator {
+	return nil
+}
+func (n EnumRepresentation_String) Length() int {
+	return len(n.t)
+}
+func (EnumRepresentation_String) IsAbsent() bool {
+	return false
+}
+func (EnumRepresentation_String) IsNull() bool {
+	return false
+}
+func (EnumRepresentation_String) AsBool() (bool, error) {
+	return mixins.Map{"ast.EnumRepresentation_String"}.AsBool()
+}
+func (EnumRepresentation_String) AsInt() (int64, error) {
+	return mixins.Map{"ast.EnumRepresentation_String"}.AsInt()
+}
+func (EnumRepresentation_String) AsFloat() (float64, error) {
+	return mixins.Map{"ast.EnumRepresentation_String"}.AsFloat()
+}
+func (EnumRepresentation_String) AsString() (string, error) {
+	return mixins.Map{"ast.EnumRepresentation_String"}.AsString()
+}
+func (EnumRepresentation_String) AsBytes() ([]byte, error) {
+	return mixins.Map{"ast.EnumRepresentation_String"}.AsBytes()
+}
+func (EnumRepresentation_String) AsLink() (ipld.Link, error) {
+	return mixins.Map{"ast.EnumRepresentation_String"}.AsLink()
+}
+func (EnumRepresentation_String) Prototype() ipld.NodePrototype {
+	return _EnumRepresentation_String__Prototype{}
+}
+
+type _EnumRepresentation_String__Prototype struct{}
+
+func (_EnumRepresentation_String__Prototype) NewBuilder() ipld.NodeBuilder {
+	var nb _EnumRepresentation_String__Builder
+	nb.Reset()
+	return &nb
+}
+
+type _EnumRepresentation_String__Builder struct {
+	_EnumRepresentation_String__Assembler
+}
+
+func (nb *_EnumRepresentation_String__Builder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_EnumRepresentation_String__Builder) Reset() {
+	var w _EnumRepresentation_String
+	var m schema.Maybe
+	*nb = _EnumRepresentation_String__Builder{_EnumRepresentation_String__Assembler{w: &w, m: &m}}
+}
+
+type _EnumRepresentation_String__Assembler struct {
+	w     *_EnumRepresentation_String
+	m     *schema.Maybe
+	state maState
+
+	cm schema.Maybe
+	ka _EnumValue__Assembler
+	va _String__Assembler
+}
+
+func (na *_EnumRepresentation_String__Assembler) reset() {
+	na.state = maState_initial
+	na.ka.reset()
+	na.va.reset()
+}
+func (na *_EnumRepresentation_String__Assembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: it makes no sense to 'begin' twice on the same assembler!")
+	}
+	*na.m = midvalue
+	if sizeHint < 0 {
+		sizeHint = 0
+	}
+	if na.w == nil {
+		na.w = &_EnumRepresentation_String{}
+	}
+	na.w.m = make(map[_EnumValue]*_String, sizeHint)
+	na.w.t = make([]_EnumRepresentation_String__entry, 0, sizeHint)
+	return na, nil
+}
+func (_EnumRepresentation_String__Assembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.MapAssembler{"ast.EnumRepresentation_String"}.BeginList(0)
+}
+func (na *_EnumRepresentation_String__Assembler) AssignNull() error {
+	switch *na.m {
+	case allowNull:
+		*na.m = schema.Maybe_Null
+		return nil
+	case schema.Maybe_Absent:
+		return mixins.MapAssembler{"ast.EnumRepresentation_String"}.AssignNull()
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+	}
+	panic("unreachable")
+}
+func (_EnumRepresentation_String__Assembler) AssignBool(bool) error {
+	return mixins.MapAssembler{"ast.EnumRepresentation_String"}.AssignBool(false)
+}
+func (_EnumRepresentation_String__Assembler) AssignInt(int64) error {
+	return mixins.MapAssembler{"ast.EnumRepresentation_String"}.AssignInt(0)
+}
+func (_EnumRepresentation_String__Assembler) AssignFloat(float64) error {
+	return mixins.MapAssembler{"ast.EnumRepresentation_String"}.AssignFloat(0)
+}
+func (_EnumRepresentation_String__Assembler) AssignString(string) error {
+	return mixins.MapAssembler{"ast.EnumRepresentation_String"}.AssignString("")
+}
+func (_EnumRepresentation_String__Assembler) AssignBytes([]byte) error {
+	return mixins.MapAssembler{"ast.EnumRepresentation_String"}.AssignBytes(nil)
+}
+func (_EnumRepresentation_String__Assembler) AssignLink(ipld.Link) error {
+	return mixins.MapAssembler{"ast.EnumRepresentation_String"}.AssignLink(nil)
+}
+func (na *_EnumRepresentation_String__Assembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_EnumRepresentation_String); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		case midvalue:
+			panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	if v.ReprKind() != ipld.ReprKind_Map {
+		return ipld.ErrWrongKind{TypeName: "ast.EnumRepresentation_String", MethodName: "AssignNode", AppropriateKind: ipld.ReprKindSet_JustMap, ActualKind: v.ReprKind()}
+	}
+	itr := v.MapIterator()
+	for !itr.Done() {
+		k, v, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if err := na.AssembleKey().AssignNode(k); err != nil {
+			return err
+		}
+		if err := na.AssembleValue().AssignNode(v); err != nil {
+			return err
+		}
+	}
+	return na.Finish()
+}
+func (_EnumRepresentation_String__Assembler) Prototype() ipld.NodePrototype {
+	return _EnumRepresentation_String__Prototype{}
+}
+func (ma *_EnumRepresentation_String__Assembler) keyFinishTidy() bool {
+	switch ma.cm {
+	case schema.Maybe_Value:
+		ma.ka.w = nil
+		tz := &ma.w.t[len(ma.w.t)-1]
+		ma.cm = schema.Maybe_Absent
+		ma.state = maState_expectValue
+		ma.w.m[tz.k] = &tz.v
+		ma.va.w = &tz.v
+		ma.va.m = &ma.cm
+		ma.ka.reset()
+		return true
+	default:
+		return false
+	}
+}
+func (ma *_EnumRepresentation_String__Assembler) valueFinishTidy() bool {
+	switch ma.cm {
+	case schema.Maybe_Value:
+		ma.va.w = nil
+		ma.cm = schema.Maybe_Absent
+		ma.state = maState_initial
+		ma.va.reset()
+		return true
+	default:
+		return false
+	}
+}
+func (ma *_EnumRepresentation_String__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleEntry cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleEntry cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleEntry cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleEntry cannot be called on an assembler that's already finished")
+	}
+
+	var k2 _EnumValue
+	if err := (_EnumValue__Prototype{}).fromString(&k2, k); err != nil {
+		return nil, err // TODO wrap in some kind of ErrInvalidKey
+	}
+	if _, exists := ma.w.m[k2]; exists {
+		return nil, ipld.ErrRepeatedMapKey{&k2}
+	}
+	ma.w.t = append(ma.w.t, _EnumRepresentation_String__entry{k: k2})
+	tz := &ma.w.t[len(ma.w.t)-1]
+	ma.state = maState_midValue
+
+	ma.w.m[k2] = &tz.v
+	ma.va.w = &tz.v
+	ma.va.m = &ma.cm
+	return &ma.va, nil
+}
+func (ma *_EnumRepresentation_String__Assembler) AssembleKey() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleKey cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleKey cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleKey cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleKey cannot be called on an assembler that's already finished")
+	}
+	ma.w.t = append(ma.w.t, _EnumRepresentation_String__entry{})
+	ma.state = maState_midKey
+	ma.ka.m = &ma.cm
+	ma.ka.w = &ma.w.t[len(ma.w.t)-1].k
+	return &ma.ka
+}
+func (ma *_EnumRepresentation_String__Assembler) AssembleValue() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		panic("invalid state: AssembleValue cannot be called when no key is primed")
+	case maState_midKey:
+		if !ma.keyFinishTidy() {
+			panic("invalid state: AssembleValue cannot be called when in the middle of assembling a key")
+		} // if tidy success: carry on
+	case maState_expectValue:
+		// carry on
+	case maState_midValue:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling another value")
+	case maState_finished:
+		panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midValue
+	return &ma.va
+}
+func (ma *_EnumRepresentation_String__Assembler) Finish() error {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: Finish cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		panic("invalid state: Finish cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: Finish cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_finished
+	*ma.m = schema.Maybe_Value
+	return nil
+}
+func (ma *_EnumRepresentation_String__Assembler) KeyPrototype() ipld.NodePrototype {
+	return _EnumValue__Prototype{}
+}
+func (ma *_EnumRepresentation_String__Assembler) ValuePrototype(_ string) ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (EnumRepresentation_String) Type() schema.Type {
+	return nil /*TODO:typelit*/
+}
+func (n EnumRepresentation_String) Representation() ipld.Node {
+	return (*_EnumRepresentation_String__Repr)(n)
+}
+
+type _EnumRepresentation_String__Repr _EnumRepresentation_String
+
+var _ ipld.Node = &_EnumRepresentation_String__Repr{}
+
+func (_EnumRepresentation_String__Repr) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_Map
+}
+func (nr *_EnumRepresentation_String__Repr) LookupByString(k string) (ipld.Node, error) {
+	v, err := (EnumRepresentation_String)(nr).LookupByString(k)
+	if err != nil || v == ipld.Null {
+		return v, err
+	}
+	return v.(String).Representation(), nil
+}
+func (nr *_EnumRepresentation_String__Repr) LookupByNode(k ipld.Node) (ipld.Node, error) {
+	v, err := (EnumRepresentation_String)(nr).LookupByNode(k)
+	if err != nil || v == ipld.Null {
+		return v, err
+	}
+	return v.(String).Representation(), nil
+}
+func (_EnumRepresentation_String__Repr) LookupByIndex(idx int) (ipld.Node, error) {
+	return mixins.Map{"ast.EnumRepresentation_String.Repr"}.LookupByIndex(0)
+}
+func (n _EnumRepresentation_String__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	return n.LookupByString(seg.String())
+}
+func (nr *_EnumRepresentation_String__Repr) MapIterator() ipld.MapIterator {
+	return &_EnumRepresentation_String__ReprMapItr{(EnumRepresentation_String)(nr), 0}
+}
+
+type _EnumRepresentation_String__ReprMapItr _EnumRepresentation_String__MapItr
+
+func (itr *_EnumRepresentation_String__ReprMapItr) Next() (k ipld.Node, v ipld.Node, err error) {
+	k, v, err = (*_EnumRepresentation_String__MapItr)(itr).Next()
+	if err != nil || v == ipld.Null {
+		return
+	}
+	return k, v.(String).Representation(), nil
+}
+func (itr *_EnumRepresentation_String__ReprMapItr) Done() bool {
+	return (*_EnumRepresentation_String__MapItr)(itr).Done()
+}
+
+func (_EnumRepresentation_String__Repr) ListIterator() ipld.ListIterator {
+	return nil
+}
+func (rn *_EnumRepresentation_String__Repr) Length() int {
+	return len(rn.t)
+}
+func (_EnumRepresentation_String__Repr) IsAbsent() bool {
+	return false
+}
+func (_EnumRepresentation_String__Repr) IsNull() bool {
+	return false
+}
+func (_EnumRepresentation_String__Repr) AsBool() (bool, error) {
+	return mixins.Map{"ast.EnumRepresentation_String.Repr"}.AsBool()
+}
+func (_EnumRepresentation_String__Repr) AsInt() (int64, error) {
+	return mixins.Map{"ast.EnumRepresentation_String.Repr"}.AsInt()
+}
+func (_EnumRepresentation_String__Repr) AsFloat() (float64, error) {
+	return mixins.Map{"ast.EnumRepresentation_String.Repr"}.AsFloat()
+}
+func (_EnumRepresentation_String__Repr) AsString() (string, error) {
+	return mixins.Map{"ast.EnumRepresentation_String.Repr"}.AsString()
+}
+func (_EnumRepresentation_String__Repr) AsBytes() ([]byte, error) {
+	return mixins.Map{"ast.EnumRepresentation_String.Repr"}.AsBytes()
+}
+func (_EnumRepresentation_String__Repr) AsLink() (ipld.Link, error) {
+	return mixins.Map{"ast.EnumRepresentation_String.Repr"}.AsLink()
+}
+func (_EnumRepresentation_String__Repr) Prototype() ipld.NodePrototype {
+	return _EnumRepresentation_String__ReprPrototype{}
+}
+
+type _EnumRepresentation_String__ReprPrototype struct{}
+
+func (_EnumRepresentation_String__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+	var nb _EnumRepresentation_String__ReprBuilder
+	nb.Reset()
+	return &nb
+}
+
+type _EnumRepresentation_String__ReprBuilder struct {
+	_EnumRepresentation_String__ReprAssembler
+}
+
+func (nb *_EnumRepresentation_String__ReprBuilder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_EnumRepresentation_String__ReprBuilder) Reset() {
+	var w _EnumRepresentation_String
+	var m schema.Maybe
+	*nb = _EnumRepresentation_String__ReprBuilder{_EnumRepresentation_String__ReprAssembler{w: &w, m: &m}}
+}
+
+type _EnumRepresentation_String__ReprAssembler struct {
+	w     *_EnumRepresentation_String
+	m     *schema.Maybe
+	state maState
+
+	cm schema.Maybe
+	ka _EnumValue__ReprAssembler
+	va _String__ReprAssembler
+}
+
+func (na *_EnumRepresentation_String__ReprAssembler) reset() {
+	na.state = maState_initial
+	na.ka.reset()
+	na.va.reset()
+}
+func (na *_EnumRepresentation_String__ReprAssembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: it makes no sense to 'begin' twice on the same assembler!")
+	}
+	*na.m = midvalue
+	if sizeHint < 0 {
+		sizeHint = 0
+	}
+	if na.w == nil {
+		na.w = &_EnumRepresentation_String{}
+	}
+	na.w.m = make(map[_EnumValue]*_String, sizeHint)
+	na.w.t = make([]_EnumRepresentation_String__entry, 0, sizeHint)
+	return na, nil
+}
+func (_EnumRepresentation_String__ReprAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.MapAssembler{"ast.EnumRepresentation_String.Repr"}.BeginList(0)
+}
+func (na *_EnumRepresentation_String__ReprAssembler) AssignNull() error {
+	switch *na.m {
+	case allowNull:
+		*na.m = schema.Maybe_Null
+		return nil
+	case schema.Maybe_Absent:
+		return mixins.MapAssembler{"ast.EnumRepresentation_String.Repr.Repr"}.AssignNull()
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+	}
+	panic("unreachable")
+}
+func (_EnumRepresentation_String__ReprAssembler) AssignBool(bool) error {
+	return mixins.MapAssembler{"ast.EnumRepresentation_String.Repr"}.AssignBool(false)
+}
+func (_EnumRepresentation_String__ReprAssembler) AssignInt(int64) error {
+	return mixins.MapAssembler{"ast.EnumRepresentation_String.Repr"}.AssignInt(0)
+}
+func (_EnumRepresentation_String__ReprAssembler) AssignFloat(float64) error {
+	return mixins.MapAssembler{"ast.EnumRepresentation_String.Repr"}.AssignFloat(0)
+}
+func (_EnumRepresentation_String__ReprAssembler) AssignString(string) error {
+	return mixins.MapAssembler{"ast.EnumRepresentation_String.Repr"}.AssignString("")
+}
+func (_EnumRepresentation_String__ReprAssembler) AssignBytes([]byte) error {
+	return mixins.MapAssembler{"ast.EnumRepresentation_String.Repr"}.AssignBytes(nil)
+}
+func (_EnumRepresentation_String__ReprAssembler) AssignLink(ipld.Link) error {
+	return mixins.MapAssembler{"ast.EnumRepresentation_String.Repr"}.AssignLink(nil)
+}
+func (na *_EnumRepresentation_String__ReprAssembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_EnumRepresentation_String); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		case midvalue:
+			panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	if v.ReprKind() != ipld.ReprKind_Map {
+		return ipld.ErrWrongKind{TypeName: "ast.EnumRepresentation_String.Repr", MethodName: "AssignNode", AppropriateKind: ipld.ReprKindSet_JustMap, ActualKind: v.ReprKind()}
+	}
+	itr := v.MapIterator()
+	for !itr.Done() {
+		k, v, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if err := na.AssembleKey().AssignNode(k); err != nil {
+			return err
+		}
+		if err := na.AssembleValue().AssignNode(v); err != nil {
+			return err
+		}
+	}
+	return na.Finish()
+}
+func (_EnumRepresentation_String__ReprAssembler) Prototype() ipld.NodePrototype {
+	return _EnumRepresentation_String__ReprPrototype{}
+}
+func (ma *_EnumRepresentation_String__ReprAssembler) keyFinishTidy() bool {
+	switch ma.cm {
+	case schema.Maybe_Value:
+		ma.ka.w = nil
+		tz := &ma.w.t[len(ma.w.t)-1]
+		ma.cm = schema.Maybe_Absent
+		ma.state = maState_expectValue
+		ma.w.m[tz.k] = &tz.v
+		ma.va.w = &tz.v
+		ma.va.m = &ma.cm
+		ma.ka.reset()
+		return true
+	default:
+		return false
+	}
+}
+func (ma *_EnumRepresentation_String__ReprAssembler) valueFinishTidy() bool {
+	switch ma.cm {
+	case schema.Maybe_Value:
+		ma.va.w = nil
+		ma.cm = schema.Maybe_Absent
+		ma.state = maState_initial
+		ma.va.reset()
+		return true
+	default:
+		return false
+	}
+}
+func (ma *_EnumRepresentation_String__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleEntry cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleEntry cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleEntry cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleEntry cannot be called on an assembler that's already finished")
+	}
+
+	var k2 _EnumValue
+	if err := (_EnumValue__ReprPrototype{}).fromString(&k2, k); err != nil {
+		return nil, err // TODO wrap in some kind of ErrInvalidKey
+	}
+	if _, exists := ma.w.m[k2]; exists {
+		return nil, ipld.ErrRepeatedMapKey{&k2}
+	}
+	ma.w.t = append(ma.w.t, _EnumRepresentation_String__entry{k: k2})
+	tz := &ma.w.t[len(ma.w.t)-1]
+	ma.state = maState_midValue
+
+	ma.w.m[k2] = &tz.v
+	ma.va.w = &tz.v
+	ma.va.m = &ma.cm
+	return &ma.va, nil
+}
+func (ma *_EnumRepresentation_String__ReprAssembler) AssembleKey() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleKey cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleKey cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleKey cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleKey cannot be called on an assembler that's already finished")
+	}
+	ma.w.t = append(ma.w.t, _EnumRepresentation_String__entry{})
+	ma.state = maState_midKey
+	ma.ka.m = &ma.cm
+	ma.ka.w = &ma.w.t[len(ma.w.t)-1].k
+	return &ma.ka
+}
+func (ma *_EnumRepresentation_String__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		panic("invalid state: AssembleValue cannot be called when no key is primed")
+	case maState_midKey:
+		if !ma.keyFinishTidy() {
+			panic("invalid state: AssembleValue cannot be called when in the middle of assembling a key")
+		} // if tidy success: carry on
+	case maState_expectValue:
+		// carry on
+	case maState_midValue:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling another value")
+	case maState_finished:
+		panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midValue
+	return &ma.va
+}
+func (ma *_EnumRepresentation_String__ReprAssembler) Finish() error {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: Finish cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		panic("invalid state: Finish cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: Finish cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_finished
+	*ma.m = schema.Maybe_Value
+	return nil
+}
+func (ma *_EnumRepresentation_String__ReprAssembler) KeyPrototype() ipld.NodePrototype {
+	return _EnumValue__ReprPrototype{}
+}
+func (ma *_EnumRepresentation_String__ReprAssembler) ValuePrototype(_ string) ipld.NodePrototype {
+	return _String__ReprPrototype{}
+}
@@ -0,0 +1,225 @@
+package ast
+
+// Code generated by go-ipld-prime gengo.  DO NOT EDIT.
+
+import (
+	ipld "github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/node/mixins"
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+type _Float struct{ x float64 }
+type Float = *_Float
+
+func (n Float) Float() float64 {
+	return n.x
+}
+func (_Float__Prototype) FromFloat(v float64) (Float, error) {
+	n := _Float{v}
+	return &n, nil
+}
+
+type _Float__Maybe struct {
+	m schema.Maybe
+	v Float
+}
+type MaybeFloat = *_Float__Maybe
+
+func (m MaybeFloat) IsNull() bool {
+	return m.m == schema.Maybe_Null
+}
+func (m MaybeFloat) IsAbsent() bool {
+	return m.m == schema.Maybe_Absent
+}
+func (m MaybeFloat) Exists() bool {
+	return m.m == schema.Maybe_Value
+}
+func (m MaybeFloat) AsNode() ipld.Node {
+	switch m.m {
+	case schema.Maybe_Absent:
+		return ipld.Absent
+	case schema.Maybe_Null:
+		return ipld.Null
+	case schema.Maybe_Value:
+		return m.v
+	default:
+		panic("unreachable")
+	}
+}
+func (m MaybeFloat) Must() Float {
+	if !m.Exists() {
+		panic("unbox of a maybe rejected")
+	}
+	return m.v
+}
+
+var _ ipld.Node = (Float)(&_Float{})
+var _ schema.TypedNode = (Float)(&_Float{})
+
+func (Float) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_Float
+}
+func (Float) LookupByString(string) (ipld.Node, error) {
+	return mixins.Float{"ast.Float"}.LookupByString("")
+}
+func (Float) LookupByNode(ipld.Node) (ipld.Node, error) {
+	return mixins.Float{"ast.Float"}.LookupByNode(nil)
+}
+func (Float) LookupByIndex(idx int) (ipld.Node, error) {
+	return mixins.Float{"ast.Float"}.LookupByIndex(0)
+}
+func (Float) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	return mixins.Float{"ast.Float"}.LookupBySegment(seg)
+}
+func (Float) MapIterator() ipld.MapIterator {
+	return nil
+}
+func (Float) ListIterator() ipld.ListIterator {
+	return nil
+}
+func (Float) Length() int {
+	return -1
+}
+func (Float) IsAbsent() bool {
+	return false
+}
+func (Float) IsNull() bool {
+	return false
+}
+func (Float) AsBool() (bool, error) {
+	return mixins.Float{"ast.Float"}.AsBool()
+}
+func (Float) AsInt() (int64, error) {
+	return mixins.Float{"ast.Float"}.AsInt()
+}
+func (n Float) AsFloat() (float64, error) {
+	return n.x, nil
+}
+func (Float) AsString() (string, error) {
+	return mixins.Float{"ast.Float"}.AsString()
+}
+func (Float) AsBytes() ([]byte, error) {
+	return mixins.Float{"ast.Float"}.AsBytes()
+}
+func (Float) AsLink() (ipld.Link, error) {
+	return mixins.Float{"ast.Float"}.AsLink()
+}
+func (Float) Prototype() ipld.NodePrototype {
+	return _Float__Prototype{}
+}
+
+type _Float__Prototype struct{}
+
+func (_Float__Prototype) NewBuilder() ipld.NodeBuilder {
+	var nb _Float__Builder
+	nb.Reset()
+	return &nb
+}
+
+type _Float__Builder struct {
+	_Float__Assembler
+}
+
+func (nb *_Float__Builder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_Float__Builder) Reset() {
+	var w _Float
+	var m schema.Maybe
+	*nb = _Float__Builder{_Float__Assembler{w: &w, m: &m}}
+}
+
+type _Float__Assembler struct {
+	w *_Float
+	m *schema.Maybe
+}
+
+func (na *_Float__Assembler) reset() {}
+func (_Float__Assembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	return mixins.FloatAssembler{"ast.Float"}.BeginMap(0)
+}
+func (_Float__Assembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.FloatAssembler{"ast.Float"}.BeginList(0)
+}
+func (na *_Float__Assembler) AssignNull() error {
+	switch *na.m {
+	case allowNull:
+		*na.m = schema.Maybe_Null
+		return nil
+	case schema.Maybe_Absent:
+		return mixins.FloatAssembler{"ast.Float"}.AssignNull()
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	}
+	panic("unreachable")
+}
+func (_Float__Assembler) AssignBool(bool) error {
+	return mixins.FloatAssembler{"ast.Float"}.AssignBool(false)
+}
+func (_Float__Assembler) AssignInt(int64) error {
+	return mixins.FloatAssembler{"ast.Float"}.AssignInt(0)
+}
+func (na *_Float__Assembler) AssignFloat(v float64) error {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	}
+	if na.w == nil {
+		na.w = &_Float{}
+	}
+	na.w.x = v
+	*na.m = schema.Maybe_Value
+	return nil
+}
+func (_Float__Assembler) AssignString(string) error {
+	return mixins.FloatAssembler{"ast.Float"}.AssignString("")
+}
+func (_Float__Assembler) AssignBytes([]byte) error {
+	return mixins.FloatAssembler{"ast.Float"}.AssignBytes(nil)
+}
+func (_Float__Assembler) AssignLink(ipld.Link) error {
+	return mixins.FloatAssembler{"ast.Float"}.AssignLink(nil)
+}
+func (na *_Float__Assembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_Float); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	if v2, err := v.AsFloat(); err != nil {
+		return err
+	} else {
+		return na.AssignFloat(v2)
+	}
+}
+func (_Float__Assembler) Prototype() ipld.NodePrototype {
+	return _Float__Prototype{}
+}
+func (Float) Type() schema.Type {
+	return nil /*TODO:typelit*/
+}
+func (n Float) Representation() ipld.Node {
+	return (*_Float__Repr)(n)
+}
+
+type _Float__Repr = _Float
+
+var _ ipld.Node = &_Float__Repr{}
+
+type _Float__ReprPrototype = _Float__Prototype
+type _Float__ReprAssembler = _Float__Assembler
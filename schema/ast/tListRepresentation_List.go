@@ -0,0 +1,755 @@
+package ast
+
+// Code generated by go-ipld-prime gengo.  DO NOT EDIT.
+
+import (
+	ipld "github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/node/mixins"
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+type _ListRepresentation_List struct {
+}
+type ListRepresentation_List = *_ListRepresentation_List
+
+type _ListRepresentation_List__Maybe struct {
+	m schema.Maybe
+	v ListRepresentation_List
+}
+type MaybeListRepresentation_List = *_ListRepresentation_List__Maybe
+
+func (m MaybeListRepresentation_List) IsNull() bool {
+	return m.m == schema.Maybe_Null
+}
+func (m MaybeListRepresentation_List) IsAbsent() bool {
+	return m.m == schema.Maybe_Absent
+}
+func (m MaybeListRepresentation_List) Exists() bool {
+	return m.m == schema.Maybe_Value
+}
+func (m MaybeListRepresentation_List) AsNode() ipld.Node {
+	switch m.m {
+	case schema.Maybe_Absent:
+		return ipld.Absent
+	case schema.Maybe_Null:
+		return ipld.Null
+	case schema.Maybe_Value:
+		return m.v
+	default:
+		panic("unreachable")
+	}
+}
+func (m MaybeListRepresentation_List) Must() ListRepresentation_List {
+	if !m.Exists() {
+		panic("unbox of a maybe rejected")
+	}
+	return m.v
+}
+
+var ()
+var _ ipld.Node = (ListRepresentation_List)(&_ListRepresentation_List{})
+var _ schema.TypedNode = (ListRepresentation_List)(&_ListRepresentation_List{})
+
+func (ListRepresentation_List) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_Map
+}
+func (n ListRepresentation_List) LookupByString(key string) (ipld.Node, error) {
+	switch key {
+	default:
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+	}
+}
+func (n ListRepresentation_List) LookupByNode(key ipld.Node) (ipld.Node, error) {
+	ks, err := key.AsString()
+	if err != nil {
+		return nil, err
+	}
+	return n.LookupByString(ks)
+}
+func (ListRepresentation_List) LookupByIndex(idx int) (ipld.Node, error) {
+	return mixins.Map{"ast.ListRepresentation_List"}.LookupByIndex(0)
+}
+func (n ListRepresentation_List) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	return n.LookupByString(seg.String())
+}
+func (n ListRepresentation_List) MapIterator() ipld.MapIterator {
+	return &_ListRepresentation_List__MapItr{n, 0}
+}
+
+type _ListRepresentation_List__MapItr struct {
+	n   ListRepresentation_List
+	idx int
+}
+
+func (itr *_ListRepresentation_List__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+	if itr.idx >= 0 {
+		return nil, nil, ipld.ErrIteratorOverread{}
+	}
+	switch itr.idx {
+	default:
+		panic("unreachable")
+	}
+	itr.idx++
+	return
+}
+func (itr *_ListRepresentation_List__MapItr) Done() bool {
+	return itr.idx >= 0
+}
+
+func (ListRepresentation_List) ListIterator() ipld.ListIterator {
+	return nil
+}
+func (ListRepresentation_List) Length() int {
+	return 0
+}
+func (ListRepresentation_List) IsAbsent() bool {
+	return false
+}
+func (ListRepresentation_List) IsNull() bool {
+	return false
+}
+func (ListRepresentation_List) AsBool() (bool, error) {
+	return mixins.Map{"ast.ListRepresentation_List"}.AsBool()
+}
+func (ListRepresentation_List) AsInt() (int64, error) {
+	return mixins.Map{"ast.ListRepresentation_List"}.AsInt()
+}
+func (ListRepresentation_List) AsFloat() (float64, error) {
+	return mixins.Map{"ast.ListRepresentation_List"}.AsFloat()
+}
+func (ListRepresentation_List) AsString() (string, error) {
+	return mixins.Map{"ast.ListRepresentation_List"}.AsString()
+}
+func (ListRepresentation_List) AsBytes() ([]byte, error) {
+	return mixins.Map{"ast.ListRepresentation_List"}.AsBytes()
+}
+func (ListRepresentation_List) AsLink() (ipld.Link, error) {
+	return mixins.Map{"ast.ListRepresentation_List"}.AsLink()
+}
+func (ListRepresentation_List) Prototype() ipld.NodePrototype {
+	return _ListRepresentation_List__Prototype{}
+}
+
+type _ListRepresentation_List__Prototype struct{}
+
+func (_ListRepresentation_List__Prototype) NewBuilder() ipld.NodeBuilder {
+	var nb _ListRepresentation_List__Builder
+	nb.Reset()
+	return &nb
+}
+
+type _ListRepresentation_List__Builder struct {
+	_ListRepresentation_List__Assembler
+}
+
+func (nb *_ListRepresentation_List__Builder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_ListRepresentation_List__Builder) Reset() {
+	var w _ListRepresentation_List
+	var m schema.Maybe
+	*nb = _ListRepresentation_List__Builder{_ListRepresentation_List__Assembler{w: &w, m: &m}}
+}
+
+type _ListRepresentation_List__Assembler struct {
+	w     *_ListRepresentation_List
+	m     *schema.Maybe
+	state maState
+	s     int
+	f     int
+
+	cm schema.Maybe
+}
+
+func (na *_ListRepresentation_List__Assembler) reset() {
+	na.state = maState_initial
+	na.s = 0
+}
+
+var (
+	fieldBits__ListRepresentation_List_sufficient = 0
+)
+
+func (na *_ListRepresentation_List__Assembler) BeginMap(int) (ipld.MapAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: it makes no sense to 'begin' twice on the same assembler!")
+	}
+	*na.m = midvalue
+	if na.w == nil {
+		na.w = &_ListRepresentation_List{}
+	}
+	return na, nil
+}
+func (_ListRepresentation_List__Assembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.MapAssembler{"ast.ListRepresentation_List"}.BeginList(0)
+}
+func (na *_ListRepresentation_List__Assembler) AssignNull() error {
+	switch *na.m {
+	case allowNull:
+		*na.m = schema.Maybe_Null
+		return nil
+	case schema.Maybe_Absent:
+		return mixins.MapAssembler{"ast.ListRepresentation_List"}.AssignNull()
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+	}
+	panic("unreachable")
+}
+func (_ListRepresentation_List__Assembler) AssignBool(bool) error {
+	return mixins.MapAssembler{"ast.ListRepresentation_List"}.AssignBool(false)
+}
+func (_ListRepresentation_List__Assembler) AssignInt(int64) error {
+	return mixins.MapAssembler{"ast.ListRepresentation_List"}.AssignInt(0)
+}
+func (_ListRepresentation_List__Assembler) AssignFloat(float64) error {
+	return mixins.MapAssembler{"ast.ListRepresentation_List"}.AssignFloat(0)
+}
+func (_ListRepresentation_List__Assembler) AssignString(string) error {
+	return mixins.MapAssembler{"ast.ListRepresentation_List"}.AssignString("")
+}
+func (_ListRepresentation_List__Assembler) AssignBytes([]byte) error {
+	return mixins.MapAssembler{"ast.ListRepresentation_List"}.AssignBytes(nil)
+}
+func (_ListRepresentation_List__Assembler) AssignLink(ipld.Link) error {
+	return mixins.MapAssembler{"ast.ListRepresentation_List"}.AssignLink(nil)
+}
+func (na *_ListRepresentation_List__Assembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_ListRepresentation_List); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		case midvalue:
+			panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	if v.ReprKind() != ipld.ReprKind_Map {
+		return ipld.ErrWrongKind{TypeName: "ast.ListRepresentation_List", MethodName: "AssignNode", AppropriateKind: ipld.ReprKindSet_JustMap, ActualKind: v.ReprKind()}
+	}
+	itr := v.MapIterator()
+	for !itr.Done() {
+		k, v, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if err := na.AssembleKey().AssignNode(k); err != nil {
+			return err
+		}
+		if err := na.AssembleValue().AssignNode(v); err != nil {
+			return err
+		}
+	}
+	return na.Finish()
+}
+func (_ListRepresentation_List__Assembler) Prototype() ipld.NodePrototype {
+	return _ListRepresentation_List__Prototype{}
+}
+func (ma *_ListRepresentation_List__Assembler) valueFinishTidy() bool {
+	switch ma.f {
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_ListRepresentation_List__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleEntry cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleEntry cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleEntry cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleEntry cannot be called on an assembler that's already finished")
+	}
+	switch k {
+	default:
+		return nil, ipld.ErrInvalidKey{TypeName: "ast.ListRepresentation_List", Key: &_String{k}}
+	}
+}
+func (ma *_ListRepresentation_List__Assembler) AssembleKey() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleKey cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleKey cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleKey cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleKey cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midKey
+	return (*_ListRepresentation_List__KeyAssembler)(ma)
+}
+func (ma *_ListRepresentation_List__Assembler) AssembleValue() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		panic("invalid state: AssembleValue cannot be called when no key is primed")
+	case maState_midKey:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		// carry on
+	case maState_midValue:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling another value")
+	case maState_finished:
+		panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midValue
+	switch ma.f {
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_ListRepresentation_List__Assembler) Finish() error {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: Finish cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		panic("invalid state: Finish cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: Finish cannot be called on an assembler that's already finished")
+	}
+	//FIXME check if all required fields are set
+	ma.state = maState_finished
+	*ma.m = schema.Maybe_Value
+	return nil
+}
+func (ma *_ListRepresentation_List__Assembler) KeyPrototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (ma *_ListRepresentation_List__Assembler) ValuePrototype(k string) ipld.NodePrototype {
+	panic("todo structbuilder mapassembler valueprototype")
+}
+
+type _ListRepresentation_List__KeyAssembler _ListRepresentation_List__Assembler
+
+func (_ListRepresentation_List__KeyAssembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	return mixins.StringAssembler{"ast.ListRepresentation_List.KeyAssembler"}.BeginMap(0)
+}
+func (_ListRepresentation_List__KeyAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.StringAssembler{"ast.ListRepresentation_List.KeyAssembler"}.BeginList(0)
+}
+func (na *_ListRepresentation_List__KeyAssembler) AssignNull() error {
+	return mixins.StringAssembler{"ast.ListRepresentation_List.KeyAssembler"}.AssignNull()
+}
+func (_ListRepresentation_List__KeyAssembler) AssignBool(bool) error {
+	return mixins.StringAssembler{"ast.ListRepresentation_List.KeyAssembler"}.AssignBool(false)
+}
+func (_ListRepresentation_List__KeyAssembler) AssignInt(int64) error {
+	return mixins.StringAssembler{"ast.ListRepresentation_List.KeyAssembler"}.AssignInt(0)
+}
+func (_ListRepresentation_List__KeyAssembler) AssignFloat(float64) error {
+	return mixins.StringAssembler{"ast.ListRepresentation_List.KeyAssembler"}.AssignFloat(0)
+}
+func (ka *_ListRepresentation_List__KeyAssembler) AssignString(k string) error {
+	if ka.state != maState_midKey {
+		panic("misuse: KeyAssembler held beyond its valid lifetime")
+	}
+	switch k {
+	default:
+		return ipld.ErrInvalidKey{TypeName: "ast.ListRepresentation_List", Key: &_String{k}}
+	}
+	return nil
+}
+func (_ListRepresentation_List__KeyAssembler) AssignBytes([]byte) error {
+	return mixins.StringAssembler{"ast.ListRepresentation_List.KeyAssembler"}.AssignBytes(nil)
+}
+func (_ListRepresentation_List__KeyAssembler) AssignLink(ipld.Link) error {
+	return mixins.StringAssembler{"ast.ListRepresentation_List.KeyAssembler"}.AssignLink(nil)
+}
+func (ka *_ListRepresentation_List__KeyAssembler) AssignNode(v ipld.Node) error {
+	if v2, err := v.AsString(); err != nil {
+		return err
+	} else {
+		return ka.AssignString(v2)
+	}
+}
+func (_ListRepresentation_List__KeyAssembler) Prototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (ListRepresentation_List) Type() schema.Type {
+	return nil /*TODO:typelit*/
+}
+func (n ListRepresentation_List) Representation() ipld.Node {
+	return (*_ListRepresentation_List__Repr)(n)
+}
+
+type _ListRepresentation_List__Repr _ListRepresentation_List
+
+var ()
+var _ ipld.Node = &_ListRepresentation_List__Repr{}
+
+func (_ListRepresentation_List__Repr) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_Map
+}
+func (n *_ListRepresentation_List__Repr) LookupByString(key string) (ipld.Node, error) {
+	switch key {
+	default:
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+	}
+}
+func (n *_ListRepresentation_List__Repr) LookupByNode(key ipld.Node) (ipld.Node, error) {
+	ks, err := key.AsString()
+	if err != nil {
+		return nil, err
+	}
+	return n.LookupByString(ks)
+}
+func (_ListRepresentation_List__Repr) LookupByIndex(idx int) (ipld.Node, error) {
+	return mixins.Map{"ast.ListRepresentation_List.Repr"}.LookupByIndex(0)
+}
+func (n _ListRepresentation_List__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	return n.LookupByString(seg.String())
+}
+func (n *_ListRepresentation_List__Repr) MapIterator() ipld.MapIterator {
+	return &_ListRepresentation_List__ReprMapItr{n, 0}
+}
+
+type _ListRepresentation_List__ReprMapItr struct {
+	n   *_ListRepresentation_List__Repr
+	idx int
+}
+
+func (itr *_ListRepresentation_List__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+	if itr.idx >= 0 {
+		return nil, nil, ipld.ErrIteratorOverread{}
+	}
+	switch itr.idx {
+	default:
+		panic("unreachable")
+	}
+	itr.idx++
+	return
+}
+func (itr *_ListRepresentation_List__ReprMapItr) Done() bool {
+	return itr.idx >= 0
+}
+func (_ListRepresentation_List__Repr) ListIterator() ipld.ListIterator {
+	return nil
+}
+func (rn *_ListRepresentation_List__Repr) Length() int {
+	l := 0
+	return l
+}
+func (_ListRepresentation_List__Repr) IsAbsent() bool {
+	return false
+}
+func (_ListRepresentation_List__Repr) IsNull() bool {
+	return false
+}
+func (_ListRepresentation_List__Repr) AsBool() (bool, error) {
+	return mixins.Map{"ast.ListRepresentation_List.Repr"}.AsBool()
+}
+func (_ListRepresentation_List__Repr) AsInt() (int64, error) {
+	return mixins.Map{"ast.ListRepresentation_List.Repr"}.AsInt()
+}
+func (_ListRepresentation_List__Repr) AsFloat() (float64, error) {
+	return mixins.Map{"ast.ListRepresentation_List.Repr"}.AsFloat()
+}
+func (_ListRepresentation_List__Repr) AsString() (string, error) {
+	return mixins.Map{"ast.ListRepresentation_List.Repr"}.AsString()
+}
+func (_ListRepresentation_List__Repr) AsBytes() ([]byte, error) {
+	return mixins.Map{"ast.ListRepresentation_List.Repr"}.AsBytes()
+}
+func (_ListRepresentation_List__Repr) AsLink() (ipld.Link, error) {
+	return mixins.Map{"ast.ListRepresentation_List.Repr"}.AsLink()
+}
+func (_ListRepresentation_List__Repr) Prototype() ipld.NodePrototype {
+	return _ListRepresentation_List__ReprPrototype{}
+}
+
+type _ListRepresentation_List__ReprPrototype struct{}
+
+func (_ListRepresentation_List__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+	var nb _ListRepresentation_List__ReprBuilder
+	nb.Reset()
+	return &nb
+}
+
+type _ListRepresentation_List__ReprBuilder struct {
+	_ListRepresentation_List__ReprAssembler
+}
+
+func (nb *_ListRepresentation_List__ReprBuilder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_ListRepresentation_List__ReprBuilder) Reset() {
+	var w _ListRepresentation_List
+	var m schema.Maybe
+	*nb = _ListRepresentation_List__ReprBuilder{_ListRepresentation_List__ReprAssembler{w: &w, m: &m}}
+}
+
+type _ListRepresentation_List__ReprAssembler struct {
+	w     *_ListRepresentation_List
+	m     *schema.Maybe
+	state maState
+	s     int
+	f     int
+
+	cm schema.Maybe
+}
+
+func (na *_ListRepresentation_List__ReprAssembler) reset() {
+	na.state = maState_initial
+	na.s = 0
+}
+func (na *_ListRepresentation_List__ReprAssembler) BeginMap(int) (ipld.MapAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: it makes no sense to 'begin' twice on the same assembler!")
+	}
+	*na.m = midvalue
+	if na.w == nil {
+		na.w = &_ListRepresentation_List{}
+	}
+	return na, nil
+}
+func (_ListRepresentation_List__ReprAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.MapAssembler{"ast.ListRepresentation_List.Repr"}.BeginList(0)
+}
+func (na *_ListRepresentation_List__ReprAssembler) AssignNull() error {
+	switch *na.m {
+	case allowNull:
+		*na.m = schema.Maybe_Null
+		return nil
+	case schema.Maybe_Absent:
+		return mixins.MapAssembler{"ast.ListRepresentation_List.Repr.Repr"}.AssignNull()
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+	}
+	panic("unreachable")
+}
+func (_ListRepresentation_List__ReprAssembler) AssignBool(bool) error {
+	return mixins.MapAssembler{"ast.ListRepresentation_List.Repr"}.AssignBool(false)
+}
+func (_ListRepresentation_List__ReprAssembler) AssignInt(int64) error {
+	return mixins.MapAssembler{"ast.ListRepresentation_List.Repr"}.AssignInt(0)
+}
+func (_ListRepresentation_List__ReprAssembler) AssignFloat(float64) error {
+	return mixins.MapAssembler{"ast.ListRepresentation_List.Repr"}.AssignFloat(0)
+}
+func (_ListRepresentation_List__ReprAssembler) AssignString(string) error {
+	return mixins.MapAssembler{"ast.ListRepresentation_List.Repr"}.AssignString("")
+}
+func (_ListRepresentation_List__ReprAssembler) AssignBytes([]byte) error {
+	return mixins.MapAssembler{"ast.ListRepresentation_List.Repr"}.AssignBytes(nil)
+}
+func (_ListRepresentation_List__ReprAssembler) AssignLink(ipld.Link) error {
+	return mixins.MapAssembler{"ast.ListRepresentation_List.Repr"}.AssignLink(nil)
+}
+func (na *_ListRepresentation_List__ReprAssembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_ListRepresentation_List); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		case midvalue:
+			panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	if v.ReprKind() != ipld.ReprKind_Map {
+		return ipld.ErrWrongKind{TypeName: "ast.ListRepresentation_List.Repr", MethodName: "AssignNode", AppropriateKind: ipld.ReprKindSet_JustMap, ActualKind: v.ReprKind()}
+	}
+	itr := v.MapIterator()
+	for !itr.Done() {
+		k, v, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if err := na.AssembleKey().AssignNode(k); err != nil {
+			return err
+		}
+		if err := na.AssembleValue().AssignNode(v); err != nil {
+			return err
+		}
+	}
+	return na.Finish()
+}
+func (_ListRepresentation_List__ReprAssembler) Prototype() ipld.NodePrototype {
+	return _ListRepresentation_List__ReprPrototype{}
+}
+func (ma *_ListRepresentation_List__ReprAssembler) valueFinishTidy() bool {
+	switch ma.f {
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_ListRepresentation_List__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleEntry cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleEntry cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleEntry cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleEntry cannot be called on an assembler that's already finished")
+	}
+	switch k {
+	default:
+		return nil, ipld.ErrInvalidKey{TypeName: "ast.ListRepresentation_List.Repr", Key: &_String{k}}
+	}
+}
+func (ma *_ListRepresentation_List__ReprAssembler) AssembleKey() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleKey cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleKey cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleKey cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleKey cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midKey
+	return (*_ListRepresentation_List__ReprKeyAssembler)(ma)
+}
+func (ma *_ListRepresentation_List__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		panic("invalid state: AssembleValue cannot be called when no key is primed")
+	case maState_midKey:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		// carry on
+	case maState_midValue:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling another value")
+	case maState_finished:
+		panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midValue
+	switch ma.f {
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_ListRepresentation_List__ReprAssembler) Finish() error {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: Finish cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		panic("invalid state: Finish cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: Finish cannot be called on an assembler that's already finished")
+	}
+	//FIXME check if all required fields are set
+	ma.state = maState_finished
+	*ma.m = schema.Maybe_Value
+	return nil
+}
+func (ma *_ListRepresentation_List__ReprAssembler) KeyPrototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (ma *_ListRepresentation_List__ReprAssembler) ValuePrototype(k string) ipld.NodePrototype {
+	panic("todo structbuilder mapassembler repr valueprototype")
+}
+
+type _ListRepresentation_List__ReprKeyAssembler _ListRepresentation_List__ReprAssembler
+
+func (_ListRepresentation_List__ReprKeyAssembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	return mixins.StringAssembler{"ast.ListRepresentation_List.Repr.KeyAssembler"}.BeginMap(0)
+}
+func (_ListRepresentation_List__ReprKeyAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.StringAssembler{"ast.ListRepresentation_List.Repr.KeyAssembler"}.BeginList(0)
+}
+func (na *_ListRepresentation_List__ReprKeyAssembler) AssignNull() error {
+	return mixins.StringAssembler{"ast.ListRepresentation_List.Repr.KeyAssembler"}.AssignNull()
+}
+func (_ListRepresentation_List__ReprKeyAssembler) AssignBool(bool) error {
+	return mixins.StringAssembler{"ast.ListRepresentation_List.Repr.KeyAssembler"}.AssignBool(false)
+}
+func (_ListRepresentation_List__ReprKeyAssembler) AssignInt(int64) error {
+	return mixins.StringAssembler{"ast.ListRepresentation_List.Repr.KeyAssembler"}.AssignInt(0)
+}
+func (_ListRepresentation_List__ReprKeyAssembler) AssignFloat(float64) error {
+	return mixins.StringAssembler{"ast.ListRepresentation_List.Repr.KeyAssembler"}.AssignFloat(0)
+}
+func (ka *_ListRepresentation_List__ReprKeyAssembler) AssignString(k string) error {
+	if ka.state != maState_midKey {
+		panic("misuse: KeyAssembler held beyond its valid lifetime")
+	}
+	switch k {
+	default:
+		return ipld.ErrInvalidKey{TypeName: "ast.ListRepresentation_List.Repr", Key: &_String{k}}
+	}
+	return nil
+}
+func (_ListRepresentation_List__ReprKeyAssembler) AssignBytes([]byte) error {
+	return mixins.StringAssembler{"ast.ListRepresentation_List.Repr.KeyAssembler"}.AssignBytes(nil)
+}
+func (_ListRepresentation_List__ReprKeyAssembler) AssignLink(ipld.Link) error {
+	return mixins.StringAssembler{"ast.ListRepresentation_List.Repr.KeyAssembler"}.AssignLink(nil)
+}
+func (ka *_ListRepresentation_List__ReprKeyAssembler) AssignNode(v ipld.Node) error {
+	if v2, err := v.AsString(); err != nil {
+		return err
+	} else {
+		return ka.AssignString(v2)
+	}
+}
+func (_ListRepresentation_List__ReprKeyAssembler) Prototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
@@ -0,0 +1,578 @@
+package ast
+
+// Code generated by go-ipld-prime gengo.  DO NOT EDIT.
+
+import (
+	ipld "github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/node/mixins"
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+type _List__FieldName struct {
+	x []_FieldName
+}
+type List__FieldName = *_List__FieldName
+type _List__FieldName__Maybe struct {
+	m schema.Maybe
+	v List__FieldName
+}
+type MaybeList__FieldName = *_List__FieldName__Maybe
+
+func (m MaybeList__FieldName) IsNull() bool {
+	return m.m == schema.Maybe_Null
+}
+func (m MaybeList__FieldName) IsAbsent() bool {
+	return m.m == schema.Maybe_Absent
+}
+func (m MaybeList__FieldName) Exists() bool {
+	return m.m == schema.Maybe_Value
+}
+func (m MaybeList__FieldName) AsNode() ipld.Node {
+	switch m.m {
+	case schema.Maybe_Absent:
+		return ipld.Absent
+	case schema.Maybe_Null:
+		return ipld.Null
+	case schema.Maybe_Value:
+		return m.v
+	default:
+		panic("unreachable")
+	}
+}
+func (m MaybeList__FieldName) Must() List__FieldName {
+	if !m.Exists() {
+		panic("unbox of a maybe rejected")
+	}
+	return m.v
+}
+
+var _ ipld.Node = (List__FieldName)(&_List__FieldName{})
+var _ schema.TypedNode = (List__FieldName)(&_List__FieldName{})
+
+func (List__FieldName) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_List
+}
+func (List__FieldName) LookupByString(string) (ipld.Node, error) {
+	return mixins.List{"ast.List__FieldName"}.LookupByString("")
+}
+func (n List__FieldName) LookupByNode(k ipld.Node) (ipld.Node, error) {
+	idx, err := k.AsInt()
+	if err != nil {
+		return nil, err
+	}
+	return n.LookupByIndex(int(idx))
+}
+func (n List__FieldName) LookupByIndex(idx int) (ipld.Node, error) {
+	if n.Length() <= idx {
+		return nil, ipld.ErrNotExists{ipld.PathSegmentOfInt(idx)}
+	}
+	v := &n.x[idx]
+	return v, nil
+}
+func (n List__FieldName) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	i, err := seg.Index()
+	if err != nil {
+		return nil, ipld.ErrInvalidSegmentForList{TypeName: "ast.List__FieldName", TroubleSegment: seg, Reason: err}
+	}
+	return n.LookupByIndex(i)
+}
+func (List__FieldName) MapIterator() ipld.MapIterator {
+	return nil
+}
+func (n List__FieldName) ListIterator() ipld.ListIterator {
+	return &_List__FieldName__ListItr{n, 0}
+}
+
+type _List__FieldName__ListItr struct {
+	n   List__FieldName
+	idx int
+}
+
+func (itr *_List__FieldName__ListItr) Next() (idx int, v ipld.Node, _ error) {
+	if itr.idx >= len(itr.n.x) {
+		return -1, nil, ipld.ErrIteratorOverread{}
+	}
+	idx = itr.idx
+	x := &itr.n.x[itr.idx]
+	v = x
+	itr.idx++
+	return
+}
+func (itr *_List__FieldName__ListItr) Done() bool {
+	return itr.idx >= len(itr.n.x)
+}
+
+func (n List__FieldName) Length() int {
+	return len(n.x)
+}
+func (List__FieldName) IsAbsent() bool {
+	return false
+}
+func (List__FieldName) IsNull() bool {
+	return false
+}
+func (List__FieldName) AsBool() (bool, error) {
+	return mixins.List{"ast.List__FieldName"}.AsBool()
+}
+func (List__FieldName) AsInt() (int64, error) {
+	return mixins.List{"ast.List__FieldName"}.AsInt()
+}
+func (List__FieldName) AsFloat() (float64, error) {
+	return mixins.List{"ast.List__FieldName"}.AsFloat()
+}
+func (List__FieldName) AsString() (string, error) {
+	return mixins.List{"ast.List__FieldName"}.AsString()
+}
+func (List__FieldName) AsBytes() ([]byte, error) {
+	return mixins.List{"ast.List__FieldName"}.AsBytes()
+}
+func (List__FieldName) AsLink() (ipld.Link, error) {
+	return mixins.List{"ast.List__FieldName"}.AsLink()
+}
+func (List__FieldName) Prototype() ipld.NodePrototype {
+	return _List__FieldName__Prototype{}
+}
+
+type _List__FieldName__Prototype struct{}
+
+func (_List__FieldName__Prototype) NewBuilder() ipld.NodeBuilder {
+	var nb _List__FieldName__Builder
+	nb.Reset()
+	return &nb
+}
+
+type _List__FieldName__Builder struct {
+	_List__FieldName__Assembler
+}
+
+func (nb *_List__FieldName__Builder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_List__FieldName__Builder) Reset() {
+	var w _List__FieldName
+	var m schema.Maybe
+	*nb = _List__FieldName__Builder{_List__FieldName__Assembler{w: &w, m: &m}}
+}
+
+type _List__FieldName__Assembler struct {
+	w     *_List__FieldName
+	m     *schema.Maybe
+	state laState
+
+	cm schema.Maybe
+	va _FieldName__Assembler
+}
+
+func (na *_List__FieldName__Assembler) reset() {
+	na.state = laState_initial
+	na.va.reset()
+}
+func (_List__FieldName__Assembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	return mixins.ListAssembler{"ast.List__FieldName"}.BeginMap(0)
+}
+func (na *_List__FieldName__Assembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: it makes no sense to 'begin' twice on the same assembler!")
+	}
+	*na.m = midvalue
+	if sizeHint < 0 {
+		sizeHint = 0
+	}
+	if na.w == nil {
+		na.w = &_List__FieldName{}
+	}
+	if sizeHint > 0 {
+		na.w.x = make([]_FieldName, 0, sizeHint)
+	}
+	return na, nil
+}
+func (na *_List__FieldName__Assembler) AssignNull() error {
+	switch *na.m {
+	case allowNull:
+		*na.m = schema.Maybe_Null
+		return nil
+	case schema.Maybe_Absent:
+		return mixins.ListAssembler{"ast.List__FieldName"}.AssignNull()
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+	}
+	panic("unreachable")
+}
+func (_List__FieldName__Assembler) AssignBool(bool) error {
+	return mixins.ListAssembler{"ast.List__FieldName"}.AssignBool(false)
+}
+func (_List__FieldName__Assembler) AssignInt(int64) error {
+	return mixins.ListAssembler{"ast.List__FieldName"}.AssignInt(0)
+}
+func (_List__FieldName__Assembler) AssignFloat(float64) error {
+	return mixins.ListAssembler{"ast.List__FieldName"}.AssignFloat(0)
+}
+func (_List__FieldName__Assembler) AssignString(string) error {
+	return mixins.ListAssembler{"ast.List__FieldName"}.AssignString("")
+}
+func (_List__FieldName__Assembler) AssignBytes([]byte) error {
+	return mixins.ListAssembler{"ast.List__FieldName"}.AssignBytes(nil)
+}
+func (_List__FieldName__Assembler) AssignLink(ipld.Link) error {
+	return mixins.ListAssembler{"ast.List__FieldName"}.AssignLink(nil)
+}
+func (na *_List__FieldName__Assembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_List__FieldName); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		case midvalue:
+			panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	if v.ReprKind() != ipld.ReprKind_List {
+		return ipld.ErrWrongKind{TypeName: "ast.List__FieldName", MethodName: "AssignNode", AppropriateKind: ipld.ReprKindSet_JustList, ActualKind: v.ReprKind()}
+	}
+	itr := v.ListIterator()
+	for !itr.Done() {
+		_, v, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if err := na.AssembleValue().AssignNode(v); err != nil {
+			return err
+		}
+	}
+	return na.Finish()
+}
+func (_List__FieldName__Assembler) Prototype() ipld.NodePrototype {
+	return _List__FieldName__Prototype{}
+}
+func (la *_List__FieldName__Assembler) valueFinishTidy() bool {
+	switch la.cm {
+	case schema.Maybe_Value:
+		la.va.w = nil
+		la.cm = schema.Maybe_Absent
+		la.state = laState_initial
+		la.va.reset()
+		return true
+	default:
+		return false
+	}
+}
+func (la *_List__FieldName__Assembler) AssembleValue() ipld.NodeAssembler {
+	switch la.state {
+	case laState_initial:
+		// carry on
+	case laState_midValue:
+		if !la.valueFinishTidy() {
+			panic("invalid state: AssembleValue cannot be called when still in the middle of assembling the previous value")
+		} // if tidy success: carry on
+	case laState_finished:
+		panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+	}
+	la.w.x = append(la.w.x, _FieldName{})
+	la.state = laState_midValue
+	row := &la.w.x[len(la.w.x)-1]
+	la.va.w = row
+	la.va.m = &la.cm
+	return &la.va
+}
+func (la *_List__FieldName__Assembler) Finish() error {
+	switch la.state {
+	case laState_initial:
+		// carry on
+	case laState_midValue:
+		if !la.valueFinishTidy() {
+			panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case laState_finished:
+		panic("invalid state: Finish cannot be called on an assembler that's already finished")
+	}
+	la.state = laState_finished
+	*la.m = schema.Maybe_Value
+	return nil
+}
+func (la *_List__FieldName__Assembler) ValuePrototype(_ int) ipld.NodePrototype {
+	return _FieldName__Prototype{}
+}
+func (List__FieldName) Type() schema.Type {
+	return nil /*TODO:typelit*/
+}
+func (n List__FieldName) Representation() ipld.Node {
+	return (*_List__FieldName__Repr)(n)
+}
+
+type _List__FieldName__Repr _List__FieldName
+
+var _ ipld.Node = &_List__FieldName__Repr{}
+
+func (_List__FieldName__Repr) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_List
+}
+func (_List__FieldName__Repr) LookupByString(string) (ipld.Node, error) {
+	return mixins.List{"ast.List__FieldName.Repr"}.LookupByString("")
+}
+func (nr *_List__FieldName__Repr) LookupByNode(k ipld.Node) (ipld.Node, error) {
+	v, err := (List__FieldName)(nr).LookupByNode(k)
+	if err != nil || v == ipld.Null {
+		return v, err
+	}
+	return v.(FieldName).Representation(), nil
+}
+func (nr *_List__FieldName__Repr) LookupByIndex(idx int) (ipld.Node, error) {
+	v, err := (List__FieldName)(nr).LookupByIndex(idx)
+	if err != nil || v == ipld.Null {
+		return v, err
+	}
+	return v.(FieldName).Representation(), nil
+}
+func (n _List__FieldName__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	i, err := seg.Index()
+	if err != nil {
+		return nil, ipld.ErrInvalidSegmentForList{TypeName: "ast.List__FieldName.Repr", TroubleSegment: seg, Reason: err}
+	}
+	return n.LookupByIndex(i)
+}
+func (_List__FieldName__Repr) MapIterator() ipld.MapIterator {
+	return nil
+}
+func (nr *_List__FieldName__Repr) ListIterator() ipld.ListIterator {
+	return &_List__FieldName__ReprListItr{(List__FieldName)(nr), 0}
+}
+
+type _List__FieldName__ReprListItr _List__FieldName__ListItr
+
+func (itr *_List__FieldName__ReprListItr) Next() (idx int, v ipld.Node, err error) {
+	idx, v, err = (*_List__FieldName__ListItr)(itr).Next()
+	if err != nil || v == ipld.Null {
+		return
+	}
+	return idx, v.(FieldName).Representation(), nil
+}
+func (itr *_List__FieldName__ReprListItr) Done() bool {
+	return (*_List__FieldName__ListItr)(itr).Done()
+}
+
+func (rn *_List__FieldName__Repr) Length() int {
+	return len(rn.x)
+}
+func (_List__FieldName__Repr) IsAbsent() bool {
+	return false
+}
+func (_List__FieldName__Repr) IsNull() bool {
+	return false
+}
+func (_List__FieldName__Repr) AsBool() (bool, error) {
+	return mixins.List{"ast.List__FieldName.Repr"}.AsBool()
+}
+func (_List__FieldName__Repr) AsInt() (int64, error) {
+	return mixins.List{"ast.List__FieldName.Repr"}.AsInt()
+}
+func (_List__FieldName__Repr) AsFloat() (float64, error) {
+	return mixins.List{"ast.List__FieldName.Repr"}.AsFloat()
+}
+func (_List__FieldName__Repr) AsString() (string, error) {
+	return mixins.List{"ast.List__FieldName.Repr"}.AsString()
+}
+func (_List__FieldName__Repr) AsBytes() ([]byte, error) {
+	return mixins.List{"ast.List__FieldName.Repr"}.AsBytes()
+}
+func (_List__FieldName__Repr) AsLink() (ipld.Link, error) {
+	return mixins.List{"ast.List__FieldName.Repr"}.AsLink()
+}
+func (_List__FieldName__Repr) Prototype() ipld.NodePrototype {
+	return _List__FieldName__ReprPrototype{}
+}
+
+type _List__FieldName__ReprPrototype struct{}
+
+func (_List__FieldName__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+	var nb _List__FieldName__ReprBuilder
+	nb.Reset()
+	return &nb
+}
+
+type _List__FieldName__ReprBuilder struct {
+	_List__FieldName__ReprAssembler
+}
+
+func (nb *_List__FieldName__ReprBuilder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_List__FieldName__ReprBuilder) Reset() {
+	var w _List__FieldName
+	var m schema.Maybe
+	*nb = _List__FieldName__ReprBuilder{_List__FieldName__ReprAssembler{w: &w, m: &m}}
+}
+
+type _List__FieldName__ReprAssembler struct {
+	w     *_List__FieldName
+	m     *schema.Maybe
+	state laState
+
+	cm schema.Maybe
+	va _FieldName__ReprAssembler
+}
+
+func (na *_List__FieldName__ReprAssembler) reset() {
+	na.state = laState_initial
+	na.va.reset()
+}
+func (_List__FieldName__ReprAssembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	return mixins.ListAssembler{"ast.List__FieldName.Repr"}.BeginMap(0)
+}
+func (na *_List__FieldName__ReprAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: it makes no sense to 'begin' twice on the same assembler!")
+	}
+	*na.m = midvalue
+	if sizeHint < 0 {
+		sizeHint = 0
+	}
+	if na.w == nil {
+		na.w = &_List__FieldName{}
+	}
+	if sizeHint > 0 {
+		na.w.x = make([]_FieldName, 0, sizeHint)
+	}
+	return na, nil
+}
+func (na *_List__FieldName__ReprAssembler) AssignNull() error {
+	switch *na.m {
+	case allowNull:
+		*na.m = schema.Maybe_Null
+		return nil
+	case schema.Maybe_Absent:
+		return mixins.ListAssembler{"ast.List__FieldName.Repr.Repr"}.AssignNull()
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+	}
+	panic("unreachable")
+}
+func (_List__FieldName__ReprAssembler) AssignBool(bool) error {
+	return mixins.ListAssembler{"ast.List__FieldName.Repr"}.AssignBool(false)
+}
+func (_List__FieldName__ReprAssembler) AssignInt(int64) error {
+	return mixins.ListAssembler{"ast.List__FieldName.Repr"}.AssignInt(0)
+}
+func (_List__FieldName__ReprAssembler) AssignFloat(float64) error {
+	return mixins.ListAssembler{"ast.List__FieldName.Repr"}.AssignFloat(0)
+}
+func (_List__FieldName__ReprAssembler) AssignString(string) error {
+	return mixins.ListAssembler{"ast.List__FieldName.Repr"}.AssignString("")
+}
+func (_List__FieldName__ReprAssembler) AssignBytes([]byte) error {
+	return mixins.ListAssembler{"ast.List__FieldName.Repr"}.AssignBytes(nil)
+}
+func (_List__FieldName__ReprAssembler) AssignLink(ipld.Link) error {
+	return mixins.ListAssembler{"ast.List__FieldName.Repr"}.AssignLink(nil)
+}
+func (na *_List__FieldName__ReprAssembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_List__FieldName); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		case midvalue:
+			panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	if v.ReprKind() != ipld.ReprKind_List {
+		return ipld.ErrWrongKind{TypeName: "ast.List__FieldName.Repr", MethodName: "AssignNode", AppropriateKind: ipld.ReprKindSet_JustList, ActualKind: v.ReprKind()}
+	}
+	itr := v.ListIterator()
+	for !itr.Done() {
+		_, v, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if err := na.AssembleValue().AssignNode(v); err != nil {
+			return err
+		}
+	}
+	return na.Finish()
+}
+func (_List__FieldName__ReprAssembler) Prototype() ipld.NodePrototype {
+	return _List__FieldName__ReprPrototype{}
+}
+func (la *_List__FieldName__ReprAssembler) valueFinishTidy() bool {
+	switch la.cm {
+	case schema.Maybe_Value:
+		la.va.w = nil
+		la.cm = schema.Maybe_Absent
+		la.state = laState_initial
+		la.va.reset()
+		return true
+	default:
+		return false
+	}
+}
+func (la *_List__FieldName__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+	switch la.state {
+	case laState_initial:
+		// carry on
+	case laState_midValue:
+		if !la.valueFinishTidy() {
+			panic("invalid state: AssembleValue cannot be called when still in the middle of assembling the previous value")
+		} // if tidy success: carry on
+	case laState_finished:
+		panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+	}
+	la.w.x = append(la.w.x, _FieldName{})
+	la.state = laState_midValue
+	row := &la.w.x[len(la.w.x)-1]
+	la.va.w = row
+	la.va.m = &la.cm
+	return &la.va
+}
+func (la *_List__FieldName__ReprAssembler) Finish() error {
+	switch la.state {
+	case laState_initial:
+		// carry on
+	case laState_midValue:
+		if !la.valueFinishTidy() {
+			panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case laState_finished:
+		panic("invalid state: Finish cannot be called on an assembler that's already finished")
+	}
+	la.state = laState_finished
+	*la.m = schema.Maybe_Value
+	return nil
+}
+func (la *_List__FieldName__ReprAssembler) ValuePrototype(_ int) ipld.NodePrototype {
+	return _FieldName__ReprPrototype{}
+}
@@ -0,0 +1,578 @@
+package ast
+
+// Code generated by go-ipld-prime gengo.  DO NOT EDIT.
+
+import (
+	ipld "github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/node/mixins"
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+type _List__TypeName struct {
+	x []_TypeName
+}
+type List__TypeName = *_List__TypeName
+type _List__TypeName__Maybe struct {
+	m schema.Maybe
+	v List__TypeName
+}
+type MaybeList__TypeName = *_List__TypeName__Maybe
+
+func (m MaybeList__TypeName) IsNull() bool {
+	return m.m == schema.Maybe_Null
+}
+func (m MaybeList__TypeName) IsAbsent() bool {
+	return m.m == schema.Maybe_Absent
+}
+func (m MaybeList__TypeName) Exists() bool {
+	return m.m == schema.Maybe_Value
+}
+func (m MaybeList__TypeName) AsNode() ipld.Node {
+	switch m.m {
+	case schema.Maybe_Absent:
+		return ipld.Absent
+	case schema.Maybe_Null:
+		return ipld.Null
+	case schema.Maybe_Value:
+		return m.v
+	default:
+		panic("unreachable")
+	}
+}
+func (m MaybeList__TypeName) Must() List__TypeName {
+	if !m.Exists() {
+		panic("unbox of a maybe rejected")
+	}
+	return m.v
+}
+
+var _ ipld.Node = (List__TypeName)(&_List__TypeName{})
+var _ schema.TypedNode = (List__TypeName)(&_List__TypeName{})
+
+func (List__TypeName) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_List
+}
+func (List__TypeName) LookupByString(string) (ipld.Node, error) {
+	return mixins.List{"ast.List__TypeName"}.LookupByString("")
+}
+func (n List__TypeName) LookupByNode(k ipld.Node) (ipld.Node, error) {
+	idx, err := k.AsInt()
+	if err != nil {
+		return nil, err
+	}
+	return n.LookupByIndex(int(idx))
+}
+func (n List__TypeName) LookupByIndex(idx int) (ipld.Node, error) {
+	if n.Length() <= idx {
+		return nil, ipld.ErrNotExists{ipld.PathSegmentOfInt(idx)}
+	}
+	v := &n.x[idx]
+	return v, nil
+}
+func (n List__TypeName) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	i, err := seg.Index()
+	if err != nil {
+		return nil, ipld.ErrInvalidSegmentForList{TypeName: "ast.List__TypeName", TroubleSegment: seg, Reason: err}
+	}
+	return n.LookupByIndex(i)
+}
+func (List__TypeName) MapIterator() ipld.MapIterator {
+	return nil
+}
+func (n List__TypeName) ListIterator() ipld.ListIterator {
+	return &_List__TypeName__ListItr{n, 0}
+}
+
+type _List__TypeName__ListItr struct {
+	n   List__TypeName
+	idx int
+}
+
+func (itr *_List__TypeName__ListItr) Next() (idx int, v ipld.Node, _ error) {
+	if itr.idx >= len(itr.n.x) {
+		return -1, nil, ipld.ErrIteratorOverread{}
+	}
+	idx = itr.idx
+	x := &itr.n.x[itr.idx]
+	v = x
+	itr.idx++
+	return
+}
+func (itr *_List__TypeName__ListItr) Done() bool {
+	return itr.idx >= len(itr.n.x)
+}
+
+func (n List__TypeName) Length() int {
+	return len(n.x)
+}
+func (List__TypeName) IsAbsent() bool {
+	return false
+}
+func (List__TypeName) IsNull() bool {
+	return false
+}
+func (List__TypeName) AsBool() (bool, error) {
+	return mixins.List{"ast.List__TypeName"}.AsBool()
+}
+func (List__TypeName) AsInt() (int64, error) {
+	return mixins.List{"ast.List__TypeName"}.AsInt()
+}
+func (List__TypeName) AsFloat() (float64, error) {
+	return mixins.List{"ast.List__TypeName"}.AsFloat()
+}
+func (List__TypeName) AsString() (string, error) {
+	return mixins.List{"ast.List__TypeName"}.AsString()
+}
+func (List__TypeName) AsBytes() ([]byte, error) {
+	return mixins.List{"ast.List__TypeName"}.AsBytes()
+}
+func (List__TypeName) AsLink() (ipld.Link, error) {
+	return mixins.List{"ast.List__TypeName"}.AsLink()
+}
+func (List__TypeName) Prototype() ipld.NodePrototype {
+	return _List__TypeName__Prototype{}
+}
+
+type _List__TypeName__Prototype struct{}
+
+func (_List__TypeName__Prototype) NewBuilder() ipld.NodeBuilder {
+	var nb _List__TypeName__Builder
+	nb.Reset()
+	return &nb
+}
+
+type _List__TypeName__Builder struct {
+	_List__TypeName__Assembler
+}
+
+func (nb *_List__TypeName__Builder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_List__TypeName__Builder) Reset() {
+	var w _List__TypeName
+	var m schema.Maybe
+	*nb = _List__TypeName__Builder{_List__TypeName__Assembler{w: &w, m: &m}}
+}
+
+type _List__TypeName__Assembler struct {
+	w     *_List__TypeName
+	m     *schema.Maybe
+	state laState
+
+	cm schema.Maybe
+	va _TypeName__Assembler
+}
+
+func (na *_List__TypeName__Assembler) reset() {
+	na.state = laState_initial
+	na.va.reset()
+}
+func (_List__TypeName__Assembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	return mixins.ListAssembler{"ast.List__TypeName"}.BeginMap(0)
+}
+func (na *_List__TypeName__Assembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: it makes no sense to 'begin' twice on the same assembler!")
+	}
+	*na.m = midvalue
+	if sizeHint < 0 {
+		sizeHint = 0
+	}
+	if na.w == nil {
+		na.w = &_List__TypeName{}
+	}
+	if sizeHint > 0 {
+		na.w.x = make([]_TypeName, 0, sizeHint)
+	}
+	return na, nil
+}
+func (na *_List__TypeName__Assembler) AssignNull() error {
+	switch *na.m {
+	case allowNull:
+		*na.m = schema.Maybe_Null
+		return nil
+	case schema.Maybe_Absent:
+		return mixins.ListAssembler{"ast.List__TypeName"}.AssignNull()
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+	}
+	panic("unreachable")
+}
+func (_List__TypeName__Assembler) AssignBool(bool) error {
+	return mixins.ListAssembler{"ast.List__TypeName"}.AssignBool(false)
+}
+func (_List__TypeName__Assembler) AssignInt(int64) error {
+	return mixins.ListAssembler{"ast.List__TypeName"}.AssignInt(0)
+}
+func (_List__TypeName__Assembler) AssignFloat(float64) error {
+	return mixins.ListAssembler{"ast.List__TypeName"}.AssignFloat(0)
+}
+func (_List__TypeName__Assembler) AssignString(string) error {
+	return mixins.ListAssembler{"ast.List__TypeName"}.AssignString("")
+}
+func (_List__TypeName__Assembler) AssignBytes([]byte) error {
+	return mixins.ListAssembler{"ast.List__TypeName"}.AssignBytes(nil)
+}
+func (_List__TypeName__Assembler) AssignLink(ipld.Link) error {
+	return mixins.ListAssembler{"ast.List__TypeName"}.AssignLink(nil)
+}
+func (na *_List__TypeName__Assembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_List__TypeName); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		case midvalue:
+			panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	if v.ReprKind() != ipld.ReprKind_List {
+		return ipld.ErrWrongKind{TypeName: "ast.List__TypeName", MethodName: "AssignNode", AppropriateKind: ipld.ReprKindSet_JustList, ActualKind: v.ReprKind()}
+	}
+	itr := v.ListIterator()
+	for !itr.Done() {
+		_, v, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if err := na.AssembleValue().AssignNode(v); err != nil {
+			return err
+		}
+	}
+	return na.Finish()
+}
+func (_List__TypeName__Assembler) Prototype() ipld.NodePrototype {
+	return _List__TypeName__Prototype{}
+}
+func (la *_List__TypeName__Assembler) valueFinishTidy() bool {
+	switch la.cm {
+	case schema.Maybe_Value:
+		la.va.w = nil
+		la.cm = schema.Maybe_Absent
+		la.state = laState_initial
+		la.va.reset()
+		return true
+	default:
+		return false
+	}
+}
+func (la *_List__TypeName__Assembler) AssembleValue() ipld.NodeAssembler {
+	switch la.state {
+	case laState_initial:
+		// carry on
+	case laState_midValue:
+		if !la.valueFinishTidy() {
+			panic("invalid state: AssembleValue cannot be called when still in the middle of assembling the previous value")
+		} // if tidy success: carry on
+	case laState_finished:
+		panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+	}
+	la.w.x = append(la.w.x, _TypeName{})
+	la.state = laState_midValue
+	row := &la.w.x[len(la.w.x)-1]
+	la.va.w = row
+	la.va.m = &la.cm
+	return &la.va
+}
+func (la *_List__TypeName__Assembler) Finish() error {
+	switch la.state {
+	case laState_initial:
+		// carry on
+	case laState_midValue:
+		if !la.valueFinishTidy() {
+			panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case laState_finished:
+		panic("invalid state: Finish cannot be called on an assembler that's already finished")
+	}
+	la.state = laState_finished
+	*la.m = schema.Maybe_Value
+	return nil
+}
+func (la *_List__TypeName__Assembler) ValuePrototype(_ int) ipld.NodePrototype {
+	return _TypeName__Prototype{}
+}
+func (List__TypeName) Type() schema.Type {
+	return nil /*TODO:typelit*/
+}
+func (n List__TypeName) Representation() ipld.Node {
+	return (*_List__TypeName__Repr)(n)
+}
+
+type _List__TypeName__Repr _List__TypeName
+
+var _ ipld.Node = &_List__TypeName__Repr{}
+
+func (_List__TypeName__Repr) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_List
+}
+func (_List__TypeName__Repr) LookupByString(string) (ipld.Node, error) {
+	return mixins.List{"ast.List__TypeName.Repr"}.LookupByString("")
+}
+func (nr *_List__TypeName__Repr) LookupByNode(k ipld.Node) (ipld.Node, error) {
+	v, err := (List__TypeName)(nr).LookupByNode(k)
+	if err != nil || v == ipld.Null {
+		return v, err
+	}
+	return v.(TypeName).Representation(), nil
+}
+func (nr *_List__TypeName__Repr) LookupByIndex(idx int) (ipld.Node, error) {
+	v, err := (List__TypeName)(nr).LookupByIndex(idx)
+	if err != nil || v == ipld.Null {
+		return v, err
+	}
+	return v.(TypeName).Representation(), nil
+}
+func (n _List__TypeName__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	i, err := seg.Index()
+	if err != nil {
+		return nil, ipld.ErrInvalidSegmentForList{TypeName: "ast.List__TypeName.Repr", TroubleSegment: seg, Reason: err}
+	}
+	return n.LookupByIndex(i)
+}
+func (_List__TypeName__Repr) MapIterator() ipld.MapIterator {
+	return nil
+}
+func (nr *_List__TypeName__Repr) ListIterator() ipld.ListIterator {
+	return &_List__TypeName__ReprListItr{(List__TypeName)(nr), 0}
+}
+
+type _List__TypeName__ReprListItr _List__TypeName__ListItr
+
+func (itr *_List__TypeName__ReprListItr) Next() (idx int, v ipld.Node, err error) {
+	idx, v, err = (*_List__TypeName__ListItr)(itr).Next()
+	if err != nil || v == ipld.Null {
+		return
+	}
+	return idx, v.(TypeName).Representation(), nil
+}
+func (itr *_List__TypeName__ReprListItr) Done() bool {
+	return (*_List__TypeName__ListItr)(itr).Done()
+}
+
+func (rn *_List__TypeName__Repr) Length() int {
+	return len(rn.x)
+}
+func (_List__TypeName__Repr) IsAbsent() bool {
+	return false
+}
+func (_List__TypeName__Repr) IsNull() bool {
+	return false
+}
+func (_List__TypeName__Repr) AsBool() (bool, error) {
+	return mixins.List{"ast.List__TypeName.Repr"}.AsBool()
+}
+func (_List__TypeName__Repr) AsInt() (int64, error) {
+	return mixins.List{"ast.List__TypeName.Repr"}.AsInt()
+}
+func (_List__TypeName__Repr) AsFloat() (float64, error) {
+	return mixins.List{"ast.List__TypeName.Repr"}.AsFloat()
+}
+func (_List__TypeName__Repr) AsString() (string, error) {
+	return mixins.List{"ast.List__TypeName.Repr"}.AsString()
+}
+func (_List__TypeName__Repr) AsBytes() ([]byte, error) {
+	return mixins.List{"ast.List__TypeName.Repr"}.AsBytes()
+}
+func (_List__TypeName__Repr) AsLink() (ipld.Link, error) {
+	return mixins.List{"ast.List__TypeName.Repr"}.AsLink()
+}
+func (_List__TypeName__Repr) Prototype() ipld.NodePrototype {
+	return _List__TypeName__ReprPrototype{}
+}
+
+type _List__TypeName__ReprPrototype struct{}
+
+func (_List__TypeName__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+	var nb _List__TypeName__ReprBuilder
+	nb.Reset()
+	return &nb
+}
+
+type _List__TypeName__ReprBuilder struct {
+	_List__TypeName__ReprAssembler
+}
+
+func (nb *_List__TypeName__ReprBuilder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_List__TypeName__ReprBuilder) Reset() {
+	var w _List__TypeName
+	var m schema.Maybe
+	*nb = _List__TypeName__ReprBuilder{_List__TypeName__ReprAssembler{w: &w, m: &m}}
+}
+
+type _List__TypeName__ReprAssembler struct {
+	w     *_List__TypeName
+	m     *schema.Maybe
+	state laState
+
+	cm schema.Maybe
+	va _TypeName__ReprAssembler
+}
+
+func (na *_List__TypeName__ReprAssembler) reset() {
+	na.state = laState_initial
+	na.va.reset()
+}
+func (_List__TypeName__ReprAssembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	return mixins.ListAssembler{"ast.List__TypeName.Repr"}.BeginMap(0)
+}
+func (na *_List__TypeName__ReprAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: it makes no sense to 'begin' twice on the same assembler!")
+	}
+	*na.m = midvalue
+	if sizeHint < 0 {
+		sizeHint = 0
+	}
+	if na.w == nil {
+		na.w = &_List__TypeName{}
+	}
+	if sizeHint > 0 {
+		na.w.x = make([]_TypeName, 0, sizeHint)
+	}
+	return na, nil
+}
+func (na *_List__TypeName__ReprAssembler) AssignNull() error {
+	switch *na.m {
+	case allowNull:
+		*na.m = schema.Maybe_Null
+		return nil
+	case schema.Maybe_Absent:
+		return mixins.ListAssembler{"ast.List__TypeName.Repr.Repr"}.AssignNull()
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+	}
+	panic("unreachable")
+}
+func (_List__TypeName__ReprAssembler) AssignBool(bool) error {
+	return mixins.ListAssembler{"ast.List__TypeName.Repr"}.AssignBool(false)
+}
+func (_List__TypeName__ReprAssembler) AssignInt(int64) error {
+	return mixins.ListAssembler{"ast.List__TypeName.Repr"}.AssignInt(0)
+}
+func (_List__TypeName__ReprAssembler) AssignFloat(float64) error {
+	return mixins.ListAssembler{"ast.List__TypeName.Repr"}.AssignFloat(0)
+}
+func (_List__TypeName__ReprAssembler) AssignString(string) error {
+	return mixins.ListAssembler{"ast.List__TypeName.Repr"}.AssignString("")
+}
+func (_List__TypeName__ReprAssembler) AssignBytes([]byte) error {
+	return mixins.ListAssembler{"ast.List__TypeName.Repr"}.AssignBytes(nil)
+}
+func (_List__TypeName__ReprAssembler) AssignLink(ipld.Link) error {
+	return mixins.ListAssembler{"ast.List__TypeName.Repr"}.AssignLink(nil)
+}
+func (na *_List__TypeName__ReprAssembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_List__TypeName); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		case midvalue:
+			panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	if v.ReprKind() != ipld.ReprKind_List {
+		return ipld.ErrWrongKind{TypeName: "ast.List__TypeName.Repr", MethodName: "AssignNode", AppropriateKind: ipld.ReprKindSet_JustList, ActualKind: v.ReprKind()}
+	}
+	itr := v.ListIterator()
+	for !itr.Done() {
+		_, v, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if err := na.AssembleValue().AssignNode(v); err != nil {
+			return err
+		}
+	}
+	return na.Finish()
+}
+func (_List__TypeName__ReprAssembler) Prototype() ipld.NodePrototype {
+	return _List__TypeName__ReprPrototype{}
+}
+func (la *_List__TypeName__ReprAssembler) valueFinishTidy() bool {
+	switch la.cm {
+	case schema.Maybe_Value:
+		la.va.w = nil
+		la.cm = schema.Maybe_Absent
+		la.state = laState_initial
+		la.va.reset()
+		return true
+	default:
+		return false
+	}
+}
+func (la *_List__TypeName__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+	switch la.state {
+	case laState_initial:
+		// carry on
+	case laState_midValue:
+		if !la.valueFinishTidy() {
+			panic("invalid state: AssembleValue cannot be called when still in the middle of assembling the previous value")
+		} // if tidy success: carry on
+	case laState_finished:
+		panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+	}
+	la.w.x = append(la.w.x, _TypeName{})
+	la.state = laState_midValue
+	row := &la.w.x[len(la.w.x)-1]
+	la.va.w = row
+	la.va.m = &la.cm
+	return &la.va
+}
+func (la *_List__TypeName__ReprAssembler) Finish() error {
+	switch la.state {
+	case laState_initial:
+		// carry on
+	case laState_midValue:
+		if !la.valueFinishTidy() {
+			panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case laState_finished:
+		panic("invalid state: Finish cannot be called on an assembler that's already finished")
+	}
+	la.state = laState_finished
+	*la.m = schema.Maybe_Value
+	return nil
+}
+func (la *_List__TypeName__ReprAssembler) ValuePrototype(_ int) ipld.NodePrototype {
+	return _TypeName__ReprPrototype{}
+}
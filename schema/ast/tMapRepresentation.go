@@ -0,0 +1,998 @@
+package ast
+
+// Code generated by go-ipld-prime gengo.  DO NOT EDIT.
+
+import (
+	ipld "github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/node/mixins"
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+type _MapRepresentation struct {
+	tag uint
+	x1  _MapRepresentation_Map
+	x2  _MapRepresentation_StringPairs
+	x3  _MapRepresentation_ListPairs
+}
+type MapRepresentation = *_MapRepresentation
+
+type _MapRepresentation__iface interface {
+	_MapRepresentation__member()
+}
+
+func (_MapRepresentation_Map) _MapRepresentation__member()         {}
+func (_MapRepresentation_StringPairs) _MapRepresentation__member() {}
+func (_MapRepresentation_ListPairs) _MapRepresentation__member()   {}
+func (n _MapRepresentation) AsInterface() _MapRepresentation__iface {
+	switch n.tag {
+	case 1:
+		return &n.x1
+	case 2:
+		return &n.x2
+	case 3:
+		return &n.x3
+	default:
+		panic("invalid union state; how did you create this object?")
+	}
+}
+
+type _MapRepresentation__Maybe struct {
+	m schema.Maybe
+	v MapRepresentation
+}
+type MaybeMapRepresentation = *_MapRepresentation__Maybe
+
+func (m MaybeMapRepresentation) IsNull() bool {
+	return m.m == schema.Maybe_Null
+}
+func (m MaybeMapRepresentation) IsAbsent() bool {
+	return m.m == schema.Maybe_Absent
+}
+func (m MaybeMapRepresentation) Exists() bool {
+	return m.m == schema.Maybe_Value
+}
+func (m MaybeMapRepresentation) AsNode() ipld.Node {
+	switch m.m {
+	case schema.Maybe_Absent:
+		return ipld.Absent
+	case schema.Maybe_Null:
+		return ipld.Null
+	case schema.Maybe_Value:
+		return m.v
+	default:
+		panic("unreachable")
+	}
+}
+func (m MaybeMapRepresentation) Must() MapRepresentation {
+	if !m.Exists() {
+		panic("unbox of a maybe rejected")
+	}
+	return m.v
+}
+
+var (
+	memberName__MapRepresentation_MapRepresentation_Map         = _String{"MapRepresentation_Map"}
+	memberName__MapRepresentation_MapRepresentation_StringPairs = _String{"MapRepresentation_StringPairs"}
+	memberName__MapRepresentation_MapRepresentation_ListPairs   = _String{"MapRepresentation_ListPairs"}
+)
+var _ ipld.Node = (MapRepresentation)(&_MapRepresentation{})
+var _ schema.TypedNode = (MapRepresentation)(&_MapRepresentation{})
+
+func (MapRepresentation) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_Map
+}
+func (n MapRepresentation) LookupByString(key string) (ipld.Node, error) {
+	switch key {
+	case "MapRepresentation_Map":
+		if n.tag != 1 {
+			return nil, ipld.ErrNotExists{ipld.PathSegmentOfString(key)}
+		}
+		return &n.x1, nil
+	case "MapRepresentation_StringPairs":
+		if n.tag != 2 {
+			return nil, ipld.ErrNotExists{ipld.PathSegmentOfString(key)}
+		}
+		return &n.x2, nil
+	case "MapRepresentation_ListPairs":
+		if n.tag != 3 {
+			return nil, ipld.ErrNotExists{ipld.PathSegmentOfString(key)}
+		}
+		return &n.x3, nil
+	default:
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+	}
+}
+func (n MapRepresentation) LookupByNode(key ipld.Node) (ipld.Node, error) {
+	ks, err := key.AsString()
+	if err != nil {
+		return nil, err
+	}
+	return n.LookupByString(ks)
+}
+func (MapRepresentation) LookupByIndex(idx int) (ipld.Node, error) {
+	return mixins.Map{"ast.MapRepresentation"}.LookupByIndex(0)
+}
+func (n MapRepresentation) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	return n.LookupByString(seg.String())
+}
+func (n MapRepresentation) MapIterator() ipld.MapIterator {
+	return &_MapRepresentation__MapItr{n, false}
+}
+
+type _MapRepresentation__MapItr struct {
+	n    MapRepresentation
+	done bool
+}
+
+func (itr *_MapRepresentation__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+	if itr.done {
+		return nil, nil, ipld.ErrIteratorOverread{}
+	}
+	switch itr.n.tag {
+	case 1:
+		k, v = &memberName__MapRepresentation_MapRepresentation_Map, &itr.n.x1
+	case 2:
+		k, v = &memberName__MapRepresentation_MapRepresentation_StringPairs, &itr.n.x2
+	case 3:
+		k, v = &memberName__MapRepresentation_MapRepresentation_ListPairs, &itr.n.x3
+	default:
+		panic("unreachable")
+	}
+	itr.done = true
+	return
+}
+func (itr *_MapRepresentation__MapItr) Done() bool {
+	return itr.done
+}
+
+func (MapRepresentation) ListIterator() ipld.ListIterator {
+	return nil
+}
+func (MapRepresentation) Length() int {
+	return 1
+}
+func (MapRepresentation) IsAbsent() bool {
+	return false
+}
+func (MapRepresentation) IsNull() bool {
+	return false
+}
+func (MapRepresentation) AsBool() (bool, error) {
+	return mixins.Map{"ast.MapRepresentation"}.AsBool()
+}
+func (MapRepresentation) AsInt() (int64, error) {
+	return mixins.Map{"ast.MapRepresentation"}.AsInt()
+}
+func (MapRepresentation) AsFloat() (float64, error) {
+	return mixins.Map{"ast.MapRepresentation"}.AsFloat()
+}
+func (MapRepresentation) AsString() (string, error) {
+	return mixins.Map{"ast.MapRepresentation"}.AsString()
+}
+func (MapRepresentation) AsBytes() ([]byte, error) {
+	return mixins.Map{"ast.MapRepresentation"}.AsBytes()
+}
+func (MapRepresentation) AsLink() (ipld.Link, error) {
+	return mixins.Map{"ast.MapRepresentation"}.AsLink()
+}
+func (MapRepresentation) Prototype() ipld.NodePrototype {
+	return _MapRepresentation__Prototype{}
+}
+
+type _MapRepresentation__Prototype struct{}
+
+func (_MapRepresentation__Prototype) NewBuilder() ipld.NodeBuilder {
+	var nb _MapRepresentation__Builder
+	nb.Reset()
+	return &nb
+}
+
+type _MapRepresentation__Builder struct {
+	_MapRepresentation__Assembler
+}
+
+func (nb *_MapRepresentation__Builder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_MapRepresentation__Builder) Reset() {
+	var w _MapRepresentation
+	var m schema.Maybe
+	*nb = _MapRepresentation__Builder{_MapRepresentation__Assembler{w: &w, m: &m}}
+}
+
+type _MapRepresentation__Assembler struct {
+	w     *_MapRepresentation
+	m     *schema.Maybe
+	state maState
+
+	cm  schema.Maybe
+	ca1 _MapRepresentation_Map__Assembler
+
+	ca2 _MapRepresentation_StringPairs__Assembler
+
+	ca3 _MapRepresentation_ListPairs__Assembler
+	ca  uint
+}
+
+func (na *_MapRepresentation__Assembler) reset() {
+	na.state = maState_initial
+	switch na.ca {
+	case 0:
+		return
+	case 1:
+		na.ca1.reset()
+
+	case 2:
+		na.ca2.reset()
+
+	case 3:
+		na.ca3.reset()
+	default:
+		panic("unreachable")
+	}
+	na.ca = 0
+	na.cm = schema.Maybe_Absent
+}
+func (na *_MapRepresentation__Assembler) BeginMap(int) (ipld.MapAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: it makes no sense to 'begin' twice on the same assembler!")
+	}
+	*na.m = midvalue
+	if na.w == nil {
+		na.w = &_MapRepresentation{}
+	}
+	return na, nil
+}
+func (_MapRepresentation__Assembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.MapAssembler{"ast.MapRepresentation"}.BeginList(0)
+}
+func (na *_MapRepresentation__Assembler) AssignNull() error {
+	switch *na.m {
+	case allowNull:
+		*na.m = schema.Maybe_Null
+		return nil
+	case schema.Maybe_Absent:
+		return mixins.MapAssembler{"ast.MapRepresentation"}.AssignNull()
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+	}
+	panic("unreachable")
+}
+func (_MapRepresentation__Assembler) AssignBool(bool) error {
+	return mixins.MapAssembler{"ast.MapRepresentation"}.AssignBool(false)
+}
+func (_MapRepresentation__Assembler) AssignInt(int64) error {
+	return mixins.MapAssembler{"ast.MapRepresentation"}.AssignInt(0)
+}
+func (_MapRepresentation__Assembler) AssignFloat(float64) error {
+	return mixins.MapAssembler{"ast.MapRepresentation"}.AssignFloat(0)
+}
+func (_MapRepresentation__Assembler) AssignString(string) error {
+	return mixins.MapAssembler{"ast.MapRepresentation"}.AssignString("")
+}
+func (_MapRepresentation__Assembler) AssignBytes([]byte) error {
+	return mixins.MapAssembler{"ast.MapRepresentation"}.AssignBytes(nil)
+}
+func (_MapRepresentation__Assembler) AssignLink(ipld.Link) error {
+	return mixins.MapAssembler{"ast.MapRepresentation"}.AssignLink(nil)
+}
+func (na *_MapRepresentation__Assembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_MapRepresentation); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		case midvalue:
+			panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	if v.ReprKind() != ipld.ReprKind_Map {
+		return ipld.ErrWrongKind{TypeName: "ast.MapRepresentation", MethodName: "AssignNode", AppropriateKind: ipld.ReprKindSet_JustMap, ActualKind: v.ReprKind()}
+	}
+	itr := v.MapIterator()
+	for !itr.Done() {
+		k, v, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if err := na.AssembleKey().AssignNode(k); err != nil {
+			return err
+		}
+		if err := na.AssembleValue().AssignNode(v); err != nil {
+			return err
+		}
+	}
+	return na.Finish()
+}
+func (_MapRepresentation__Assembler) Prototype() ipld.NodePrototype {
+	return _MapRepresentation__Prototype{}
+}
+func (ma *_MapRepresentation__Assembler) valueFinishTidy() bool {
+	switch ma.cm {
+	case schema.Maybe_Value:
+		ma.state = maState_initial
+		return true
+	default:
+		return false
+	}
+}
+func (ma *_MapRepresentation__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleEntry cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleEntry cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleEntry cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on for the moment, but we'll still be erroring shortly.
+	case maState_finished:
+		panic("invalid state: AssembleEntry cannot be called on an assembler that's already finished")
+	}
+	if ma.ca != 0 {
+		return nil, schema.ErrNotUnionStructure{TypeName: "ast.MapRepresentation", Detail: "cannot add another entry -- a union can only contain one thing!"}
+	}
+	switch k {
+	case "MapRepresentation_Map":
+		ma.state = maState_midValue
+		ma.ca = 1
+		ma.w.tag = 1
+		ma.ca1.w = &ma.w.x1
+		ma.ca1.m = &ma.cm
+		return &ma.ca1, nil
+	case "MapRepresentation_StringPairs":
+		ma.state = maState_midValue
+		ma.ca = 2
+		ma.w.tag = 2
+		ma.ca2.w = &ma.w.x2
+		ma.ca2.m = &ma.cm
+		return &ma.ca2, nil
+	case "MapRepresentation_ListPairs":
+		ma.state = maState_midValue
+		ma.ca = 3
+		ma.w.tag = 3
+		ma.ca3.w = &ma.w.x3
+		ma.ca3.m = &ma.cm
+		return &ma.ca3, nil
+	default:
+		return nil, ipld.ErrInvalidKey{TypeName: "ast.MapRepresentation", Key: &_String{k}}
+	}
+}
+func (ma *_MapRepresentation__Assembler) AssembleKey() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleKey cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleKey cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleKey cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on for the moment, but we'll still be erroring shortly... or rather, the keyassembler will be.
+	case maState_finished:
+		panic("invalid state: AssembleKey cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midKey
+	return (*_MapRepresentation__KeyAssembler)(ma)
+}
+func (ma *_MapRepresentation__Assembler) AssembleValue() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		panic("invalid state: AssembleValue cannot be called when no key is primed")
+	case maState_midKey:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		// carry on
+	case maState_midValue:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling another value")
+	case maState_finished:
+		panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midValue
+	switch ma.ca {
+	case 0:
+		ma.ca1.w = &ma.w.x1
+		ma.ca1.m = &ma.cm
+		return &ma.ca1
+	case 1:
+		ma.ca2.w = &ma.w.x2
+		ma.ca2.m = &ma.cm
+		return &ma.ca2
+	case 2:
+		ma.ca3.w = &ma.w.x3
+		ma.ca3.m = &ma.cm
+		return &ma.ca3
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_MapRepresentation__Assembler) Finish() error {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: Finish cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		panic("invalid state: Finish cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: Finish cannot be called on an assembler that's already finished")
+	}
+	if ma.ca == 0 {
+		return schema.ErrNotUnionStructure{TypeName: "ast.MapRepresentation", Detail: "a union must have exactly one entry (not none)!"}
+	}
+	ma.state = maState_finished
+	*ma.m = schema.Maybe_Value
+	return nil
+}
+func (ma *_MapRepresentation__Assembler) KeyPrototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (ma *_MapRepresentation__Assembler) ValuePrototype(k string) ipld.NodePrototype {
+	switch k {
+	case "MapRepresentation_Map":
+		return _MapRepresentation_Map__Prototype{}
+	case "MapRepresentation_StringPairs":
+		return _MapRepresentation_StringPairs__Prototype{}
+	case "MapRepresentation_ListPairs":
+		return _MapRepresentation_ListPairs__Prototype{}
+	default:
+		return nil
+	}
+}
+
+type _MapRepresentation__KeyAssembler _MapRepresentation__Assembler
+
+func (_MapRepresentation__KeyAssembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	return mixins.StringAssembler{"ast.MapRepresentation.KeyAssembler"}.BeginMap(0)
+}
+func (_MapRepresentation__KeyAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.StringAssembler{"ast.MapRepresentation.KeyAssembler"}.BeginList(0)
+}
+func (na *_MapRepresentation__KeyAssembler) AssignNull() error {
+	return mixins.StringAssembler{"ast.MapRepresentation.KeyAssembler"}.AssignNull()
+}
+func (_MapRepresentation__KeyAssembler) AssignBool(bool) error {
+	return mixins.StringAssembler{"ast.MapRepresentation.KeyAssembler"}.AssignBool(false)
+}
+func (_MapRepresentation__KeyAssembler) AssignInt(int64) error {
+	return mixins.StringAssembler{"ast.MapRepresentation.KeyAssembler"}.AssignInt(0)
+}
+func (_MapRepresentation__KeyAssembler) AssignFloat(float64) error {
+	return mixins.StringAssembler{"ast.MapRepresentation.KeyAssembler"}.AssignFloat(0)
+}
+func (ka *_MapRepresentation__KeyAssembler) AssignString(k string) error {
+	if ka.state != maState_midKey {
+		panic("misuse: KeyAssembler held beyond its valid lifetime")
+	}
+	if ka.ca != 0 {
+		return schema.ErrNotUnionStructure{TypeName: "ast.MapRepresentation", Detail: "cannot add another entry -- a union can only contain one thing!"}
+	}
+	switch k {
+	case "MapRepresentation_Map":
+		ka.ca = 1
+		ka.w.tag = 1
+		ka.state = maState_expectValue
+		return nil
+	case "MapRepresentation_StringPairs":
+		ka.ca = 2
+		ka.w.tag = 2
+		ka.state = maState_expectValue
+		return nil
+	case "MapRepresentation_ListPairs":
+		ka.ca = 3
+		ka.w.tag = 3
+		ka.state = maState_expectValue
+		return nil
+	default:
+		return ipld.ErrInvalidKey{TypeName: "ast.MapRepresentation", Key: &_String{k}} // TODO: error quality: ErrInvalidUnionDiscriminant ?
+	}
+	return nil
+}
+func (_MapRepresentation__KeyAssembler) AssignBytes([]byte) error {
+	return mixins.StringAssembler{"ast.MapRepresentation.KeyAssembler"}.AssignBytes(nil)
+}
+func (_MapRepresentation__KeyAssembler) AssignLink(ipld.Link) error {
+	return mixins.StringAssembler{"ast.MapRepresentation.KeyAssembler"}.AssignLink(nil)
+}
+func (ka *_MapRepresentation__KeyAssembler) AssignNode(v ipld.Node) error {
+	if v2, err := v.AsString(); err != nil {
+		return err
+	} else {
+		return ka.AssignString(v2)
+	}
+}
+func (_MapRepresentation__KeyAssembler) Prototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (MapRepresentation) Type() schema.Type {
+	return nil /*TODO:typelit*/
+}
+func (n MapRepresentation) Representation() ipld.Node {
+	return (*_MapRepresentation__Repr)(n)
+}
+
+type _MapRepresentation__Repr _MapRepresentation
+
+var (
+	memberName__MapRepresentation_MapRepresentation_Map_serial         = _String{"map"}
+	memberName__MapRepresentation_MapRepresentation_StringPairs_serial = _String{"stringpairs"}
+	memberName__MapRepresentation_MapRepresentation_ListPairs_serial   = _String{"listpairs"}
+)
+var _ ipld.Node = &_MapRepresentation__Repr{}
+
+func (_MapRepresentation__Repr) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_Map
+}
+func (n *_MapRepresentation__Repr) LookupByString(key string) (ipld.Node, error) {
+	switch key {
+	case "map":
+		if n.tag != 1 {
+			return nil, ipld.ErrNotExists{ipld.PathSegmentOfString(key)}
+		}
+		return n.x1.Representation(), nil
+	case "stringpairs":
+		if n.tag != 2 {
+			return nil, ipld.ErrNotExists{ipld.PathSegmentOfString(key)}
+		}
+		return n.x2.Representation(), nil
+	case "listpairs":
+		if n.tag != 3 {
+			return nil, ipld.ErrNotExists{ipld.PathSegmentOfString(key)}
+		}
+		return n.x3.Representation(), nil
+	default:
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+	}
+}
+func (n *_MapRepresentation__Repr) LookupByNode(key ipld.Node) (ipld.Node, error) {
+	ks, err := key.AsString()
+	if err != nil {
+		return nil, err
+	}
+	return n.LookupByString(ks)
+}
+func (_MapRepresentation__Repr) LookupByIndex(idx int) (ipld.Node, error) {
+	return mixins.Map{"ast.MapRepresentation.Repr"}.LookupByIndex(0)
+}
+func (n _MapRepresentation__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	return n.LookupByString(seg.String())
+}
+func (n *_MapRepresentation__Repr) MapIterator() ipld.MapIterator {
+	return &_MapRepresentation__ReprMapItr{n, false}
+}
+
+type _MapRepresentation__ReprMapItr struct {
+	n    *_MapRepresentation__Repr
+	done bool
+}
+
+func (itr *_MapRepresentation__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+	if itr.done {
+		return nil, nil, ipld.ErrIteratorOverread{}
+	}
+	switch itr.n.tag {
+	case 1:
+		k, v = &memberName__MapRepresentation_MapRepresentation_Map_serial, itr.n.x1.Representation()
+	case 2:
+		k, v = &memberName__MapRepresentation_MapRepresentation_StringPairs_serial, itr.n.x2.Representation()
+	case 3:
+		k, v = &memberName__MapRepresentation_MapRepresentation_ListPairs_serial, itr.n.x3.Representation()
+	default:
+		panic("unreachable")
+	}
+	itr.done = true
+	return
+}
+func (itr *_MapRepresentation__ReprMapItr) Done() bool {
+	return itr.done
+}
+
+func (_MapRepresentation__Repr) ListIterator() ipld.ListIterator {
+	return nil
+}
+func (_MapRepresentation__Repr) Length() int {
+	return 1
+}
+func (_MapRepresentation__Repr) IsAbsent() bool {
+	return false
+}
+func (_MapRepresentation__Repr) IsNull() bool {
+	return false
+}
+func (_MapRepresentation__Repr) AsBool() (bool, error) {
+	return mixins.Map{"ast.MapRepresentation.Repr"}.AsBool()
+}
+func (_MapRepresentation__Repr) AsInt() (int64, error) {
+	return mixins.Map{"ast.MapRepresentation.Repr"}.AsInt()
+}
+func (_MapRepresentation__Repr) AsFloat() (float64, error) {
+	return mixins.Map{"ast.MapRepresentation.Repr"}.AsFloat()
+}
+func (_MapRepresentation__Repr) AsString() (string, error) {
+	return mixins.Map{"ast.MapRepresentation.Repr"}.AsString()
+}
+func (_MapRepresentation__Repr) AsBytes() ([]byte, error) {
+	return mixins.Map{"ast.MapRepresentation.Repr"}.AsBytes()
+}
+func (_MapRepresentation__Repr) AsLink() (ipld.Link, error) {
+	return mixins.Map{"ast.MapRepresentation.Repr"}.AsLink()
+}
+func (_MapRepresentation__Repr) Prototype() ipld.NodePrototype {
+	return _MapRepresentation__ReprPrototype{}
+}
+
+type _MapRepresentation__ReprPrototype struct{}
+
+func (_MapRepresentation__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+	var nb _MapRepresentation__ReprBuilder
+	nb.Reset()
+	return &nb
+}
+
+type _MapRepresentation__ReprBuilder struct {
+	_MapRepresentation__ReprAssembler
+}
+
+func (nb *_MapRepresentation__ReprBuilder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_MapRepresentation__ReprBuilder) Reset() {
+	var w _MapRepresentation
+	var m schema.Maybe
+	*nb = _MapRepresentation__ReprBuilder{_MapRepresentation__ReprAssembler{w: &w, m: &m}}
+}
+
+type _MapRepresentation__ReprAssembler struct {
+	w     *_MapRepresentation
+	m     *schema.Maybe
+	state maState
+
+	cm  schema.Maybe
+	ca1 _MapRepresentation_Map__ReprAssembler
+
+	ca2 _MapRepresentation_StringPairs__ReprAssembler
+
+	ca3 _MapRepresentation_ListPairs__ReprAssembler
+	ca  uint
+}
+
+func (na *_MapRepresentation__ReprAssembler) reset() {
+	na.state = maState_initial
+	switch na.ca {
+	case 0:
+		return
+	case 1:
+		na.ca1.reset()
+
+	case 2:
+		na.ca2.reset()
+
+	case 3:
+		na.ca3.reset()
+	default:
+		panic("unreachable")
+	}
+	na.ca = 0
+	na.cm = schema.Maybe_Absent
+}
+func (na *_MapRepresentation__ReprAssembler) BeginMap(int) (ipld.MapAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: it makes no sense to 'begin' twice on the same assembler!")
+	}
+	*na.m = midvalue
+	if na.w == nil {
+		na.w = &_MapRepresentation{}
+	}
+	return na, nil
+}
+func (_MapRepresentation__ReprAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.MapAssembler{"ast.MapRepresentation.Repr"}.BeginList(0)
+}
+func (na *_MapRepresentation__ReprAssembler) AssignNull() error {
+	switch *na.m {
+	case allowNull:
+		*na.m = schema.Maybe_Null
+		return nil
+	case schema.Maybe_Absent:
+		return mixins.MapAssembler{"ast.MapRepresentation.Repr.Repr"}.AssignNull()
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+	}
+	panic("unreachable")
+}
+func (_MapRepresentation__ReprAssembler) AssignBool(bool) error {
+	return mixins.MapAssembler{"ast.MapRepresentation.Repr"}.AssignBool(false)
+}
+func (_MapRepresentation__ReprAssembler) AssignInt(int64) error {
+	return mixins.MapAssembler{"ast.MapRepresentation.Repr"}.AssignInt(0)
+}
+func (_MapRepresentation__ReprAssembler) AssignFloat(float64) error {
+	return mixins.MapAssembler{"ast.MapRepresentation.Repr"}.AssignFloat(0)
+}
+func (_MapRepresentation__ReprAssembler) AssignString(string) error {
+	return mixins.MapAssembler{"ast.MapRepresentation.Repr"}.AssignString("")
+}
+func (_MapRepresentation__ReprAssembler) AssignBytes([]byte) error {
+	return mixins.MapAssembler{"ast.MapRepresentation.Repr"}.AssignBytes(nil)
+}
+func (_MapRepresentation__ReprAssembler) AssignLink(ipld.Link) error {
+	return mixins.MapAssembler{"ast.MapRepresentation.Repr"}.AssignLink(nil)
+}
+func (na *_MapRepresentation__ReprAssembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_MapRepresentation); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		case midvalue:
+			panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	if v.ReprKind() != ipld.ReprKind_Map {
+		return ipld.ErrWrongKind{TypeName: "ast.MapRepresentation.Repr", MethodName: "AssignNode", AppropriateKind: ipld.ReprKindSet_JustMap, ActualKind: v.ReprKind()}
+	}
+	itr := v.MapIterator()
+	for !itr.Done() {
+		k, v, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if err := na.AssembleKey().AssignNode(k); err != nil {
+			return err
+		}
+		if err := na.AssembleValue().AssignNode(v); err != nil {
+			return err
+		}
+	}
+	return na.Finish()
+}
+func (_MapRepresentation__ReprAssembler) Prototype() ipld.NodePrototype {
+	return _MapRepresentation__ReprPrototype{}
+}
+func (ma *_MapRepresentation__ReprAssembler) valueFinishTidy() bool {
+	switch ma.cm {
+	case schema.Maybe_Value:
+		ma.state = maState_initial
+		return true
+	default:
+		return false
+	}
+}
+func (ma *_MapRepresentation__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleEntry cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleEntry cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleEntry cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on for the moment, but we'll still be erroring shortly.
+	case maState_finished:
+		panic("invalid state: AssembleEntry cannot be called on an assembler that's already finished")
+	}
+	if ma.ca != 0 {
+		return nil, schema.ErrNotUnionStructure{TypeName: "ast.MapRepresentation.Repr", Detail: "cannot add another entry -- a union can only contain one thing!"}
+	}
+	switch k {
+	case "map":
+		ma.state = maState_midValue
+		ma.ca = 1
+		ma.w.tag = 1
+		ma.ca1.w = &ma.w.x1
+		ma.ca1.m = &ma.cm
+		return &ma.ca1, nil
+	case "stringpairs":
+		ma.state = maState_midValue
+		ma.ca = 2
+		ma.w.tag = 2
+		ma.ca2.w = &ma.w.x2
+		ma.ca2.m = &ma.cm
+		return &ma.ca2, nil
+	case "listpairs":
+		ma.state = maState_midValue
+		ma.ca = 3
+		ma.w.tag = 3
+		ma.ca3.w = &ma.w.x3
+		ma.ca3.m = &ma.cm
+		return &ma.ca3, nil
+	default:
+		return nil, ipld.ErrInvalidKey{TypeName: "ast.MapRepresentation.Repr", Key: &_String{k}}
+	}
+}
+func (ma *_MapRepresentation__ReprAssembler) AssembleKey() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleKey cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleKey cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleKey cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on for the moment, but we'll still be erroring shortly... or rather, the keyassembler will be.
+	case maState_finished:
+		panic("invalid state: AssembleKey cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midKey
+	return (*_MapRepresentation__ReprKeyAssembler)(ma)
+}
+func (ma *_MapRepresentation__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		panic("invalid state: AssembleValue cannot be called when no key is primed")
+	case maState_midKey:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		// carry on
+	case maState_midValue:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling another value")
+	case maState_finished:
+		panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midValue
+	switch ma.ca {
+	case 0:
+		ma.ca1.w = &ma.w.x1
+		ma.ca1.m = &ma.cm
+		return &ma.ca1
+	case 1:
+		ma.ca2.w = &ma.w.x2
+		ma.ca2.m = &ma.cm
+		return &ma.ca2
+	case 2:
+		ma.ca3.w = &ma.w.x3
+		ma.ca3.m = &ma.cm
+		return &ma.ca3
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_MapRepresentation__ReprAssembler) Finish() error {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: Finish cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		panic("invalid state: Finish cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: Finish cannot be called on an assembler that's already finished")
+	}
+	if ma.ca == 0 {
+		return schema.ErrNotUnionStructure{TypeName: "ast.MapRepresentation.Repr", Detail: "a union must have exactly one entry (not none)!"}
+	}
+	ma.state = maState_finished
+	*ma.m = schema.Maybe_Value
+	return nil
+}
+func (ma *_MapRepresentation__ReprAssembler) KeyPrototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (ma *_MapRepresentation__ReprAssembler) ValuePrototype(k string) ipld.NodePrototype {
+	switch k {
+	case "MapRepresentation_Map":
+		return _MapRepresentation_Map__ReprPrototype{}
+	case "MapRepresentation_StringPairs":
+		return _MapRepresentation_StringPairs__ReprPrototype{}
+	case "MapRepresentation_ListPairs":
+		return _MapRepresentation_ListPairs__ReprPrototype{}
+	default:
+		return nil
+	}
+}
+
+type _MapRepresentation__ReprKeyAssembler _MapRepresentation__ReprAssembler
+
+func (_MapRepresentation__ReprKeyAssembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	return mixins.StringAssembler{"ast.MapRepresentation.Repr.KeyAssembler"}.BeginMap(0)
+}
+func (_MapRepresentation__ReprKeyAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.StringAssembler{"ast.MapRepresentation.Repr.KeyAssembler"}.BeginList(0)
+}
+func (na *_MapRepresentation__ReprKeyAssembler) AssignNull() error {
+	return mixins.StringAssembler{"ast.MapRepresentation.Repr.KeyAssembler"}.AssignNull()
+}
+func (_MapRepresentation__ReprKeyAssembler) AssignBool(bool) error {
+	return mixins.StringAssembler{"ast.MapRepresentation.Repr.KeyAssembler"}.AssignBool(false)
+}
+func (_MapRepresentation__ReprKeyAssembler) AssignInt(int64) error {
+	return mixins.StringAssembler{"ast.MapRepresentation.Repr.KeyAssembler"}.AssignInt(0)
+}
+func (_MapRepresentation__ReprKeyAssembler) AssignFloat(float64) error {
+	return mixins.StringAssembler{"ast.MapRepresentation.Repr.KeyAssembler"}.AssignFloat(0)
+}
+func (ka *_MapRepresentation__ReprKeyAssembler) AssignString(k string) error {
+	if ka.state != maState_midKey {
+		panic("misuse: KeyAssembler held beyond its valid lifetime")
+	}
+	if ka.ca != 0 {
+		return schema.ErrNotUnionStructure{TypeName: "ast.MapRepresentation.Repr", Detail: "cannot add another entry -- a union can only contain one thing!"}
+	}
+	switch k {
+	case "map":
+		ka.ca = 1
+		ka.w.tag = 1
+		ka.state = maState_expectValue
+		return nil
+	case "stringpairs":
+		ka.ca = 2
+		ka.w.tag = 2
+		ka.state = maState_expectValue
+		return nil
+	case "listpairs":
+		ka.ca = 3
+		ka.w.tag = 3
+		ka.state = maState_expectValue
+		return nil
+	default:
+		return ipld.ErrInvalidKey{TypeName: "ast.MapRepresentation.Repr", Key: &_String{k}} // TODO: error quality: ErrInvalidUnionDiscriminant ?
+	}
+	return nil
+}
+func (_MapRepresentation__ReprKeyAssembler) AssignBytes([]byte) error {
+	return mixins.StringAssembler{"ast.MapRepresentation.Repr.KeyAssembler"}.AssignBytes(nil)
+}
+func (_MapRepresentation__ReprKeyAssembler) AssignLink(ipld.Link) error {
+	return mixins.StringAssembler{"ast.MapRepresentation.Repr.KeyAssembler"}.AssignLink(nil)
+}
+func (ka *_MapRepresentation__ReprKeyAssembler) AssignNode(v ipld.Node) error {
+	if v2, err := v.AsString(); err != nil {
+		return err
+	} else {
+		return ka.AssignString(v2)
+	}
+}
+func (_MapRepresentation__ReprKeyAssembler) Prototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
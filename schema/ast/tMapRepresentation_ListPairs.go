@@ -0,0 +1,755 @@
+package ast
+
+// Code generated by go-ipld-prime gengo.  DO NOT EDIT.
+
+import (
+	ipld "github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/node/mixins"
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+type _MapRepresentation_ListPairs struct {
+}
+type MapRepresentation_ListPairs = *_MapRepresentation_ListPairs
+
+type _MapRepresentation_ListPairs__Maybe struct {
+	m schema.Maybe
+	v MapRepresentation_ListPairs
+}
+type MaybeMapRepresentation_ListPairs = *_MapRepresentation_ListPairs__Maybe
+
+func (m MaybeMapRepresentation_ListPairs) IsNull() bool {
+	return m.m == schema.Maybe_Null
+}
+func (m MaybeMapRepresentation_ListPairs) IsAbsent() bool {
+	return m.m == schema.Maybe_Absent
+}
+func (m MaybeMapRepresentation_ListPairs) Exists() bool {
+	return m.m == schema.Maybe_Value
+}
+func (m MaybeMapRepresentation_ListPairs) AsNode() ipld.Node {
+	switch m.m {
+	case schema.Maybe_Absent:
+		return ipld.Absent
+	case schema.Maybe_Null:
+		return ipld.Null
+	case schema.Maybe_Value:
+		return m.v
+	default:
+		panic("unreachable")
+	}
+}
+func (m MaybeMapRepresentation_ListPairs) Must() MapRepresentation_ListPairs {
+	if !m.Exists() {
+		panic("unbox of a maybe rejected")
+	}
+	return m.v
+}
+
+var ()
+var _ ipld.Node = (MapRepresentation_ListPairs)(&_MapRepresentation_ListPairs{})
+var _ schema.TypedNode = (MapRepresentation_ListPairs)(&_MapRepresentation_ListPairs{})
+
+func (MapRepresentation_ListPairs) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_Map
+}
+func (n MapRepresentation_ListPairs) LookupByString(key string) (ipld.Node, error) {
+	switch key {
+	default:
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+	}
+}
+func (n MapRepresentation_ListPairs) LookupByNode(key ipld.Node) (ipld.Node, error) {
+	ks, err := key.AsString()
+	if err != nil {
+		return nil, err
+	}
+	return n.LookupByString(ks)
+}
+func (MapRepresentation_ListPairs) LookupByIndex(idx int) (ipld.Node, error) {
+	return mixins.Map{"ast.MapRepresentation_ListPairs"}.LookupByIndex(0)
+}
+func (n MapRepresentation_ListPairs) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	return n.LookupByString(seg.String())
+}
+func (n MapRepresentation_ListPairs) MapIterator() ipld.MapIterator {
+	return &_MapRepresentation_ListPairs__MapItr{n, 0}
+}
+
+type _MapRepresentation_ListPairs__MapItr struct {
+	n   MapRepresentation_ListPairs
+	idx int
+}
+
+func (itr *_MapRepresentation_ListPairs__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+	if itr.idx >= 0 {
+		return nil, nil, ipld.ErrIteratorOverread{}
+	}
+	switch itr.idx {
+	default:
+		panic("unreachable")
+	}
+	itr.idx++
+	return
+}
+func (itr *_MapRepresentation_ListPairs__MapItr) Done() bool {
+	return itr.idx >= 0
+}
+
+func (MapRepresentation_ListPairs) ListIterator() ipld.ListIterator {
+	return nil
+}
+func (MapRepresentation_ListPairs) Length() int {
+	return 0
+}
+func (MapRepresentation_ListPairs) IsAbsent() bool {
+	return false
+}
+func (MapRepresentation_ListPairs) IsNull() bool {
+	return false
+}
+func (MapRepresentation_ListPairs) AsBool() (bool, error) {
+	return mixins.Map{"ast.MapRepresentation_ListPairs"}.AsBool()
+}
+func (MapRepresentation_ListPairs) AsInt() (int64, error) {
+	return mixins.Map{"ast.MapRepresentation_ListPairs"}.AsInt()
+}
+func (MapRepresentation_ListPairs) AsFloat() (float64, error) {
+	return mixins.Map{"ast.MapRepresentation_ListPairs"}.AsFloat()
+}
+func (MapRepresentation_ListPairs) AsString() (string, error) {
+	return mixins.Map{"ast.MapRepresentation_ListPairs"}.AsString()
+}
+func (MapRepresentation_ListPairs) AsBytes() ([]byte, error) {
+	return mixins.Map{"ast.MapRepresentation_ListPairs"}.AsBytes()
+}
+func (MapRepresentation_ListPairs) AsLink() (ipld.Link, error) {
+	return mixins.Map{"ast.MapRepresentation_ListPairs"}.AsLink()
+}
+func (MapRepresentation_ListPairs) Prototype() ipld.NodePrototype {
+	return _MapRepresentation_ListPairs__Prototype{}
+}
+
+type _MapRepresentation_ListPairs__Prototype struct{}
+
+func (_MapRepresentation_ListPairs__Prototype) NewBuilder() ipld.NodeBuilder {
+	var nb _MapRepresentation_ListPairs__Builder
+	nb.Reset()
+	return &nb
+}
+
+type _MapRepresentation_ListPairs__Builder struct {
+	_MapRepresentation_ListPairs__Assembler
+}
+
+func (nb *_MapRepresentation_ListPairs__Builder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_MapRepresentation_ListPairs__Builder) Reset() {
+	var w _MapRepresentation_ListPairs
+	var m schema.Maybe
+	*nb = _MapRepresentation_ListPairs__Builder{_MapRepresentation_ListPairs__Assembler{w: &w, m: &m}}
+}
+
+type _MapRepresentation_ListPairs__Assembler struct {
+	w     *_MapRepresentation_ListPairs
+	m     *schema.Maybe
+	state maState
+	s     int
+	f     int
+
+	cm schema.Maybe
+}
+
+func (na *_MapRepresentation_ListPairs__Assembler) reset() {
+	na.state = maState_initial
+	na.s = 0
+}
+
+var (
+	fieldBits__MapRepresentation_ListPairs_sufficient = 0
+)
+
+func (na *_MapRepresentation_ListPairs__Assembler) BeginMap(int) (ipld.MapAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: it makes no sense to 'begin' twice on the same assembler!")
+	}
+	*na.m = midvalue
+	if na.w == nil {
+		na.w = &_MapRepresentation_ListPairs{}
+	}
+	return na, nil
+}
+func (_MapRepresentation_ListPairs__Assembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.MapAssembler{"ast.MapRepresentation_ListPairs"}.BeginList(0)
+}
+func (na *_MapRepresentation_ListPairs__Assembler) AssignNull() error {
+	switch *na.m {
+	case allowNull:
+		*na.m = schema.Maybe_Null
+		return nil
+	case schema.Maybe_Absent:
+		return mixins.MapAssembler{"ast.MapRepresentation_ListPairs"}.AssignNull()
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+	}
+	panic("unreachable")
+}
+func (_MapRepresentation_ListPairs__Assembler) AssignBool(bool) error {
+	return mixins.MapAssembler{"ast.MapRepresentation_ListPairs"}.AssignBool(false)
+}
+func (_MapRepresentation_ListPairs__Assembler) AssignInt(int64) error {
+	return mixins.MapAssembler{"ast.MapRepresentation_ListPairs"}.AssignInt(0)
+}
+func (_MapRepresentation_ListPairs__Assembler) AssignFloat(float64) error {
+	return mixins.MapAssembler{"ast.MapRepresentation_ListPairs"}.AssignFloat(0)
+}
+func (_MapRepresentation_ListPairs__Assembler) AssignString(string) error {
+	return mixins.MapAssembler{"ast.MapRepresentation_ListPairs"}.AssignString("")
+}
+func (_MapRepresentation_ListPairs__Assembler) AssignBytes([]byte) error {
+	return mixins.MapAssembler{"ast.MapRepresentation_ListPairs"}.AssignBytes(nil)
+}
+func (_MapRepresentation_ListPairs__Assembler) AssignLink(ipld.Link) error {
+	return mixins.MapAssembler{"ast.MapRepresentation_ListPairs"}.AssignLink(nil)
+}
+func (na *_MapRepresentation_ListPairs__Assembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_MapRepresentation_ListPairs); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		case midvalue:
+			panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	if v.ReprKind() != ipld.ReprKind_Map {
+		return ipld.ErrWrongKind{TypeName: "ast.MapRepresentation_ListPairs", MethodName: "AssignNode", AppropriateKind: ipld.ReprKindSet_JustMap, ActualKind: v.ReprKind()}
+	}
+	itr := v.MapIterator()
+	for !itr.Done() {
+		k, v, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if err := na.AssembleKey().AssignNode(k); err != nil {
+			return err
+		}
+		if err := na.AssembleValue().AssignNode(v); err != nil {
+			return err
+		}
+	}
+	return na.Finish()
+}
+func (_MapRepresentation_ListPairs__Assembler) Prototype() ipld.NodePrototype {
+	return _MapRepresentation_ListPairs__Prototype{}
+}
+func (ma *_MapRepresentation_ListPairs__Assembler) valueFinishTidy() bool {
+	switch ma.f {
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_MapRepresentation_ListPairs__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleEntry cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleEntry cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleEntry cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleEntry cannot be called on an assembler that's already finished")
+	}
+	switch k {
+	default:
+		return nil, ipld.ErrInvalidKey{TypeName: "ast.MapRepresentation_ListPairs", Key: &_String{k}}
+	}
+}
+func (ma *_MapRepresentation_ListPairs__Assembler) AssembleKey() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleKey cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleKey cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleKey cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleKey cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midKey
+	return (*_MapRepresentation_ListPairs__KeyAssembler)(ma)
+}
+func (ma *_MapRepresentation_ListPairs__Assembler) AssembleValue() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		panic("invalid state: AssembleValue cannot be called when no key is primed")
+	case maState_midKey:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		// carry on
+	case maState_midValue:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling another value")
+	case maState_finished:
+		panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midValue
+	switch ma.f {
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_MapRepresentation_ListPairs__Assembler) Finish() error {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: Finish cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		panic("invalid state: Finish cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: Finish cannot be called on an assembler that's already finished")
+	}
+	//FIXME check if all required fields are set
+	ma.state = maState_finished
+	*ma.m = schema.Maybe_Value
+	return nil
+}
+func (ma *_MapRepresentation_ListPairs__Assembler) KeyPrototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (ma *_MapRepresentation_ListPairs__Assembler) ValuePrototype(k string) ipld.NodePrototype {
+	panic("todo structbuilder mapassembler valueprototype")
+}
+
+type _MapRepresentation_ListPairs__KeyAssembler _MapRepresentation_ListPairs__Assembler
+
+func (_MapRepresentation_ListPairs__KeyAssembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	return mixins.StringAssembler{"ast.MapRepresentation_ListPairs.KeyAssembler"}.BeginMap(0)
+}
+func (_MapRepresentation_ListPairs__KeyAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.StringAssembler{"ast.MapRepresentation_ListPairs.KeyAssembler"}.BeginList(0)
+}
+func (na *_MapRepresentation_ListPairs__KeyAssembler) AssignNull() error {
+	return mixins.StringAssembler{"ast.MapRepresentation_ListPairs.KeyAssembler"}.AssignNull()
+}
+func (_MapRepresentation_ListPairs__KeyAssembler) AssignBool(bool) error {
+	return mixins.StringAssembler{"ast.MapRepresentation_ListPairs.KeyAssembler"}.AssignBool(false)
+}
+func (_MapRepresentation_ListPairs__KeyAssembler) AssignInt(int64) error {
+	return mixins.StringAssembler{"ast.MapRepresentation_ListPairs.KeyAssembler"}.AssignInt(0)
+}
+func (_MapRepresentation_ListPairs__KeyAssembler) AssignFloat(float64) error {
+	return mixins.StringAssembler{"ast.MapRepresentation_ListPairs.KeyAssembler"}.AssignFloat(0)
+}
+func (ka *_MapRepresentation_ListPairs__KeyAssembler) AssignString(k string) error {
+	if ka.state != maState_midKey {
+		panic("misuse: KeyAssembler held beyond its valid lifetime")
+	}
+	switch k {
+	default:
+		return ipld.ErrInvalidKey{TypeName: "ast.MapRepresentation_ListPairs", Key: &_String{k}}
+	}
+	return nil
+}
+func (_MapRepresentation_ListPairs__KeyAssembler) AssignBytes([]byte) error {
+	return mixins.StringAssembler{"ast.MapRepresentation_ListPairs.KeyAssembler"}.AssignBytes(nil)
+}
+func (_MapRepresentation_ListPairs__KeyAssembler) AssignLink(ipld.Link) error {
+	return mixins.StringAssembler{"ast.MapRepresentation_ListPairs.KeyAssembler"}.AssignLink(nil)
+}
+func (ka *_MapRepresentation_ListPairs__KeyAssembler) AssignNode(v ipld.Node) error {
+	if v2, err := v.AsString(); err != nil {
+		return err
+	} else {
+		return ka.AssignString(v2)
+	}
+}
+func (_MapRepresentation_ListPairs__KeyAssembler) Prototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (MapRepresentation_ListPairs) Type() schema.Type {
+	return nil /*TODO:typelit*/
+}
+func (n MapRepresentation_ListPairs) Representation() ipld.Node {
+	return (*_MapRepresentation_ListPairs__Repr)(n)
+}
+
+type _MapRepresentation_ListPairs__Repr _MapRepresentation_ListPairs
+
+var ()
+var _ ipld.Node = &_MapRepresentation_ListPairs__Repr{}
+
+func (_MapRepresentation_ListPairs__Repr) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_Map
+}
+func (n *_MapRepresentation_ListPairs__Repr) LookupByString(key string) (ipld.Node, error) {
+	switch key {
+	default:
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+	}
+}
+func (n *_MapRepresentation_ListPairs__Repr) LookupByNode(key ipld.Node) (ipld.Node, error) {
+	ks, err := key.AsString()
+	if err != nil {
+		return nil, err
+	}
+	return n.LookupByString(ks)
+}
+func (_MapRepresentation_ListPairs__Repr) LookupByIndex(idx int) (ipld.Node, error) {
+	return mixins.Map{"ast.MapRepresentation_ListPairs.Repr"}.LookupByIndex(0)
+}
+func (n _MapRepresentation_ListPairs__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	return n.LookupByString(seg.String())
+}
+func (n *_MapRepresentation_ListPairs__Repr) MapIterator() ipld.MapIterator {
+	return &_MapRepresentation_ListPairs__ReprMapItr{n, 0}
+}
+
+type _MapRepresentation_ListPairs__ReprMapItr struct {
+	n   *_MapRepresentation_ListPairs__Repr
+	idx int
+}
+
+func (itr *_MapRepresentation_ListPairs__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+	if itr.idx >= 0 {
+		return nil, nil, ipld.ErrIteratorOverread{}
+	}
+	switch itr.idx {
+	default:
+		panic("unreachable")
+	}
+	itr.idx++
+	return
+}
+func (itr *_MapRepresentation_ListPairs__ReprMapItr) Done() bool {
+	return itr.idx >= 0
+}
+func (_MapRepresentation_ListPairs__Repr) ListIterator() ipld.ListIterator {
+	return nil
+}
+func (rn *_MapRepresentation_ListPairs__Repr) Length() int {
+	l := 0
+	return l
+}
+func (_MapRepresentation_ListPairs__Repr) IsAbsent() bool {
+	return false
+}
+func (_MapRepresentation_ListPairs__Repr) IsNull() bool {
+	return false
+}
+func (_MapRepresentation_ListPairs__Repr) AsBool() (bool, error) {
+	return mixins.Map{"ast.MapRepresentation_ListPairs.Repr"}.AsBool()
+}
+func (_MapRepresentation_ListPairs__Repr) AsInt() (int64, error) {
+	return mixins.Map{"ast.MapRepresentation_ListPairs.Repr"}.AsInt()
+}
+func (_MapRepresentation_ListPairs__Repr) AsFloat() (float64, error) {
+	return mixins.Map{"ast.MapRepresentation_ListPairs.Repr"}.AsFloat()
+}
+func (_MapRepresentation_ListPairs__Repr) AsString() (string, error) {
+	return mixins.Map{"ast.MapRepresentation_ListPairs.Repr"}.AsString()
+}
+func (_MapRepresentation_ListPairs__Repr) AsBytes() ([]byte, error) {
+	return mixins.Map{"ast.MapRepresentation_ListPairs.Repr"}.AsBytes()
+}
+func (_MapRepresentation_ListPairs__Repr) AsLink() (ipld.Link, error) {
+	return mixins.Map{"ast.MapRepresentation_ListPairs.Repr"}.AsLink()
+}
+func (_MapRepresentation_ListPairs__Repr) Prototype() ipld.NodePrototype {
+	return _MapRepresentation_ListPairs__ReprPrototype{}
+}
+
+type _MapRepresentation_ListPairs__ReprPrototype struct{}
+
+func (_MapRepresentation_ListPairs__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+	var nb _MapRepresentation_ListPairs__ReprBuilder
+	nb.Reset()
+	return &nb
+}
+
+type _MapRepresentation_ListPairs__ReprBuilder struct {
+	_MapRepresentation_ListPairs__ReprAssembler
+}
+
+func (nb *_MapRepresentation_ListPairs__ReprBuilder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_MapRepresentation_ListPairs__ReprBuilder) Reset() {
+	var w _MapRepresentation_ListPairs
+	var m schema.Maybe
+	*nb = _MapRepresentation_ListPairs__ReprBuilder{_MapRepresentation_ListPairs__ReprAssembler{w: &w, m: &m}}
+}
+
+type _MapRepresentation_ListPairs__ReprAssembler struct {
+	w     *_MapRepresentation_ListPairs
+	m     *schema.Maybe
+	state maState
+	s     int
+	f     int
+
+	cm schema.Maybe
+}
+
+func (na *_MapRepresentation_ListPairs__ReprAssembler) reset() {
+	na.state = maState_initial
+	na.s = 0
+}
+func (na *_MapRepresentation_ListPairs__ReprAssembler) BeginMap(int) (ipld.MapAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: it makes no sense to 'begin' twice on the same assembler!")
+	}
+	*na.m = midvalue
+	if na.w == nil {
+		na.w = &_MapRepresentation_ListPairs{}
+	}
+	return na, nil
+}
+func (_MapRepresentation_ListPairs__ReprAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.MapAssembler{"ast.MapRepresentation_ListPairs.Repr"}.BeginList(0)
+}
+func (na *_MapRepresentation_ListPairs__ReprAssembler) AssignNull() error {
+	switch *na.m {
+	case allowNull:
+		*na.m = schema.Maybe_Null
+		return nil
+	case schema.Maybe_Absent:
+		return mixins.MapAssembler{"ast.MapRepresentation_ListPairs.Repr.Repr"}.AssignNull()
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+	}
+	panic("unreachable")
+}
+func (_MapRepresentation_ListPairs__ReprAssembler) AssignBool(bool) error {
+	return mixins.MapAssembler{"ast.MapRepresentation_ListPairs.Repr"}.AssignBool(false)
+}
+func (_MapRepresentation_ListPairs__ReprAssembler) AssignInt(int64) error {
+	return mixins.MapAssembler{"ast.MapRepresentation_ListPairs.Repr"}.AssignInt(0)
+}
+func (_MapRepresentation_ListPairs__ReprAssembler) AssignFloat(float64) error {
+	return mixins.MapAssembler{"ast.MapRepresentation_ListPairs.Repr"}.AssignFloat(0)
+}
+func (_MapRepresentation_ListPairs__ReprAssembler) AssignString(string) error {
+	return mixins.MapAssembler{"ast.MapRepresentation_ListPairs.Repr"}.AssignString("")
+}
+func (_MapRepresentation_ListPairs__ReprAssembler) AssignBytes([]byte) error {
+	return mixins.MapAssembler{"ast.MapRepresentation_ListPairs.Repr"}.AssignBytes(nil)
+}
+func (_MapRepresentation_ListPairs__ReprAssembler) AssignLink(ipld.Link) error {
+	return mixins.MapAssembler{"ast.MapRepresentation_ListPairs.Repr"}.AssignLink(nil)
+}
+func (na *_MapRepresentation_ListPairs__ReprAssembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_MapRepresentation_ListPairs); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		case midvalue:
+			panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	if v.ReprKind() != ipld.ReprKind_Map {
+		return ipld.ErrWrongKind{TypeName: "ast.MapRepresentation_ListPairs.Repr", MethodName: "AssignNode", AppropriateKind: ipld.ReprKindSet_JustMap, ActualKind: v.ReprKind()}
+	}
+	itr := v.MapIterator()
+	for !itr.Done() {
+		k, v, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if err := na.AssembleKey().AssignNode(k); err != nil {
+			return err
+		}
+		if err := na.AssembleValue().AssignNode(v); err != nil {
+			return err
+		}
+	}
+	return na.Finish()
+}
+func (_MapRepresentation_ListPairs__ReprAssembler) Prototype() ipld.NodePrototype {
+	return _MapRepresentation_ListPairs__ReprPrototype{}
+}
+func (ma *_MapRepresentation_ListPairs__ReprAssembler) valueFinishTidy() bool {
+	switch ma.f {
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_MapRepresentation_ListPairs__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleEntry cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleEntry cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleEntry cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleEntry cannot be called on an assembler that's already finished")
+	}
+	switch k {
+	default:
+		return nil, ipld.ErrInvalidKey{TypeName: "ast.MapRepresentation_ListPairs.Repr", Key: &_String{k}}
+	}
+}
+func (ma *_MapRepresentation_ListPairs__ReprAssembler) AssembleKey() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleKey cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleKey cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleKey cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleKey cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midKey
+	return (*_MapRepresentation_ListPairs__ReprKeyAssembler)(ma)
+}
+func (ma *_MapRepresentation_ListPairs__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		panic("invalid state: AssembleValue cannot be called when no key is primed")
+	case maState_midKey:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		// carry on
+	case maState_midValue:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling another value")
+	case maState_finished:
+		panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midValue
+	switch ma.f {
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_MapRepresentation_ListPairs__ReprAssembler) Finish() error {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: Finish cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		panic("invalid state: Finish cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: Finish cannot be called on an assembler that's already finished")
+	}
+	//FIXME check if all required fields are set
+	ma.state = maState_finished
+	*ma.m = schema.Maybe_Value
+	return nil
+}
+func (ma *_MapRepresentation_ListPairs__ReprAssembler) KeyPrototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (ma *_MapRepresentation_ListPairs__ReprAssembler) ValuePrototype(k string) ipld.NodePrototype {
+	panic("todo structbuilder mapassembler repr valueprototype")
+}
+
+type _MapRepresentation_ListPairs__ReprKeyAssembler _MapRepresentation_ListPairs__ReprAssembler
+
+func (_MapRepresentation_ListPairs__ReprKeyAssembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	return mixins.StringAssembler{"ast.MapRepresentation_ListPairs.Repr.KeyAssembler"}.BeginMap(0)
+}
+func (_MapRepresentation_ListPairs__ReprKeyAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.StringAssembler{"ast.MapRepresentation_ListPairs.Repr.KeyAssembler"}.BeginList(0)
+}
+func (na *_MapRepresentation_ListPairs__ReprKeyAssembler) AssignNull() error {
+	return mixins.StringAssembler{"ast.MapRepresentation_ListPairs.Repr.KeyAssembler"}.AssignNull()
+}
+func (_MapRepresentation_ListPairs__ReprKeyAssembler) AssignBool(bool) error {
+	return mixins.StringAssembler{"ast.MapRepresentation_ListPairs.Repr.KeyAssembler"}.AssignBool(false)
+}
+func (_MapRepresentation_ListPairs__ReprKeyAssembler) AssignInt(int64) error {
+	return mixins.StringAssembler{"ast.MapRepresentation_ListPairs.Repr.KeyAssembler"}.AssignInt(0)
+}
+func (_MapRepresentation_ListPairs__ReprKeyAssembler) AssignFloat(float64) error {
+	return mixins.StringAssembler{"ast.MapRepresentation_ListPairs.Repr.KeyAssembler"}.AssignFloat(0)
+}
+func (ka *_MapRepresentation_ListPairs__ReprKeyAssembler) AssignString(k string) error {
+	if ka.state != maState_midKey {
+		panic("misuse: KeyAssembler held beyond its valid lifetime")
+	}
+	switch k {
+	default:
+		return ipld.ErrInvalidKey{TypeName: "ast.MapRepresentation_ListPairs.Repr", Key: &_String{k}}
+	}
+	return nil
+}
+func (_MapRepresentation_ListPairs__ReprKeyAssembler) AssignBytes([]byte) error {
+	return mixins.StringAssembler{"ast.MapRepresentation_ListPairs.Repr.KeyAssembler"}.AssignBytes(nil)
+}
+func (_MapRepresentation_ListPairs__ReprKeyAssembler) AssignLink(ipld.Link) error {
+	return mixins.StringAssembler{"ast.MapRepresentation_ListPairs.Repr.KeyAssembler"}.AssignLink(nil)
+}
+func (ka *_MapRepresentation_ListPairs__ReprKeyAssembler) AssignNode(v ipld.Node) error {
+	if v2, err := v.AsString(); err != nil {
+		return err
+	} else {
+		return ka.AssignString(v2)
+	}
+}
+func (_MapRepresentation_ListPairs__ReprKeyAssembler) Prototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
@@ -0,0 +1,759 @@
+package ast
+
+// Code generated by go-ipld-prime gengo.  DO NOT EDIT.
+
+import (
+	ipld "github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/node/mixins"
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+type _Map__FieldName__StructRepresentation_Map_FieldDetails struct {
+	m map[_FieldName]*_StructRepresentation_Map_FieldDetails
+	t []_Map__FieldName__StructRepresentation_Map_FieldDetails__entry
+}
+type Map__FieldName__StructRepresentation_Map_FieldDetails = *_Map__FieldName__StructRepresentation_Map_FieldDetails
+type _Map__FieldName__StructRepresentation_Map_FieldDetails__entry struct {
+	k _FieldName
+	v _StructRepresentation_Map_FieldDetails
+}
+
+func (n *_Map__FieldName__StructRepresentation_Map_FieldDetails) LookupMaybe(k FieldName) MaybeStructRepresentation_Map_FieldDetails {
+	v, ok := n.m[*k]
+	if !ok {
+		return &_Map__FieldName__StructRepresentation_Map_FieldDetails__valueAbsent
+	}
+	return &_StructRepresentation_Map_FieldDetails__Maybe{
+		m: schema.Maybe_Value,
+		v: v,
+	}
+}
+
+var _Map__FieldName__StructRepresentation_Map_FieldDetails__valueAbsent = _StructRepresentation_Map_FieldDetails__Maybe{m: schema.Maybe_Absent}
+
+// TODO generate also a plain Lookup method that doesn't box and alloc if this type contains non-nullable values!
+type _Map__FieldName__StructRepresentation_Map_FieldDetails__Maybe struct {
+	m schema.Maybe
+	v Map__FieldName__StructRepresentation_Map_FieldDetails
+}
+type MaybeMap__FieldName__StructRepresentation_Map_FieldDetails = *_Map__FieldName__StructRepresentation_Map_FieldDetails__Maybe
+
+func (m MaybeMap__FieldName__StructRepresentation_Map_FieldDetails) IsNull() bool {
+	return m.m == schema.Maybe_Null
+}
+func (m MaybeMap__FieldName__StructRepresentation_Map_FieldDetails) IsAbsent() bool {
+	return m.m == schema.Maybe_Absent
+}
+func (m MaybeMap__FieldName__StructRepresentation_Map_FieldDetails) Exists() bool {
+	return m.m == schema.Maybe_Value
+}
+func (m MaybeMap__FieldName__StructRepresentation_Map_FieldDetails) AsNode() ipld.Node {
+	switch m.m {
+	case schema.Maybe_Absent:
+		return ipld.Absent
+	case schema.Maybe_Null:
+		return ipld.Null
+	case schema.Maybe_Value:
+		return m.v
+	default:
+		panic("unreachable")
+	}
+}
+func (m MaybeMap__FieldName__StructRepresentation_Map_FieldDetails) Must() Map__FieldName__StructRepresentation_Map_FieldDetails {
+	if !m.Exists() {
+		panic("unbox of a maybe rejected")
+	}
+	return m.v
+}
+
+var _ ipld.Node = (Map__FieldName__StructRepresentation_Map_FieldDetails)(&_Map__FieldName__StructRepresentation_Map_FieldDetails{})
+var _ schema.TypedNode = (Map__FieldName__StructRepresentation_Map_FieldDetails)(&_Map__FieldName__StructRepresentation_Map_FieldDetails{})
+
+func (Map__FieldName__StructRepresentation_Map_FieldDetails) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_Map
+}
+func (n Map__FieldName__StructRepresentation_Map_FieldDetails) LookupByString(k string) (ipld.Node, error) {
+	var k2 _FieldName
+	if err := (_FieldName__Prototype{}).fromString(&k2, k); err != nil {
+		return nil, err // TODO wrap in some kind of ErrInvalidKey
+	}
+	v, exists := n.m[k2]
+	if !exists {
+		return nil, ipld.ErrNotExists{ipld.PathSegmentOfString(k)}
+	}
+	return v, nil
+}
+func (n Map__FieldName__StructRepresentation_Map_FieldDetails) LookupByNode(k ipld.Node) (ipld.Node, error) {
+	k2, ok := k.(FieldName)
+	if !ok {
+		panic("todo invalid key type error")
+		// 'ipld.ErrInvalidKey{TypeName:"ast.Map__FieldName__StructRepresentation_Map_FieldDetails", Key:&_String{k}}' doesn't quite cut it: need room to explain the type, and it's not guaranteed k can be turned into a string at all
+	}
+	v, exists := n.m[*k2]
+	if !exists {
+		return nil, ipld.ErrNotExists{ipld.PathSegmentOfString(k2.String())}
+	}
+	return v, nil
+}
+func (Map__FieldName__StructRepresentation_Map_FieldDetails) LookupByIndex(idx int) (ipld.Node, error) {
+	return mixins.Map{"ast.Map__FieldName__StructRepresentation_Map_FieldDetails"}.LookupByIndex(0)
+}
+func (n Map__FieldName__StructRepresentation_Map_FieldDetails) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	return n.LookupByString(seg.String())
+}
+func (n Map__FieldName__StructRepresentation_Map_FieldDetails) MapIterator() ipld.MapIterator {
+	return &_Map__FieldName__StructRepresentation_Map_FieldDetails__MapItr{n, 0}
+}
+
+type _Map__FieldName__StructRepresentation_Map_FieldDetails__MapItr struct {
+	n   Map__FieldName__StructRepresentation_Map_FieldDetails
+	idx int
+}
+
+func (itr *_Map__FieldName__StructRepresentation_Map_FieldDetails__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+	if itr.idx >= len(itr.n.t) {
+		return nil, nil, ipld.ErrIteratorOverread{}
+	}
+	x := &itr.n.t[itr.idx]
+	k = &x.k
+	v = &x.v
+	itr.idx++
+	return
+}
+func (itr *_Map__FieldName__StructRepresentation_Map_FieldDetails__MapItr) Done() bool {
+	return itr.idx >= len(itr.n.t)
+}
+
+func (Map__FieldName__StructRepresentation_Map_FieldDetails) ListIterator() ipld.ListIterator {
+	return nil
+}
+func (n Map__FieldName__StructRepresentation_Map_FieldDetails) Length() int {
+	return len(n.t)
+}
+func (Map__FieldName__StructRepresentation_Map_FieldDetails) IsAbsent() bool {
+	return false
+}
+func (Map__FieldName__StructRepresentation_Map_FieldDetails) IsNull() bool {
+	return false
+}
+func (Map__FieldName__StructRepresentation_Map_FieldDetails) AsBool() (bool, error) {
+	return mixins.Map{"ast.Map__FieldName__StructRepresentation_Map_FieldDetails"}.AsBool()
+}
+func (Map__FieldName__StructRepresentation_Map_FieldDetails) AsInt() (int64, error) {
+	return mixins.Map{"ast.Map__FieldName__StructRepresentation_Map_FieldDetails"}.AsInt()
+}
+func (Map__FieldName__StructRepresentation_Map_FieldDetails) AsFloat() (float64, error) {
+	return mixins.Map{"ast.Map__FieldName__StructRepresentation_Map_FieldDetails"}.AsFloat()
+}
+func (Map__FieldName__StructRepresentation_Map_FieldDetails) AsString() (string, error) {
+	return mixins.Map{"ast.Map__FieldName__StructRepresentation_Map_FieldDetails"}.AsString()
+}
+func (Map__FieldName__StructRepresentation_Map_FieldDetails) AsBytes() ([]byte, error) {
+	return mixins.Map{"ast.Map__FieldName__StructRepresentation_Map_FieldDetails"}.AsBytes()
+}
+func (Map__FieldName__StructRepresentation_Map_FieldDetails) AsLink() (ipld.Link, error) {
+	return mixins.Map{"ast.Map__FieldName__StructRepresentation_Map_FieldDetails"}.AsLink()
+}
+func (Map__FieldName__StructRepresentation_Map_FieldDetails) Prototype() ipld.NodePrototype {
+	return _Map__FieldName__StructRepresentation_Map_FieldDetails__Prototype{}
+}
+
+type _Map__FieldName__StructRepresentation_Map_FieldDetails__Prototype struct{}
+
+func (_Map__FieldName__StructRepresentation_Map_FieldDetails__Prototype) NewBuilder() ipld.NodeBuilder {
+	var nb _Map__FieldName__StructRepresentation_Map_FieldDetails__Builder
+	nb.Reset()
+	return &nb
+}
+
+type _Map__FieldName__StructRepresentation_Map_FieldDetails__Builder struct {
+	_Map__FieldName__StructRepresentation_Map_FieldDetails__Assembler
+}
+
+func (nb *_Map__FieldName__StructRepresentation_Map_FieldDetails__Builder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_Map__FieldName__StructRepresentation_Map_FieldDetails__Builder) Reset() {
+	var w _Map__FieldName__StructRepresentation_Map_FieldDetails
+	var m schema.Maybe
+	*nb = _Map__FieldName__StructRepresentation_Map_FieldDetails__Builder{_Map__FieldName__StructRepresentation_Map_FieldDetails__Assembler{w: &w, m: &m}}
+}
+
+type _Map__FieldName__StructRepresentation_Map_FieldDetails__Assembler struct {
+	w     *_Map__FieldName__StructRepresentation_Map_FieldDetails
+	m     *schema.Maybe
+	state maState
+
+	cm schema.Maybe
+	ka _FieldName__Assembler
+	va _StructRepresentation_Map_FieldDetails__Assembler
+}
+
+func (na *_Map__FieldName__StructRepresentation_Map_FieldDetails__Assembler) reset() {
+	na.state = maState_initial
+	na.ka.reset()
+	na.va.reset()
+}
+func (na *_Map__FieldName__StructRepresentation_Map_FieldDetails__Assembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: it makes no sense to 'begin' twice on the same assembler!")
+	}
+	*na.m = midvalue
+	if sizeHint < 0 {
+		sizeHint = 0
+	}
+	if na.w == nil {
+		na.w = &_Map__FieldName__StructRepresentation_Map_FieldDetails{}
+	}
+	na.w.m = make(map[_FieldName]*_StructRepresentation_Map_FieldDetails, sizeHint)
+	na.w.t = make([]_Map__FieldName__StructRepresentation_Map_FieldDetails__entry, 0, sizeHint)
+	return na, nil
+}
+func (_Map__FieldName__StructRepresentation_Map_FieldDetails__Assembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.MapAssembler{"ast.Map__FieldName__StructRepresentation_Map_FieldDetails"}.BeginList(0)
+}
+func (na *_Map__FieldName__StructRepresentation_Map_FieldDetails__Assembler) AssignNull() error {
+	switch *na.m {
+	case allowNull:
+		*na.m = schema.Maybe_Null
+		return nil
+	case schema.Maybe_Absent:
+		return mixins.MapAssembler{"ast.Map__FieldName__StructRepresentation_Map_FieldDetails"}.AssignNull()
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+	}
+	panic("unreachable")
+}
+func (_Map__FieldName__StructRepresentation_Map_FieldDetails__Assembler) AssignBool(bool) error {
+	return mixins.MapAssembler{"ast.Map__FieldName__StructRepresentation_Map_FieldDetails"}.AssignBool(false)
+}
+func (_Map__FieldName__StructRepresentation_Map_FieldDetails__Assembler) AssignInt(int64) error {
+	return mixins.MapAssembler{"ast.Map__FieldName__StructRepresentation_Map_FieldDetails"}.AssignInt(0)
+}
+func (_Map__FieldName__StructRepresentation_Map_FieldDetails__Assembler) AssignFloat(float64) error {
+	return mixins.MapAssembler{"ast.Map__FieldName__StructRepresentation_Map_FieldDetails"}.AssignFloat(0)
+}
+func (_Map__FieldName__StructRepresentation_Map_FieldDetails__Assembler) AssignString(string) error {
+	return mixins.MapAssembler{"ast.Map__FieldName__StructRepresentation_Map_FieldDetails"}.AssignString("")
+}
+func (_Map__FieldName__StructRepresentation_Map_FieldDetails__Assembler) AssignBytes([]byte) error {
+	return mixins.MapAssembler{"ast.Map__FieldName__StructRepresentation_Map_FieldDetails"}.AssignBytes(nil)
+}
+func (_Map__FieldName__StructRepresentation_Map_FieldDetails__Assembler) AssignLink(ipld.Link) error {
+	return mixins.MapAssembler{"ast.Map__FieldName__StructRepresentation_Map_FieldDetails"}.AssignLink(nil)
+}
+func (na *_Map__FieldName__StructRepresentation_Map_FieldDetails__Assembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_Map__FieldName__StructRepresentation_Map_FieldDetails); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		case midvalue:
+			panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	if v.ReprKind() != ipld.ReprKind_Map {
+		return ipld.ErrWrongKind{TypeName: "ast.Map__FieldName__StructRepresentation_Map_FieldDetails", MethodName: "AssignNode", AppropriateKind: ipld.ReprKindSet_JustMap, ActualKind: v.ReprKind()}
+	}
+	itr := v.MapIterator()
+	for !itr.Done() {
+		k, v, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if err := na.AssembleKey().AssignNode(k); err != nil {
+			return err
+		}
+		if err := na.AssembleValue().AssignNode(v); err != nil {
+			return err
+		}
+	}
+	return na.Finish()
+}
+func (_Map__FieldName__StructRepresentation_Map_FieldDetails__Assembler) Prototype() ipld.NodePrototype {
+	return _Map__FieldName__StructRepresentation_Map_FieldDetails__Prototype{}
+}
+func (ma *_Map__FieldName__StructRepresentation_Map_FieldDetails__Assembler) keyFinishTidy() bool {
+	switch ma.cm {
+	case schema.Maybe_Value:
+		ma.ka.w = nil
+		tz := &ma.w.t[len(ma.w.t)-1]
+		ma.cm = schema.Maybe_Absent
+		ma.state = maState_expectValue
+		ma.w.m[tz.k] = &tz.v
+		ma.va.w = &tz.v
+		ma.va.m = &ma.cm
+		ma.ka.reset()
+		return true
+	default:
+		return false
+	}
+}
+func (ma *_Map__FieldName__StructRepresentation_Map_FieldDetails__Assembler) valueFinishTidy() bool {
+	switch ma.cm {
+	case schema.Maybe_Value:
+		ma.va.w = nil
+		ma.cm = schema.Maybe_Absent
+		ma.state = maState_initial
+		ma.va.reset()
+		return true
+	default:
+		return false
+	}
+}
+func (ma *_Map__FieldName__StructRepresentation_Map_FieldDetails__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleEntry cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleEntry cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleEntry cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleEntry cannot be called on an assembler that's already finished")
+	}
+
+	var k2 _FieldName
+	if err := (_FieldName__Prototype{}).fromString(&k2, k); err != nil {
+		return nil, err // TODO wrap in some kind of ErrInvalidKey
+	}
+	if _, exists := ma.w.m[k2]; exists {
+		return nil, ipld.ErrRepeatedMapKey{&k2}
+	}
+	ma.w.t = append(ma.w.t, _Map__FieldName__StructRepresentation_Map_FieldDetails__entry{k: k2})
+	tz := &ma.w.t[len(ma.w.t)-1]
+	ma.state = maState_midValue
+
+	ma.w.m[k2] = &tz.v
+	ma.va.w = &tz.v
+	ma.va.m = &ma.cm
+	return &ma.va, nil
+}
+func (ma *_Map__FieldName__StructRepresentation_Map_FieldDetails__Assembler) AssembleKey() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleKey cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleKey cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleKey cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleKey cannot be called on an assembler that's already finished")
+	}
+	ma.w.t = append(ma.w.t, _Map__FieldName__StructRepresentation_Map_FieldDetails__entry{})
+	ma.state = maState_midKey
+	ma.ka.m = &ma.cm
+	ma.ka.w = &ma.w.t[len(ma.w.t)-1].k
+	return &ma.ka
+}
+func (ma *_Map__FieldName__StructRepresentation_Map_FieldDetails__Assembler) AssembleValue() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		panic("invalid state: AssembleValue cannot be called when no key is primed")
+	case maState_midKey:
+		if !ma.keyFinishTidy() {
+			panic("invalid state: AssembleValue cannot be called when in the middle of assembling a key")
+		} // if tidy success: carry on
+	case maState_expectValue:
+		// carry on
+	case maState_midValue:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling another value")
+	case maState_finished:
+		panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midValue
+	return &ma.va
+}
+func (ma *_Map__FieldName__StructRepresentation_Map_FieldDetails__Assembler) Finish() error {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: Finish cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		panic("invalid state: Finish cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: Finish cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_finished
+	*ma.m = schema.Maybe_Value
+	return nil
+}
+func (ma *_Map__FieldName__StructRepresentation_Map_FieldDetails__Assembler) KeyPrototype() ipld.NodePrototype {
+	return _FieldName__Prototype{}
+}
+func (ma *_Map__FieldName__StructRepresentation_Map_FieldDetails__Assembler) ValuePrototype(_ string) ipld.NodePrototype {
+	return _StructRepresentation_Map_FieldDetails__Prototype{}
+}
+func (Map__FieldName__StructRepresentation_Map_FieldDetails) Type() schema.Type {
+	return nil /*TODO:typelit*/
+}
+func (n Map__FieldName__StructRepresentation_Map_FieldDetails) Representation() ipld.Node {
+	return (*_Map__FieldName__StructRepresentation_Map_FieldDetails__Repr)(n)
+}
+
+type _Map__FieldName__StructRepresentation_Map_FieldDetails__Repr _Map__FieldName__StructRepresentation_Map_FieldDetails
+
+var _ ipld.Node = &_Map__FieldName__StructRepresentation_Map_FieldDetails__Repr{}
+
+func (_Map__FieldName__StructRepresentation_Map_FieldDetails__Repr) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_Map
+}
+func (nr *_Map__FieldName__StructRepresentation_Map_FieldDetails__Repr) LookupByString(k string) (ipld.Node, error) {
+	v, err := (Map__FieldName__StructRepresentation_Map_FieldDetails)(nr).LookupByString(k)
+	if err != nil || v == ipld.Null {
+		return v, err
+	}
+	return v.(StructRepresentation_Map_FieldDetails).Representation(), nil
+}
+func (nr *_Map__FieldName__StructRepresentation_Map_FieldDetails__Repr) LookupByNode(k ipld.Node) (ipld.Node, error) {
+	v, err := (Map__FieldName__StructRepresentation_Map_FieldDetails)(nr).LookupByNode(k)
+	if err != nil || v == ipld.Null {
+		return v, err
+	}
+	return v.(StructRepresentation_Map_FieldDetails).Representation(), nil
+}
+func (_Map__FieldName__StructRepresentation_Map_FieldDetails__Repr) LookupByIndex(idx int) (ipld.Node, error) {
+	return mixins.Map{"ast.Map__FieldName__StructRepresentation_Map_FieldDetails.Repr"}.LookupByIndex(0)
+}
+func (n _Map__FieldName__StructRepresentation_Map_FieldDetails__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	return n.LookupByString(seg.String())
+}
+func (nr *_Map__FieldName__StructRepresentation_Map_FieldDetails__Repr) MapIterator() ipld.MapIterator {
+	return &_Map__FieldName__StructRepresentation_Map_FieldDetails__ReprMapItr{(Map__FieldName__StructRepresentation_Map_FieldDetails)(nr), 0}
+}
+
+type _Map__FieldName__StructRepresentation_Map_FieldDetails__ReprMapItr _Map__FieldName__StructRepresentation_Map_FieldDetails__MapItr
+
+func (itr *_Map__FieldName__StructRepresentation_Map_FieldDetails__ReprMapItr) Next() (k ipld.Node, v ipld.Node, err error) {
+	k, v, err = (*_Map__FieldName__StructRepresentation_Map_FieldDetails__MapItr)(itr).Next()
+	if err != nil || v == ipld.Null {
+		return
+	}
+	return k, v.(StructRepresentation_Map_FieldDetails).Representation(), nil
+}
+func (itr *_Map__FieldName__StructRepresentation_Map_FieldDetails__ReprMapItr) Done() bool {
+	return (*_Map__FieldName__StructRepresentation_Map_FieldDetails__MapItr)(itr).Done()
+}
+
+func (_Map__FieldName__StructRepresentation_Map_FieldDetails__Repr) ListIterator() ipld.ListIterator {
+	return nil
+}
+func (rn *_Map__FieldName__StructRepresentation_Map_FieldDetails__Repr) Length() int {
+	return len(rn.t)
+}
+func (_Map__FieldName__StructRepresentation_Map_FieldDetails__Repr) IsAbsent() bool {
+	return false
+}
+func (_Map__FieldName__StructRepresentation_Map_FieldDetails__Repr) IsNull() bool {
+	return false
+}
+func (_Map__FieldName__StructRepresentation_Map_FieldDetails__Repr) AsBool() (bool, error) {
+	return mixins.Map{"ast.Map__FieldName__StructRepresentation_Map_FieldDetails.Repr"}.AsBool()
+}
+func (_Map__FieldName__StructRepresentation_Map_FieldDetails__Repr) AsInt() (int64, error) {
+	return mixins.Map{"ast.Map__FieldName__StructRepresentation_Map_FieldDetails.Repr"}.AsInt()
+}
+func (_Map__FieldName__StructRepresentation_Map_FieldDetails__Repr) AsFloat() (float64, error) {
+	return mixins.Map{"ast.Map__FieldName__StructRepresentation_Map_FieldDetails.Repr"}.AsFloat()
+}
+func (_Map__FieldName__StructRepresentation_Map_FieldDetails__Repr) AsString() (string, error) {
+	return mixins.Map{"ast.Map__FieldName__StructRepresentation_Map_FieldDetails.Repr"}.AsString()
+}
+func (_Map__FieldName__StructRepresentation_Map_FieldDetails__Repr) AsBytes() ([]byte, error) {
+	return mixins.Map{"ast.Map__FieldName__StructRepresentation_Map_FieldDetails.Repr"}.AsBytes()
+}
+func (_Map__FieldName__StructRepresentation_Map_FieldDetails__Repr) AsLink() (ipld.Link, error) {
+	return mixins.Map{"ast.Map__FieldName__StructRepresentation_Map_FieldDetails.Repr"}.AsLink()
+}
+func (_Map__FieldName__StructRepresentation_Map_FieldDetails__Repr) Prototype() ipld.NodePrototype {
+	return _Map__FieldName__StructRepresentation_Map_FieldDetails__ReprPrototype{}
+}
+
+type _Map__FieldName__StructRepresentation_Map_FieldDetails__ReprPrototype struct{}
+
+func (_Map__FieldName__StructRepresentation_Map_FieldDetails__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+	var nb _Map__FieldName__StructRepresentation_Map_FieldDetails__ReprBuilder
+	nb.Reset()
+	return &nb
+}
+
+type _Map__FieldName__StructRepresentation_Map_FieldDetails__ReprBuilder struct {
+	_Map__FieldName__StructRepresentation_Map_FieldDetails__ReprAssembler
+}
+
+func (nb *_Map__FieldName__StructRepresentation_Map_FieldDetails__ReprBuilder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_Map__FieldName__StructRepresentation_Map_FieldDetails__ReprBuilder) Reset() {
+	var w _Map__FieldName__StructRepresentation_Map_FieldDetails
+	var m schema.Maybe
+	*nb = _Map__FieldName__StructRepresentation_Map_FieldDetails__ReprBuilder{_Map__FieldName__StructRepresentation_Map_FieldDetails__ReprAssembler{w: &w, m: &m}}
+}
+
+type _Map__FieldName__StructRepresentation_Map_FieldDetails__ReprAssembler struct {
+	w     *_Map__FieldName__StructRepresentation_Map_FieldDetails
+	m     *schema.Maybe
+	state maState
+
+	cm schema.Maybe
+	ka _FieldName__ReprAssembler
+	va _StructRepresentation_Map_FieldDetails__ReprAssembler
+}
+
+func (na *_Map__FieldName__StructRepresentation_Map_FieldDetails__ReprAssembler) reset() {
+	na.state = maState_initial
+	na.ka.reset()
+	na.va.reset()
+}
+func (na *_Map__FieldName__StructRepresentation_Map_FieldDetails__ReprAssembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: it makes no sense to 'begin' twice on the same assembler!")
+	}
+	*na.m = midvalue
+	if sizeHint < 0 {
+		sizeHint = 0
+	}
+	if na.w == nil {
+		na.w = &_Map__FieldName__StructRepresentation_Map_FieldDetails{}
+	}
+	na.w.m = make(map[_FieldName]*_StructRepresentation_Map_FieldDetails, sizeHint)
+	na.w.t = make([]_Map__FieldName__StructRepresentation_Map_FieldDetails__entry, 0, sizeHint)
+	return na, nil
+}
+func (_Map__FieldName__StructRepresentation_Map_FieldDetails__ReprAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.MapAssembler{"ast.Map__FieldName__StructRepresentation_Map_FieldDetails.Repr"}.BeginList(0)
+}
+func (na *_Map__FieldName__StructRepresentation_Map_FieldDetails__ReprAssembler) AssignNull() error {
+	switch *na.m {
+	case allowNull:
+		*na.m = schema.Maybe_Null
+		return nil
+	case schema.Maybe_Absent:
+		return mixins.MapAssembler{"ast.Map__FieldName__StructRepresentation_Map_FieldDetails.Repr.Repr"}.AssignNull()
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+	}
+	panic("unreachable")
+}
+func (_Map__FieldName__StructRepresentation_Map_FieldDetails__ReprAssembler) AssignBool(bool) error {
+	return mixins.MapAssembler{"ast.Map__FieldName__StructRepresentation_Map_FieldDetails.Repr"}.AssignBool(false)
+}
+func (_Map__FieldName__StructRepresentation_Map_FieldDetails__ReprAssembler) AssignInt(int64) error {
+	return mixins.MapAssembler{"ast.Map__FieldName__StructRepresentation_Map_FieldDetails.Repr"}.AssignInt(0)
+}
+func (_Map__FieldName__StructRepresentation_Map_FieldDetails__ReprAssembler) AssignFloat(float64) error {
+	return mixins.MapAssembler{"ast.Map__FieldName__StructRepresentation_Map_FieldDetails.Repr"}.AssignFloat(0)
+}
+func (_Map__FieldName__StructRepresentation_Map_FieldDetails__ReprAssembler) AssignString(string) error {
+	return mixins.MapAssembler{"ast.Map__FieldName__StructRepresentation_Map_FieldDetails.Repr"}.AssignString("")
+}
+func (_Map__FieldName__StructRepresentation_Map_FieldDetails__ReprAssembler) AssignBytes([]byte) error {
+	return mixins.MapAssembler{"ast.Map__FieldName__StructRepresentation_Map_FieldDetails.Repr"}.AssignBytes(nil)
+}
+func (_Map__FieldName__StructRepresentation_Map_FieldDetails__ReprAssembler) AssignLink(ipld.Link) error {
+	return mixins.MapAssembler{"ast.Map__FieldName__StructRepresentation_Map_FieldDetails.Repr"}.AssignLink(nil)
+}
+func (na *_Map__FieldName__StructRepresentation_Map_FieldDetails__ReprAssembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_Map__FieldName__StructRepresentation_Map_FieldDetails); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		case midvalue:
+			panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	if v.ReprKind() != ipld.ReprKind_Map {
+		return ipld.ErrWrongKind{TypeName: "ast.Map__FieldName__StructRepresentation_Map_FieldDetails.Repr", MethodName: "AssignNode", AppropriateKind: ipld.ReprKindSet_JustMap, ActualKind: v.ReprKind()}
+	}
+	itr := v.MapIterator()
+	for !itr.Done() {
+		k, v, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if err := na.AssembleKey().AssignNode(k); err != nil {
+			return err
+		}
+		if err := na.AssembleValue().AssignNode(v); err != nil {
+			return err
+		}
+	}
+	return na.Finish()
+}
+func (_Map__FieldName__StructRepresentation_Map_FieldDetails__ReprAssembler) Prototype() ipld.NodePrototype {
+	return _Map__FieldName__StructRepresentation_Map_FieldDetails__ReprPrototype{}
+}
+func (ma *_Map__FieldName__StructRepresentation_Map_FieldDetails__ReprAssembler) keyFinishTidy() bool {
+	switch ma.cm {
+	case schema.Maybe_Value:
+		ma.ka.w = nil
+		tz := &ma.w.t[len(ma.w.t)-1]
+		ma.cm = schema.Maybe_Absent
+		ma.state = maState_expectValue
+		ma.w.m[tz.k] = &tz.v
+		ma.va.w = &tz.v
+		ma.va.m = &ma.cm
+		ma.ka.reset()
+		return true
+	default:
+		return false
+	}
+}
+func (ma *_Map__FieldName__StructRepresentation_Map_FieldDetails__ReprAssembler) valueFinishTidy() bool {
+	switch ma.cm {
+	case schema.Maybe_Value:
+		ma.va.w = nil
+		ma.cm = schema.Maybe_Absent
+		ma.state = maState_initial
+		ma.va.reset()
+		return true
+	default:
+		return false
+	}
+}
+func (ma *_Map__FieldName__StructRepresentation_Map_FieldDetails__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleEntry cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleEntry cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleEntry cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleEntry cannot be called on an assembler that's already finished")
+	}
+
+	var k2 _FieldName
+	if err := (_FieldName__ReprPrototype{}).fromString(&k2, k); err != nil {
+		return nil, err // TODO wrap in some kind of ErrInvalidKey
+	}
+	if _, exists := ma.w.m[k2]; exists {
+		return nil, ipld.ErrRepeatedMapKey{&k2}
+	}
+	ma.w.t = append(ma.w.t, _Map__FieldName__StructRepresentation_Map_FieldDetails__entry{k: k2})
+	tz := &ma.w.t[len(ma.w.t)-1]
+	ma.state = maState_midValue
+
+	ma.w.m[k2] = &tz.v
+	ma.va.w = &tz.v
+	ma.va.m = &ma.cm
+	return &ma.va, nil
+}
+func (ma *_Map__FieldName__StructRepresentation_Map_FieldDetails__ReprAssembler) AssembleKey() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleKey cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleKey cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleKey cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleKey cannot be called on an assembler that's already finished")
+	}
+	ma.w.t = append(ma.w.t, _Map__FieldName__StructRepresentation_Map_FieldDetails__entry{})
+	ma.state = maState_midKey
+	ma.ka.m = &ma.cm
+	ma.ka.w = &ma.w.t[len(ma.w.t)-1].k
+	return &ma.ka
+}
+func (ma *_Map__FieldName__StructRepresentation_Map_FieldDetails__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		panic("invalid state: AssembleValue cannot be called when no key is primed")
+	case maState_midKey:
+		if !ma.keyFinishTidy() {
+			panic("invalid state: AssembleValue cannot be called when in the middle of assembling a key")
+		} // if tidy success: carry on
+	case maState_expectValue:
+		// carry on
+	case maState_midValue:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling another value")
+	case maState_finished:
+		panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midValue
+	return &ma.va
+}
+func (ma *_Map__FieldName__StructRepresentation_Map_FieldDetails__ReprAssembler) Finish() error {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: Finish cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		panic("invalid state: Finish cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: Finish cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_finished
+	*ma.m = schema.Maybe_Value
+	return nil
+}
+func (ma *_Map__FieldName__StructRepresentation_Map_FieldDetails__ReprAssembler) KeyPrototype() ipld.NodePrototype {
+	return _FieldName__ReprPrototype{}
+}
+func (ma *_Map__FieldName__StructRepresentation_Map_FieldDetails__ReprAssembler) ValuePrototype(_ string) ipld.NodePrototype {
+	return _StructRepresentation_Map_FieldDetails__ReprPrototype{}
+}
@@ -0,0 +1,759 @@
+package ast
+
+// Code generated by go-ipld-prime gengo.  DO NOT EDIT.
+
+import (
+	ipld "github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/node/mixins"
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+type _SchemaMap struct {
+	m map[_TypeName]*_TypeDefn
+	t []_SchemaMap__entry
+}
+type SchemaMap = *_SchemaMap
+type _SchemaMap__entry struct {
+	k _TypeName
+	v _TypeDefn
+}
+
+func (n *_SchemaMap) LookupMaybe(k TypeName) MaybeTypeDefn {
+	v, ok := n.m[*k]
+	if !ok {
+		return &_SchemaMap__valueAbsent
+	}
+	return &_TypeDefn__Maybe{
+		m: schema.Maybe_Value,
+		v: v,
+	}
+}
+
+var _SchemaMap__valueAbsent = _TypeDefn__Maybe{m: schema.Maybe_Absent}
+
+// TODO generate also a plain Lookup method that doesn't box and alloc if this type contains non-nullable values!
+type _SchemaMap__Maybe struct {
+	m schema.Maybe
+	v SchemaMap
+}
+type MaybeSchemaMap = *_SchemaMap__Maybe
+
+func (m MaybeSchemaMap) IsNull() bool {
+	return m.m == schema.Maybe_Null
+}
+func (m MaybeSchemaMap) IsAbsent() bool {
+	return m.m == schema.Maybe_Absent
+}
+func (m MaybeSchemaMap) Exists() bool {
+	return m.m == schema.Maybe_Value
+}
+func (m MaybeSchemaMap) AsNode() ipld.Node {
+	switch m.m {
+	case schema.Maybe_Absent:
+		return ipld.Absent
+	case schema.Maybe_Null:
+		return ipld.Null
+	case schema.Maybe_Value:
+		return m.v
+	default:
+		panic("unreachable")
+	}
+}
+func (m MaybeSchemaMap) Must() SchemaMap {
+	if !m.Exists() {
+		panic("unbox of a maybe rejected")
+	}
+	return m.v
+}
+
+var _ ipld.Node = (SchemaMap)(&_SchemaMap{})
+var _ schema.TypedNode = (SchemaMap)(&_SchemaMap{})
+
+func (SchemaMap) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_Map
+}
+func (n SchemaMap) LookupByString(k string) (ipld.Node, error) {
+	var k2 _TypeName
+	if err := (_TypeName__Prototype{}).fromString(&k2, k); err != nil {
+		return nil, err // TODO wrap in some kind of ErrInvalidKey
+	}
+	v, exists := n.m[k2]
+	if !exists {
+		return nil, ipld.ErrNotExists{ipld.PathSegmentOfString(k)}
+	}
+	return v, nil
+}
+func (n SchemaMap) LookupByNode(k ipld.Node) (ipld.Node, error) {
+	k2, ok := k.(TypeName)
+	if !ok {
+		panic("todo invalid key type error")
+		// 'ipld.ErrInvalidKey{TypeName:"ast.SchemaMap", Key:&_String{k}}' doesn't quite cut it: need room to explain the type, and it's not guaranteed k can be turned into a string at all
+	}
+	v, exists := n.m[*k2]
+	if !exists {
+		return nil, ipld.ErrNotExists{ipld.PathSegmentOfString(k2.String())}
+	}
+	return v, nil
+}
+func (SchemaMap) LookupByIndex(idx int) (ipld.Node, error) {
+	return mixins.Map{"ast.SchemaMap"}.LookupByIndex(0)
+}
+func (n SchemaMap) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	return n.LookupByString(seg.String())
+}
+func (n SchemaMap) MapIterator() ipld.MapIterator {
+	return &_SchemaMap__MapItr{n, 0}
+}
+
+type _SchemaMap__MapItr struct {
+	n   SchemaMap
+	idx int
+}
+
+func (itr *_SchemaMap__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+	if itr.idx >= len(itr.n.t) {
+		return nil, nil, ipld.ErrIteratorOverread{}
+	}
+	x := &itr.n.t[itr.idx]
+	k = &x.k
+	v = &x.v
+	itr.idx++
+	return
+}
+func (itr *_SchemaMap__MapItr) Done() bool {
+	return itr.idx >= len(itr.n.t)
+}
+
+func (SchemaMap) ListIterator() ipld.ListIterator {
+	return nil
+}
+func (n SchemaMap) Length() int {
+	return len(n.t)
+}
+func (SchemaMap) IsAbsent() bool {
+	return false
+}
+func (SchemaMap) IsNull() bool {
+	return false
+}
+func (SchemaMap) AsBool() (bool, error) {
+	return mixins.Map{"ast.SchemaMap"}.AsBool()
+}
+func (SchemaMap) AsInt() (int64, error) {
+	return mixins.Map{"ast.SchemaMap"}.AsInt()
+}
+func (SchemaMap) AsFloat() (float64, error) {
+	return mixins.Map{"ast.SchemaMap"}.AsFloat()
+}
+func (SchemaMap) AsString() (string, error) {
+	return mixins.Map{"ast.SchemaMap"}.AsString()
+}
+func (SchemaMap) AsBytes() ([]byte, error) {
+	return mixins.Map{"ast.SchemaMap"}.AsBytes()
+}
+func (SchemaMap) AsLink() (ipld.Link, error) {
+	return mixins.Map{"ast.SchemaMap"}.AsLink()
+}
+func (SchemaMap) Prototype() ipld.NodePrototype {
+	return _SchemaMap__Prototype{}
+}
+
+type _SchemaMap__Prototype struct{}
+
+func (_SchemaMap__Prototype) NewBuilder() ipld.NodeBuilder {
+	var nb _SchemaMap__Builder
+	nb.Reset()
+	return &nb
+}
+
+type _SchemaMap__Builder struct {
+	_SchemaMap__Assembler
+}
+
+func (nb *_SchemaMap__Builder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_SchemaMap__Builder) Reset() {
+	var w _SchemaMap
+	var m schema.Maybe
+	*nb = _SchemaMap__Builder{_SchemaMap__Assembler{w: &w, m: &m}}
+}
+
+type _SchemaMap__Assembler struct {
+	w     *_SchemaMap
+	m     *schema.Maybe
+	state maState
+
+	cm schema.Maybe
+	ka _TypeName__Assembler
+	va _TypeDefn__Assembler
+}
+
+func (na *_SchemaMap__Assembler) reset() {
+	na.state = maState_initial
+	na.ka.reset()
+	na.va.reset()
+}
+func (na *_SchemaMap__Assembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: it makes no sense to 'begin' twice on the same assembler!")
+	}
+	*na.m = midvalue
+	if sizeHint < 0 {
+		sizeHint = 0
+	}
+	if na.w == nil {
+		na.w = &_SchemaMap{}
+	}
+	na.w.m = make(map[_TypeName]*_TypeDefn, sizeHint)
+	na.w.t = make([]_SchemaMap__entry, 0, sizeHint)
+	return na, nil
+}
+func (_SchemaMap__Assembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.MapAssembler{"ast.SchemaMap"}.BeginList(0)
+}
+func (na *_SchemaMap__Assembler) AssignNull() error {
+	switch *na.m {
+	case allowNull:
+		*na.m = schema.Maybe_Null
+		return nil
+	case schema.Maybe_Absent:
+		return mixins.MapAssembler{"ast.SchemaMap"}.AssignNull()
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+	}
+	panic("unreachable")
+}
+func (_SchemaMap__Assembler) AssignBool(bool) error {
+	return mixins.MapAssembler{"ast.SchemaMap"}.AssignBool(false)
+}
+func (_SchemaMap__Assembler) AssignInt(int64) error {
+	return mixins.MapAssembler{"ast.SchemaMap"}.AssignInt(0)
+}
+func (_SchemaMap__Assembler) AssignFloat(float64) error {
+	return mixins.MapAssembler{"ast.SchemaMap"}.AssignFloat(0)
+}
+func (_SchemaMap__Assembler) AssignString(string) error {
+	return mixins.MapAssembler{"ast.SchemaMap"}.AssignString("")
+}
+func (_SchemaMap__Assembler) AssignBytes([]byte) error {
+	return mixins.MapAssembler{"ast.SchemaMap"}.AssignBytes(nil)
+}
+func (_SchemaMap__Assembler) AssignLink(ipld.Link) error {
+	return mixins.MapAssembler{"ast.SchemaMap"}.AssignLink(nil)
+}
+func (na *_SchemaMap__Assembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_SchemaMap); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		case midvalue:
+			panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	if v.ReprKind() != ipld.ReprKind_Map {
+		return ipld.ErrWrongKind{TypeName: "ast.SchemaMap", MethodName: "AssignNode", AppropriateKind: ipld.ReprKindSet_JustMap, ActualKind: v.ReprKind()}
+	}
+	itr := v.MapIterator()
+	for !itr.Done() {
+		k, v, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if err := na.AssembleKey().AssignNode(k); err != nil {
+			return err
+		}
+		if err := na.AssembleValue().AssignNode(v); err != nil {
+			return err
+		}
+	}
+	return na.Finish()
+}
+func (_SchemaMap__Assembler) Prototype() ipld.NodePrototype {
+	return _SchemaMap__Prototype{}
+}
+func (ma *_SchemaMap__Assembler) keyFinishTidy() bool {
+	switch ma.cm {
+	case schema.Maybe_Value:
+		ma.ka.w = nil
+		tz := &ma.w.t[len(ma.w.t)-1]
+		ma.cm = schema.Maybe_Absent
+		ma.state = maState_expectValue
+		ma.w.m[tz.k] = &tz.v
+		ma.va.w = &tz.v
+		ma.va.m = &ma.cm
+		ma.ka.reset()
+		return true
+	default:
+		return false
+	}
+}
+func (ma *_SchemaMap__Assembler) valueFinishTidy() bool {
+	switch ma.cm {
+	case schema.Maybe_Value:
+		ma.va.w = nil
+		ma.cm = schema.Maybe_Absent
+		ma.state = maState_initial
+		ma.va.reset()
+		return true
+	default:
+		return false
+	}
+}
+func (ma *_SchemaMap__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleEntry cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleEntry cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleEntry cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleEntry cannot be called on an assembler that's already finished")
+	}
+
+	var k2 _TypeName
+	if err := (_TypeName__Prototype{}).fromString(&k2, k); err != nil {
+		return nil, err // TODO wrap in some kind of ErrInvalidKey
+	}
+	if _, exists := ma.w.m[k2]; exists {
+		return nil, ipld.ErrRepeatedMapKey{&k2}
+	}
+	ma.w.t = append(ma.w.t, _SchemaMap__entry{k: k2})
+	tz := &ma.w.t[len(ma.w.t)-1]
+	ma.state = maState_midValue
+
+	ma.w.m[k2] = &tz.v
+	ma.va.w = &tz.v
+	ma.va.m = &ma.cm
+	return &ma.va, nil
+}
+func (ma *_SchemaMap__Assembler) AssembleKey() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleKey cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleKey cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleKey cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleKey cannot be called on an assembler that's already finished")
+	}
+	ma.w.t = append(ma.w.t, _SchemaMap__entry{})
+	ma.state = maState_midKey
+	ma.ka.m = &ma.cm
+	ma.ka.w = &ma.w.t[len(ma.w.t)-1].k
+	return &ma.ka
+}
+func (ma *_SchemaMap__Assembler) AssembleValue() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		panic("invalid state: AssembleValue cannot be called when no key is primed")
+	case maState_midKey:
+		if !ma.keyFinishTidy() {
+			panic("invalid state: AssembleValue cannot be called when in the middle of assembling a key")
+		} // if tidy success: carry on
+	case maState_expectValue:
+		// carry on
+	case maState_midValue:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling another value")
+	case maState_finished:
+		panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midValue
+	return &ma.va
+}
+func (ma *_SchemaMap__Assembler) Finish() error {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: Finish cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		panic("invalid state: Finish cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: Finish cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_finished
+	*ma.m = schema.Maybe_Value
+	return nil
+}
+func (ma *_SchemaMap__Assembler) KeyPrototype() ipld.NodePrototype {
+	return _TypeName__Prototype{}
+}
+func (ma *_SchemaMap__Assembler) ValuePrototype(_ string) ipld.NodePrototype {
+	return _TypeDefn__Prototype{}
+}
+func (SchemaMap) Type() schema.Type {
+	return nil /*TODO:typelit*/
+}
+func (n SchemaMap) Representation() ipld.Node {
+	return (*_SchemaMap__Repr)(n)
+}
+
+type _SchemaMap__Repr _SchemaMap
+
+var _ ipld.Node = &_SchemaMap__Repr{}
+
+func (_SchemaMap__Repr) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_Map
+}
+func (nr *_SchemaMap__Repr) LookupByString(k string) (ipld.Node, error) {
+	v, err := (SchemaMap)(nr).LookupByString(k)
+	if err != nil || v == ipld.Null {
+		return v, err
+	}
+	return v.(TypeDefn).Representation(), nil
+}
+func (nr *_SchemaMap__Repr) LookupByNode(k ipld.Node) (ipld.Node, error) {
+	v, err := (SchemaMap)(nr).LookupByNode(k)
+	if err != nil || v == ipld.Null {
+		return v, err
+	}
+	return v.(TypeDefn).Representation(), nil
+}
+func (_SchemaMap__Repr) LookupByIndex(idx int) (ipld.Node, error) {
+	return mixins.Map{"ast.SchemaMap.Repr"}.LookupByIndex(0)
+}
+func (n _SchemaMap__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	return n.LookupByString(seg.String())
+}
+func (nr *_SchemaMap__Repr) MapIterator() ipld.MapIterator {
+	return &_SchemaMap__ReprMapItr{(SchemaMap)(nr), 0}
+}
+
+type _SchemaMap__ReprMapItr _SchemaMap__MapItr
+
+func (itr *_SchemaMap__ReprMapItr) Next() (k ipld.Node, v ipld.Node, err error) {
+	k, v, err = (*_SchemaMap__MapItr)(itr).Next()
+	if err != nil || v == ipld.Null {
+		return
+	}
+	return k, v.(TypeDefn).Representation(), nil
+}
+func (itr *_SchemaMap__ReprMapItr) Done() bool {
+	return (*_SchemaMap__MapItr)(itr).Done()
+}
+
+func (_SchemaMap__Repr) ListIterator() ipld.ListIterator {
+	return nil
+}
+func (rn *_SchemaMap__Repr) Length() int {
+	return len(rn.t)
+}
+func (_SchemaMap__Repr) IsAbsent() bool {
+	return false
+}
+func (_SchemaMap__Repr) IsNull() bool {
+	return false
+}
+func (_SchemaMap__Repr) AsBool() (bool, error) {
+	return mixins.Map{"ast.SchemaMap.Repr"}.AsBool()
+}
+func (_SchemaMap__Repr) AsInt() (int64, error) {
+	return mixins.Map{"ast.SchemaMap.Repr"}.AsInt()
+}
+func (_SchemaMap__Repr) AsFloat() (float64, error) {
+	return mixins.Map{"ast.SchemaMap.Repr"}.AsFloat()
+}
+func (_SchemaMap__Repr) AsString() (string, error) {
+	return mixins.Map{"ast.SchemaMap.Repr"}.AsString()
+}
+func (_SchemaMap__Repr) AsBytes() ([]byte, error) {
+	return mixins.Map{"ast.SchemaMap.Repr"}.AsBytes()
+}
+func (_SchemaMap__Repr) AsLink() (ipld.Link, error) {
+	return mixins.Map{"ast.SchemaMap.Repr"}.AsLink()
+}
+func (_SchemaMap__Repr) Prototype() ipld.NodePrototype {
+	return _SchemaMap__ReprPrototype{}
+}
+
+type _SchemaMap__ReprPrototype struct{}
+
+func (_SchemaMap__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+	var nb _SchemaMap__ReprBuilder
+	nb.Reset()
+	return &nb
+}
+
+type _SchemaMap__ReprBuilder struct {
+	_SchemaMap__ReprAssembler
+}
+
+func (nb *_SchemaMap__ReprBuilder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_SchemaMap__ReprBuilder) Reset() {
+	var w _SchemaMap
+	var m schema.Maybe
+	*nb = _SchemaMap__ReprBuilder{_SchemaMap__ReprAssembler{w: &w, m: &m}}
+}
+
+type _SchemaMap__ReprAssembler struct {
+	w     *_SchemaMap
+	m     *schema.Maybe
+	state maState
+
+	cm schema.Maybe
+	ka _TypeName__ReprAssembler
+	va _TypeDefn__ReprAssembler
+}
+
+func (na *_SchemaMap__ReprAssembler) reset() {
+	na.state = maState_initial
+	na.ka.reset()
+	na.va.reset()
+}
+func (na *_SchemaMap__ReprAssembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: it makes no sense to 'begin' twice on the same assembler!")
+	}
+	*na.m = midvalue
+	if sizeHint < 0 {
+		sizeHint = 0
+	}
+	if na.w == nil {
+		na.w = &_SchemaMap{}
+	}
+	na.w.m = make(map[_TypeName]*_TypeDefn, sizeHint)
+	na.w.t = make([]_SchemaMap__entry, 0, sizeHint)
+	return na, nil
+}
+func (_SchemaMap__ReprAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.MapAssembler{"ast.SchemaMap.Repr"}.BeginList(0)
+}
+func (na *_SchemaMap__ReprAssembler) AssignNull() error {
+	switch *na.m {
+	case allowNull:
+		*na.m = schema.Maybe_Null
+		return nil
+	case schema.Maybe_Absent:
+		return mixins.MapAssembler{"ast.SchemaMap.Repr.Repr"}.AssignNull()
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+	}
+	panic("unreachable")
+}
+func (_SchemaMap__ReprAssembler) AssignBool(bool) error {
+	return mixins.MapAssembler{"ast.SchemaMap.Repr"}.AssignBool(false)
+}
+func (_SchemaMap__ReprAssembler) AssignInt(int64) error {
+	return mixins.MapAssembler{"ast.SchemaMap.Repr"}.AssignInt(0)
+}
+func (_SchemaMap__ReprAssembler) AssignFloat(float64) error {
+	return mixins.MapAssembler{"ast.SchemaMap.Repr"}.AssignFloat(0)
+}
+func (_SchemaMap__ReprAssembler) AssignString(string) error {
+	return mixins.MapAssembler{"ast.SchemaMap.Repr"}.AssignString("")
+}
+func (_SchemaMap__ReprAssembler) AssignBytes([]byte) error {
+	return mixins.MapAssembler{"ast.SchemaMap.Repr"}.AssignBytes(nil)
+}
+func (_SchemaMap__ReprAssembler) AssignLink(ipld.Link) error {
+	return mixins.MapAssembler{"ast.SchemaMap.Repr"}.AssignLink(nil)
+}
+func (na *_SchemaMap__ReprAssembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_SchemaMap); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		case midvalue:
+			panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	if v.ReprKind() != ipld.ReprKind_Map {
+		return ipld.ErrWrongKind{TypeName: "ast.SchemaMap.Repr", MethodName: "AssignNode", AppropriateKind: ipld.ReprKindSet_JustMap, ActualKind: v.ReprKind()}
+	}
+	itr := v.MapIterator()
+	for !itr.Done() {
+		k, v, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if err := na.AssembleKey().AssignNode(k); err != nil {
+			return err
+		}
+		if err := na.AssembleValue().AssignNode(v); err != nil {
+			return err
+		}
+	}
+	return na.Finish()
+}
+func (_SchemaMap__ReprAssembler) Prototype() ipld.NodePrototype {
+	return _SchemaMap__ReprPrototype{}
+}
+func (ma *_SchemaMap__ReprAssembler) keyFinishTidy() bool {
+	switch ma.cm {
+	case schema.Maybe_Value:
+		ma.ka.w = nil
+		tz := &ma.w.t[len(ma.w.t)-1]
+		ma.cm = schema.Maybe_Absent
+		ma.state = maState_expectValue
+		ma.w.m[tz.k] = &tz.v
+		ma.va.w = &tz.v
+		ma.va.m = &ma.cm
+		ma.ka.reset()
+		return true
+	default:
+		return false
+	}
+}
+func (ma *_SchemaMap__ReprAssembler) valueFinishTidy() bool {
+	switch ma.cm {
+	case schema.Maybe_Value:
+		ma.va.w = nil
+		ma.cm = schema.Maybe_Absent
+		ma.state = maState_initial
+		ma.va.reset()
+		return true
+	default:
+		return false
+	}
+}
+func (ma *_SchemaMap__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleEntry cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleEntry cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleEntry cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleEntry cannot be called on an assembler that's already finished")
+	}
+
+	var k2 _TypeName
+	if err := (_TypeName__ReprPrototype{}).fromString(&k2, k); err != nil {
+		return nil, err // TODO wrap in some kind of ErrInvalidKey
+	}
+	if _, exists := ma.w.m[k2]; exists {
+		return nil, ipld.ErrRepeatedMapKey{&k2}
+	}
+	ma.w.t = append(ma.w.t, _SchemaMap__entry{k: k2})
+	tz := &ma.w.t[len(ma.w.t)-1]
+	ma.state = maState_midValue
+
+	ma.w.m[k2] = &tz.v
+	ma.va.w = &tz.v
+	ma.va.m = &ma.cm
+	return &ma.va, nil
+}
+func (ma *_SchemaMap__ReprAssembler) AssembleKey() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleKey cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleKey cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleKey cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleKey cannot be called on an assembler that's already finished")
+	}
+	ma.w.t = append(ma.w.t, _SchemaMap__entry{})
+	ma.state = maState_midKey
+	ma.ka.m = &ma.cm
+	ma.ka.w = &ma.w.t[len(ma.w.t)-1].k
+	return &ma.ka
+}
+func (ma *_SchemaMap__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		panic("invalid state: AssembleValue cannot be called when no key is primed")
+	case maState_midKey:
+		if !ma.keyFinishTidy() {
+			panic("invalid state: AssembleValue cannot be called when in the middle of assembling a key")
+		} // if tidy success: carry on
+	case maState_expectValue:
+		// carry on
+	case maState_midValue:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling another value")
+	case maState_finished:
+		panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midValue
+	return &ma.va
+}
+func (ma *_SchemaMap__ReprAssembler) Finish() error {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: Finish cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		panic("invalid state: Finish cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: Finish cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_finished
+	*ma.m = schema.Maybe_Value
+	return nil
+}
+func (ma *_SchemaMap__ReprAssembler) KeyPrototype() ipld.NodePrototype {
+	return _TypeName__ReprPrototype{}
+}
+func (ma *_SchemaMap__ReprAssembler) ValuePrototype(_ string) ipld.NodePrototype {
+	return _TypeDefn__ReprPrototype{}
+}
@@ -0,0 +1,867 @@
+package ast
+
+// Code generated by go-ipld-prime gengo.  DO NOT EDIT.
+
+import (
+	ipld "github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/node/mixins"
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+type _StructRepresentation_Map struct {
+	fields _Map__FieldName__StructRepresentation_Map_FieldDetails__Maybe
+}
+type StructRepresentation_Map = *_StructRepresentation_Map
+
+func (n _StructRepresentation_Map) FieldFields() MaybeMap__FieldName__StructRepresentation_Map_FieldDetails {
+	return &n.fields
+}
+
+type _StructRepresentation_Map__Maybe struct {
+	m schema.Maybe
+	v StructRepresentation_Map
+}
+type MaybeStructRepresentation_Map = *_StructRepresentation_Map__Maybe
+
+func (m MaybeStructRepresentation_Map) IsNull() bool {
+	return m.m == schema.Maybe_Null
+}
+func (m MaybeStructRepresentation_Map) IsAbsent() bool {
+	return m.m == schema.Maybe_Absent
+}
+func (m MaybeStructRepresentation_Map) Exists() bool {
+	return m.m == schema.Maybe_Value
+}
+func (m MaybeStructRepresentation_Map) AsNode() ipld.Node {
+	switch m.m {
+	case schema.Maybe_Absent:
+		return ipld.Absent
+	case schema.Maybe_Null:
+		return ipld.Null
+	case schema.Maybe_Value:
+		return m.v
+	default:
+		panic("unreachable")
+	}
+}
+func (m MaybeStructRepresentation_Map) Must() StructRepresentation_Map {
+	if !m.Exists() {
+		panic("unbox of a maybe rejected")
+	}
+	return m.v
+}
+
+var (
+	fieldName__StructRepresentation_Map_Fields = _String{"fields"}
+)
+var _ ipld.Node = (StructRepresentation_Map)(&_StructRepresentation_Map{})
+var _ schema.TypedNode = (StructRepresentation_Map)(&_StructRepresentation_Map{})
+
+func (StructRepresentation_Map) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_Map
+}
+func (n StructRepresentation_Map) LookupByString(key string) (ipld.Node, error) {
+	switch key {
+	case "fields":
+		if n.fields.m == schema.Maybe_Absent {
+			return ipld.Absent, nil
+		}
+		return n.fields.v, nil
+	default:
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+	}
+}
+func (n StructRepresentation_Map) LookupByNode(key ipld.Node) (ipld.Node, error) {
+	ks, err := key.AsString()
+	if err != nil {
+		return nil, err
+	}
+	return n.LookupByString(ks)
+}
+func (StructRepresentation_Map) LookupByIndex(idx int) (ipld.Node, error) {
+	return mixins.Map{"ast.StructRepresentation_Map"}.LookupByIndex(0)
+}
+func (n StructRepresentation_Map) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	return n.LookupByString(seg.String())
+}
+func (n StructRepresentation_Map) MapIterator() ipld.MapIterator {
+	return &_StructRepresentation_Map__MapItr{n, 0}
+}
+
+type _StructRepresentation_Map__MapItr struct {
+	n   StructRepresentation_Map
+	idx int
+}
+
+func (itr *_StructRepresentation_Map__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+	if itr.idx >= 1 {
+		return nil, nil, ipld.ErrIteratorOverread{}
+	}
+	switch itr.idx {
+	case 0:
+		k = &fieldName__StructRepresentation_Map_Fields
+		if itr.n.fields.m == schema.Maybe_Absent {
+			v = ipld.Absent
+			break
+		}
+		v = itr.n.fields.v
+	default:
+		panic("unreachable")
+	}
+	itr.idx++
+	return
+}
+func (itr *_StructRepresentation_Map__MapItr) Done() bool {
+	return itr.idx >= 1
+}
+
+func (StructRepresentation_Map) ListIterator() ipld.ListIterator {
+	return nil
+}
+func (StructRepresentation_Map) Length() int {
+	return 1
+}
+func (StructRepresentation_Map) IsAbsent() bool {
+	return false
+}
+func (StructRepresentation_Map) IsNull() bool {
+	return false
+}
+func (StructRepresentation_Map) AsBool() (bool, error) {
+	return mixins.Map{"ast.StructRepresentation_Map"}.AsBool()
+}
+func (StructRepresentation_Map) AsInt() (int64, error) {
+	return mixins.Map{"ast.StructRepresentation_Map"}.AsInt()
+}
+func (StructRepresentation_Map) AsFloat() (float64, error) {
+	return mixins.Map{"ast.StructRepresentation_Map"}.AsFloat()
+}
+func (StructRepresentation_Map) AsString() (string, error) {
+	return mixins.Map{"ast.StructRepresentation_Map"}.AsString()
+}
+func (StructRepresentation_Map) AsBytes() ([]byte, error) {
+	return mixins.Map{"ast.StructRepresentation_Map"}.AsBytes()
+}
+func (StructRepresentation_Map) AsLink() (ipld.Link, error) {
+	return mixins.Map{"ast.StructRepresentation_Map"}.AsLink()
+}
+func (StructRepresentation_Map) Prototype() ipld.NodePrototype {
+	return _StructRepresentation_Map__Prototype{}
+}
+
+type _StructRepresentation_Map__Prototype struct{}
+
+func (_StructRepresentation_Map__Prototype) NewBuilder() ipld.NodeBuilder {
+	var nb _StructRepresentation_Map__Builder
+	nb.Reset()
+	return &nb
+}
+
+type _StructRepresentation_Map__Builder struct {
+	_StructRepresentation_Map__Assembler
+}
+
+func (nb *_StructRepresentation_Map__Builder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_StructRepresentation_Map__Builder) Reset() {
+	var w _StructRepresentation_Map
+	var m schema.Maybe
+	*nb = _StructRepresentation_Map__Builder{_StructRepresentation_Map__Assembler{w: &w, m: &m}}
+}
+
+type _StructRepresentation_Map__Assembler struct {
+	w     *_StructRepresentation_Map
+	m     *schema.Maybe
+	state maState
+	s     int
+	f     int
+
+	cm        schema.Maybe
+	ca_fields _Map__FieldName__StructRepresentation_Map_FieldDetails__Assembler
+}
+
+func (na *_StructRepresentation_Map__Assembler) reset() {
+	na.state = maState_initial
+	na.s = 0
+	na.ca_fields.reset()
+}
+
+var (
+	fieldBit__StructRepresentation_Map_Fields      = 1 << 0
+	fieldBits__StructRepresentation_Map_sufficient = 0
+)
+
+func (na *_StructRepresentation_Map__Assembler) BeginMap(int) (ipld.MapAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: it makes no sense to 'begin' twice on the same assembler!")
+	}
+	*na.m = midvalue
+	if na.w == nil {
+		na.w = &_StructRepresentation_Map{}
+	}
+	return na, nil
+}
+func (_StructRepresentation_Map__Assembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.MapAssembler{"ast.StructRepresentation_Map"}.BeginList(0)
+}
+func (na *_StructRepresentation_Map__Assembler) AssignNull() error {
+	switch *na.m {
+	case allowNull:
+		*na.m = schema.Maybe_Null
+		return nil
+	case schema.Maybe_Absent:
+		return mixins.MapAssembler{"ast.StructRepresentation_Map"}.AssignNull()
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+	}
+	panic("unreachable")
+}
+func (_StructRepresentation_Map__Assembler) AssignBool(bool) error {
+	return mixins.MapAssembler{"ast.StructRepresentation_Map"}.AssignBool(false)
+}
+func (_StructRepresentation_Map__Assembler) AssignInt(int64) error {
+	return mixins.MapAssembler{"ast.StructRepresentation_Map"}.AssignInt(0)
+}
+func (_StructRepresentation_Map__Assembler) AssignFloat(float64) error {
+	return mixins.MapAssembler{"ast.StructRepresentation_Map"}.AssignFloat(0)
+}
+func (_StructRepresentation_Map__Assembler) AssignString(string) error {
+	return mixins.MapAssembler{"ast.StructRepresentation_Map"}.AssignString("")
+}
+func (_StructRepresentation_Map__Assembler) AssignBytes([]byte) error {
+	return mixins.MapAssembler{"ast.StructRepresentation_Map"}.AssignBytes(nil)
+}
+func (_StructRepresentation_Map__Assembler) AssignLink(ipld.Link) error {
+	return mixins.MapAssembler{"ast.StructRepresentation_Map"}.AssignLink(nil)
+}
+func (na *_StructRepresentation_Map__Assembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_StructRepresentation_Map); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		case midvalue:
+			panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	if v.ReprKind() != ipld.ReprKind_Map {
+		return ipld.ErrWrongKind{TypeName: "ast.StructRepresentation_Map", MethodName: "AssignNode", AppropriateKind: ipld.ReprKindSet_JustMap, ActualKind: v.ReprKind()}
+	}
+	itr := v.MapIterator()
+	for !itr.Done() {
+		k, v, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if err := na.AssembleKey().AssignNode(k); err != nil {
+			return err
+		}
+		if err := na.AssembleValue().AssignNode(v); err != nil {
+			return err
+		}
+	}
+	return na.Finish()
+}
+func (_StructRepresentation_Map__Assembler) Prototype() ipld.NodePrototype {
+	return _StructRepresentation_Map__Prototype{}
+}
+func (ma *_StructRepresentation_Map__Assembler) valueFinishTidy() bool {
+	switch ma.f {
+	case 0:
+		switch ma.w.fields.m {
+		case schema.Maybe_Value:
+			ma.w.fields.v = ma.ca_fields.w
+			ma.state = maState_initial
+			return true
+		default:
+			return false
+		}
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_StructRepresentation_Map__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleEntry cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleEntry cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleEntry cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleEntry cannot be called on an assembler that's already finished")
+	}
+	switch k {
+	case "fields":
+		if ma.s&fieldBit__StructRepresentation_Map_Fields != 0 {
+			return nil, ipld.ErrRepeatedMapKey{&fieldName__StructRepresentation_Map_Fields}
+		}
+		ma.s += fieldBit__StructRepresentation_Map_Fields
+		ma.state = maState_midValue
+		ma.f = 0
+		ma.ca_fields.w = ma.w.fields.v
+		ma.ca_fields.m = &ma.w.fields.m
+		return &ma.ca_fields, nil
+	default:
+		return nil, ipld.ErrInvalidKey{TypeName: "ast.StructRepresentation_Map", Key: &_String{k}}
+	}
+}
+func (ma *_StructRepresentation_Map__Assembler) AssembleKey() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleKey cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleKey cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleKey cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleKey cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midKey
+	return (*_StructRepresentation_Map__KeyAssembler)(ma)
+}
+func (ma *_StructRepresentation_Map__Assembler) AssembleValue() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		panic("invalid state: AssembleValue cannot be called when no key is primed")
+	case maState_midKey:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		// carry on
+	case maState_midValue:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling another value")
+	case maState_finished:
+		panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midValue
+	switch ma.f {
+	case 0:
+		ma.ca_fields.w = ma.w.fields.v
+		ma.ca_fields.m = &ma.w.fields.m
+		return &ma.ca_fields
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_StructRepresentation_Map__Assembler) Finish() error {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: Finish cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		panic("invalid state: Finish cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: Finish cannot be called on an assembler that's already finished")
+	}
+	//FIXME check if all required fields are set
+	ma.state = maState_finished
+	*ma.m = schema.Maybe_Value
+	return nil
+}
+func (ma *_StructRepresentation_Map__Assembler) KeyPrototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (ma *_StructRepresentation_Map__Assembler) ValuePrototype(k string) ipld.NodePrototype {
+	panic("todo structbuilder mapassembler valueprototype")
+}
+
+type _StructRepresentation_Map__KeyAssembler _StructRepresentation_Map__Assembler
+
+func (_StructRepresentation_Map__KeyAssembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	return mixins.StringAssembler{"ast.StructRepresentation_Map.KeyAssembler"}.BeginMap(0)
+}
+func (_StructRepresentation_Map__KeyAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.StringAssembler{"ast.StructRepresentation_Map.KeyAssembler"}.BeginList(0)
+}
+func (na *_StructRepresentation_Map__KeyAssembler) AssignNull() error {
+	return mixins.StringAssembler{"ast.StructRepresentation_Map.KeyAssembler"}.AssignNull()
+}
+func (_StructRepresentation_Map__KeyAssembler) AssignBool(bool) error {
+	return mixins.StringAssembler{"ast.StructRepresentation_Map.KeyAssembler"}.AssignBool(false)
+}
+func (_StructRepresentation_Map__KeyAssembler) AssignInt(int64) error {
+	return mixins.StringAssembler{"ast.StructRepresentation_Map.KeyAssembler"}.AssignInt(0)
+}
+func (_StructRepresentation_Map__KeyAssembler) AssignFloat(float64) error {
+	return mixins.StringAssembler{"ast.StructRepresentation_Map.KeyAssembler"}.AssignFloat(0)
+}
+func (ka *_StructRepresentation_Map__KeyAssembler) AssignString(k string) error {
+	if ka.state != maState_midKey {
+		panic("misuse: KeyAssembler held beyond its valid lifetime")
+	}
+	switch k {
+	case "fields":
+		if ka.s&fieldBit__StructRepresentation_Map_Fields != 0 {
+			return ipld.ErrRepeatedMapKey{&fieldName__StructRepresentation_Map_Fields}
+		}
+		ka.s += fieldBit__StructRepresentation_Map_Fields
+		ka.state = maState_expectValue
+		ka.f = 0
+	default:
+		return ipld.ErrInvalidKey{TypeName: "ast.StructRepresentation_Map", Key: &_String{k}}
+	}
+	return nil
+}
+func (_StructRepresentation_Map__KeyAssembler) AssignBytes([]byte) error {
+	return mixins.StringAssembler{"ast.StructRepresentation_Map.KeyAssembler"}.AssignBytes(nil)
+}
+func (_StructRepresentation_Map__KeyAssembler) AssignLink(ipld.Link) error {
+	return mixins.StringAssembler{"ast.StructRepresentation_Map.KeyAssembler"}.AssignLink(nil)
+}
+func (ka *_StructRepresentation_Map__KeyAssembler) AssignNode(v ipld.Node) error {
+	if v2, err := v.AsString(); err != nil {
+		return err
+	} else {
+		return ka.AssignString(v2)
+	}
+}
+func (_StructRepresentation_Map__KeyAssembler) Prototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (StructRepresentation_Map) Type() schema.Type {
+	return nil /*TODO:typelit*/
+}
+func (n StructRepresentation_Map) Representation() ipld.Node {
+	return (*_StructRepresentation_Map__Repr)(n)
+}
+
+type _StructRepresentation_Map__Repr _StructRepresentation_Map
+
+var (
+	fieldName__StructRepresentation_Map_Fields_serial = _String{"fields"}
+)
+var _ ipld.Node = &_StructRepresentation_Map__Repr{}
+
+func (_StructRepresentation_Map__Repr) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_Map
+}
+func (n *_StructRepresentation_Map__Repr) LookupByString(key string) (ipld.Node, error) {
+	switch key {
+	case "fields":
+		if n.fields.m == schema.Maybe_Absent {
+			return ipld.Absent, ipld.ErrNotExists{ipld.PathSegmentOfString(key)}
+		}
+		return n.fields.v.Representation(), nil
+	default:
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+	}
+}
+func (n *_StructRepresentation_Map__Repr) LookupByNode(key ipld.Node) (ipld.Node, error) {
+	ks, err := key.AsString()
+	if err != nil {
+		return nil, err
+	}
+	return n.LookupByString(ks)
+}
+func (_StructRepresentation_Map__Repr) LookupByIndex(idx int) (ipld.Node, error) {
+	return mixins.Map{"ast.StructRepresentation_Map.Repr"}.LookupByIndex(0)
+}
+func (n _StructRepresentation_Map__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	return n.LookupByString(seg.String())
+}
+func (n *_StructRepresentation_Map__Repr) MapIterator() ipld.MapIterator {
+	end := 1
+	if n.fields.m == schema.Maybe_Absent {
+		end = 0
+	} else {
+		goto done
+	}
+done:
+	return &_StructRepresentation_Map__ReprMapItr{n, 0, end}
+}
+
+type _StructRepresentation_Map__ReprMapItr struct {
+	n   *_StructRepresentation_Map__Repr
+	idx int
+	end int
+}
+
+func (itr *_StructRepresentation_Map__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+advance:
+	if itr.idx >= 1 {
+		return nil, nil, ipld.ErrIteratorOverread{}
+	}
+	switch itr.idx {
+	case 0:
+		k = &fieldName__StructRepresentation_Map_Fields_serial
+		if itr.n.fields.m == schema.Maybe_Absent {
+			itr.idx++
+			goto advance
+		}
+		v = itr.n.fields.v.Representation()
+	default:
+		panic("unreachable")
+	}
+	itr.idx++
+	return
+}
+func (itr *_StructRepresentation_Map__ReprMapItr) Done() bool {
+	return itr.idx >= itr.end
+}
+func (_StructRepresentation_Map__Repr) ListIterator() ipld.ListIterator {
+	return nil
+}
+func (rn *_StructRepresentation_Map__Repr) Length() int {
+	l := 1
+	if rn.fields.m == schema.Maybe_Absent {
+		l--
+	}
+	return l
+}
+func (_StructRepresentation_Map__Repr) IsAbsent() bool {
+	return false
+}
+func (_StructRepresentation_Map__Repr) IsNull() bool {
+	return false
+}
+func (_StructRepresentation_Map__Repr) AsBool() (bool, error) {
+	return mixins.Map{"ast.StructRepresentation_Map.Repr"}.AsBool()
+}
+func (_StructRepresentation_Map__Repr) AsInt() (int64, error) {
+	return mixins.Map{"ast.StructRepresentation_Map.Repr"}.AsInt()
+}
+func (_StructRepresentation_Map__Repr) AsFloat() (float64, error) {
+	return mixins.Map{"ast.StructRepresentation_Map.Repr"}.AsFloat()
+}
+func (_StructRepresentation_Map__Repr) AsString() (string, error) {
+	return mixins.Map{"ast.StructRepresentation_Map.Repr"}.AsString()
+}
+func (_StructRepresentation_Map__Repr) AsBytes() ([]byte, error) {
+	return mixins.Map{"ast.StructRepresentation_Map.Repr"}.AsBytes()
+}
+func (_StructRepresentation_Map__Repr) AsLink() (ipld.Link, error) {
+	return mixins.Map{"ast.StructRepresentation_Map.Repr"}.AsLink()
+}
+func (_StructRepresentation_Map__Repr) Prototype() ipld.NodePrototype {
+	return _StructRepresentation_Map__ReprPrototype{}
+}
+
+type _StructRepresentation_Map__ReprPrototype struct{}
+
+func (_StructRepresentation_Map__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+	var nb _StructRepresentation_Map__ReprBuilder
+	nb.Reset()
+	return &nb
+}
+
+type _StructRepresentation_Map__ReprBuilder struct {
+	_StructRepresentation_Map__ReprAssembler
+}
+
+func (nb *_StructRepresentation_Map__ReprBuilder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_StructRepresentation_Map__ReprBuilder) Reset() {
+	var w _StructRepresentation_Map
+	var m schema.Maybe
+	*nb = _StructRepresentation_Map__ReprBuilder{_StructRepresentation_Map__ReprAssembler{w: &w, m: &m}}
+}
+
+type _StructRepresentation_Map__ReprAssembler struct {
+	w     *_StructRepresentation_Map
+	m     *schema.Maybe
+	state maState
+	s     int
+	f     int
+
+	cm        schema.Maybe
+	ca_fields _Map__FieldName__StructRepresentation_Map_FieldDetails__ReprAssembler
+}
+
+func (na *_StructRepresentation_Map__ReprAssembler) reset() {
+	na.state = maState_initial
+	na.s = 0
+	na.ca_fields.reset()
+}
+func (na *_StructRepresentation_Map__ReprAssembler) BeginMap(int) (ipld.MapAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: it makes no sense to 'begin' twice on the same assembler!")
+	}
+	*na.m = midvalue
+	if na.w == nil {
+		na.w = &_StructRepresentation_Map{}
+	}
+	return na, nil
+}
+func (_StructRepresentation_Map__ReprAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.MapAssembler{"ast.StructRepresentation_Map.Repr"}.BeginList(0)
+}
+func (na *_StructRepresentation_Map__ReprAssembler) AssignNull() error {
+	switch *na.m {
+	case allowNull:
+		*na.m = schema.Maybe_Null
+		return nil
+	case schema.Maybe_Absent:
+		return mixins.MapAssembler{"ast.StructRepresentation_Map.Repr.Repr"}.AssignNull()
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+	}
+	panic("unreachable")
+}
+func (_StructRepresentation_Map__ReprAssembler) AssignBool(bool) error {
+	return mixins.MapAssembler{"ast.StructRepresentation_Map.Repr"}.AssignBool(false)
+}
+func (_StructRepresentation_Map__ReprAssembler) AssignInt(int64) error {
+	return mixins.MapAssembler{"ast.StructRepresentation_Map.Repr"}.AssignInt(0)
+}
+func (_StructRepresentation_Map__ReprAssembler) AssignFloat(float64) error {
+	return mixins.MapAssembler{"ast.StructRepresentation_Map.Repr"}.AssignFloat(0)
+}
+func (_StructRepresentation_Map__ReprAssembler) AssignString(string) error {
+	return mixins.MapAssembler{"ast.StructRepresentation_Map.Repr"}.AssignString("")
+}
+func (_StructRepresentation_Map__ReprAssembler) AssignBytes([]byte) error {
+	return mixins.MapAssembler{"ast.StructRepresentation_Map.Repr"}.AssignBytes(nil)
+}
+func (_StructRepresentation_Map__ReprAssembler) AssignLink(ipld.Link) error {
+	return mixins.MapAssembler{"ast.StructRepresentation_Map.Repr"}.AssignLink(nil)
+}
+func (na *_StructRepresentation_Map__ReprAssembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_StructRepresentation_Map); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		case midvalue:
+			panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	if v.ReprKind() != ipld.ReprKind_Map {
+		return ipld.ErrWrongKind{TypeName: "ast.StructRepresentation_Map.Repr", MethodName: "AssignNode", AppropriateKind: ipld.ReprKindSet_JustMap, ActualKind: v.ReprKind()}
+	}
+	itr := v.MapIterator()
+	for !itr.Done() {
+		k, v, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if err := na.AssembleKey().AssignNode(k); err != nil {
+			return err
+		}
+		if err := na.AssembleValue().AssignNode(v); err != nil {
+			return err
+		}
+	}
+	return na.Finish()
+}
+func (_StructRepresentation_Map__ReprAssembler) Prototype() ipld.NodePrototype {
+	return _StructRepresentation_Map__ReprPrototype{}
+}
+func (ma *_StructRepresentation_Map__ReprAssembler) valueFinishTidy() bool {
+	switch ma.f {
+	case 0:
+		switch ma.w.fields.m {
+		case schema.Maybe_Value:
+			ma.w.fields.v = ma.ca_fields.w
+			ma.state = maState_initial
+			return true
+		default:
+			return false
+		}
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_StructRepresentation_Map__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleEntry cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleEntry cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleEntry cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleEntry cannot be called on an assembler that's already finished")
+	}
+	switch k {
+	case "fields":
+		if ma.s&fieldBit__StructRepresentation_Map_Fields != 0 {
+			return nil, ipld.ErrRepeatedMapKey{&fieldName__StructRepresentation_Map_Fields_serial}
+		}
+		ma.s += fieldBit__StructRepresentation_Map_Fields
+		ma.state = maState_midValue
+		ma.f = 0
+		ma.ca_fields.w = ma.w.fields.v
+		ma.ca_fields.m = &ma.w.fields.m
+
+		return &ma.ca_fields, nil
+	default:
+		return nil, ipld.ErrInvalidKey{TypeName: "ast.StructRepresentation_Map.Repr", Key: &_String{k}}
+	}
+}
+func (ma *_StructRepresentation_Map__ReprAssembler) AssembleKey() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleKey cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleKey cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleKey cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleKey cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midKey
+	return (*_StructRepresentation_Map__ReprKeyAssembler)(ma)
+}
+func (ma *_StructRepresentation_Map__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		panic("invalid state: AssembleValue cannot be called when no key is primed")
+	case maState_midKey:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		// carry on
+	case maState_midValue:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling another value")
+	case maState_finished:
+		panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midValue
+	switch ma.f {
+	case 0:
+		ma.ca_fields.w = ma.w.fields.v
+		ma.ca_fields.m = &ma.w.fields.m
+
+		return &ma.ca_fields
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_StructRepresentation_Map__ReprAssembler) Finish() error {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: Finish cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		panic("invalid state: Finish cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: Finish cannot be called on an assembler that's already finished")
+	}
+	//FIXME check if all required fields are set
+	ma.state = maState_finished
+	*ma.m = schema.Maybe_Value
+	return nil
+}
+func (ma *_StructRepresentation_Map__ReprAssembler) KeyPrototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (ma *_StructRepresentation_Map__ReprAssembler) ValuePrototype(k string) ipld.NodePrototype {
+	panic("todo structbuilder mapassembler repr valueprototype")
+}
+
+type _StructRepresentation_Map__ReprKeyAssembler _StructRepresentation_Map__ReprAssembler
+
+func (_StructRepresentation_Map__ReprKeyAssembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	return mixins.StringAssembler{"ast.StructRepresentation_Map.Repr.KeyAssembler"}.BeginMap(0)
+}
+func (_StructRepresentation_Map__ReprKeyAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.StringAssembler{"ast.StructRepresentation_Map.Repr.KeyAssembler"}.BeginList(0)
+}
+func (na *_StructRepresentation_Map__ReprKeyAssembler) AssignNull() error {
+	return mixins.StringAssembler{"ast.StructRepresentation_Map.Repr.KeyAssembler"}.AssignNull()
+}
+func (_StructRepresentation_Map__ReprKeyAssembler) AssignBool(bool) error {
+	return mixins.StringAssembler{"ast.StructRepresentation_Map.Repr.KeyAssembler"}.AssignBool(false)
+}
+func (_StructRepresentation_Map__ReprKeyAssembler) AssignInt(int64) error {
+	return mixins.StringAssembler{"ast.StructRepresentation_Map.Repr.KeyAssembler"}.AssignInt(0)
+}
+func (_StructRepresentation_Map__ReprKeyAssembler) AssignFloat(float64) error {
+	return mixins.StringAssembler{"ast.StructRepresentation_Map.Repr.KeyAssembler"}.AssignFloat(0)
+}
+func (ka *_StructRepresentation_Map__ReprKeyAssembler) AssignString(k string) error {
+	if ka.state != maState_midKey {
+		panic("misuse: KeyAssembler held beyond its valid lifetime")
+	}
+	switch k {
+	case "fields":
+		if ka.s&fieldBit__StructRepresentation_Map_Fields != 0 {
+			return ipld.ErrRepeatedMapKey{&fieldName__StructRepresentation_Map_Fields_serial}
+		}
+		ka.s += fieldBit__StructRepresentation_Map_Fields
+		ka.state = maState_expectValue
+		ka.f = 0
+	default:
+		return ipld.ErrInvalidKey{TypeName: "ast.StructRepresentation_Map.Repr", Key: &_String{k}}
+	}
+	return nil
+}
+func (_StructRepresentation_Map__ReprKeyAssembler) AssignBytes([]byte) error {
+	return mixins.StringAssembler{"ast.StructRepresentation_Map.Repr.KeyAssembler"}.AssignBytes(nil)
+}
+func (_StructRepresentation_Map__ReprKeyAssembler) AssignLink(ipld.Link) error {
+	return mixins.StringAssembler{"ast.StructRepresentation_Map.Repr.KeyAssembler"}.AssignLink(nil)
+}
+func (ka *_StructRepresentation_Map__ReprKeyAssembler) AssignNode(v ipld.Node) error {
+	if v2, err := v.AsString(); err != nil {
+		return err
+	} else {
+		return ka.AssignString(v2)
+	}
+}
+func (_StructRepresentation_Map__ReprKeyAssembler) Prototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
@@ -0,0 +1,867 @@
+package ast
+
+// Code generated by go-ipld-prime gengo.  DO NOT EDIT.
+
+import (
+	ipld "github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/node/mixins"
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+type _StructRepresentation_Tuple struct {
+	fieldOrder _List__FieldName__Maybe
+}
+type StructRepresentation_Tuple = *_StructRepresentation_Tuple
+
+func (n _StructRepresentation_Tuple) FieldFieldOrder() MaybeList__FieldName {
+	return &n.fieldOrder
+}
+
+type _StructRepresentation_Tuple__Maybe struct {
+	m schema.Maybe
+	v StructRepresentation_Tuple
+}
+type MaybeStructRepresentation_Tuple = *_StructRepresentation_Tuple__Maybe
+
+func (m MaybeStructRepresentation_Tuple) IsNull() bool {
+	return m.m == schema.Maybe_Null
+}
+func (m MaybeStructRepresentation_Tuple) IsAbsent() bool {
+	return m.m == schema.Maybe_Absent
+}
+func (m MaybeStructRepresentation_Tuple) Exists() bool {
+	return m.m == schema.Maybe_Value
+}
+func (m MaybeStructRepresentation_Tuple) AsNode() ipld.Node {
+	switch m.m {
+	case schema.Maybe_Absent:
+		return ipld.Absent
+	case schema.Maybe_Null:
+		return ipld.Null
+	case schema.Maybe_Value:
+		return m.v
+	default:
+		panic("unreachable")
+	}
+}
+func (m MaybeStructRepresentation_Tuple) Must() StructRepresentation_Tuple {
+	if !m.Exists() {
+		panic("unbox of a maybe rejected")
+	}
+	return m.v
+}
+
+var (
+	fieldName__StructRepresentation_Tuple_FieldOrder = _String{"fieldOrder"}
+)
+var _ ipld.Node = (StructRepresentation_Tuple)(&_StructRepresentation_Tuple{})
+var _ schema.TypedNode = (StructRepresentation_Tuple)(&_StructRepresentation_Tuple{})
+
+func (StructRepresentation_Tuple) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_Map
+}
+func (n StructRepresentation_Tuple) LookupByString(key string) (ipld.Node, error) {
+	switch key {
+	case "fieldOrder":
+		if n.fieldOrder.m == schema.Maybe_Absent {
+			return ipld.Absent, nil
+		}
+		return n.fieldOrder.v, nil
+	default:
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+	}
+}
+func (n StructRepresentation_Tuple) LookupByNode(key ipld.Node) (ipld.Node, error) {
+	ks, err := key.AsString()
+	if err != nil {
+		return nil, err
+	}
+	return n.LookupByString(ks)
+}
+func (StructRepresentation_Tuple) LookupByIndex(idx int) (ipld.Node, error) {
+	return mixins.Map{"ast.StructRepresentation_Tuple"}.LookupByIndex(0)
+}
+func (n StructRepresentation_Tuple) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	return n.LookupByString(seg.String())
+}
+func (n StructRepresentation_Tuple) MapIterator() ipld.MapIterator {
+	return &_StructRepresentation_Tuple__MapItr{n, 0}
+}
+
+type _StructRepresentation_Tuple__MapItr struct {
+	n   StructRepresentation_Tuple
+	idx int
+}
+
+func (itr *_StructRepresentation_Tuple__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+	if itr.idx >= 1 {
+		return nil, nil, ipld.ErrIteratorOverread{}
+	}
+	switch itr.idx {
+	case 0:
+		k = &fieldName__StructRepresentation_Tuple_FieldOrder
+		if itr.n.fieldOrder.m == schema.Maybe_Absent {
+			v = ipld.Absent
+			break
+		}
+		v = itr.n.fieldOrder.v
+	default:
+		panic("unreachable")
+	}
+	itr.idx++
+	return
+}
+func (itr *_StructRepresentation_Tuple__MapItr) Done() bool {
+	return itr.idx >= 1
+}
+
+func (StructRepresentation_Tuple) ListIterator() ipld.ListIterator {
+	return nil
+}
+func (StructRepresentation_Tuple) Length() int {
+	return 1
+}
+func (StructRepresentation_Tuple) IsAbsent() bool {
+	return false
+}
+func (StructRepresentation_Tuple) IsNull() bool {
+	return false
+}
+func (StructRepresentation_Tuple) AsBool() (bool, error) {
+	return mixins.Map{"ast.StructRepresentation_Tuple"}.AsBool()
+}
+func (StructRepresentation_Tuple) AsInt() (int64, error) {
+	return mixins.Map{"ast.StructRepresentation_Tuple"}.AsInt()
+}
+func (StructRepresentation_Tuple) AsFloat() (float64, error) {
+	return mixins.Map{"ast.StructRepresentation_Tuple"}.AsFloat()
+}
+func (StructRepresentation_Tuple) AsString() (string, error) {
+	return mixins.Map{"ast.StructRepresentation_Tuple"}.AsString()
+}
+func (StructRepresentation_Tuple) AsBytes() ([]byte, error) {
+	return mixins.Map{"ast.StructRepresentation_Tuple"}.AsBytes()
+}
+func (StructRepresentation_Tuple) AsLink() (ipld.Link, error) {
+	return mixins.Map{"ast.StructRepresentation_Tuple"}.AsLink()
+}
+func (StructRepresentation_Tuple) Prototype() ipld.NodePrototype {
+	return _StructRepresentation_Tuple__Prototype{}
+}
+
+type _StructRepresentation_Tuple__Prototype struct{}
+
+func (_StructRepresentation_Tuple__Prototype) NewBuilder() ipld.NodeBuilder {
+	var nb _StructRepresentation_Tuple__Builder
+	nb.Reset()
+	return &nb
+}
+
+type _StructRepresentation_Tuple__Builder struct {
+	_StructRepresentation_Tuple__Assembler
+}
+
+func (nb *_StructRepresentation_Tuple__Builder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_StructRepresentation_Tuple__Builder) Reset() {
+	var w _StructRepresentation_Tuple
+	var m schema.Maybe
+	*nb = _StructRepresentation_Tuple__Builder{_StructRepresentation_Tuple__Assembler{w: &w, m: &m}}
+}
+
+type _StructRepresentation_Tuple__Assembler struct {
+	w     *_StructRepresentation_Tuple
+	m     *schema.Maybe
+	state maState
+	s     int
+	f     int
+
+	cm            schema.Maybe
+	ca_fieldOrder _List__FieldName__Assembler
+}
+
+func (na *_StructRepresentation_Tuple__Assembler) reset() {
+	na.state = maState_initial
+	na.s = 0
+	na.ca_fieldOrder.reset()
+}
+
+var (
+	fieldBit__StructRepresentation_Tuple_FieldOrder  = 1 << 0
+	fieldBits__StructRepresentation_Tuple_sufficient = 0
+)
+
+func (na *_StructRepresentation_Tuple__Assembler) BeginMap(int) (ipld.MapAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: it makes no sense to 'begin' twice on the same assembler!")
+	}
+	*na.m = midvalue
+	if na.w == nil {
+		na.w = &_StructRepresentation_Tuple{}
+	}
+	return na, nil
+}
+func (_StructRepresentation_Tuple__Assembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.MapAssembler{"ast.StructRepresentation_Tuple"}.BeginList(0)
+}
+func (na *_StructRepresentation_Tuple__Assembler) AssignNull() error {
+	switch *na.m {
+	case allowNull:
+		*na.m = schema.Maybe_Null
+		return nil
+	case schema.Maybe_Absent:
+		return mixins.MapAssembler{"ast.StructRepresentation_Tuple"}.AssignNull()
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+	}
+	panic("unreachable")
+}
+func (_StructRepresentation_Tuple__Assembler) AssignBool(bool) error {
+	return mixins.MapAssembler{"ast.StructRepresentation_Tuple"}.AssignBool(false)
+}
+func (_StructRepresentation_Tuple__Assembler) AssignInt(int64) error {
+	return mixins.MapAssembler{"ast.StructRepresentation_Tuple"}.AssignInt(0)
+}
+func (_StructRepresentation_Tuple__Assembler) AssignFloat(float64) error {
+	return mixins.MapAssembler{"ast.StructRepresentation_Tuple"}.AssignFloat(0)
+}
+func (_StructRepresentation_Tuple__Assembler) AssignString(string) error {
+	return mixins.MapAssembler{"ast.StructRepresentation_Tuple"}.AssignString("")
+}
+func (_StructRepresentation_Tuple__Assembler) AssignBytes([]byte) error {
+	return mixins.MapAssembler{"ast.StructRepresentation_Tuple"}.AssignBytes(nil)
+}
+func (_StructRepresentation_Tuple__Assembler) AssignLink(ipld.Link) error {
+	return mixins.MapAssembler{"ast.StructRepresentation_Tuple"}.AssignLink(nil)
+}
+func (na *_StructRepresentation_Tuple__Assembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_StructRepresentation_Tuple); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		case midvalue:
+			panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	if v.ReprKind() != ipld.ReprKind_Map {
+		return ipld.ErrWrongKind{TypeName: "ast.StructRepresentation_Tuple", MethodName: "AssignNode", AppropriateKind: ipld.ReprKindSet_JustMap, ActualKind: v.ReprKind()}
+	}
+	itr := v.MapIterator()
+	for !itr.Done() {
+		k, v, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if err := na.AssembleKey().AssignNode(k); err != nil {
+			return err
+		}
+		if err := na.AssembleValue().AssignNode(v); err != nil {
+			return err
+		}
+	}
+	return na.Finish()
+}
+func (_StructRepresentation_Tuple__Assembler) Prototype() ipld.NodePrototype {
+	return _StructRepresentation_Tuple__Prototype{}
+}
+func (ma *_StructRepresentation_Tuple__Assembler) valueFinishTidy() bool {
+	switch ma.f {
+	case 0:
+		switch ma.w.fieldOrder.m {
+		case schema.Maybe_Value:
+			ma.w.fieldOrder.v = ma.ca_fieldOrder.w
+			ma.state = maState_initial
+			return true
+		default:
+			return false
+		}
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_StructRepresentation_Tuple__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleEntry cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleEntry cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleEntry cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleEntry cannot be called on an assembler that's already finished")
+	}
+	switch k {
+	case "fieldOrder":
+		if ma.s&fieldBit__StructRepresentation_Tuple_FieldOrder != 0 {
+			return nil, ipld.ErrRepeatedMapKey{&fieldName__StructRepresentation_Tuple_FieldOrder}
+		}
+		ma.s += fieldBit__StructRepresentation_Tuple_FieldOrder
+		ma.state = maState_midValue
+		ma.f = 0
+		ma.ca_fieldOrder.w = ma.w.fieldOrder.v
+		ma.ca_fieldOrder.m = &ma.w.fieldOrder.m
+		return &ma.ca_fieldOrder, nil
+	default:
+		return nil, ipld.ErrInvalidKey{TypeName: "ast.StructRepresentation_Tuple", Key: &_String{k}}
+	}
+}
+func (ma *_StructRepresentation_Tuple__Assembler) AssembleKey() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleKey cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleKey cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleKey cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleKey cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midKey
+	return (*_StructRepresentation_Tuple__KeyAssembler)(ma)
+}
+func (ma *_StructRepresentation_Tuple__Assembler) AssembleValue() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		panic("invalid state: AssembleValue cannot be called when no key is primed")
+	case maState_midKey:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		// carry on
+	case maState_midValue:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling another value")
+	case maState_finished:
+		panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midValue
+	switch ma.f {
+	case 0:
+		ma.ca_fieldOrder.w = ma.w.fieldOrder.v
+		ma.ca_fieldOrder.m = &ma.w.fieldOrder.m
+		return &ma.ca_fieldOrder
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_StructRepresentation_Tuple__Assembler) Finish() error {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: Finish cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		panic("invalid state: Finish cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: Finish cannot be called on an assembler that's already finished")
+	}
+	//FIXME check if all required fields are set
+	ma.state = maState_finished
+	*ma.m = schema.Maybe_Value
+	return nil
+}
+func (ma *_StructRepresentation_Tuple__Assembler) KeyPrototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (ma *_StructRepresentation_Tuple__Assembler) ValuePrototype(k string) ipld.NodePrototype {
+	panic("todo structbuilder mapassembler valueprototype")
+}
+
+type _StructRepresentation_Tuple__KeyAssembler _StructRepresentation_Tuple__Assembler
+
+func (_StructRepresentation_Tuple__KeyAssembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	return mixins.StringAssembler{"ast.StructRepresentation_Tuple.KeyAssembler"}.BeginMap(0)
+}
+func (_StructRepresentation_Tuple__KeyAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.StringAssembler{"ast.StructRepresentation_Tuple.KeyAssembler"}.BeginList(0)
+}
+func (na *_StructRepresentation_Tuple__KeyAssembler) AssignNull() error {
+	return mixins.StringAssembler{"ast.StructRepresentation_Tuple.KeyAssembler"}.AssignNull()
+}
+func (_StructRepresentation_Tuple__KeyAssembler) AssignBool(bool) error {
+	return mixins.StringAssembler{"ast.StructRepresentation_Tuple.KeyAssembler"}.AssignBool(false)
+}
+func (_StructRepresentation_Tuple__KeyAssembler) AssignInt(int64) error {
+	return mixins.StringAssembler{"ast.StructRepresentation_Tuple.KeyAssembler"}.AssignInt(0)
+}
+func (_StructRepresentation_Tuple__KeyAssembler) AssignFloat(float64) error {
+	return mixins.StringAssembler{"ast.StructRepresentation_Tuple.KeyAssembler"}.AssignFloat(0)
+}
+func (ka *_StructRepresentation_Tuple__KeyAssembler) AssignString(k string) error {
+	if ka.state != maState_midKey {
+		panic("misuse: KeyAssembler held beyond its valid lifetime")
+	}
+	switch k {
+	case "fieldOrder":
+		if ka.s&fieldBit__StructRepresentation_Tuple_FieldOrder != 0 {
+			return ipld.ErrRepeatedMapKey{&fieldName__StructRepresentation_Tuple_FieldOrder}
+		}
+		ka.s += fieldBit__StructRepresentation_Tuple_FieldOrder
+		ka.state = maState_expectValue
+		ka.f = 0
+	default:
+		return ipld.ErrInvalidKey{TypeName: "ast.StructRepresentation_Tuple", Key: &_String{k}}
+	}
+	return nil
+}
+func (_StructRepresentation_Tuple__KeyAssembler) AssignBytes([]byte) error {
+	return mixins.StringAssembler{"ast.StructRepresentation_Tuple.KeyAssembler"}.AssignBytes(nil)
+}
+func (_StructRepresentation_Tuple__KeyAssembler) AssignLink(ipld.Link) error {
+	return mixins.StringAssembler{"ast.StructRepresentation_Tuple.KeyAssembler"}.AssignLink(nil)
+}
+func (ka *_StructRepresentation_Tuple__KeyAssembler) AssignNode(v ipld.Node) error {
+	if v2, err := v.AsString(); err != nil {
+		return err
+	} else {
+		return ka.AssignString(v2)
+	}
+}
+func (_StructRepresentation_Tuple__KeyAssembler) Prototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (StructRepresentation_Tuple) Type() schema.Type {
+	return nil /*TODO:typelit*/
+}
+func (n StructRepresentation_Tuple) Representation() ipld.Node {
+	return (*_StructRepresentation_Tuple__Repr)(n)
+}
+
+type _StructRepresentation_Tuple__Repr _StructRepresentation_Tuple
+
+var (
+	fieldName__StructRepresentation_Tuple_FieldOrder_serial = _String{"fieldOrder"}
+)
+var _ ipld.Node = &_StructRepresentation_Tuple__Repr{}
+
+func (_StructRepresentation_Tuple__Repr) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_Map
+}
+func (n *_StructRepresentation_Tuple__Repr) LookupByString(key string) (ipld.Node, error) {
+	switch key {
+	case "fieldOrder":
+		if n.fieldOrder.m == schema.Maybe_Absent {
+			return ipld.Absent, ipld.ErrNotExists{ipld.PathSegmentOfString(key)}
+		}
+		return n.fieldOrder.v.Representation(), nil
+	default:
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+	}
+}
+func (n *_StructRepresentation_Tuple__Repr) LookupByNode(key ipld.Node) (ipld.Node, error) {
+	ks, err := key.AsString()
+	if err != nil {
+		return nil, err
+	}
+	return n.LookupByString(ks)
+}
+func (_StructRepresentation_Tuple__Repr) LookupByIndex(idx int) (ipld.Node, error) {
+	return mixins.Map{"ast.StructRepresentation_Tuple.Repr"}.LookupByIndex(0)
+}
+func (n _StructRepresentation_Tuple__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	return n.LookupByString(seg.String())
+}
+func (n *_StructRepresentation_Tuple__Repr) MapIterator() ipld.MapIterator {
+	end := 1
+	if n.fieldOrder.m == schema.Maybe_Absent {
+		end = 0
+	} else {
+		goto done
+	}
+done:
+	return &_StructRepresentation_Tuple__ReprMapItr{n, 0, end}
+}
+
+type _StructRepresentation_Tuple__ReprMapItr struct {
+	n   *_StructRepresentation_Tuple__Repr
+	idx int
+	end int
+}
+
+func (itr *_StructRepresentation_Tuple__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+advance:
+	if itr.idx >= 1 {
+		return nil, nil, ipld.ErrIteratorOverread{}
+	}
+	switch itr.idx {
+	case 0:
+		k = &fieldName__StructRepresentation_Tuple_FieldOrder_serial
+		if itr.n.fieldOrder.m == schema.Maybe_Absent {
+			itr.idx++
+			goto advance
+		}
+		v = itr.n.fieldOrder.v.Representation()
+	default:
+		panic("unreachable")
+	}
+	itr.idx++
+	return
+}
+func (itr *_StructRepresentation_Tuple__ReprMapItr) Done() bool {
+	return itr.idx >= itr.end
+}
+func (_StructRepresentation_Tuple__Repr) ListIterator() ipld.ListIterator {
+	return nil
+}
+func (rn *_StructRepresentation_Tuple__Repr) Length() int {
+	l := 1
+	if rn.fieldOrder.m == schema.Maybe_Absent {
+		l--
+	}
+	return l
+}
+func (_StructRepresentation_Tuple__Repr) IsAbsent() bool {
+	return false
+}
+func (_StructRepresentation_Tuple__Repr) IsNull() bool {
+	return false
+}
+func (_StructRepresentation_Tuple__Repr) AsBool() (bool, error) {
+	return mixins.Map{"ast.StructRepresentation_Tuple.Repr"}.AsBool()
+}
+func (_StructRepresentation_Tuple__Repr) AsInt() (int64, error) {
+	return mixins.Map{"ast.StructRepresentation_Tuple.Repr"}.AsInt()
+}
+func (_StructRepresentation_Tuple__Repr) AsFloat() (float64, error) {
+	return mixins.Map{"ast.StructRepresentation_Tuple.Repr"}.AsFloat()
+}
+func (_StructRepresentation_Tuple__Repr) AsString() (string, error) {
+	return mixins.Map{"ast.StructRepresentation_Tuple.Repr"}.AsString()
+}
+func (_StructRepresentation_Tuple__Repr) AsBytes() ([]byte, error) {
+	return mixins.Map{"ast.StructRepresentation_Tuple.Repr"}.AsBytes()
+}
+func (_StructRepresentation_Tuple__Repr) AsLink() (ipld.Link, error) {
+	return mixins.Map{"ast.StructRepresentation_Tuple.Repr"}.AsLink()
+}
+func (_StructRepresentation_Tuple__Repr) Prototype() ipld.NodePrototype {
+	return _StructRepresentation_Tuple__ReprPrototype{}
+}
+
+type _StructRepresentation_Tuple__ReprPrototype struct{}
+
+func (_StructRepresentation_Tuple__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+	var nb _StructRepresentation_Tuple__ReprBuilder
+	nb.Reset()
+	return &nb
+}
+
+type _StructRepresentation_Tuple__ReprBuilder struct {
+	_StructRepresentation_Tuple__ReprAssembler
+}
+
+func (nb *_StructRepresentation_Tuple__ReprBuilder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_StructRepresentation_Tuple__ReprBuilder) Reset() {
+	var w _StructRepresentation_Tuple
+	var m schema.Maybe
+	*nb = _StructRepresentation_Tuple__ReprBuilder{_StructRepresentation_Tuple__ReprAssembler{w: &w, m: &m}}
+}
+
+type _StructRepresentation_Tuple__ReprAssembler struct {
+	w     *_StructRepresentation_Tuple
+	m     *schema.Maybe
+	state maState
+	s     int
+	f     int
+
+	cm            schema.Maybe
+	ca_fieldOrder _List__FieldName__ReprAssembler
+}
+
+func (na *_StructRepresentation_Tuple__ReprAssembler) reset() {
+	na.state = maState_initial
+	na.s = 0
+	na.ca_fieldOrder.reset()
+}
+func (na *_StructRepresentation_Tuple__ReprAssembler) BeginMap(int) (ipld.MapAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: it makes no sense to 'begin' twice on the same assembler!")
+	}
+	*na.m = midvalue
+	if na.w == nil {
+		na.w = &_StructRepresentation_Tuple{}
+	}
+	return na, nil
+}
+func (_StructRepresentation_Tuple__ReprAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.MapAssembler{"ast.StructRepresentation_Tuple.Repr"}.BeginList(0)
+}
+func (na *_StructRepresentation_Tuple__ReprAssembler) AssignNull() error {
+	switch *na.m {
+	case allowNull:
+		*na.m = schema.Maybe_Null
+		return nil
+	case schema.Maybe_Absent:
+		return mixins.MapAssembler{"ast.StructRepresentation_Tuple.Repr.Repr"}.AssignNull()
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+	}
+	panic("unreachable")
+}
+func (_StructRepresentation_Tuple__ReprAssembler) AssignBool(bool) error {
+	return mixins.MapAssembler{"ast.StructRepresentation_Tuple.Repr"}.AssignBool(false)
+}
+func (_StructRepresentation_Tuple__ReprAssembler) AssignInt(int64) error {
+	return mixins.MapAssembler{"ast.StructRepresentation_Tuple.Repr"}.AssignInt(0)
+}
+func (_StructRepresentation_Tuple__ReprAssembler) AssignFloat(float64) error {
+	return mixins.MapAssembler{"ast.StructRepresentation_Tuple.Repr"}.AssignFloat(0)
+}
+func (_StructRepresentation_Tuple__ReprAssembler) AssignString(string) error {
+	return mixins.MapAssembler{"ast.StructRepresentation_Tuple.Repr"}.AssignString("")
+}
+func (_StructRepresentation_Tuple__ReprAssembler) AssignBytes([]byte) error {
+	return mixins.MapAssembler{"ast.StructRepresentation_Tuple.Repr"}.AssignBytes(nil)
+}
+func (_StructRepresentation_Tuple__ReprAssembler) AssignLink(ipld.Link) error {
+	return mixins.MapAssembler{"ast.StructRepresentation_Tuple.Repr"}.AssignLink(nil)
+}
+func (na *_StructRepresentation_Tuple__ReprAssembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_StructRepresentation_Tuple); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		case midvalue:
+			panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	if v.ReprKind() != ipld.ReprKind_Map {
+		return ipld.ErrWrongKind{TypeName: "ast.StructRepresentation_Tuple.Repr", MethodName: "AssignNode", AppropriateKind: ipld.ReprKindSet_JustMap, ActualKind: v.ReprKind()}
+	}
+	itr := v.MapIterator()
+	for !itr.Done() {
+		k, v, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if err := na.AssembleKey().AssignNode(k); err != nil {
+			return err
+		}
+		if err := na.AssembleValue().AssignNode(v); err != nil {
+			return err
+		}
+	}
+	return na.Finish()
+}
+func (_StructRepresentation_Tuple__ReprAssembler) Prototype() ipld.NodePrototype {
+	return _StructRepresentation_Tuple__ReprPrototype{}
+}
+func (ma *_StructRepresentation_Tuple__ReprAssembler) valueFinishTidy() bool {
+	switch ma.f {
+	case 0:
+		switch ma.w.fieldOrder.m {
+		case schema.Maybe_Value:
+			ma.w.fieldOrder.v = ma.ca_fieldOrder.w
+			ma.state = maState_initial
+			return true
+		default:
+			return false
+		}
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_StructRepresentation_Tuple__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleEntry cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleEntry cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleEntry cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleEntry cannot be called on an assembler that's already finished")
+	}
+	switch k {
+	case "fieldOrder":
+		if ma.s&fieldBit__StructRepresentation_Tuple_FieldOrder != 0 {
+			return nil, ipld.ErrRepeatedMapKey{&fieldName__StructRepresentation_Tuple_FieldOrder_serial}
+		}
+		ma.s += fieldBit__StructRepresentation_Tuple_FieldOrder
+		ma.state = maState_midValue
+		ma.f = 0
+		ma.ca_fieldOrder.w = ma.w.fieldOrder.v
+		ma.ca_fieldOrder.m = &ma.w.fieldOrder.m
+
+		return &ma.ca_fieldOrder, nil
+	default:
+		return nil, ipld.ErrInvalidKey{TypeName: "ast.StructRepresentation_Tuple.Repr", Key: &_String{k}}
+	}
+}
+func (ma *_StructRepresentation_Tuple__ReprAssembler) AssembleKey() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleKey cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleKey cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleKey cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleKey cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midKey
+	return (*_StructRepresentation_Tuple__ReprKeyAssembler)(ma)
+}
+func (ma *_StructRepresentation_Tuple__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		panic("invalid state: AssembleValue cannot be called when no key is primed")
+	case maState_midKey:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		// carry on
+	case maState_midValue:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling another value")
+	case maState_finished:
+		panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midValue
+	switch ma.f {
+	case 0:
+		ma.ca_fieldOrder.w = ma.w.fieldOrder.v
+		ma.ca_fieldOrder.m = &ma.w.fieldOrder.m
+
+		return &ma.ca_fieldOrder
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_StructRepresentation_Tuple__ReprAssembler) Finish() error {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: Finish cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		panic("invalid state: Finish cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: Finish cannot be called on an assembler that's already finished")
+	}
+	//FIXME check if all required fields are set
+	ma.state = maState_finished
+	*ma.m = schema.Maybe_Value
+	return nil
+}
+func (ma *_StructRepresentation_Tuple__ReprAssembler) KeyPrototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (ma *_StructRepresentation_Tuple__ReprAssembler) ValuePrototype(k string) ipld.NodePrototype {
+	panic("todo structbuilder mapassembler repr valueprototype")
+}
+
+type _StructRepresentation_Tuple__ReprKeyAssembler _StructRepresentation_Tuple__ReprAssembler
+
+func (_StructRepresentation_Tuple__ReprKeyAssembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	return mixins.StringAssembler{"ast.StructRepresentation_Tuple.Repr.KeyAssembler"}.BeginMap(0)
+}
+func (_StructRepresentation_Tuple__ReprKeyAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.StringAssembler{"ast.StructRepresentation_Tuple.Repr.KeyAssembler"}.BeginList(0)
+}
+func (na *_StructRepresentation_Tuple__ReprKeyAssembler) AssignNull() error {
+	return mixins.StringAssembler{"ast.StructRepresentation_Tuple.Repr.KeyAssembler"}.AssignNull()
+}
+func (_StructRepresentation_Tuple__ReprKeyAssembler) AssignBool(bool) error {
+	return mixins.StringAssembler{"ast.StructRepresentation_Tuple.Repr.KeyAssembler"}.AssignBool(false)
+}
+func (_StructRepresentation_Tuple__ReprKeyAssembler) AssignInt(int64) error {
+	return mixins.StringAssembler{"ast.StructRepresentation_Tuple.Repr.KeyAssembler"}.AssignInt(0)
+}
+func (_StructRepresentation_Tuple__ReprKeyAssembler) AssignFloat(float64) error {
+	return mixins.StringAssembler{"ast.StructRepresentation_Tuple.Repr.KeyAssembler"}.AssignFloat(0)
+}
+func (ka *_StructRepresentation_Tuple__ReprKeyAssembler) AssignString(k string) error {
+	if ka.state != maState_midKey {
+		panic("misuse: KeyAssembler held beyond its valid lifetime")
+	}
+	switch k {
+	case "fieldOrder":
+		if ka.s&fieldBit__StructRepresentation_Tuple_FieldOrder != 0 {
+			return ipld.ErrRepeatedMapKey{&fieldName__StructRepresentation_Tuple_FieldOrder_serial}
+		}
+		ka.s += fieldBit__StructRepresentation_Tuple_FieldOrder
+		ka.state = maState_expectValue
+		ka.f = 0
+	default:
+		return ipld.ErrInvalidKey{TypeName: "ast.StructRepresentation_Tuple.Repr", Key: &_String{k}}
+	}
+	return nil
+}
+func (_StructRepresentation_Tuple__ReprKeyAssembler) AssignBytes([]byte) error {
+	return mixins.StringAssembler{"ast.StructRepresentation_Tuple.Repr.KeyAssembler"}.AssignBytes(nil)
+}
+func (_StructRepresentation_Tuple__ReprKeyAssembler) AssignLink(ipld.Link) error {
+	return mixins.StringAssembler{"ast.StructRepresentation_Tuple.Repr.KeyAssembler"}.AssignLink(nil)
+}
+func (ka *_StructRepresentation_Tuple__ReprKeyAssembler) AssignNode(v ipld.Node) error {
+	if v2, err := v.AsString(); err != nil {
+		return err
+	} else {
+		return ka.AssignString(v2)
+	}
+}
+func (_StructRepresentation_Tuple__ReprKeyAssembler) Prototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
@@ -0,0 +1,755 @@
+package ast
+
+// Code generated by go-ipld-prime gengo.  DO NOT EDIT.
+
+import (
+	ipld "github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/node/mixins"
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+type _TypeBool struct {
+}
+type TypeBool = *_TypeBool
+
+type _TypeBool__Maybe struct {
+	m schema.Maybe
+	v TypeBool
+}
+type MaybeTypeBool = *_TypeBool__Maybe
+
+func (m MaybeTypeBool) IsNull() bool {
+	return m.m == schema.Maybe_Null
+}
+func (m MaybeTypeBool) IsAbsent() bool {
+	return m.m == schema.Maybe_Absent
+}
+func (m MaybeTypeBool) Exists() bool {
+	return m.m == schema.Maybe_Value
+}
+func (m MaybeTypeBool) AsNode() ipld.Node {
+	switch m.m {
+	case schema.Maybe_Absent:
+		return ipld.Absent
+	case schema.Maybe_Null:
+		return ipld.Null
+	case schema.Maybe_Value:
+		return m.v
+	default:
+		panic("unreachable")
+	}
+}
+func (m MaybeTypeBool) Must() TypeBool {
+	if !m.Exists() {
+		panic("unbox of a maybe rejected")
+	}
+	return m.v
+}
+
+var ()
+var _ ipld.Node = (TypeBool)(&_TypeBool{})
+var _ schema.TypedNode = (TypeBool)(&_TypeBool{})
+
+func (TypeBool) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_Map
+}
+func (n TypeBool) LookupByString(key string) (ipld.Node, error) {
+	switch key {
+	default:
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+	}
+}
+func (n TypeBool) LookupByNode(key ipld.Node) (ipld.Node, error) {
+	ks, err := key.AsString()
+	if err != nil {
+		return nil, err
+	}
+	return n.LookupByString(ks)
+}
+func (TypeBool) LookupByIndex(idx int) (ipld.Node, error) {
+	return mixins.Map{"ast.TypeBool"}.LookupByIndex(0)
+}
+func (n TypeBool) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	return n.LookupByString(seg.String())
+}
+func (n TypeBool) MapIterator() ipld.MapIterator {
+	return &_TypeBool__MapItr{n, 0}
+}
+
+type _TypeBool__MapItr struct {
+	n   TypeBool
+	idx int
+}
+
+func (itr *_TypeBool__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+	if itr.idx >= 0 {
+		return nil, nil, ipld.ErrIteratorOverread{}
+	}
+	switch itr.idx {
+	default:
+		panic("unreachable")
+	}
+	itr.idx++
+	return
+}
+func (itr *_TypeBool__MapItr) Done() bool {
+	return itr.idx >= 0
+}
+
+func (TypeBool) ListIterator() ipld.ListIterator {
+	return nil
+}
+func (TypeBool) Length() int {
+	return 0
+}
+func (TypeBool) IsAbsent() bool {
+	return false
+}
+func (TypeBool) IsNull() bool {
+	return false
+}
+func (TypeBool) AsBool() (bool, error) {
+	return mixins.Map{"ast.TypeBool"}.AsBool()
+}
+func (TypeBool) AsInt() (int64, error) {
+	return mixins.Map{"ast.TypeBool"}.AsInt()
+}
+func (TypeBool) AsFloat() (float64, error) {
+	return mixins.Map{"ast.TypeBool"}.AsFloat()
+}
+func (TypeBool) AsString() (string, error) {
+	return mixins.Map{"ast.TypeBool"}.AsString()
+}
+func (TypeBool) AsBytes() ([]byte, error) {
+	return mixins.Map{"ast.TypeBool"}.AsBytes()
+}
+func (TypeBool) AsLink() (ipld.Link, error) {
+	return mixins.Map{"ast.TypeBool"}.AsLink()
+}
+func (TypeBool) Prototype() ipld.NodePrototype {
+	return _TypeBool__Prototype{}
+}
+
+type _TypeBool__Prototype struct{}
+
+func (_TypeBool__Prototype) NewBuilder() ipld.NodeBuilder {
+	var nb _TypeBool__Builder
+	nb.Reset()
+	return &nb
+}
+
+type _TypeBool__Builder struct {
+	_TypeBool__Assembler
+}
+
+func (nb *_TypeBool__Builder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_TypeBool__Builder) Reset() {
+	var w _TypeBool
+	var m schema.Maybe
+	*nb = _TypeBool__Builder{_TypeBool__Assembler{w: &w, m: &m}}
+}
+
+type _TypeBool__Assembler struct {
+	w     *_TypeBool
+	m     *schema.Maybe
+	state maState
+	s     int
+	f     int
+
+	cm schema.Maybe
+}
+
+func (na *_TypeBool__Assembler) reset() {
+	na.state = maState_initial
+	na.s = 0
+}
+
+var (
+	fieldBits__TypeBool_sufficient = 0
+)
+
+func (na *_TypeBool__Assembler) BeginMap(int) (ipld.MapAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: it makes no sense to 'begin' twice on the same assembler!")
+	}
+	*na.m = midvalue
+	if na.w == nil {
+		na.w = &_TypeBool{}
+	}
+	return na, nil
+}
+func (_TypeBool__Assembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.MapAssembler{"ast.TypeBool"}.BeginList(0)
+}
+func (na *_TypeBool__Assembler) AssignNull() error {
+	switch *na.m {
+	case allowNull:
+		*na.m = schema.Maybe_Null
+		return nil
+	case schema.Maybe_Absent:
+		return mixins.MapAssembler{"ast.TypeBool"}.AssignNull()
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+	}
+	panic("unreachable")
+}
+func (_TypeBool__Assembler) AssignBool(bool) error {
+	return mixins.MapAssembler{"ast.TypeBool"}.AssignBool(false)
+}
+func (_TypeBool__Assembler) AssignInt(int64) error {
+	return mixins.MapAssembler{"ast.TypeBool"}.AssignInt(0)
+}
+func (_TypeBool__Assembler) AssignFloat(float64) error {
+	return mixins.MapAssembler{"ast.TypeBool"}.AssignFloat(0)
+}
+func (_TypeBool__Assembler) AssignString(string) error {
+	return mixins.MapAssembler{"ast.TypeBool"}.AssignString("")
+}
+func (_TypeBool__Assembler) AssignBytes([]byte) error {
+	return mixins.MapAssembler{"ast.TypeBool"}.AssignBytes(nil)
+}
+func (_TypeBool__Assembler) AssignLink(ipld.Link) error {
+	return mixins.MapAssembler{"ast.TypeBool"}.AssignLink(nil)
+}
+func (na *_TypeBool__Assembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_TypeBool); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		case midvalue:
+			panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	if v.ReprKind() != ipld.ReprKind_Map {
+		return ipld.ErrWrongKind{TypeName: "ast.TypeBool", MethodName: "AssignNode", AppropriateKind: ipld.ReprKindSet_JustMap, ActualKind: v.ReprKind()}
+	}
+	itr := v.MapIterator()
+	for !itr.Done() {
+		k, v, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if err := na.AssembleKey().AssignNode(k); err != nil {
+			return err
+		}
+		if err := na.AssembleValue().AssignNode(v); err != nil {
+			return err
+		}
+	}
+	return na.Finish()
+}
+func (_TypeBool__Assembler) Prototype() ipld.NodePrototype {
+	return _TypeBool__Prototype{}
+}
+func (ma *_TypeBool__Assembler) valueFinishTidy() bool {
+	switch ma.f {
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_TypeBool__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleEntry cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleEntry cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleEntry cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleEntry cannot be called on an assembler that's already finished")
+	}
+	switch k {
+	default:
+		return nil, ipld.ErrInvalidKey{TypeName: "ast.TypeBool", Key: &_String{k}}
+	}
+}
+func (ma *_TypeBool__Assembler) AssembleKey() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleKey cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleKey cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleKey cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleKey cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midKey
+	return (*_TypeBool__KeyAssembler)(ma)
+}
+func (ma *_TypeBool__Assembler) AssembleValue() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		panic("invalid state: AssembleValue cannot be called when no key is primed")
+	case maState_midKey:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		// carry on
+	case maState_midValue:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling another value")
+	case maState_finished:
+		panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midValue
+	switch ma.f {
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_TypeBool__Assembler) Finish() error {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: Finish cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		panic("invalid state: Finish cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: Finish cannot be called on an assembler that's already finished")
+	}
+	//FIXME check if all required fields are set
+	ma.state = maState_finished
+	*ma.m = schema.Maybe_Value
+	return nil
+}
+func (ma *_TypeBool__Assembler) KeyPrototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (ma *_TypeBool__Assembler) ValuePrototype(k string) ipld.NodePrototype {
+	panic("todo structbuilder mapassembler valueprototype")
+}
+
+type _TypeBool__KeyAssembler _TypeBool__Assembler
+
+func (_TypeBool__KeyAssembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	return mixins.StringAssembler{"ast.TypeBool.KeyAssembler"}.BeginMap(0)
+}
+func (_TypeBool__KeyAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.StringAssembler{"ast.TypeBool.KeyAssembler"}.BeginList(0)
+}
+func (na *_TypeBool__KeyAssembler) AssignNull() error {
+	return mixins.StringAssembler{"ast.TypeBool.KeyAssembler"}.AssignNull()
+}
+func (_TypeBool__KeyAssembler) AssignBool(bool) error {
+	return mixins.StringAssembler{"ast.TypeBool.KeyAssembler"}.AssignBool(false)
+}
+func (_TypeBool__KeyAssembler) AssignInt(int64) error {
+	return mixins.StringAssembler{"ast.TypeBool.KeyAssembler"}.AssignInt(0)
+}
+func (_TypeBool__KeyAssembler) AssignFloat(float64) error {
+	return mixins.StringAssembler{"ast.TypeBool.KeyAssembler"}.AssignFloat(0)
+}
+func (ka *_TypeBool__KeyAssembler) AssignString(k string) error {
+	if ka.state != maState_midKey {
+		panic("misuse: KeyAssembler held beyond its valid lifetime")
+	}
+	switch k {
+	default:
+		return ipld.ErrInvalidKey{TypeName: "ast.TypeBool", Key: &_String{k}}
+	}
+	return nil
+}
+func (_TypeBool__KeyAssembler) AssignBytes([]byte) error {
+	return mixins.StringAssembler{"ast.TypeBool.KeyAssembler"}.AssignBytes(nil)
+}
+func (_TypeBool__KeyAssembler) AssignLink(ipld.Link) error {
+	return mixins.StringAssembler{"ast.TypeBool.KeyAssembler"}.AssignLink(nil)
+}
+func (ka *_TypeBool__KeyAssembler) AssignNode(v ipld.Node) error {
+	if v2, err := v.AsString(); err != nil {
+		return err
+	} else {
+		return ka.AssignString(v2)
+	}
+}
+func (_TypeBool__KeyAssembler) Prototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (TypeBool) Type() schema.Type {
+	return nil /*TODO:typelit*/
+}
+func (n TypeBool) Representation() ipld.Node {
+	return (*_TypeBool__Repr)(n)
+}
+
+type _TypeBool__Repr _TypeBool
+
+var ()
+var _ ipld.Node = &_TypeBool__Repr{}
+
+func (_TypeBool__Repr) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_Map
+}
+func (n *_TypeBool__Repr) LookupByString(key string) (ipld.Node, error) {
+	switch key {
+	default:
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+	}
+}
+func (n *_TypeBool__Repr) LookupByNode(key ipld.Node) (ipld.Node, error) {
+	ks, err := key.AsString()
+	if err != nil {
+		return nil, err
+	}
+	return n.LookupByString(ks)
+}
+func (_TypeBool__Repr) LookupByIndex(idx int) (ipld.Node, error) {
+	return mixins.Map{"ast.TypeBool.Repr"}.LookupByIndex(0)
+}
+func (n _TypeBool__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	return n.LookupByString(seg.String())
+}
+func (n *_TypeBool__Repr) MapIterator() ipld.MapIterator {
+	return &_TypeBool__ReprMapItr{n, 0}
+}
+
+type _TypeBool__ReprMapItr struct {
+	n   *_TypeBool__Repr
+	idx int
+}
+
+func (itr *_TypeBool__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+	if itr.idx >= 0 {
+		return nil, nil, ipld.ErrIteratorOverread{}
+	}
+	switch itr.idx {
+	default:
+		panic("unreachable")
+	}
+	itr.idx++
+	return
+}
+func (itr *_TypeBool__ReprMapItr) Done() bool {
+	return itr.idx >= 0
+}
+func (_TypeBool__Repr) ListIterator() ipld.ListIterator {
+	return nil
+}
+func (rn *_TypeBool__Repr) Length() int {
+	l := 0
+	return l
+}
+func (_TypeBool__Repr) IsAbsent() bool {
+	return false
+}
+func (_TypeBool__Repr) IsNull() bool {
+	return false
+}
+func (_TypeBool__Repr) AsBool() (bool, error) {
+	return mixins.Map{"ast.TypeBool.Repr"}.AsBool()
+}
+func (_TypeBool__Repr) AsInt() (int64, error) {
+	return mixins.Map{"ast.TypeBool.Repr"}.AsInt()
+}
+func (_TypeBool__Repr) AsFloat() (float64, error) {
+	return mixins.Map{"ast.TypeBool.Repr"}.AsFloat()
+}
+func (_TypeBool__Repr) AsString() (string, error) {
+	return mixins.Map{"ast.TypeBool.Repr"}.AsString()
+}
+func (_TypeBool__Repr) AsBytes() ([]byte, error) {
+	return mixins.Map{"ast.TypeBool.Repr"}.AsBytes()
+}
+func (_TypeBool__Repr) AsLink() (ipld.Link, error) {
+	return mixins.Map{"ast.TypeBool.Repr"}.AsLink()
+}
+func (_TypeBool__Repr) Prototype() ipld.NodePrototype {
+	return _TypeBool__ReprPrototype{}
+}
+
+type _TypeBool__ReprPrototype struct{}
+
+func (_TypeBool__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+	var nb _TypeBool__ReprBuilder
+	nb.Reset()
+	return &nb
+}
+
+type _TypeBool__ReprBuilder struct {
+	_TypeBool__ReprAssembler
+}
+
+func (nb *_TypeBool__ReprBuilder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_TypeBool__ReprBuilder) Reset() {
+	var w _TypeBool
+	var m schema.Maybe
+	*nb = _TypeBool__ReprBuilder{_TypeBool__ReprAssembler{w: &w, m: &m}}
+}
+
+type _TypeBool__ReprAssembler struct {
+	w     *_TypeBool
+	m     *schema.Maybe
+	state maState
+	s     int
+	f     int
+
+	cm schema.Maybe
+}
+
+func (na *_TypeBool__ReprAssembler) reset() {
+	na.state = maState_initial
+	na.s = 0
+}
+func (na *_TypeBool__ReprAssembler) BeginMap(int) (ipld.MapAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: it makes no sense to 'begin' twice on the same assembler!")
+	}
+	*na.m = midvalue
+	if na.w == nil {
+		na.w = &_TypeBool{}
+	}
+	return na, nil
+}
+func (_TypeBool__ReprAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.MapAssembler{"ast.TypeBool.Repr"}.BeginList(0)
+}
+func (na *_TypeBool__ReprAssembler) AssignNull() error {
+	switch *na.m {
+	case allowNull:
+		*na.m = schema.Maybe_Null
+		return nil
+	case schema.Maybe_Absent:
+		return mixins.MapAssembler{"ast.TypeBool.Repr.Repr"}.AssignNull()
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+	}
+	panic("unreachable")
+}
+func (_TypeBool__ReprAssembler) AssignBool(bool) error {
+	return mixins.MapAssembler{"ast.TypeBool.Repr"}.AssignBool(false)
+}
+func (_TypeBool__ReprAssembler) AssignInt(int64) error {
+	return mixins.MapAssembler{"ast.TypeBool.Repr"}.AssignInt(0)
+}
+func (_TypeBool__ReprAssembler) AssignFloat(float64) error {
+	return mixins.MapAssembler{"ast.TypeBool.Repr"}.AssignFloat(0)
+}
+func (_TypeBool__ReprAssembler) AssignString(string) error {
+	return mixins.MapAssembler{"ast.TypeBool.Repr"}.AssignString("")
+}
+func (_TypeBool__ReprAssembler) AssignBytes([]byte) error {
+	return mixins.MapAssembler{"ast.TypeBool.Repr"}.AssignBytes(nil)
+}
+func (_TypeBool__ReprAssembler) AssignLink(ipld.Link) error {
+	return mixins.MapAssembler{"ast.TypeBool.Repr"}.AssignLink(nil)
+}
+func (na *_TypeBool__ReprAssembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_TypeBool); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		case midvalue:
+			panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	if v.ReprKind() != ipld.ReprKind_Map {
+		return ipld.ErrWrongKind{TypeName: "ast.TypeBool.Repr", MethodName: "AssignNode", AppropriateKind: ipld.ReprKindSet_JustMap, ActualKind: v.ReprKind()}
+	}
+	itr := v.MapIterator()
+	for !itr.Done() {
+		k, v, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if err := na.AssembleKey().AssignNode(k); err != nil {
+			return err
+		}
+		if err := na.AssembleValue().AssignNode(v); err != nil {
+			return err
+		}
+	}
+	return na.Finish()
+}
+func (_TypeBool__ReprAssembler) Prototype() ipld.NodePrototype {
+	return _TypeBool__ReprPrototype{}
+}
+func (ma *_TypeBool__ReprAssembler) valueFinishTidy() bool {
+	switch ma.f {
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_TypeBool__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleEntry cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleEntry cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleEntry cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleEntry cannot be called on an assembler that's already finished")
+	}
+	switch k {
+	default:
+		return nil, ipld.ErrInvalidKey{TypeName: "ast.TypeBool.Repr", Key: &_String{k}}
+	}
+}
+func (ma *_TypeBool__ReprAssembler) AssembleKey() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleKey cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleKey cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleKey cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleKey cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midKey
+	return (*_TypeBool__ReprKeyAssembler)(ma)
+}
+func (ma *_TypeBool__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		panic("invalid state: AssembleValue cannot be called when no key is primed")
+	case maState_midKey:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		// carry on
+	case maState_midValue:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling another value")
+	case maState_finished:
+		panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midValue
+	switch ma.f {
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_TypeBool__ReprAssembler) Finish() error {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: Finish cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		panic("invalid state: Finish cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: Finish cannot be called on an assembler that's already finished")
+	}
+	//FIXME check if all required fields are set
+	ma.state = maState_finished
+	*ma.m = schema.Maybe_Value
+	return nil
+}
+func (ma *_TypeBool__ReprAssembler) KeyPrototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (ma *_TypeBool__ReprAssembler) ValuePrototype(k string) ipld.NodePrototype {
+	panic("todo structbuilder mapassembler repr valueprototype")
+}
+
+type _TypeBool__ReprKeyAssembler _TypeBool__ReprAssembler
+
+func (_TypeBool__ReprKeyAssembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	return mixins.StringAssembler{"ast.TypeBool.Repr.KeyAssembler"}.BeginMap(0)
+}
+func (_TypeBool__ReprKeyAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.StringAssembler{"ast.TypeBool.Repr.KeyAssembler"}.BeginList(0)
+}
+func (na *_TypeBool__ReprKeyAssembler) AssignNull() error {
+	return mixins.StringAssembler{"ast.TypeBool.Repr.KeyAssembler"}.AssignNull()
+}
+func (_TypeBool__ReprKeyAssembler) AssignBool(bool) error {
+	return mixins.StringAssembler{"ast.TypeBool.Repr.KeyAssembler"}.AssignBool(false)
+}
+func (_TypeBool__ReprKeyAssembler) AssignInt(int64) error {
+	return mixins.StringAssembler{"ast.TypeBool.Repr.KeyAssembler"}.AssignInt(0)
+}
+func (_TypeBool__ReprKeyAssembler) AssignFloat(float64) error {
+	return mixins.StringAssembler{"ast.TypeBool.Repr.KeyAssembler"}.AssignFloat(0)
+}
+func (ka *_TypeBool__ReprKeyAssembler) AssignString(k string) error {
+	if ka.state != maState_midKey {
+		panic("misuse: KeyAssembler held beyond its valid lifetime")
+	}
+	switch k {
+	default:
+		return ipld.ErrInvalidKey{TypeName: "ast.TypeBool.Repr", Key: &_String{k}}
+	}
+	return nil
+}
+func (_TypeBool__ReprKeyAssembler) AssignBytes([]byte) error {
+	return mixins.StringAssembler{"ast.TypeBool.Repr.KeyAssembler"}.AssignBytes(nil)
+}
+func (_TypeBool__ReprKeyAssembler) AssignLink(ipld.Link) error {
+	return mixins.StringAssembler{"ast.TypeBool.Repr.KeyAssembler"}.AssignLink(nil)
+}
+func (ka *_TypeBool__ReprKeyAssembler) AssignNode(v ipld.Node) error {
+	if v2, err := v.AsString(); err != nil {
+		return err
+	} else {
+		return ka.AssignString(v2)
+	}
+}
+func (_TypeBool__ReprKeyAssembler) Prototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
@@ -0,0 +1,867 @@
+package ast
+
+// Code generated by go-ipld-prime gengo.  DO NOT EDIT.
+
+import (
+	ipld "github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/node/mixins"
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+type _TypeLink struct {
+	expectedType _TypeName__Maybe
+}
+type TypeLink = *_TypeLink
+
+func (n _TypeLink) FieldExpectedType() MaybeTypeName {
+	return &n.expectedType
+}
+
+type _TypeLink__Maybe struct {
+	m schema.Maybe
+	v TypeLink
+}
+type MaybeTypeLink = *_TypeLink__Maybe
+
+func (m MaybeTypeLink) IsNull() bool {
+	return m.m == schema.Maybe_Null
+}
+func (m MaybeTypeLink) IsAbsent() bool {
+	return m.m == schema.Maybe_Absent
+}
+func (m MaybeTypeLink) Exists() bool {
+	return m.m == schema.Maybe_Value
+}
+func (m MaybeTypeLink) AsNode() ipld.Node {
+	switch m.m {
+	case schema.Maybe_Absent:
+		return ipld.Absent
+	case schema.Maybe_Null:
+		return ipld.Null
+	case schema.Maybe_Value:
+		return m.v
+	default:
+		panic("unreachable")
+	}
+}
+func (m MaybeTypeLink) Must() TypeLink {
+	if !m.Exists() {
+		panic("unbox of a maybe rejected")
+	}
+	return m.v
+}
+
+var (
+	fieldName__TypeLink_ExpectedType = _String{"expectedType"}
+)
+var _ ipld.Node = (TypeLink)(&_TypeLink{})
+var _ schema.TypedNode = (TypeLink)(&_TypeLink{})
+
+func (TypeLink) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_Map
+}
+func (n TypeLink) LookupByString(key string) (ipld.Node, error) {
+	switch key {
+	case "expectedType":
+		if n.expectedType.m == schema.Maybe_Absent {
+			return ipld.Absent, nil
+		}
+		return n.expectedType.v, nil
+	default:
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+	}
+}
+func (n TypeLink) LookupByNode(key ipld.Node) (ipld.Node, error) {
+	ks, err := key.AsString()
+	if err != nil {
+		return nil, err
+	}
+	return n.LookupByString(ks)
+}
+func (TypeLink) LookupByIndex(idx int) (ipld.Node, error) {
+	return mixins.Map{"ast.TypeLink"}.LookupByIndex(0)
+}
+func (n TypeLink) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	return n.LookupByString(seg.String())
+}
+func (n TypeLink) MapIterator() ipld.MapIterator {
+	return &_TypeLink__MapItr{n, 0}
+}
+
+type _TypeLink__MapItr struct {
+	n   TypeLink
+	idx int
+}
+
+func (itr *_TypeLink__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+	if itr.idx >= 1 {
+		return nil, nil, ipld.ErrIteratorOverread{}
+	}
+	switch itr.idx {
+	case 0:
+		k = &fieldName__TypeLink_ExpectedType
+		if itr.n.expectedType.m == schema.Maybe_Absent {
+			v = ipld.Absent
+			break
+		}
+		v = itr.n.expectedType.v
+	default:
+		panic("unreachable")
+	}
+	itr.idx++
+	return
+}
+func (itr *_TypeLink__MapItr) Done() bool {
+	return itr.idx >= 1
+}
+
+func (TypeLink) ListIterator() ipld.ListIterator {
+	return nil
+}
+func (TypeLink) Length() int {
+	return 1
+}
+func (TypeLink) IsAbsent() bool {
+	return false
+}
+func (TypeLink) IsNull() bool {
+	return false
+}
+func (TypeLink) AsBool() (bool, error) {
+	return mixins.Map{"ast.TypeLink"}.AsBool()
+}
+func (TypeLink) AsInt() (int64, error) {
+	return mixins.Map{"ast.TypeLink"}.AsInt()
+}
+func (TypeLink) AsFloat() (float64, error) {
+	return mixins.Map{"ast.TypeLink"}.AsFloat()
+}
+func (TypeLink) AsString() (string, error) {
+	return mixins.Map{"ast.TypeLink"}.AsString()
+}
+func (TypeLink) AsBytes() ([]byte, error) {
+	return mixins.Map{"ast.TypeLink"}.AsBytes()
+}
+func (TypeLink) AsLink() (ipld.Link, error) {
+	return mixins.Map{"ast.TypeLink"}.AsLink()
+}
+func (TypeLink) Prototype() ipld.NodePrototype {
+	return _TypeLink__Prototype{}
+}
+
+type _TypeLink__Prototype struct{}
+
+func (_TypeLink__Prototype) NewBuilder() ipld.NodeBuilder {
+	var nb _TypeLink__Builder
+	nb.Reset()
+	return &nb
+}
+
+type _TypeLink__Builder struct {
+	_TypeLink__Assembler
+}
+
+func (nb *_TypeLink__Builder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_TypeLink__Builder) Reset() {
+	var w _TypeLink
+	var m schema.Maybe
+	*nb = _TypeLink__Builder{_TypeLink__Assembler{w: &w, m: &m}}
+}
+
+type _TypeLink__Assembler struct {
+	w     *_TypeLink
+	m     *schema.Maybe
+	state maState
+	s     int
+	f     int
+
+	cm              schema.Maybe
+	ca_expectedType _TypeName__Assembler
+}
+
+func (na *_TypeLink__Assembler) reset() {
+	na.state = maState_initial
+	na.s = 0
+	na.ca_expectedType.reset()
+}
+
+var (
+	fieldBit__TypeLink_ExpectedType = 1 << 0
+	fieldBits__TypeLink_sufficient  = 0
+)
+
+func (na *_TypeLink__Assembler) BeginMap(int) (ipld.MapAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: it makes no sense to 'begin' twice on the same assembler!")
+	}
+	*na.m = midvalue
+	if na.w == nil {
+		na.w = &_TypeLink{}
+	}
+	return na, nil
+}
+func (_TypeLink__Assembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.MapAssembler{"ast.TypeLink"}.BeginList(0)
+}
+func (na *_TypeLink__Assembler) AssignNull() error {
+	switch *na.m {
+	case allowNull:
+		*na.m = schema.Maybe_Null
+		return nil
+	case schema.Maybe_Absent:
+		return mixins.MapAssembler{"ast.TypeLink"}.AssignNull()
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+	}
+	panic("unreachable")
+}
+func (_TypeLink__Assembler) AssignBool(bool) error {
+	return mixins.MapAssembler{"ast.TypeLink"}.AssignBool(false)
+}
+func (_TypeLink__Assembler) AssignInt(int64) error {
+	return mixins.MapAssembler{"ast.TypeLink"}.AssignInt(0)
+}
+func (_TypeLink__Assembler) AssignFloat(float64) error {
+	return mixins.MapAssembler{"ast.TypeLink"}.AssignFloat(0)
+}
+func (_TypeLink__Assembler) AssignString(string) error {
+	return mixins.MapAssembler{"ast.TypeLink"}.AssignString("")
+}
+func (_TypeLink__Assembler) AssignBytes([]byte) error {
+	return mixins.MapAssembler{"ast.TypeLink"}.AssignBytes(nil)
+}
+func (_TypeLink__Assembler) AssignLink(ipld.Link) error {
+	return mixins.MapAssembler{"ast.TypeLink"}.AssignLink(nil)
+}
+func (na *_TypeLink__Assembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_TypeLink); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		case midvalue:
+			panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	if v.ReprKind() != ipld.ReprKind_Map {
+		return ipld.ErrWrongKind{TypeName: "ast.TypeLink", MethodName: "AssignNode", AppropriateKind: ipld.ReprKindSet_JustMap, ActualKind: v.ReprKind()}
+	}
+	itr := v.MapIterator()
+	for !itr.Done() {
+		k, v, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if err := na.AssembleKey().AssignNode(k); err != nil {
+			return err
+		}
+		if err := na.AssembleValue().AssignNode(v); err != nil {
+			return err
+		}
+	}
+	return na.Finish()
+}
+func (_TypeLink__Assembler) Prototype() ipld.NodePrototype {
+	return _TypeLink__Prototype{}
+}
+func (ma *_TypeLink__Assembler) valueFinishTidy() bool {
+	switch ma.f {
+	case 0:
+		switch ma.w.expectedType.m {
+		case schema.Maybe_Value:
+			ma.w.expectedType.v = ma.ca_expectedType.w
+			ma.state = maState_initial
+			return true
+		default:
+			return false
+		}
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_TypeLink__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleEntry cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleEntry cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleEntry cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleEntry cannot be called on an assembler that's already finished")
+	}
+	switch k {
+	case "expectedType":
+		if ma.s&fieldBit__TypeLink_ExpectedType != 0 {
+			return nil, ipld.ErrRepeatedMapKey{&fieldName__TypeLink_ExpectedType}
+		}
+		ma.s += fieldBit__TypeLink_ExpectedType
+		ma.state = maState_midValue
+		ma.f = 0
+		ma.ca_expectedType.w = ma.w.expectedType.v
+		ma.ca_expectedType.m = &ma.w.expectedType.m
+		return &ma.ca_expectedType, nil
+	default:
+		return nil, ipld.ErrInvalidKey{TypeName: "ast.TypeLink", Key: &_String{k}}
+	}
+}
+func (ma *_TypeLink__Assembler) AssembleKey() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleKey cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleKey cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleKey cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleKey cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midKey
+	return (*_TypeLink__KeyAssembler)(ma)
+}
+func (ma *_TypeLink__Assembler) AssembleValue() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		panic("invalid state: AssembleValue cannot be called when no key is primed")
+	case maState_midKey:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		// carry on
+	case maState_midValue:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling another value")
+	case maState_finished:
+		panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midValue
+	switch ma.f {
+	case 0:
+		ma.ca_expectedType.w = ma.w.expectedType.v
+		ma.ca_expectedType.m = &ma.w.expectedType.m
+		return &ma.ca_expectedType
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_TypeLink__Assembler) Finish() error {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: Finish cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		panic("invalid state: Finish cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: Finish cannot be called on an assembler that's already finished")
+	}
+	//FIXME check if all required fields are set
+	ma.state = maState_finished
+	*ma.m = schema.Maybe_Value
+	return nil
+}
+func (ma *_TypeLink__Assembler) KeyPrototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (ma *_TypeLink__Assembler) ValuePrototype(k string) ipld.NodePrototype {
+	panic("todo structbuilder mapassembler valueprototype")
+}
+
+type _TypeLink__KeyAssembler _TypeLink__Assembler
+
+func (_TypeLink__KeyAssembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	return mixins.StringAssembler{"ast.TypeLink.KeyAssembler"}.BeginMap(0)
+}
+func (_TypeLink__KeyAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.StringAssembler{"ast.TypeLink.KeyAssembler"}.BeginList(0)
+}
+func (na *_TypeLink__KeyAssembler) AssignNull() error {
+	return mixins.StringAssembler{"ast.TypeLink.KeyAssembler"}.AssignNull()
+}
+func (_TypeLink__KeyAssembler) AssignBool(bool) error {
+	return mixins.StringAssembler{"ast.TypeLink.KeyAssembler"}.AssignBool(false)
+}
+func (_TypeLink__KeyAssembler) AssignInt(int64) error {
+	return mixins.StringAssembler{"ast.TypeLink.KeyAssembler"}.AssignInt(0)
+}
+func (_TypeLink__KeyAssembler) AssignFloat(float64) error {
+	return mixins.StringAssembler{"ast.TypeLink.KeyAssembler"}.AssignFloat(0)
+}
+func (ka *_TypeLink__KeyAssembler) AssignString(k string) error {
+	if ka.state != maState_midKey {
+		panic("misuse: KeyAssembler held beyond its valid lifetime")
+	}
+	switch k {
+	case "expectedType":
+		if ka.s&fieldBit__TypeLink_ExpectedType != 0 {
+			return ipld.ErrRepeatedMapKey{&fieldName__TypeLink_ExpectedType}
+		}
+		ka.s += fieldBit__TypeLink_ExpectedType
+		ka.state = maState_expectValue
+		ka.f = 0
+	default:
+		return ipld.ErrInvalidKey{TypeName: "ast.TypeLink", Key: &_String{k}}
+	}
+	return nil
+}
+func (_TypeLink__KeyAssembler) AssignBytes([]byte) error {
+	return mixins.StringAssembler{"ast.TypeLink.KeyAssembler"}.AssignBytes(nil)
+}
+func (_TypeLink__KeyAssembler) AssignLink(ipld.Link) error {
+	return mixins.StringAssembler{"ast.TypeLink.KeyAssembler"}.AssignLink(nil)
+}
+func (ka *_TypeLink__KeyAssembler) AssignNode(v ipld.Node) error {
+	if v2, err := v.AsString(); err != nil {
+		return err
+	} else {
+		return ka.AssignString(v2)
+	}
+}
+func (_TypeLink__KeyAssembler) Prototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (TypeLink) Type() schema.Type {
+	return nil /*TODO:typelit*/
+}
+func (n TypeLink) Representation() ipld.Node {
+	return (*_TypeLink__Repr)(n)
+}
+
+type _TypeLink__Repr _TypeLink
+
+var (
+	fieldName__TypeLink_ExpectedType_serial = _String{"expectedType"}
+)
+var _ ipld.Node = &_TypeLink__Repr{}
+
+func (_TypeLink__Repr) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_Map
+}
+func (n *_TypeLink__Repr) LookupByString(key string) (ipld.Node, error) {
+	switch key {
+	case "expectedType":
+		if n.expectedType.m == schema.Maybe_Absent {
+			return ipld.Absent, ipld.ErrNotExists{ipld.PathSegmentOfString(key)}
+		}
+		return n.expectedType.v.Representation(), nil
+	default:
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+	}
+}
+func (n *_TypeLink__Repr) LookupByNode(key ipld.Node) (ipld.Node, error) {
+	ks, err := key.AsString()
+	if err != nil {
+		return nil, err
+	}
+	return n.LookupByString(ks)
+}
+func (_TypeLink__Repr) LookupByIndex(idx int) (ipld.Node, error) {
+	return mixins.Map{"ast.TypeLink.Repr"}.LookupByIndex(0)
+}
+func (n _TypeLink__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	return n.LookupByString(seg.String())
+}
+func (n *_TypeLink__Repr) MapIterator() ipld.MapIterator {
+	end := 1
+	if n.expectedType.m == schema.Maybe_Absent {
+		end = 0
+	} else {
+		goto done
+	}
+done:
+	return &_TypeLink__ReprMapItr{n, 0, end}
+}
+
+type _TypeLink__ReprMapItr struct {
+	n   *_TypeLink__Repr
+	idx int
+	end int
+}
+
+func (itr *_TypeLink__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+advance:
+	if itr.idx >= 1 {
+		return nil, nil, ipld.ErrIteratorOverread{}
+	}
+	switch itr.idx {
+	case 0:
+		k = &fieldName__TypeLink_ExpectedType_serial
+		if itr.n.expectedType.m == schema.Maybe_Absent {
+			itr.idx++
+			goto advance
+		}
+		v = itr.n.expectedType.v.Representation()
+	default:
+		panic("unreachable")
+	}
+	itr.idx++
+	return
+}
+func (itr *_TypeLink__ReprMapItr) Done() bool {
+	return itr.idx >= itr.end
+}
+func (_TypeLink__Repr) ListIterator() ipld.ListIterator {
+	return nil
+}
+func (rn *_TypeLink__Repr) Length() int {
+	l := 1
+	if rn.expectedType.m == schema.Maybe_Absent {
+		l--
+	}
+	return l
+}
+func (_TypeLink__Repr) IsAbsent() bool {
+	return false
+}
+func (_TypeLink__Repr) IsNull() bool {
+	return false
+}
+func (_TypeLink__Repr) AsBool() (bool, error) {
+	return mixins.Map{"ast.TypeLink.Repr"}.AsBool()
+}
+func (_TypeLink__Repr) AsInt() (int64, error) {
+	return mixins.Map{"ast.TypeLink.Repr"}.AsInt()
+}
+func (_TypeLink__Repr) AsFloat() (float64, error) {
+	return mixins.Map{"ast.TypeLink.Repr"}.AsFloat()
+}
+func (_TypeLink__Repr) AsString() (string, error) {
+	return mixins.Map{"ast.TypeLink.Repr"}.AsString()
+}
+func (_TypeLink__Repr) AsBytes() ([]byte, error) {
+	return mixins.Map{"ast.TypeLink.Repr"}.AsBytes()
+}
+func (_TypeLink__Repr) AsLink() (ipld.Link, error) {
+	return mixins.Map{"ast.TypeLink.Repr"}.AsLink()
+}
+func (_TypeLink__Repr) Prototype() ipld.NodePrototype {
+	return _TypeLink__ReprPrototype{}
+}
+
+type _TypeLink__ReprPrototype struct{}
+
+func (_TypeLink__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+	var nb _TypeLink__ReprBuilder
+	nb.Reset()
+	return &nb
+}
+
+type _TypeLink__ReprBuilder struct {
+	_TypeLink__ReprAssembler
+}
+
+func (nb *_TypeLink__ReprBuilder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_TypeLink__ReprBuilder) Reset() {
+	var w _TypeLink
+	var m schema.Maybe
+	*nb = _TypeLink__ReprBuilder{_TypeLink__ReprAssembler{w: &w, m: &m}}
+}
+
+type _TypeLink__ReprAssembler struct {
+	w     *_TypeLink
+	m     *schema.Maybe
+	state maState
+	s     int
+	f     int
+
+	cm              schema.Maybe
+	ca_expectedType _TypeName__ReprAssembler
+}
+
+func (na *_TypeLink__ReprAssembler) reset() {
+	na.state = maState_initial
+	na.s = 0
+	na.ca_expectedType.reset()
+}
+func (na *_TypeLink__ReprAssembler) BeginMap(int) (ipld.MapAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: it makes no sense to 'begin' twice on the same assembler!")
+	}
+	*na.m = midvalue
+	if na.w == nil {
+		na.w = &_TypeLink{}
+	}
+	return na, nil
+}
+func (_TypeLink__ReprAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.MapAssembler{"ast.TypeLink.Repr"}.BeginList(0)
+}
+func (na *_TypeLink__ReprAssembler) AssignNull() error {
+	switch *na.m {
+	case allowNull:
+		*na.m = schema.Maybe_Null
+		return nil
+	case schema.Maybe_Absent:
+		return mixins.MapAssembler{"ast.TypeLink.Repr.Repr"}.AssignNull()
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+	}
+	panic("unreachable")
+}
+func (_TypeLink__ReprAssembler) AssignBool(bool) error {
+	return mixins.MapAssembler{"ast.TypeLink.Repr"}.AssignBool(false)
+}
+func (_TypeLink__ReprAssembler) AssignInt(int64) error {
+	return mixins.MapAssembler{"ast.TypeLink.Repr"}.AssignInt(0)
+}
+func (_TypeLink__ReprAssembler) AssignFloat(float64) error {
+	return mixins.MapAssembler{"ast.TypeLink.Repr"}.AssignFloat(0)
+}
+func (_TypeLink__ReprAssembler) AssignString(string) error {
+	return mixins.MapAssembler{"ast.TypeLink.Repr"}.AssignString("")
+}
+func (_TypeLink__ReprAssembler) AssignBytes([]byte) error {
+	return mixins.MapAssembler{"ast.TypeLink.Repr"}.AssignBytes(nil)
+}
+func (_TypeLink__ReprAssembler) AssignLink(ipld.Link) error {
+	return mixins.MapAssembler{"ast.TypeLink.Repr"}.AssignLink(nil)
+}
+func (na *_TypeLink__ReprAssembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_TypeLink); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		case midvalue:
+			panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	if v.ReprKind() != ipld.ReprKind_Map {
+		return ipld.ErrWrongKind{TypeName: "ast.TypeLink.Repr", MethodName: "AssignNode", AppropriateKind: ipld.ReprKindSet_JustMap, ActualKind: v.ReprKind()}
+	}
+	itr := v.MapIterator()
+	for !itr.Done() {
+		k, v, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if err := na.AssembleKey().AssignNode(k); err != nil {
+			return err
+		}
+		if err := na.AssembleValue().AssignNode(v); err != nil {
+			return err
+		}
+	}
+	return na.Finish()
+}
+func (_TypeLink__ReprAssembler) Prototype() ipld.NodePrototype {
+	return _TypeLink__ReprPrototype{}
+}
+func (ma *_TypeLink__ReprAssembler) valueFinishTidy() bool {
+	switch ma.f {
+	case 0:
+		switch ma.w.expectedType.m {
+		case schema.Maybe_Value:
+			ma.w.expectedType.v = ma.ca_expectedType.w
+			ma.state = maState_initial
+			return true
+		default:
+			return false
+		}
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_TypeLink__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleEntry cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleEntry cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleEntry cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleEntry cannot be called on an assembler that's already finished")
+	}
+	switch k {
+	case "expectedType":
+		if ma.s&fieldBit__TypeLink_ExpectedType != 0 {
+			return nil, ipld.ErrRepeatedMapKey{&fieldName__TypeLink_ExpectedType_serial}
+		}
+		ma.s += fieldBit__TypeLink_ExpectedType
+		ma.state = maState_midValue
+		ma.f = 0
+		ma.ca_expectedType.w = ma.w.expectedType.v
+		ma.ca_expectedType.m = &ma.w.expectedType.m
+
+		return &ma.ca_expectedType, nil
+	default:
+		return nil, ipld.ErrInvalidKey{TypeName: "ast.TypeLink.Repr", Key: &_String{k}}
+	}
+}
+func (ma *_TypeLink__ReprAssembler) AssembleKey() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleKey cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleKey cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleKey cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleKey cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midKey
+	return (*_TypeLink__ReprKeyAssembler)(ma)
+}
+func (ma *_TypeLink__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		panic("invalid state: AssembleValue cannot be called when no key is primed")
+	case maState_midKey:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		// carry on
+	case maState_midValue:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling another value")
+	case maState_finished:
+		panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midValue
+	switch ma.f {
+	case 0:
+		ma.ca_expectedType.w = ma.w.expectedType.v
+		ma.ca_expectedType.m = &ma.w.expectedType.m
+
+		return &ma.ca_expectedType
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_TypeLink__ReprAssembler) Finish() error {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: Finish cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		panic("invalid state: Finish cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: Finish cannot be called on an assembler that's already finished")
+	}
+	//FIXME check if all required fields are set
+	ma.state = maState_finished
+	*ma.m = schema.Maybe_Value
+	return nil
+}
+func (ma *_TypeLink__ReprAssembler) KeyPrototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (ma *_TypeLink__ReprAssembler) ValuePrototype(k string) ipld.NodePrototype {
+	panic("todo structbuilder mapassembler repr valueprototype")
+}
+
+type _TypeLink__ReprKeyAssembler _TypeLink__ReprAssembler
+
+func (_TypeLink__ReprKeyAssembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	return mixins.StringAssembler{"ast.TypeLink.Repr.KeyAssembler"}.BeginMap(0)
+}
+func (_TypeLink__ReprKeyAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.StringAssembler{"ast.TypeLink.Repr.KeyAssembler"}.BeginList(0)
+}
+func (na *_TypeLink__ReprKeyAssembler) AssignNull() error {
+	return mixins.StringAssembler{"ast.TypeLink.Repr.KeyAssembler"}.AssignNull()
+}
+func (_TypeLink__ReprKeyAssembler) AssignBool(bool) error {
+	return mixins.StringAssembler{"ast.TypeLink.Repr.KeyAssembler"}.AssignBool(false)
+}
+func (_TypeLink__ReprKeyAssembler) AssignInt(int64) error {
+	return mixins.StringAssembler{"ast.TypeLink.Repr.KeyAssembler"}.AssignInt(0)
+}
+func (_TypeLink__ReprKeyAssembler) AssignFloat(float64) error {
+	return mixins.StringAssembler{"ast.TypeLink.Repr.KeyAssembler"}.AssignFloat(0)
+}
+func (ka *_TypeLink__ReprKeyAssembler) AssignString(k string) error {
+	if ka.state != maState_midKey {
+		panic("misuse: KeyAssembler held beyond its valid lifetime")
+	}
+	switch k {
+	case "expectedType":
+		if ka.s&fieldBit__TypeLink_ExpectedType != 0 {
+			return ipld.ErrRepeatedMapKey{&fieldName__TypeLink_ExpectedType_serial}
+		}
+		ka.s += fieldBit__TypeLink_ExpectedType
+		ka.state = maState_expectValue
+		ka.f = 0
+	default:
+		return ipld.ErrInvalidKey{TypeName: "ast.TypeLink.Repr", Key: &_String{k}}
+	}
+	return nil
+}
+func (_TypeLink__ReprKeyAssembler) AssignBytes([]byte) error {
+	return mixins.StringAssembler{"ast.TypeLink.Repr.KeyAssembler"}.AssignBytes(nil)
+}
+func (_TypeLink__ReprKeyAssembler) AssignLink(ipld.Link) error {
+	return mixins.StringAssembler{"ast.TypeLink.Repr.KeyAssembler"}.AssignLink(nil)
+}
+func (ka *_TypeLink__ReprKeyAssembler) AssignNode(v ipld.Node) error {
+	if v2, err := v.AsString(); err != nil {
+		return err
+	} else {
+		return ka.AssignString(v2)
+	}
+}
+func (_TypeLink__ReprKeyAssembler) Prototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
@@ -0,0 +1,819 @@
+package ast
+
+// Code generated by go-ipld-prime gengo.  DO NOT EDIT.
+
+import (
+	ipld "github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/node/mixins"
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+type _TypeNameOrInlineDefn struct {
+	tag uint
+	x1  _TypeName
+	x2  _TypeDefnInline
+}
+type TypeNameOrInlineDefn = *_TypeNameOrInlineDefn
+
+type _TypeNameOrInlineDefn__iface interface {
+	_TypeNameOrInlineDefn__member()
+}
+
+func (_TypeName) _TypeNameOrInlineDefn__member()       {}
+func (_TypeDefnInline) _TypeNameOrInlineDefn__member() {}
+func (n _TypeNameOrInlineDefn) AsInterface() _TypeNameOrInlineDefn__iface {
+	switch n.tag {
+	case 1:
+		return &n.x1
+	case 2:
+		return &n.x2
+	default:
+		panic("invalid union state; how did you create this object?")
+	}
+}
+
+type _TypeNameOrInlineDefn__Maybe struct {
+	m schema.Maybe
+	v TypeNameOrInlineDefn
+}
+type MaybeTypeNameOrInlineDefn = *_TypeNameOrInlineDefn__Maybe
+
+func (m MaybeTypeNameOrInlineDefn) IsNull() bool {
+	return m.m == schema.Maybe_Null
+}
+func (m MaybeTypeNameOrInlineDefn) IsAbsent() bool {
+	return m.m == schema.Maybe_Absent
+}
+func (m MaybeTypeNameOrInlineDefn) Exists() bool {
+	return m.m == schema.Maybe_Value
+}
+func (m MaybeTypeNameOrInlineDefn) AsNode() ipld.Node {
+	switch m.m {
+	case schema.Maybe_Absent:
+		return ipld.Absent
+	case schema.Maybe_Null:
+		return ipld.Null
+	case schema.Maybe_Value:
+		return m.v
+	default:
+		panic("unreachable")
+	}
+}
+func (m MaybeTypeNameOrInlineDefn) Must() TypeNameOrInlineDefn {
+	if !m.Exists() {
+		panic("unbox of a maybe rejected")
+	}
+	return m.v
+}
+
+var (
+	memberName__TypeNameOrInlineDefn_TypeName       = _String{"TypeName"}
+	memberName__TypeNameOrInlineDefn_TypeDefnInline = _String{"TypeDefnInline"}
+)
+var _ ipld.Node = (TypeNameOrInlineDefn)(&_TypeNameOrInlineDefn{})
+var _ schema.TypedNode = (TypeNameOrInlineDefn)(&_TypeNameOrInlineDefn{})
+
+func (TypeNameOrInlineDefn) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_Map
+}
+func (n TypeNameOrInlineDefn) LookupByString(key string) (ipld.Node, error) {
+	switch key {
+	case "TypeName":
+		if n.tag != 1 {
+			return nil, ipld.ErrNotExists{ipld.PathSegmentOfString(key)}
+		}
+		return &n.x1, nil
+	case "TypeDefnInline":
+		if n.tag != 2 {
+			return nil, ipld.ErrNotExists{ipld.PathSegmentOfString(key)}
+		}
+		return &n.x2, nil
+	default:
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+	}
+}
+func (n TypeNameOrInlineDefn) LookupByNode(key ipld.Node) (ipld.Node, error) {
+	ks, err := key.AsString()
+	if err != nil {
+		return nil, err
+	}
+	return n.LookupByString(ks)
+}
+func (TypeNameOrInlineDefn) LookupByIndex(idx int) (ipld.Node, error) {
+	return mixins.Map{"ast.TypeNameOrInlineDefn"}.LookupByIndex(0)
+}
+func (n TypeNameOrInlineDefn) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	return n.LookupByString(seg.String())
+}
+func (n TypeNameOrInlineDefn) MapIterator() ipld.MapIterator {
+	return &_TypeNameOrInlineDefn__MapItr{n, false}
+}
+
+type _TypeNameOrInlineDefn__MapItr struct {
+	n    TypeNameOrInlineDefn
+	done bool
+}
+
+func (itr *_TypeNameOrInlineDefn__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+	if itr.done {
+		return nil, nil, ipld.ErrIteratorOverread{}
+	}
+	switch itr.n.tag {
+	case 1:
+		k, v = &memberName__TypeNameOrInlineDefn_TypeName, &itr.n.x1
+	case 2:
+		k, v = &memberName__TypeNameOrInlineDefn_TypeDefnInline, &itr.n.x2
+	default:
+		panic("unreachable")
+	}
+	itr.done = true
+	return
+}
+func (itr *_TypeNameOrInlineDefn__MapItr) Done() bool {
+	return itr.done
+}
+
+func (TypeNameOrInlineDefn) ListIterator() ipld.ListIterator {
+	return nil
+}
+func (TypeNameOrInlineDefn) Length() int {
+	return 1
+}
+func (TypeNameOrInlineDefn) IsAbsent() bool {
+	return false
+}
+func (TypeNameOrInlineDefn) IsNull() bool {
+	return false
+}
+func (TypeNameOrInlineDefn) AsBool() (bool, error) {
+	return mixins.Map{"ast.TypeNameOrInlineDefn"}.AsBool()
+}
+func (TypeNameOrInlineDefn) AsInt() (int64, error) {
+	return mixins.Map{"ast.TypeNameOrInlineDefn"}.AsInt()
+}
+func (TypeNameOrInlineDefn) AsFloat() (float64, error) {
+	return mixins.Map{"ast.TypeNameOrInlineDefn"}.AsFloat()
+}
+func (TypeNameOrInlineDefn) AsString() (string, error) {
+	return mixins.Map{"ast.TypeNameOrInlineDefn"}.AsString()
+}
+func (TypeNameOrInlineDefn) AsBytes() ([]byte, error) {
+	return mixins.Map{"ast.TypeNameOrInlineDefn"}.AsBytes()
+}
+func (TypeNameOrInlineDefn) AsLink() (ipld.Link, error) {
+	return mixins.Map{"ast.TypeNameOrInlineDefn"}.AsLink()
+}
+func (TypeNameOrInlineDefn) Prototype() ipld.NodePrototype {
+	return _TypeNameOrInlineDefn__Prototype{}
+}
+
+type _TypeNameOrInlineDefn__Prototype struct{}
+
+func (_TypeNameOrInlineDefn__Prototype) NewBuilder() ipld.NodeBuilder {
+	var nb _TypeNameOrInlineDefn__Builder
+	nb.Reset()
+	return &nb
+}
+
+type _TypeNameOrInlineDefn__Builder struct {
+	_TypeNameOrInlineDefn__Assembler
+}
+
+func (nb *_TypeNameOrInlineDefn__Builder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_TypeNameOrInlineDefn__Builder) Reset() {
+	var w _TypeNameOrInlineDefn
+	var m schema.Maybe
+	*nb = _TypeNameOrInlineDefn__Builder{_TypeNameOrInlineDefn__Assembler{w: &w, m: &m}}
+}
+
+type _TypeNameOrInlineDefn__Assembler struct {
+	w     *_TypeNameOrInlineDefn
+	m     *schema.Maybe
+	state maState
+
+	cm  schema.Maybe
+	ca1 _TypeName__Assembler
+
+	ca2 _TypeDefnInline__Assembler
+	ca  uint
+}
+
+func (na *_TypeNameOrInlineDefn__Assembler) reset() {
+	na.state = maState_initial
+	switch na.ca {
+	case 0:
+		return
+	case 1:
+		na.ca1.reset()
+
+	case 2:
+		na.ca2.reset()
+	default:
+		panic("unreachable")
+	}
+	na.ca = 0
+	na.cm = schema.Maybe_Absent
+}
+func (na *_TypeNameOrInlineDefn__Assembler) BeginMap(int) (ipld.MapAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: it makes no sense to 'begin' twice on the same assembler!")
+	}
+	*na.m = midvalue
+	if na.w == nil {
+		na.w = &_TypeNameOrInlineDefn{}
+	}
+	return na, nil
+}
+func (_TypeNameOrInlineDefn__Assembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.MapAssembler{"ast.TypeNameOrInlineDefn"}.BeginList(0)
+}
+func (na *_TypeNameOrInlineDefn__Assembler) AssignNull() error {
+	switch *na.m {
+	case allowNull:
+		*na.m = schema.Maybe_Null
+		return nil
+	case schema.Maybe_Absent:
+		return mixins.MapAssembler{"ast.TypeNameOrInlineDefn"}.AssignNull()
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+	}
+	panic("unreachable")
+}
+func (_TypeNameOrInlineDefn__Assembler) AssignBool(bool) error {
+	return mixins.MapAssembler{"ast.TypeNameOrInlineDefn"}.AssignBool(false)
+}
+func (_TypeNameOrInlineDefn__Assembler) AssignInt(int64) error {
+	return mixins.MapAssembler{"ast.TypeNameOrInlineDefn"}.AssignInt(0)
+}
+func (_TypeNameOrInlineDefn__Assembler) AssignFloat(float64) error {
+	return mixins.MapAssembler{"ast.TypeNameOrInlineDefn"}.AssignFloat(0)
+}
+func (_TypeNameOrInlineDefn__Assembler) AssignString(string) error {
+	return mixins.MapAssembler{"ast.TypeNameOrInlineDefn"}.AssignString("")
+}
+func (_TypeNameOrInlineDefn__Assembler) AssignBytes([]byte) error {
+	return mixins.MapAssembler{"ast.TypeNameOrInlineDefn"}.AssignBytes(nil)
+}
+func (_TypeNameOrInlineDefn__Assembler) AssignLink(ipld.Link) error {
+	return mixins.MapAssembler{"ast.TypeNameOrInlineDefn"}.AssignLink(nil)
+}
+func (na *_TypeNameOrInlineDefn__Assembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_TypeNameOrInlineDefn); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		case midvalue:
+			panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	if v.ReprKind() != ipld.ReprKind_Map {
+		return ipld.ErrWrongKind{TypeName: "ast.TypeNameOrInlineDefn", MethodName: "AssignNode", AppropriateKind: ipld.ReprKindSet_JustMap, ActualKind: v.ReprKind()}
+	}
+	itr := v.MapIterator()
+	for !itr.Done() {
+		k, v, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if err := na.AssembleKey().AssignNode(k); err != nil {
+			return err
+		}
+		if err := na.AssembleValue().AssignNode(v); err != nil {
+			return err
+		}
+	}
+	return na.Finish()
+}
+func (_TypeNameOrInlineDefn__Assembler) Prototype() ipld.NodePrototype {
+	return _TypeNameOrInlineDefn__Prototype{}
+}
+func (ma *_TypeNameOrInlineDefn__Assembler) valueFinishTidy() bool {
+	switch ma.cm {
+	case schema.Maybe_Value:
+		ma.state = maState_initial
+		return true
+	default:
+		return false
+	}
+}
+func (ma *_TypeNameOrInlineDefn__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleEntry cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleEntry cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleEntry cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on for the moment, but we'll still be erroring shortly.
+	case maState_finished:
+		panic("invalid state: AssembleEntry cannot be called on an assembler that's already finished")
+	}
+	if ma.ca != 0 {
+		return nil, schema.ErrNotUnionStructure{TypeName: "ast.TypeNameOrInlineDefn", Detail: "cannot add another entry -- a union can only contain one thing!"}
+	}
+	switch k {
+	case "TypeName":
+		ma.state = maState_midValue
+		ma.ca = 1
+		ma.w.tag = 1
+		ma.ca1.w = &ma.w.x1
+		ma.ca1.m = &ma.cm
+		return &ma.ca1, nil
+	case "TypeDefnInline":
+		ma.state = maState_midValue
+		ma.ca = 2
+		ma.w.tag = 2
+		ma.ca2.w = &ma.w.x2
+		ma.ca2.m = &ma.cm
+		return &ma.ca2, nil
+	default:
+		return nil, ipld.ErrInvalidKey{TypeName: "ast.TypeNameOrInlineDefn", Key: &_String{k}}
+	}
+}
+func (ma *_TypeNameOrInlineDefn__Assembler) AssembleKey() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleKey cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleKey cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleKey cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on for the moment, but we'll still be erroring shortly... or rather, the keyassembler will be.
+	case maState_finished:
+		panic("invalid state: AssembleKey cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midKey
+	return (*_TypeNameOrInlineDefn__KeyAssembler)(ma)
+}
+func (ma *_TypeNameOrInlineDefn__Assembler) AssembleValue() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		panic("invalid state: AssembleValue cannot be called when no key is primed")
+	case maState_midKey:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		// carry on
+	case maState_midValue:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling another value")
+	case maState_finished:
+		panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midValue
+	switch ma.ca {
+	case 0:
+		ma.ca1.w = &ma.w.x1
+		ma.ca1.m = &ma.cm
+		return &ma.ca1
+	case 1:
+		ma.ca2.w = &ma.w.x2
+		ma.ca2.m = &ma.cm
+		return &ma.ca2
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_TypeNameOrInlineDefn__Assembler) Finish() error {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: Finish cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		panic("invalid state: Finish cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: Finish cannot be called on an assembler that's already finished")
+	}
+	if ma.ca == 0 {
+		return schema.ErrNotUnionStructure{TypeName: "ast.TypeNameOrInlineDefn", Detail: "a union must have exactly one entry (not none)!"}
+	}
+	ma.state = maState_finished
+	*ma.m = schema.Maybe_Value
+	return nil
+}
+func (ma *_TypeNameOrInlineDefn__Assembler) KeyPrototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (ma *_TypeNameOrInlineDefn__Assembler) ValuePrototype(k string) ipld.NodePrototype {
+	switch k {
+	case "TypeName":
+		return _TypeName__Prototype{}
+	case "TypeDefnInline":
+		return _TypeDefnInline__Prototype{}
+	default:
+		return nil
+	}
+}
+
+type _TypeNameOrInlineDefn__KeyAssembler _TypeNameOrInlineDefn__Assembler
+
+func (_TypeNameOrInlineDefn__KeyAssembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	return mixins.StringAssembler{"ast.TypeNameOrInlineDefn.KeyAssembler"}.BeginMap(0)
+}
+func (_TypeNameOrInlineDefn__KeyAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.StringAssembler{"ast.TypeNameOrInlineDefn.KeyAssembler"}.BeginList(0)
+}
+func (na *_TypeNameOrInlineDefn__KeyAssembler) AssignNull() error {
+	return mixins.StringAssembler{"ast.TypeNameOrInlineDefn.KeyAssembler"}.AssignNull()
+}
+func (_TypeNameOrInlineDefn__KeyAssembler) AssignBool(bool) error {
+	return mixins.StringAssembler{"ast.TypeNameOrInlineDefn.KeyAssembler"}.AssignBool(false)
+}
+func (_TypeNameOrInlineDefn__KeyAssembler) AssignInt(int64) error {
+	return mixins.StringAssembler{"ast.TypeNameOrInlineDefn.KeyAssembler"}.AssignInt(0)
+}
+func (_TypeNameOrInlineDefn__KeyAssembler) AssignFloat(float64) error {
+	return mixins.StringAssembler{"ast.TypeNameOrInlineDefn.KeyAssembler"}.AssignFloat(0)
+}
+func (ka *_TypeNameOrInlineDefn__KeyAssembler) AssignString(k string) error {
+	if ka.state != maState_midKey {
+		panic("misuse: KeyAssembler held beyond its valid lifetime")
+	}
+	if ka.ca != 0 {
+		return schema.ErrNotUnionStructure{TypeName: "ast.TypeNameOrInlineDefn", Detail: "cannot add another entry -- a union can only contain one thing!"}
+	}
+	switch k {
+	case "TypeName":
+		ka.ca = 1
+		ka.w.tag = 1
+		ka.state = maState_expectValue
+		return nil
+	case "TypeDefnInline":
+		ka.ca = 2
+		ka.w.tag = 2
+		ka.state = maState_expectValue
+		return nil
+	default:
+		return ipld.ErrInvalidKey{TypeName: "ast.TypeNameOrInlineDefn", Key: &_String{k}} // TODO: error quality: ErrInvalidUnionDiscriminant ?
+	}
+	return nil
+}
+func (_TypeNameOrInlineDefn__KeyAssembler) AssignBytes([]byte) error {
+	return mixins.StringAssembler{"ast.TypeNameOrInlineDefn.KeyAssembler"}.AssignBytes(nil)
+}
+func (_TypeNameOrInlineDefn__KeyAssembler) AssignLink(ipld.Link) error {
+	return mixins.StringAssembler{"ast.TypeNameOrInlineDefn.KeyAssembler"}.AssignLink(nil)
+}
+func (ka *_TypeNameOrInlineDefn__KeyAssembler) AssignNode(v ipld.Node) error {
+	if v2, err := v.AsString(); err != nil {
+		return err
+	} else {
+		return ka.AssignString(v2)
+	}
+}
+func (_TypeNameOrInlineDefn__KeyAssembler) Prototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (TypeNameOrInlineDefn) Type() schema.Type {
+	return nil /*TODO:typelit*/
+}
+func (n TypeNameOrInlineDefn) Representation() ipld.Node {
+	return (*_TypeNameOrInlineDefn__Repr)(n)
+}
+
+type _TypeNameOrInlineDefn__Repr _TypeNameOrInlineDefn
+
+var _ ipld.Node = &_TypeNameOrInlineDefn__Repr{}
+
+func (n *_TypeNameOrInlineDefn__Repr) ReprKind() ipld.ReprKind {
+	switch n.tag {
+	case 1:
+		return ipld.ReprKind_String
+	case 2:
+		return ipld.ReprKind_Map
+	default:
+		panic("unreachable")
+	}
+}
+func (n *_TypeNameOrInlineDefn__Repr) LookupByString(key string) (ipld.Node, error) {
+	switch n.tag {
+	case 2:
+		return n.x2.Representation().LookupByString(key)
+	default:
+		return nil, ipld.ErrWrongKind{TypeName: "ast.TypeNameOrInlineDefn.Repr", MethodName: "LookupByString", AppropriateKind: ipld.ReprKindSet_JustMap, ActualKind: n.ReprKind()}
+	}
+}
+func (n *_TypeNameOrInlineDefn__Repr) LookupByNode(key ipld.Node) (ipld.Node, error) {
+	switch n.tag {
+	case 2:
+		return n.x2.Representation().LookupByNode(key)
+	default:
+		return nil, ipld.ErrWrongKind{TypeName: "ast.TypeNameOrInlineDefn.Repr", MethodName: "LookupByNode", AppropriateKind: ipld.ReprKindSet_Recursive, ActualKind: n.ReprKind()}
+	}
+}
+func (n *_TypeNameOrInlineDefn__Repr) LookupByIndex(idx int) (ipld.Node, error) {
+	return nil, ipld.ErrWrongKind{TypeName: "ast.TypeNameOrInlineDefn.Repr", MethodName: "LookupByIndex", AppropriateKind: ipld.ReprKindSet_JustList, ActualKind: n.ReprKind()}
+}
+func (n *_TypeNameOrInlineDefn__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	switch n.tag {
+	case 2:
+		return n.x2.Representation().LookupBySegment(seg)
+	default:
+		return nil, ipld.ErrWrongKind{TypeName: "ast.TypeNameOrInlineDefn.Repr", MethodName: "LookupBySegment", AppropriateKind: ipld.ReprKindSet_Recursive, ActualKind: n.ReprKind()}
+	}
+}
+func (n *_TypeNameOrInlineDefn__Repr) MapIterator() ipld.MapIterator {
+	switch n.tag {
+	case 2:
+		return n.x2.Representation().MapIterator()
+	default:
+		return nil
+	}
+}
+func (n *_TypeNameOrInlineDefn__Repr) ListIterator() ipld.ListIterator {
+	return nil
+}
+func (n *_TypeNameOrInlineDefn__Repr) Length() int {
+	switch n.tag {
+	case 2:
+		return n.x2.Representation().Length()
+	default:
+		return -1
+	}
+}
+func (n *_TypeNameOrInlineDefn__Repr) IsAbsent() bool {
+	return false
+}
+func (n *_TypeNameOrInlineDefn__Repr) IsNull() bool {
+	return false
+}
+func (n *_TypeNameOrInlineDefn__Repr) AsBool() (bool, error) {
+	return false, ipld.ErrWrongKind{TypeName: "ast.TypeNameOrInlineDefn.Repr", MethodName: "AsBool", AppropriateKind: ipld.ReprKindSet_JustBool, ActualKind: n.ReprKind()}
+}
+func (n *_TypeNameOrInlineDefn__Repr) AsInt() (int64, error) {
+	return 0, ipld.ErrWrongKind{TypeName: "ast.TypeNameOrInlineDefn.Repr", MethodName: "AsInt", AppropriateKind: ipld.ReprKindSet_JustInt, ActualKind: n.ReprKind()}
+}
+func (n *_TypeNameOrInlineDefn__Repr) AsFloat() (float64, error) {
+	return 0, ipld.ErrWrongKind{TypeName: "ast.TypeNameOrInlineDefn.Repr", MethodName: "AsFloat", AppropriateKind: ipld.ReprKindSet_JustFloat, ActualKind: n.ReprKind()}
+}
+func (n *_TypeNameOrInlineDefn__Repr) AsString() (string, error) {
+	switch n.tag {
+	case 1:
+		return n.x1.Representation().AsString()
+	default:
+		return "", ipld.ErrWrongKind{TypeName: "ast.TypeNameOrInlineDefn.Repr", MethodName: "AsString", AppropriateKind: ipld.ReprKindSet_JustString, ActualKind: n.ReprKind()}
+	}
+}
+func (n *_TypeNameOrInlineDefn__Repr) AsBytes() ([]byte, error) {
+	return nil, ipld.ErrWrongKind{TypeName: "ast.TypeNameOrInlineDefn.Repr", MethodName: "AsBytes", AppropriateKind: ipld.ReprKindSet_JustBytes, ActualKind: n.ReprKind()}
+}
+func (n *_TypeNameOrInlineDefn__Repr) AsLink() (ipld.Link, error) {
+	return nil, ipld.ErrWrongKind{TypeName: "ast.TypeNameOrInlineDefn.Repr", MethodName: "AsLink", AppropriateKind: ipld.ReprKindSet_JustLink, ActualKind: n.ReprKind()}
+}
+func (_TypeNameOrInlineDefn__Repr) Prototype() ipld.NodePrototype {
+	return _TypeNameOrInlineDefn__ReprPrototype{}
+}
+
+type _TypeNameOrInlineDefn__ReprPrototype struct{}
+
+func (_TypeNameOrInlineDefn__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+	var nb _TypeNameOrInlineDefn__ReprBuilder
+	nb.Reset()
+	return &nb
+}
+
+type _TypeNameOrInlineDefn__ReprBuilder struct {
+	_TypeNameOrInlineDefn__ReprAssembler
+}
+
+func (nb *_TypeNameOrInlineDefn__ReprBuilder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_TypeNameOrInlineDefn__ReprBuilder) Reset() {
+	var w _TypeNameOrInlineDefn
+	var m schema.Maybe
+	*nb = _TypeNameOrInlineDefn__ReprBuilder{_TypeNameOrInlineDefn__ReprAssembler{w: &w, m: &m}}
+}
+
+type _TypeNameOrInlineDefn__ReprAssembler struct {
+	w   *_TypeNameOrInlineDefn
+	m   *schema.Maybe
+	ca1 _TypeName__ReprAssembler
+	ca2 _TypeDefnInline__ReprAssembler
+	ca  uint
+}
+
+func (na *_TypeNameOrInlineDefn__ReprAssembler) reset() {
+	switch na.ca {
+	case 0:
+		return
+	case 1:
+		na.ca1.reset()
+	case 2:
+		na.ca2.reset()
+	default:
+		panic("unreachable")
+	}
+	na.ca = 0
+}
+func (na *_TypeNameOrInlineDefn__ReprAssembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign into assembler that's already working on a larger structure!")
+	}
+	if na.w == nil {
+		na.w = &_TypeNameOrInlineDefn{}
+	}
+	na.ca = 2
+	na.w.tag = 2
+	na.ca2.w = &na.w.x2
+	na.ca2.m = na.m
+	return na.ca2.BeginMap(sizeHint)
+	return nil, schema.ErrNotUnionStructure{TypeName: "ast.TypeNameOrInlineDefn.Repr", Detail: "BeginMap called but is not valid for any of the kinds that are valid members of this union"}
+}
+func (na *_TypeNameOrInlineDefn__ReprAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign into assembler that's already working on a larger structure!")
+	}
+	return nil, schema.ErrNotUnionStructure{TypeName: "ast.TypeNameOrInlineDefn.Repr", Detail: "BeginList called but is not valid for any of the kinds that are valid members of this union"}
+}
+func (na *_TypeNameOrInlineDefn__ReprAssembler) AssignNull() error {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign into assembler that's already working on a larger structure!")
+	}
+	return schema.ErrNotUnionStructure{TypeName: "ast.TypeNameOrInlineDefn.Repr", Detail: "AssignNull called but is not valid for any of the kinds that are valid members of this union"}
+}
+func (na *_TypeNameOrInlineDefn__ReprAssembler) AssignBool(v bool) error {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign into assembler that's already working on a larger structure!")
+	}
+	return schema.ErrNotUnionStructure{TypeName: "ast.TypeNameOrInlineDefn.Repr", Detail: "AssignBool called but is not valid for any of the kinds that are valid members of this union"}
+}
+func (na *_TypeNameOrInlineDefn__ReprAssembler) AssignInt(v int64) error {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign into assembler that's already working on a larger structure!")
+	}
+	return schema.ErrNotUnionStructure{TypeName: "ast.TypeNameOrInlineDefn.Repr", Detail: "AssignInt called but is not valid for any of the kinds that are valid members of this union"}
+}
+func (na *_TypeNameOrInlineDefn__ReprAssembler) AssignFloat(v float64) error {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign into assembler that's already working on a larger structure!")
+	}
+	return schema.ErrNotUnionStructure{TypeName: "ast.TypeNameOrInlineDefn.Repr", Detail: "AssignFloat called but is not valid for any of the kinds that are valid members of this union"}
+}
+func (na *_TypeNameOrInlineDefn__ReprAssembler) AssignString(v string) error {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign into assembler that's already working on a larger structure!")
+	}
+	if na.w == nil {
+		na.w = &_TypeNameOrInlineDefn{}
+	}
+	na.ca = 1
+	na.w.tag = 1
+	na.ca1.w = &na.w.x1
+	na.ca1.m = na.m
+	return na.ca1.AssignString(v)
+	return schema.ErrNotUnionStructure{TypeName: "ast.TypeNameOrInlineDefn.Repr", Detail: "AssignString called but is not valid for any of the kinds that are valid members of this union"}
+}
+func (na *_TypeNameOrInlineDefn__ReprAssembler) AssignBytes(v []byte) error {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign into assembler that's already working on a larger structure!")
+	}
+	return schema.ErrNotUnionStructure{TypeName: "ast.TypeNameOrInlineDefn.Repr", Detail: "AssignBytes called but is not valid for any of the kinds that are valid members of this union"}
+}
+func (na *_TypeNameOrInlineDefn__ReprAssembler) AssignLink(v ipld.Link) error {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign into assembler that's already working on a larger structure!")
+	}
+	return schema.ErrNotUnionStructure{TypeName: "ast.TypeNameOrInlineDefn.Repr", Detail: "AssignLink called but is not valid for any of the kinds that are valid members of this union"}
+}
+func (na *_TypeNameOrInlineDefn__ReprAssembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_TypeNameOrInlineDefn); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		case midvalue:
+			panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	switch v.ReprKind() {
+	case ipld.ReprKind_Bool:
+		v2, _ := v.AsBool()
+		return na.AssignBool(v2)
+	case ipld.ReprKind_Int:
+		v2, _ := v.AsInt()
+		return na.AssignInt(v2)
+	case ipld.ReprKind_Float:
+		v2, _ := v.AsFloat()
+		return na.AssignFloat(v2)
+	case ipld.ReprKind_String:
+		v2, _ := v.AsString()
+		return na.AssignString(v2)
+	case ipld.ReprKind_Bytes:
+		v2, _ := v.AsBytes()
+		return na.AssignBytes(v2)
+	case ipld.ReprKind_Map:
+		na, err := na.BeginMap(v.Length())
+		if err != nil {
+			return err
+		}
+		itr := v.MapIterator()
+		for !itr.Done() {
+			k, v, err := itr.Next()
+			if err != nil {
+				return err
+			}
+			if err := na.AssembleKey().AssignNode(k); err != nil {
+				return err
+			}
+			if err := na.AssembleValue().AssignNode(v); err != nil {
+				return err
+			}
+		}
+		return na.Finish()
+	case ipld.ReprKind_List:
+		na, err := na.BeginList(v.Length())
+		if err != nil {
+			return err
+		}
+		itr := v.ListIterator()
+		for !itr.Done() {
+			_, v, err := itr.Next()
+			if err != nil {
+				return err
+			}
+			if err := na.AssembleValue().AssignNode(v); err != nil {
+				return err
+			}
+		}
+		return na.Finish()
+	case ipld.ReprKind_Link:
+		v2, _ := v.AsLink()
+		return na.AssignLink(v2)
+	default:
+		panic("unreachable")
+	}
+}
+func (na *_TypeNameOrInlineDefn__ReprAssembler) Prototype() ipld.NodePrototype {
+	return _TypeNameOrInlineDefn__ReprPrototype{}
+}
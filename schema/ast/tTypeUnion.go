@@ -0,0 +1,922 @@
+package ast
+
+// Code generated by go-ipld-prime gengo.  DO NOT EDIT.
+
+import (
+	ipld "github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/node/mixins"
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+type _TypeUnion struct {
+	members        _List__TypeName
+	representation _UnionRepresentation
+}
+type TypeUnion = *_TypeUnion
+
+func (n _TypeUnion) FieldMembers() List__TypeName {
+	return &n.members
+}
+func (n _TypeUnion) FieldRepresentation() UnionRepresentation {
+	return &n.representation
+}
+
+type _TypeUnion__Maybe struct {
+	m schema.Maybe
+	v TypeUnion
+}
+type MaybeTypeUnion = *_TypeUnion__Maybe
+
+func (m MaybeTypeUnion) IsNull() bool {
+	return m.m == schema.Maybe_Null
+}
+func (m MaybeTypeUnion) IsAbsent() bool {
+	return m.m == schema.Maybe_Absent
+}
+func (m MaybeTypeUnion) Exists() bool {
+	return m.m == schema.Maybe_Value
+}
+func (m MaybeTypeUnion) AsNode() ipld.Node {
+	switch m.m {
+	case schema.Maybe_Absent:
+		return ipld.Absent
+	case schema.Maybe_Null:
+		return ipld.Null
+	case schema.Maybe_Value:
+		return m.v
+	default:
+		panic("unreachable")
+	}
+}
+func (m MaybeTypeUnion) Must() TypeUnion {
+	if !m.Exists() {
+		panic("unbox of a maybe rejected")
+	}
+	return m.v
+}
+
+var (
+	fieldName__TypeUnion_Members        = _String{"members"}
+	fieldName__TypeUnion_Representation = _String{"representation"}
+)
+var _ ipld.Node = (TypeUnion)(&_TypeUnion{})
+var _ schema.TypedNode = (TypeUnion)(&_TypeUnion{})
+
+func (TypeUnion) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_Map
+}
+func (n TypeUnion) LookupByString(key string) (ipld.Node, error) {
+	switch key {
+	case "members":
+		return &n.members, nil
+	case "representation":
+		return &n.representation, nil
+	default:
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+	}
+}
+func (n TypeUnion) LookupByNode(key ipld.Node) (ipld.Node, error) {
+	ks, err := key.AsString()
+	if err != nil {
+		return nil, err
+	}
+	return n.LookupByString(ks)
+}
+func (TypeUnion) LookupByIndex(idx int) (ipld.Node, error) {
+	return mixins.Map{"ast.TypeUnion"}.LookupByIndex(0)
+}
+func (n TypeUnion) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	return n.LookupByString(seg.String())
+}
+func (n TypeUnion) MapIterator() ipld.MapIterator {
+	return &_TypeUnion__MapItr{n, 0}
+}
+
+type _TypeUnion__MapItr struct {
+	n   TypeUnion
+	idx int
+}
+
+func (itr *_TypeUnion__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+	if itr.idx >= 2 {
+		return nil, nil, ipld.ErrIteratorOverread{}
+	}
+	switch itr.idx {
+	case 0:
+		k = &fieldName__TypeUnion_Members
+		v = &itr.n.members
+	case 1:
+		k = &fieldName__TypeUnion_Representation
+		v = &itr.n.representation
+	default:
+		panic("unreachable")
+	}
+	itr.idx++
+	return
+}
+func (itr *_TypeUnion__MapItr) Done() bool {
+	return itr.idx >= 2
+}
+
+func (TypeUnion) ListIterator() ipld.ListIterator {
+	return nil
+}
+func (TypeUnion) Length() int {
+	return 2
+}
+func (TypeUnion) IsAbsent() bool {
+	return false
+}
+func (TypeUnion) IsNull() bool {
+	return false
+}
+func (TypeUnion) AsBool() (bool, error) {
+	return mixins.Map{"ast.TypeUnion"}.AsBool()
+}
+func (TypeUnion) AsInt() (int64, error) {
+	return mixins.Map{"ast.TypeUnion"}.AsInt()
+}
+func (TypeUnion) AsFloat() (float64, error) {
+	return mixins.Map{"ast.TypeUnion"}.AsFloat()
+}
+func (TypeUnion) AsString() (string, error) {
+	return mixins.Map{"ast.TypeUnion"}.AsString()
+}
+func (TypeUnion) AsBytes() ([]byte, error) {
+	return mixins.Map{"ast.TypeUnion"}.AsBytes()
+}
+func (TypeUnion) AsLink() (ipld.Link, error) {
+	return mixins.Map{"ast.TypeUnion"}.AsLink()
+}
+func (TypeUnion) Prototype() ipld.NodePrototype {
+	return _TypeUnion__Prototype{}
+}
+
+type _TypeUnion__Prototype struct{}
+
+func (_TypeUnion__Prototype) NewBuilder() ipld.NodeBuilder {
+	var nb _TypeUnion__Builder
+	nb.Reset()
+	return &nb
+}
+
+type _TypeUnion__Builder struct {
+	_TypeUnion__Assembler
+}
+
+func (nb *_TypeUnion__Builder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_TypeUnion__Builder) Reset() {
+	var w _TypeUnion
+	var m schema.Maybe
+	*nb = _TypeUnion__Builder{_TypeUnion__Assembler{w: &w, m: &m}}
+}
+
+type _TypeUnion__Assembler struct {
+	w     *_TypeUnion
+	m     *schema.Maybe
+	state maState
+	s     int
+	f     int
+
+	cm                schema.Maybe
+	ca_members        _List__TypeName__Assembler
+	ca_representation _UnionRepresentation__Assembler
+}
+
+func (na *_TypeUnion__Assembler) reset() {
+	na.state = maState_initial
+	na.s = 0
+	na.ca_members.reset()
+	na.ca_representation.reset()
+}
+
+var (
+	fieldBit__TypeUnion_Members        = 1 << 0
+	fieldBit__TypeUnion_Representation = 1 << 1
+	fieldBits__TypeUnion_sufficient    = 0 + 1<<0 + 1<<1
+)
+
+func (na *_TypeUnion__Assembler) BeginMap(int) (ipld.MapAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: it makes no sense to 'begin' twice on the same assembler!")
+	}
+	*na.m = midvalue
+	if na.w == nil {
+		na.w = &_TypeUnion{}
+	}
+	return na, nil
+}
+func (_TypeUnion__Assembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.MapAssembler{"ast.TypeUnion"}.BeginList(0)
+}
+func (na *_TypeUnion__Assembler) AssignNull() error {
+	switch *na.m {
+	case allowNull:
+		*na.m = schema.Maybe_Null
+		return nil
+	case schema.Maybe_Absent:
+		return mixins.MapAssembler{"ast.TypeUnion"}.AssignNull()
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+	}
+	panic("unreachable")
+}
+func (_TypeUnion__Assembler) AssignBool(bool) error {
+	return mixins.MapAssembler{"ast.TypeUnion"}.AssignBool(false)
+}
+func (_TypeUnion__Assembler) AssignInt(int64) error {
+	return mixins.MapAssembler{"ast.TypeUnion"}.AssignInt(0)
+}
+func (_TypeUnion__Assembler) AssignFloat(float64) error {
+	return mixins.MapAssembler{"ast.TypeUnion"}.AssignFloat(0)
+}
+func (_TypeUnion__Assembler) AssignString(string) error {
+	return mixins.MapAssembler{"ast.TypeUnion"}.AssignString("")
+}
+func (_TypeUnion__Assembler) AssignBytes([]byte) error {
+	return mixins.MapAssembler{"ast.TypeUnion"}.AssignBytes(nil)
+}
+func (_TypeUnion__Assembler) AssignLink(ipld.Link) error {
+	return mixins.MapAssembler{"ast.TypeUnion"}.AssignLink(nil)
+}
+func (na *_TypeUnion__Assembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_TypeUnion); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		case midvalue:
+			panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	if v.ReprKind() != ipld.ReprKind_Map {
+		return ipld.ErrWrongKind{TypeName: "ast.TypeUnion", MethodName: "AssignNode", AppropriateKind: ipld.ReprKindSet_JustMap, ActualKind: v.ReprKind()}
+	}
+	itr := v.MapIterator()
+	for !itr.Done() {
+		k, v, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if err := na.AssembleKey().AssignNode(k); err != nil {
+			return err
+		}
+		if err := na.AssembleValue().AssignNode(v); err != nil {
+			return err
+		}
+	}
+	return na.Finish()
+}
+func (_TypeUnion__Assembler) Prototype() ipld.NodePrototype {
+	return _TypeUnion__Prototype{}
+}
+func (ma *_TypeUnion__Assembler) valueFinishTidy() bool {
+	switch ma.f {
+	case 0:
+		switch ma.cm {
+		case schema.Maybe_Value:
+			ma.ca_members.w = nil
+			ma.cm = schema.Maybe_Absent
+			ma.state = maState_initial
+			return true
+		default:
+			return false
+		}
+	case 1:
+		switch ma.cm {
+		case schema.Maybe_Value:
+			ma.ca_representation.w = nil
+			ma.cm = schema.Maybe_Absent
+			ma.state = maState_initial
+			return true
+		default:
+			return false
+		}
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_TypeUnion__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleEntry cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleEntry cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleEntry cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleEntry cannot be called on an assembler that's already finished")
+	}
+	switch k {
+	case "members":
+		if ma.s&fieldBit__TypeUnion_Members != 0 {
+			return nil, ipld.ErrRepeatedMapKey{&fieldName__TypeUnion_Members}
+		}
+		ma.s += fieldBit__TypeUnion_Members
+		ma.state = maState_midValue
+		ma.f = 0
+		ma.ca_members.w = &ma.w.members
+		ma.ca_members.m = &ma.cm
+		return &ma.ca_members, nil
+	case "representation":
+		if ma.s&fieldBit__TypeUnion_Representation != 0 {
+			return nil, ipld.ErrRepeatedMapKey{&fieldName__TypeUnion_Representation}
+		}
+		ma.s += fieldBit__TypeUnion_Representation
+		ma.state = maState_midValue
+		ma.f = 1
+		ma.ca_representation.w = &ma.w.representation
+		ma.ca_representation.m = &ma.cm
+		return &ma.ca_representation, nil
+	default:
+		return nil, ipld.ErrInvalidKey{TypeName: "ast.TypeUnion", Key: &_String{k}}
+	}
+}
+func (ma *_TypeUnion__Assembler) AssembleKey() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleKey cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleKey cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleKey cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleKey cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midKey
+	return (*_TypeUnion__KeyAssembler)(ma)
+}
+func (ma *_TypeUnion__Assembler) AssembleValue() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		panic("invalid state: AssembleValue cannot be called when no key is primed")
+	case maState_midKey:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		// carry on
+	case maState_midValue:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling another value")
+	case maState_finished:
+		panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midValue
+	switch ma.f {
+	case 0:
+		ma.ca_members.w = &ma.w.members
+		ma.ca_members.m = &ma.cm
+		return &ma.ca_members
+	case 1:
+		ma.ca_representation.w = &ma.w.representation
+		ma.ca_representation.m = &ma.cm
+		return &ma.ca_representation
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_TypeUnion__Assembler) Finish() error {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: Finish cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		panic("invalid state: Finish cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: Finish cannot be called on an assembler that's already finished")
+	}
+	//FIXME check if all required fields are set
+	ma.state = maState_finished
+	*ma.m = schema.Maybe_Value
+	return nil
+}
+func (ma *_TypeUnion__Assembler) KeyPrototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (ma *_TypeUnion__Assembler) ValuePrototype(k string) ipld.NodePrototype {
+	panic("todo structbuilder mapassembler valueprototype")
+}
+
+type _TypeUnion__KeyAssembler _TypeUnion__Assembler
+
+func (_TypeUnion__KeyAssembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	return mixins.StringAssembler{"ast.TypeUnion.KeyAssembler"}.BeginMap(0)
+}
+func (_TypeUnion__KeyAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.StringAssembler{"ast.TypeUnion.KeyAssembler"}.BeginList(0)
+}
+func (na *_TypeUnion__KeyAssembler) AssignNull() error {
+	return mixins.StringAssembler{"ast.TypeUnion.KeyAssembler"}.AssignNull()
+}
+func (_TypeUnion__KeyAssembler) AssignBool(bool) error {
+	return mixins.StringAssembler{"ast.TypeUnion.KeyAssembler"}.AssignBool(false)
+}
+func (_TypeUnion__KeyAssembler) AssignInt(int64) error {
+	return mixins.StringAssembler{"ast.TypeUnion.KeyAssembler"}.AssignInt(0)
+}
+func (_TypeUnion__KeyAssembler) AssignFloat(float64) error {
+	return mixins.StringAssembler{"ast.TypeUnion.KeyAssembler"}.AssignFloat(0)
+}
+func (ka *_TypeUnion__KeyAssembler) AssignString(k string) error {
+	if ka.state != maState_midKey {
+		panic("misuse: KeyAssembler held beyond its valid lifetime")
+	}
+	switch k {
+	case "members":
+		if ka.s&fieldBit__TypeUnion_Members != 0 {
+			return ipld.ErrRepeatedMapKey{&fieldName__TypeUnion_Members}
+		}
+		ka.s += fieldBit__TypeUnion_Members
+		ka.state = maState_expectValue
+		ka.f = 0
+	case "representation":
+		if ka.s&fieldBit__TypeUnion_Representation != 0 {
+			return ipld.ErrRepeatedMapKey{&fieldName__TypeUnion_Representation}
+		}
+		ka.s += fieldBit__TypeUnion_Representation
+		ka.state = maState_expectValue
+		ka.f = 1
+	default:
+		return ipld.ErrInvalidKey{TypeName: "ast.TypeUnion", Key: &_String{k}}
+	}
+	return nil
+}
+func (_TypeUnion__KeyAssembler) AssignBytes([]byte) error {
+	return mixins.StringAssembler{"ast.TypeUnion.KeyAssembler"}.AssignBytes(nil)
+}
+func (_TypeUnion__KeyAssembler) AssignLink(ipld.Link) error {
+	return mixins.StringAssembler{"ast.TypeUnion.KeyAssembler"}.AssignLink(nil)
+}
+func (ka *_TypeUnion__KeyAssembler) AssignNode(v ipld.Node) error {
+	if v2, err := v.AsString(); err != nil {
+		return err
+	} else {
+		return ka.AssignString(v2)
+	}
+}
+func (_TypeUnion__KeyAssembler) Prototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (TypeUnion) Type() schema.Type {
+	return nil /*TODO:typelit*/
+}
+func (n TypeUnion) Representation() ipld.Node {
+	return (*_TypeUnion__Repr)(n)
+}
+
+type _TypeUnion__Repr _TypeUnion
+
+var (
+	fieldName__TypeUnion_Members_serial        = _String{"members"}
+	fieldName__TypeUnion_Representation_serial = _String{"representation"}
+)
+var _ ipld.Node = &_TypeUnion__Repr{}
+
+func (_TypeUnion__Repr) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_Map
+}
+func (n *_TypeUnion__Repr) LookupByString(key string) (ipld.Node, error) {
+	switch key {
+	case "members":
+		return n.members.Representation(), nil
+	case "representation":
+		return n.representation.Representation(), nil
+	default:
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+	}
+}
+func (n *_TypeUnion__Repr) LookupByNode(key ipld.Node) (ipld.Node, error) {
+	ks, err := key.AsString()
+	if err != nil {
+		return nil, err
+	}
+	return n.LookupByString(ks)
+}
+func (_TypeUnion__Repr) LookupByIndex(idx int) (ipld.Node, error) {
+	return mixins.Map{"ast.TypeUnion.Repr"}.LookupByIndex(0)
+}
+func (n _TypeUnion__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	return n.LookupByString(seg.String())
+}
+func (n *_TypeUnion__Repr) MapIterator() ipld.MapIterator {
+	return &_TypeUnion__ReprMapItr{n, 0}
+}
+
+type _TypeUnion__ReprMapItr struct {
+	n   *_TypeUnion__Repr
+	idx int
+}
+
+func (itr *_TypeUnion__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+	if itr.idx >= 2 {
+		return nil, nil, ipld.ErrIteratorOverread{}
+	}
+	switch itr.idx {
+	case 0:
+		k = &fieldName__TypeUnion_Members_serial
+		v = itr.n.members.Representation()
+	case 1:
+		k = &fieldName__TypeUnion_Representation_serial
+		v = itr.n.representation.Representation()
+	default:
+		panic("unreachable")
+	}
+	itr.idx++
+	return
+}
+func (itr *_TypeUnion__ReprMapItr) Done() bool {
+	return itr.idx >= 2
+}
+func (_TypeUnion__Repr) ListIterator() ipld.ListIterator {
+	return nil
+}
+func (rn *_TypeUnion__Repr) Length() int {
+	l := 2
+	return l
+}
+func (_TypeUnion__Repr) IsAbsent() bool {
+	return false
+}
+func (_TypeUnion__Repr) IsNull() bool {
+	return false
+}
+func (_TypeUnion__Repr) AsBool() (bool, error) {
+	return mixins.Map{"ast.TypeUnion.Repr"}.AsBool()
+}
+func (_TypeUnion__Repr) AsInt() (int64, error) {
+	return mixins.Map{"ast.TypeUnion.Repr"}.AsInt()
+}
+func (_TypeUnion__Repr) AsFloat() (float64, error) {
+	return mixins.Map{"ast.TypeUnion.Repr"}.AsFloat()
+}
+func (_TypeUnion__Repr) AsString() (string, error) {
+	return mixins.Map{"ast.TypeUnion.Repr"}.AsString()
+}
+func (_TypeUnion__Repr) AsBytes() ([]byte, error) {
+	return mixins.Map{"ast.TypeUnion.Repr"}.AsBytes()
+}
+func (_TypeUnion__Repr) AsLink() (ipld.Link, error) {
+	return mixins.Map{"ast.TypeUnion.Repr"}.AsLink()
+}
+func (_TypeUnion__Repr) Prototype() ipld.NodePrototype {
+	return _TypeUnion__ReprPrototype{}
+}
+
+type _TypeUnion__ReprPrototype struct{}
+
+func (_TypeUnion__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+	var nb _TypeUnion__ReprBuilder
+	nb.Reset()
+	return &nb
+}
+
+type _TypeUnion__ReprBuilder struct {
+	_TypeUnion__ReprAssembler
+}
+
+func (nb *_TypeUnion__ReprBuilder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_TypeUnion__ReprBuilder) Reset() {
+	var w _TypeUnion
+	var m schema.Maybe
+	*nb = _TypeUnion__ReprBuilder{_TypeUnion__ReprAssembler{w: &w, m: &m}}
+}
+
+type _TypeUnion__ReprAssembler struct {
+	w     *_TypeUnion
+	m     *schema.Maybe
+	state maState
+	s     int
+	f     int
+
+	cm                schema.Maybe
+	ca_members        _List__TypeName__ReprAssembler
+	ca_representation _UnionRepresentation__ReprAssembler
+}
+
+func (na *_TypeUnion__ReprAssembler) reset() {
+	na.state = maState_initial
+	na.s = 0
+	na.ca_members.reset()
+	na.ca_representation.reset()
+}
+func (na *_TypeUnion__ReprAssembler) BeginMap(int) (ipld.MapAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: it makes no sense to 'begin' twice on the same assembler!")
+	}
+	*na.m = midvalue
+	if na.w == nil {
+		na.w = &_TypeUnion{}
+	}
+	return na, nil
+}
+func (_TypeUnion__ReprAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.MapAssembler{"ast.TypeUnion.Repr"}.BeginList(0)
+}
+func (na *_TypeUnion__ReprAssembler) AssignNull() error {
+	switch *na.m {
+	case allowNull:
+		*na.m = schema.Maybe_Null
+		return nil
+	case schema.Maybe_Absent:
+		return mixins.MapAssembler{"ast.TypeUnion.Repr.Repr"}.AssignNull()
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+	}
+	panic("unreachable")
+}
+func (_TypeUnion__ReprAssembler) AssignBool(bool) error {
+	return mixins.MapAssembler{"ast.TypeUnion.Repr"}.AssignBool(false)
+}
+func (_TypeUnion__ReprAssembler) AssignInt(int64) error {
+	return mixins.MapAssembler{"ast.TypeUnion.Repr"}.AssignInt(0)
+}
+func (_TypeUnion__ReprAssembler) AssignFloat(float64) error {
+	return mixins.MapAssembler{"ast.TypeUnion.Repr"}.AssignFloat(0)
+}
+func (_TypeUnion__ReprAssembler) AssignString(string) error {
+	return mixins.MapAssembler{"ast.TypeUnion.Repr"}.AssignString("")
+}
+func (_TypeUnion__ReprAssembler) AssignBytes([]byte) error {
+	return mixins.MapAssembler{"ast.TypeUnion.Repr"}.AssignBytes(nil)
+}
+func (_TypeUnion__ReprAssembler) AssignLink(ipld.Link) error {
+	return mixins.MapAssembler{"ast.TypeUnion.Repr"}.AssignLink(nil)
+}
+func (na *_TypeUnion__ReprAssembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_TypeUnion); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		case midvalue:
+			panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	if v.ReprKind() != ipld.ReprKind_Map {
+		return ipld.ErrWrongKind{TypeName: "ast.TypeUnion.Repr", MethodName: "AssignNode", AppropriateKind: ipld.ReprKindSet_JustMap, ActualKind: v.ReprKind()}
+	}
+	itr := v.MapIterator()
+	for !itr.Done() {
+		k, v, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if err := na.AssembleKey().AssignNode(k); err != nil {
+			return err
+		}
+		if err := na.AssembleValue().AssignNode(v); err != nil {
+			return err
+		}
+	}
+	return na.Finish()
+}
+func (_TypeUnion__ReprAssembler) Prototype() ipld.NodePrototype {
+	return _TypeUnion__ReprPrototype{}
+}
+func (ma *_TypeUnion__ReprAssembler) valueFinishTidy() bool {
+	switch ma.f {
+	case 0:
+		switch ma.cm {
+		case schema.Maybe_Value:
+			ma.cm = schema.Maybe_Absent
+			ma.state = maState_initial
+			return true
+		default:
+			return false
+		}
+	case 1:
+		switch ma.cm {
+		case schema.Maybe_Value:
+			ma.cm = schema.Maybe_Absent
+			ma.state = maState_initial
+			return true
+		default:
+			return false
+		}
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_TypeUnion__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleEntry cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleEntry cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleEntry cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleEntry cannot be called on an assembler that's already finished")
+	}
+	switch k {
+	case "members":
+		if ma.s&fieldBit__TypeUnion_Members != 0 {
+			return nil, ipld.ErrRepeatedMapKey{&fieldName__TypeUnion_Members_serial}
+		}
+		ma.s += fieldBit__TypeUnion_Members
+		ma.state = maState_midValue
+		ma.f = 0
+		ma.ca_members.w = &ma.w.members
+		ma.ca_members.m = &ma.cm
+		return &ma.ca_members, nil
+	case "representation":
+		if ma.s&fieldBit__TypeUnion_Representation != 0 {
+			return nil, ipld.ErrRepeatedMapKey{&fieldName__TypeUnion_Representation_serial}
+		}
+		ma.s += fieldBit__TypeUnion_Representation
+		ma.state = maState_midValue
+		ma.f = 1
+		ma.ca_representation.w = &ma.w.representation
+		ma.ca_representation.m = &ma.cm
+		return &ma.ca_representation, nil
+	default:
+		return nil, ipld.ErrInvalidKey{TypeName: "ast.TypeUnion.Repr", Key: &_String{k}}
+	}
+}
+func (ma *_TypeUnion__ReprAssembler) AssembleKey() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleKey cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleKey cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleKey cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleKey cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midKey
+	return (*_TypeUnion__ReprKeyAssembler)(ma)
+}
+func (ma *_TypeUnion__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		panic("invalid state: AssembleValue cannot be called when no key is primed")
+	case maState_midKey:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		// carry on
+	case maState_midValue:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling another value")
+	case maState_finished:
+		panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midValue
+	switch ma.f {
+	case 0:
+		ma.ca_members.w = &ma.w.members
+		ma.ca_members.m = &ma.cm
+		return &ma.ca_members
+	case 1:
+		ma.ca_representation.w = &ma.w.representation
+		ma.ca_representation.m = &ma.cm
+		return &ma.ca_representation
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_TypeUnion__ReprAssembler) Finish() error {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: Finish cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		panic("invalid state: Finish cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: Finish cannot be called on an assembler that's already finished")
+	}
+	//FIXME check if all required fields are set
+	ma.state = maState_finished
+	*ma.m = schema.Maybe_Value
+	return nil
+}
+func (ma *_TypeUnion__ReprAssembler) KeyPrototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (ma *_TypeUnion__ReprAssembler) ValuePrototype(k string) ipld.NodePrototype {
+	panic("todo structbuilder mapassembler repr valueprototype")
+}
+
+type _TypeUnion__ReprKeyAssembler _TypeUnion__ReprAssembler
+
+func (_TypeUnion__ReprKeyAssembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	return mixins.StringAssembler{"ast.TypeUnion.Repr.KeyAssembler"}.BeginMap(0)
+}
+func (_TypeUnion__ReprKeyAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.StringAssembler{"ast.TypeUnion.Repr.KeyAssembler"}.BeginList(0)
+}
+func (na *_TypeUnion__ReprKeyAssembler) AssignNull() error {
+	return mixins.StringAssembler{"ast.TypeUnion.Repr.KeyAssembler"}.AssignNull()
+}
+func (_TypeUnion__ReprKeyAssembler) AssignBool(bool) error {
+	return mixins.StringAssembler{"ast.TypeUnion.Repr.KeyAssembler"}.AssignBool(false)
+}
+func (_TypeUnion__ReprKeyAssembler) AssignInt(int64) error {
+	return mixins.StringAssembler{"ast.TypeUnion.Repr.KeyAssembler"}.AssignInt(0)
+}
+func (_TypeUnion__ReprKeyAssembler) AssignFloat(float64) error {
+	return mixins.StringAssembler{"ast.TypeUnion.Repr.KeyAssembler"}.AssignFloat(0)
+}
+func (ka *_TypeUnion__ReprKeyAssembler) AssignString(k string) error {
+	if ka.state != maState_midKey {
+		panic("misuse: KeyAssembler held beyond its valid lifetime")
+	}
+	switch k {
+	case "members":
+		if ka.s&fieldBit__TypeUnion_Members != 0 {
+			return ipld.ErrRepeatedMapKey{&fieldName__TypeUnion_Members_serial}
+		}
+		ka.s += fieldBit__TypeUnion_Members
+		ka.state = maState_expectValue
+		ka.f = 0
+	case "representation":
+		if ka.s&fieldBit__TypeUnion_Representation != 0 {
+			return ipld.ErrRepeatedMapKey{&fieldName__TypeUnion_Representation_serial}
+		}
+		ka.s += fieldBit__TypeUnion_Representation
+		ka.state = maState_expectValue
+		ka.f = 1
+	default:
+		return ipld.ErrInvalidKey{TypeName: "ast.TypeUnion.Repr", Key: &_String{k}}
+	}
+	return nil
+}
+func (_TypeUnion__ReprKeyAssembler) AssignBytes([]byte) error {
+	return mixins.StringAssembler{"ast.TypeUnion.Repr.KeyAssembler"}.AssignBytes(nil)
+}
+func (_TypeUnion__ReprKeyAssembler) AssignLink(ipld.Link) error {
+	return mixins.StringAssembler{"ast.TypeUnion.Repr.KeyAssembler"}.AssignLink(nil)
+}
+func (ka *_TypeUnion__ReprKeyAssembler) AssignNode(v ipld.Node) error {
+	if v2, err := v.AsString(); err != nil {
+		return err
+	} else {
+		return ka.AssignString(v2)
+	}
+}
+func (_TypeUnion__ReprKeyAssembler) Prototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
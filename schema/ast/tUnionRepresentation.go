@@ -0,0 +1,1130 @@
+package ast
+
+// Code generated by go-ipld-prime gengo.  DO NOT EDIT.
+
+import (
+	ipld "github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/node/mixins"
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+type _UnionRepresentation struct {
+	tag uint
+	x1  _UnionRepresentation_Kinded
+	x2  _UnionRepresentation_Keyed
+	x3  _UnionRepresentation_Envelope
+	x4  _UnionRepresentation_Inline
+	x5  _UnionRepresentation_BytePrefix
+}
+type UnionRepresentation = *_UnionRepresentation
+
+type _UnionRepresentation__iface interface {
+	_UnionRepresentation__member()
+}
+
+func (_UnionRepresentation_Kinded) _UnionRepresentation__member()     {}
+func (_UnionRepresentation_Keyed) _UnionRepresentation__member()      {}
+func (_UnionRepresentation_Envelope) _UnionRepresentation__member()   {}
+func (_UnionRepresentation_Inline) _UnionRepresentation__member()     {}
+func (_UnionRepresentation_BytePrefix) _UnionRepresentation__member() {}
+func (n _UnionRepresentation) AsInterface() _UnionRepresentation__iface {
+	switch n.tag {
+	case 1:
+		return &n.x1
+	case 2:
+		return &n.x2
+	case 3:
+		return &n.x3
+	case 4:
+		return &n.x4
+	case 5:
+		return &n.x5
+	default:
+		panic("invalid union state; how did you create this object?")
+	}
+}
+
+type _UnionRepresentation__Maybe struct {
+	m schema.Maybe
+	v UnionRepresentation
+}
+type MaybeUnionRepresentation = *_UnionRepresentation__Maybe
+
+func (m MaybeUnionRepresentation) IsNull() bool {
+	return m.m == schema.Maybe_Null
+}
+func (m MaybeUnionRepresentation) IsAbsent() bool {
+	return m.m == schema.Maybe_Absent
+}
+func (m MaybeUnionRepresentation) Exists() bool {
+	return m.m == schema.Maybe_Value
+}
+func (m MaybeUnionRepresentation) AsNode() ipld.Node {
+	switch m.m {
+	case schema.Maybe_Absent:
+		return ipld.Absent
+	case schema.Maybe_Null:
+		return ipld.Null
+	case schema.Maybe_Value:
+		return m.v
+	default:
+		panic("unreachable")
+	}
+}
+func (m MaybeUnionRepresentation) Must() UnionRepresentation {
+	if !m.Exists() {
+		panic("unbox of a maybe rejected")
+	}
+	return m.v
+}
+
+var (
+	memberName__UnionRepresentation_UnionRepresentation_Kinded     = _String{"UnionRepresentation_Kinded"}
+	memberName__UnionRepresentation_UnionRepresentation_Keyed      = _String{"UnionRepresentation_Keyed"}
+	memberName__UnionRepresentation_UnionRepresentation_Envelope   = _String{"UnionRepresentation_Envelope"}
+	memberName__UnionRepresentation_UnionRepresentation_Inline     = _String{"UnionRepresentation_Inline"}
+	memberName__UnionRepresentation_UnionRepresentation_BytePrefix = _String{"UnionRepresentation_BytePrefix"}
+)
+var _ ipld.Node = (UnionRepresentation)(&_UnionRepresentation{})
+var _ schema.TypedNode = (UnionRepresentation)(&_UnionRepresentation{})
+
+func (UnionRepresentation) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_Map
+}
+func (n UnionRepresentation) LookupByString(key string) (ipld.Node, error) {
+	switch key {
+	case "UnionRepresentation_Kinded":
+		if n.tag != 1 {
+			return nil, ipld.ErrNotExists{ipld.PathSegmentOfString(key)}
+		}
+		return &n.x1, nil
+	case "UnionRepresentation_Keyed":
+		if n.tag != 2 {
+			return nil, ipld.ErrNotExists{ipld.PathSegmentOfString(key)}
+		}
+		return &n.x2, nil
+	case "UnionRepresentation_Envelope":
+		if n.tag != 3 {
+			return nil, ipld.ErrNotExists{ipld.PathSegmentOfString(key)}
+		}
+		return &n.x3, nil
+	case "UnionRepresentation_Inline":
+		if n.tag != 4 {
+			return nil, ipld.ErrNotExists{ipld.PathSegmentOfString(key)}
+		}
+		return &n.x4, nil
+	case "UnionRepresentation_BytePrefix":
+		if n.tag != 5 {
+			return nil, ipld.ErrNotExists{ipld.PathSegmentOfString(key)}
+		}
+		return &n.x5, nil
+	default:
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+	}
+}
+func (n UnionRepresentation) LookupByNode(key ipld.Node) (ipld.Node, error) {
+	ks, err := key.AsString()
+	if err != nil {
+		return nil, err
+	}
+	return n.LookupByString(ks)
+}
+func (UnionRepresentation) LookupByIndex(idx int) (ipld.Node, error) {
+	return mixins.Map{"ast.UnionRepresentation"}.LookupByIndex(0)
+}
+func (n UnionRepresentation) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	return n.LookupByString(seg.String())
+}
+func (n UnionRepresentation) MapIterator() ipld.MapIterator {
+	return &_UnionRepresentation__MapItr{n, false}
+}
+
+type _UnionRepresentation__MapItr struct {
+	n    UnionRepresentation
+	done bool
+}
+
+func (itr *_UnionRepresentation__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+	if itr.done {
+		return nil, nil, ipld.ErrIteratorOverread{}
+	}
+	switch itr.n.tag {
+	case 1:
+		k, v = &memberName__UnionRepresentation_UnionRepresentation_Kinded, &itr.n.x1
+	case 2:
+		k, v = &memberName__UnionRepresentation_UnionRepresentation_Keyed, &itr.n.x2
+	case 3:
+		k, v = &memberName__UnionRepresentation_UnionRepresentation_Envelope, &itr.n.x3
+	case 4:
+		k, v = &memberName__UnionRepresentation_UnionRepresentation_Inline, &itr.n.x4
+	case 5:
+		k, v = &memberName__UnionRepresentation_UnionRepresentation_BytePrefix, &itr.n.x5
+	default:
+		panic("unreachable")
+	}
+	itr.done = true
+	return
+}
+func (itr *_UnionRepresentation__MapItr) Done() bool {
+	return itr.done
+}
+
+func (UnionRepresentation) ListIterator() ipld.ListIterator {
+	return nil
+}
+func (UnionRepresentation) Length() int {
+	return 1
+}
+func (UnionRepresentation) IsAbsent() bool {
+	return false
+}
+func (UnionRepresentation) IsNull() bool {
+	return false
+}
+func (UnionRepresentation) AsBool() (bool, error) {
+	return mixins.Map{"ast.UnionRepresentation"}.AsBool()
+}
+func (UnionRepresentation) AsInt() (int64, error) {
+	return mixins.Map{"ast.UnionRepresentation"}.AsInt()
+}
+func (UnionRepresentation) AsFloat() (float64, error) {
+	return mixins.Map{"ast.UnionRepresentation"}.AsFloat()
+}
+func (UnionRepresentation) AsString() (string, error) {
+	return mixins.Map{"ast.UnionRepresentation"}.AsString()
+}
+func (UnionRepresentation) AsBytes() ([]byte, error) {
+	return mixins.Map{"ast.UnionRepresentation"}.AsBytes()
+}
+func (UnionRepresentation) AsLink() (ipld.Link, error) {
+	return mixins.Map{"ast.UnionRepresentation"}.AsLink()
+}
+func (UnionRepresentation) Prototype() ipld.NodePrototype {
+	return _UnionRepresentation__Prototype{}
+}
+
+type _UnionRepresentation__Prototype struct{}
+
+func (_UnionRepresentation__Prototype) NewBuilder() ipld.NodeBuilder {
+	var nb _UnionRepresentation__Builder
+	nb.Reset()
+	return &nb
+}
+
+type _UnionRepresentation__Builder struct {
+	_UnionRepresentation__Assembler
+}
+
+func (nb *_UnionRepresentation__Builder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_UnionRepresentation__Builder) Reset() {
+	var w _UnionRepresentation
+	var m schema.Maybe
+	*nb = _UnionRepresentation__Builder{_UnionRepresentation__Assembler{w: &w, m: &m}}
+}
+
+type _UnionRepresentation__Assembler struct {
+	w     *_UnionRepresentation
+	m     *schema.Maybe
+	state maState
+
+	cm  schema.Maybe
+	ca1 _UnionRepresentation_Kinded__Assembler
+
+	ca2 _UnionRepresentation_Keyed__Assembler
+
+	ca3 _UnionRepresentation_Envelope__Assembler
+
+	ca4 _UnionRepresentation_Inline__Assembler
+
+	ca5 _UnionRepresentation_BytePrefix__Assembler
+	ca  uint
+}
+
+func (na *_UnionRepresentation__Assembler) reset() {
+	na.state = maState_initial
+	switch na.ca {
+	case 0:
+		return
+	case 1:
+		na.ca1.reset()
+
+	case 2:
+		na.ca2.reset()
+
+	case 3:
+		na.ca3.reset()
+
+	case 4:
+		na.ca4.reset()
+
+	case 5:
+		na.ca5.reset()
+	default:
+		panic("unreachable")
+	}
+	na.ca = 0
+	na.cm = schema.Maybe_Absent
+}
+func (na *_UnionRepresentation__Assembler) BeginMap(int) (ipld.MapAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: it makes no sense to 'begin' twice on the same assembler!")
+	}
+	*na.m = midvalue
+	if na.w == nil {
+		na.w = &_UnionRepresentation{}
+	}
+	return na, nil
+}
+func (_UnionRepresentation__Assembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.MapAssembler{"ast.UnionRepresentation"}.BeginList(0)
+}
+func (na *_UnionRepresentation__Assembler) AssignNull() error {
+	switch *na.m {
+	case allowNull:
+		*na.m = schema.Maybe_Null
+		return nil
+	case schema.Maybe_Absent:
+		return mixins.MapAssembler{"ast.UnionRepresentation"}.AssignNull()
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+	}
+	panic("unreachable")
+}
+func (_UnionRepresentation__Assembler) AssignBool(bool) error {
+	return mixins.MapAssembler{"ast.UnionRepresentation"}.AssignBool(false)
+}
+func (_UnionRepresentation__Assembler) AssignInt(int64) error {
+	return mixins.MapAssembler{"ast.UnionRepresentation"}.AssignInt(0)
+}
+func (_UnionRepresentation__Assembler) AssignFloat(float64) error {
+	return mixins.MapAssembler{"ast.UnionRepresentation"}.AssignFloat(0)
+}
+func (_UnionRepresentation__Assembler) AssignString(string) error {
+	return mixins.MapAssembler{"ast.UnionRepresentation"}.AssignString("")
+}
+func (_UnionRepresentation__Assembler) AssignBytes([]byte) error {
+	return mixins.MapAssembler{"ast.UnionRepresentation"}.AssignBytes(nil)
+}
+func (_UnionRepresentation__Assembler) AssignLink(ipld.Link) error {
+	return mixins.MapAssembler{"ast.UnionRepresentation"}.AssignLink(nil)
+}
+func (na *_UnionRepresentation__Assembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_UnionRepresentation); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		case midvalue:
+			panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	if v.ReprKind() != ipld.ReprKind_Map {
+		return ipld.ErrWrongKind{TypeName: "ast.UnionRepresentation", MethodName: "AssignNode", AppropriateKind: ipld.ReprKindSet_JustMap, ActualKind: v.ReprKind()}
+	}
+	itr := v.MapIterator()
+	for !itr.Done() {
+		k, v, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if err := na.AssembleKey().AssignNode(k); err != nil {
+			return err
+		}
+		if err := na.AssembleValue().AssignNode(v); err != nil {
+			return err
+		}
+	}
+	return na.Finish()
+}
+func (_UnionRepresentation__Assembler) Prototype() ipld.NodePrototype {
+	return _UnionRepresentation__Prototype{}
+}
+func (ma *_UnionRepresentation__Assembler) valueFinishTidy() bool {
+	switch ma.cm {
+	case schema.Maybe_Value:
+		ma.state = maState_initial
+		return true
+	default:
+		return false
+	}
+}
+func (ma *_UnionRepresentation__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleEntry cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleEntry cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleEntry cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on for the moment, but we'll still be erroring shortly.
+	case maState_finished:
+		panic("invalid state: AssembleEntry cannot be called on an assembler that's already finished")
+	}
+	if ma.ca != 0 {
+		return nil, schema.ErrNotUnionStructure{TypeName: "ast.UnionRepresentation", Detail: "cannot add another entry -- a union can only contain one thing!"}
+	}
+	switch k {
+	case "UnionRepresentation_Kinded":
+		ma.state = maState_midValue
+		ma.ca = 1
+		ma.w.tag = 1
+		ma.ca1.w = &ma.w.x1
+		ma.ca1.m = &ma.cm
+		return &ma.ca1, nil
+	case "UnionRepresentation_Keyed":
+		ma.state = maState_midValue
+		ma.ca = 2
+		ma.w.tag = 2
+		ma.ca2.w = &ma.w.x2
+		ma.ca2.m = &ma.cm
+		return &ma.ca2, nil
+	case "UnionRepresentation_Envelope":
+		ma.state = maState_midValue
+		ma.ca = 3
+		ma.w.tag = 3
+		ma.ca3.w = &ma.w.x3
+		ma.ca3.m = &ma.cm
+		return &ma.ca3, nil
+	case "UnionRepresentation_Inline":
+		ma.state = maState_midValue
+		ma.ca = 4
+		ma.w.tag = 4
+		ma.ca4.w = &ma.w.x4
+		ma.ca4.m = &ma.cm
+		return &ma.ca4, nil
+	case "UnionRepresentation_BytePrefix":
+		ma.state = maState_midValue
+		ma.ca = 5
+		ma.w.tag = 5
+		ma.ca5.w = &ma.w.x5
+		ma.ca5.m = &ma.cm
+		return &ma.ca5, nil
+	default:
+		return nil, ipld.ErrInvalidKey{TypeName: "ast.UnionRepresentation", Key: &_String{k}}
+	}
+}
+func (ma *_UnionRepresentation__Assembler) AssembleKey() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleKey cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleKey cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleKey cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on for the moment, but we'll still be erroring shortly... or rather, the keyassembler will be.
+	case maState_finished:
+		panic("invalid state: AssembleKey cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midKey
+	return (*_UnionRepresentation__KeyAssembler)(ma)
+}
+func (ma *_UnionRepresentation__Assembler) AssembleValue() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		panic("invalid state: AssembleValue cannot be called when no key is primed")
+	case maState_midKey:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		// carry on
+	case maState_midValue:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling another value")
+	case maState_finished:
+		panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midValue
+	switch ma.ca {
+	case 0:
+		ma.ca1.w = &ma.w.x1
+		ma.ca1.m = &ma.cm
+		return &ma.ca1
+	case 1:
+		ma.ca2.w = &ma.w.x2
+		ma.ca2.m = &ma.cm
+		return &ma.ca2
+	case 2:
+		ma.ca3.w = &ma.w.x3
+		ma.ca3.m = &ma.cm
+		return &ma.ca3
+	case 3:
+		ma.ca4.w = &ma.w.x4
+		ma.ca4.m = &ma.cm
+		return &ma.ca4
+	case 4:
+		ma.ca5.w = &ma.w.x5
+		ma.ca5.m = &ma.cm
+		return &ma.ca5
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_UnionRepresentation__Assembler) Finish() error {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: Finish cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		panic("invalid state: Finish cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: Finish cannot be called on an assembler that's already finished")
+	}
+	if ma.ca == 0 {
+		return schema.ErrNotUnionStructure{TypeName: "ast.UnionRepresentation", Detail: "a union must have exactly one entry (not none)!"}
+	}
+	ma.state = maState_finished
+	*ma.m = schema.Maybe_Value
+	return nil
+}
+func (ma *_UnionRepresentation__Assembler) KeyPrototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (ma *_UnionRepresentation__Assembler) ValuePrototype(k string) ipld.NodePrototype {
+	switch k {
+	case "UnionRepresentation_Kinded":
+		return _UnionRepresentation_Kinded__Prototype{}
+	case "UnionRepresentation_Keyed":
+		return _UnionRepresentation_Keyed__Prototype{}
+	case "UnionRepresentation_Envelope":
+		return _UnionRepresentation_Envelope__Prototype{}
+	case "UnionRepresentation_Inline":
+		return _UnionRepresentation_Inline__Prototype{}
+	case "UnionRepresentation_BytePrefix":
+		return _UnionRepresentation_BytePrefix__Prototype{}
+	default:
+		return nil
+	}
+}
+
+type _UnionRepresentation__KeyAssembler _UnionRepresentation__Assembler
+
+func (_UnionRepresentation__KeyAssembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	return mixins.StringAssembler{"ast.UnionRepresentation.KeyAssembler"}.BeginMap(0)
+}
+func (_UnionRepresentation__KeyAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.StringAssembler{"ast.UnionRepresentation.KeyAssembler"}.BeginList(0)
+}
+func (na *_UnionRepresentation__KeyAssembler) AssignNull() error {
+	return mixins.StringAssembler{"ast.UnionRepresentation.KeyAssembler"}.AssignNull()
+}
+func (_UnionRepresentation__KeyAssembler) AssignBool(bool) error {
+	return mixins.StringAssembler{"ast.UnionRepresentation.KeyAssembler"}.AssignBool(false)
+}
+func (_UnionRepresentation__KeyAssembler) AssignInt(int64) error {
+	return mixins.StringAssembler{"ast.UnionRepresentation.KeyAssembler"}.AssignInt(0)
+}
+func (_UnionRepresentation__KeyAssembler) AssignFloat(float64) error {
+	return mixins.StringAssembler{"ast.UnionRepresentation.KeyAssembler"}.AssignFloat(0)
+}
+func (ka *_UnionRepresentation__KeyAssembler) AssignString(k string) error {
+	if ka.state != maState_midKey {
+		panic("misuse: KeyAssembler held beyond its valid lifetime")
+	}
+	if ka.ca != 0 {
+		return schema.ErrNotUnionStructure{TypeName: "ast.UnionRepresentation", Detail: "cannot add another entry -- a union can only contain one thing!"}
+	}
+	switch k {
+	case "UnionRepresentation_Kinded":
+		ka.ca = 1
+		ka.w.tag = 1
+		ka.state = maState_expectValue
+		return nil
+	case "UnionRepresentation_Keyed":
+		ka.ca = 2
+		ka.w.tag = 2
+		ka.state = maState_expectValue
+		return nil
+	case "UnionRepresentation_Envelope":
+		ka.ca = 3
+		ka.w.tag = 3
+		ka.state = maState_expectValue
+		return nil
+	case "UnionRepresentation_Inline":
+		ka.ca = 4
+		ka.w.tag = 4
+		ka.state = maState_expectValue
+		return nil
+	case "UnionRepresentation_BytePrefix":
+		ka.ca = 5
+		ka.w.tag = 5
+		ka.state = maState_expectValue
+		return nil
+	default:
+		return ipld.ErrInvalidKey{TypeName: "ast.UnionRepresentation", Key: &_String{k}} // TODO: error quality: ErrInvalidUnionDiscriminant ?
+	}
+	return nil
+}
+func (_UnionRepresentation__KeyAssembler) AssignBytes([]byte) error {
+	return mixins.StringAssembler{"ast.UnionRepresentation.KeyAssembler"}.AssignBytes(nil)
+}
+func (_UnionRepresentation__KeyAssembler) AssignLink(ipld.Link) error {
+	return mixins.StringAssembler{"ast.UnionRepresentation.KeyAssembler"}.AssignLink(nil)
+}
+func (ka *_UnionRepresentation__KeyAssembler) AssignNode(v ipld.Node) error {
+	if v2, err := v.AsString(); err != nil {
+		return err
+	} else {
+		return ka.AssignString(v2)
+	}
+}
+func (_UnionRepresentation__KeyAssembler) Prototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (UnionRepresentation) Type() schema.Type {
+	return nil /*TODO:typelit*/
+}
+func (n UnionRepresentation) Representation() ipld.Node {
+	return (*_UnionRepresentation__Repr)(n)
+}
+
+type _UnionRepresentation__Repr _UnionRepresentation
+
+var (
+	memberName__UnionRepresentation_UnionRepresentation_Kinded_serial     = _String{"kinded"}
+	memberName__UnionRepresentation_UnionRepresentation_Keyed_serial      = _String{"keyed"}
+	memberName__UnionRepresentation_UnionRepresentation_Envelope_serial   = _String{"envelope"}
+	memberName__UnionRepresentation_UnionRepresentation_Inline_serial     = _String{"inline"}
+	memberName__UnionRepresentation_UnionRepresentation_BytePrefix_serial = _String{"byteprefix"}
+)
+var _ ipld.Node = &_UnionRepresentation__Repr{}
+
+func (_UnionRepresentation__Repr) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_Map
+}
+func (n *_UnionRepresentation__Repr) LookupByString(key string) (ipld.Node, error) {
+	switch key {
+	case "kinded":
+		if n.tag != 1 {
+			return nil, ipld.ErrNotExists{ipld.PathSegmentOfString(key)}
+		}
+		return n.x1.Representation(), nil
+	case "keyed":
+		if n.tag != 2 {
+			return nil, ipld.ErrNotExists{ipld.PathSegmentOfString(key)}
+		}
+		return n.x2.Representation(), nil
+	case "envelope":
+		if n.tag != 3 {
+			return nil, ipld.ErrNotExists{ipld.PathSegmentOfString(key)}
+		}
+		return n.x3.Representation(), nil
+	case "inline":
+		if n.tag != 4 {
+			return nil, ipld.ErrNotExists{ipld.PathSegmentOfString(key)}
+		}
+		return n.x4.Representation(), nil
+	case "byteprefix":
+		if n.tag != 5 {
+			return nil, ipld.ErrNotExists{ipld.PathSegmentOfString(key)}
+		}
+		return n.x5.Representation(), nil
+	default:
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+	}
+}
+func (n *_UnionRepresentation__Repr) LookupByNode(key ipld.Node) (ipld.Node, error) {
+	ks, err := key.AsString()
+	if err != nil {
+		return nil, err
+	}
+	return n.LookupByString(ks)
+}
+func (_UnionRepresentation__Repr) LookupByIndex(idx int) (ipld.Node, error) {
+	return mixins.Map{"ast.UnionRepresentation.Repr"}.LookupByIndex(0)
+}
+func (n _UnionRepresentation__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	return n.LookupByString(seg.String())
+}
+func (n *_UnionRepresentation__Repr) MapIterator() ipld.MapIterator {
+	return &_UnionRepresentation__ReprMapItr{n, false}
+}
+
+type _UnionRepresentation__ReprMapItr struct {
+	n    *_UnionRepresentation__Repr
+	done bool
+}
+
+func (itr *_UnionRepresentation__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+	if itr.done {
+		return nil, nil, ipld.ErrIteratorOverread{}
+	}
+	switch itr.n.tag {
+	case 1:
+		k, v = &memberName__UnionRepresentation_UnionRepresentation_Kinded_serial, itr.n.x1.Representation()
+	case 2:
+		k, v = &memberName__UnionRepresentation_UnionRepresentation_Keyed_serial, itr.n.x2.Representation()
+	case 3:
+		k, v = &memberName__UnionRepresentation_UnionRepresentation_Envelope_serial, itr.n.x3.Representation()
+	case 4:
+		k, v = &memberName__UnionRepresentation_UnionRepresentation_Inline_serial, itr.n.x4.Representation()
+	case 5:
+		k, v = &memberName__UnionRepresentation_UnionRepresentation_BytePrefix_serial, itr.n.x5.Representation()
+	default:
+		panic("unreachable")
+	}
+	itr.done = true
+	return
+}
+func (itr *_UnionRepresentation__ReprMapItr) Done() bool {
+	return itr.done
+}
+
+func (_UnionRepresentation__Repr) ListIterator() ipld.ListIterator {
+	return nil
+}
+func (_UnionRepresentation__Repr) Length() int {
+	return 1
+}
+func (_UnionRepresentation__Repr) IsAbsent() bool {
+	return false
+}
+func (_UnionRepresentation__Repr) IsNull() bool {
+	return false
+}
+func (_UnionRepresentation__Repr) AsBool() (bool, error) {
+	return mixins.Map{"ast.UnionRepresentation.Repr"}.AsBool()
+}
+func (_UnionRepresentation__Repr) AsInt() (int64, error) {
+	return mixins.Map{"ast.UnionRepresentation.Repr"}.AsInt()
+}
+func (_UnionRepresentation__Repr) AsFloat() (float64, error) {
+	return mixins.Map{"ast.UnionRepresentation.Repr"}.AsFloat()
+}
+func (_UnionRepresentation__Repr) AsString() (string, error) {
+	return mixins.Map{"ast.UnionRepresentation.Repr"}.AsString()
+}
+func (_UnionRepresentation__Repr) AsBytes() ([]byte, error) {
+	return mixins.Map{"ast.UnionRepresentation.Repr"}.AsBytes()
+}
+func (_UnionRepresentation__Repr) AsLink() (ipld.Link, error) {
+	return mixins.Map{"ast.UnionRepresentation.Repr"}.AsLink()
+}
+func (_UnionRepresentation__Repr) Prototype() ipld.NodePrototype {
+	return _UnionRepresentation__ReprPrototype{}
+}
+
+type _UnionRepresentation__ReprPrototype struct{}
+
+func (_UnionRepresentation__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+	var nb _UnionRepresentation__ReprBuilder
+	nb.Reset()
+	return &nb
+}
+
+type _UnionRepresentation__ReprBuilder struct {
+	_UnionRepresentation__ReprAssembler
+}
+
+func (nb *_UnionRepresentation__ReprBuilder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_UnionRepresentation__ReprBuilder) Reset() {
+	var w _UnionRepresentation
+	var m schema.Maybe
+	*nb = _UnionRepresentation__ReprBuilder{_UnionRepresentation__ReprAssembler{w: &w, m: &m}}
+}
+
+type _UnionRepresentation__ReprAssembler struct {
+	w     *_UnionRepresentation
+	m     *schema.Maybe
+	state maState
+
+	cm  schema.Maybe
+	ca1 _UnionRepresentation_Kinded__ReprAssembler
+
+	ca2 _UnionRepresentation_Keyed__ReprAssembler
+
+	ca3 _UnionRepresentation_Envelope__ReprAssembler
+
+	ca4 _UnionRepresentation_Inline__ReprAssembler
+
+	ca5 _UnionRepresentation_BytePrefix__ReprAssembler
+	ca  uint
+}
+
+func (na *_UnionRepresentation__ReprAssembler) reset() {
+	na.state = maState_initial
+	switch na.ca {
+	case 0:
+		return
+	case 1:
+		na.ca1.reset()
+
+	case 2:
+		na.ca2.reset()
+
+	case 3:
+		na.ca3.reset()
+
+	case 4:
+		na.ca4.reset()
+
+	case 5:
+		na.ca5.reset()
+	default:
+		panic("unreachable")
+	}
+	na.ca = 0
+	na.cm = schema.Maybe_Absent
+}
+func (na *_UnionRepresentation__ReprAssembler) BeginMap(int) (ipld.MapAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: it makes no sense to 'begin' twice on the same assembler!")
+	}
+	*na.m = midvalue
+	if na.w == nil {
+		na.w = &_UnionRepresentation{}
+	}
+	return na, nil
+}
+func (_UnionRepresentation__ReprAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.MapAssembler{"ast.UnionRepresentation.Repr"}.BeginList(0)
+}
+func (na *_UnionRepresentation__ReprAssembler) AssignNull() error {
+	switch *na.m {
+	case allowNull:
+		*na.m = schema.Maybe_Null
+		return nil
+	case schema.Maybe_Absent:
+		return mixins.MapAssembler{"ast.UnionRepresentation.Repr.Repr"}.AssignNull()
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+	}
+	panic("unreachable")
+}
+func (_UnionRepresentation__ReprAssembler) AssignBool(bool) error {
+	return mixins.MapAssembler{"ast.UnionRepresentation.Repr"}.AssignBool(false)
+}
+func (_UnionRepresentation__ReprAssembler) AssignInt(int64) error {
+	return mixins.MapAssembler{"ast.UnionRepresentation.Repr"}.AssignInt(0)
+}
+func (_UnionRepresentation__ReprAssembler) AssignFloat(float64) error {
+	return mixins.MapAssembler{"ast.UnionRepresentation.Repr"}.AssignFloat(0)
+}
+func (_UnionRepresentation__ReprAssembler) AssignString(string) error {
+	return mixins.MapAssembler{"ast.UnionRepresentation.Repr"}.AssignString("")
+}
+func (_UnionRepresentation__ReprAssembler) AssignBytes([]byte) error {
+	return mixins.MapAssembler{"ast.UnionRepresentation.Repr"}.AssignBytes(nil)
+}
+func (_UnionRepresentation__ReprAssembler) AssignLink(ipld.Link) error {
+	return mixins.MapAssembler{"ast.UnionRepresentation.Repr"}.AssignLink(nil)
+}
+func (na *_UnionRepresentation__ReprAssembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_UnionRepresentation); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		case midvalue:
+			panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	if v.ReprKind() != ipld.ReprKind_Map {
+		return ipld.ErrWrongKind{TypeName: "ast.UnionRepresentation.Repr", MethodName: "AssignNode", AppropriateKind: ipld.ReprKindSet_JustMap, ActualKind: v.ReprKind()}
+	}
+	itr := v.MapIterator()
+	for !itr.Done() {
+		k, v, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if err := na.AssembleKey().AssignNode(k); err != nil {
+			return err
+		}
+		if err := na.AssembleValue().AssignNode(v); err != nil {
+			return err
+		}
+	}
+	return na.Finish()
+}
+func (_UnionRepresentation__ReprAssembler) Prototype() ipld.NodePrototype {
+	return _UnionRepresentation__ReprPrototype{}
+}
+func (ma *_UnionRepresentation__ReprAssembler) valueFinishTidy() bool {
+	switch ma.cm {
+	case schema.Maybe_Value:
+		ma.state = maState_initial
+		return true
+	default:
+		return false
+	}
+}
+func (ma *_UnionRepresentation__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleEntry cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleEntry cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleEntry cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on for the moment, but we'll still be erroring shortly.
+	case maState_finished:
+		panic("invalid state: AssembleEntry cannot be called on an assembler that's already finished")
+	}
+	if ma.ca != 0 {
+		return nil, schema.ErrNotUnionStructure{TypeName: "ast.UnionRepresentation.Repr", Detail: "cannot add another entry -- a union can only contain one thing!"}
+	}
+	switch k {
+	case "kinded":
+		ma.state = maState_midValue
+		ma.ca = 1
+		ma.w.tag = 1
+		ma.ca1.w = &ma.w.x1
+		ma.ca1.m = &ma.cm
+		return &ma.ca1, nil
+	case "keyed":
+		ma.state = maState_midValue
+		ma.ca = 2
+		ma.w.tag = 2
+		ma.ca2.w = &ma.w.x2
+		ma.ca2.m = &ma.cm
+		return &ma.ca2, nil
+	case "envelope":
+		ma.state = maState_midValue
+		ma.ca = 3
+		ma.w.tag = 3
+		ma.ca3.w = &ma.w.x3
+		ma.ca3.m = &ma.cm
+		return &ma.ca3, nil
+	case "inline":
+		ma.state = maState_midValue
+		ma.ca = 4
+		ma.w.tag = 4
+		ma.ca4.w = &ma.w.x4
+		ma.ca4.m = &ma.cm
+		return &ma.ca4, nil
+	case "byteprefix":
+		ma.state = maState_midValue
+		ma.ca = 5
+		ma.w.tag = 5
+		ma.ca5.w = &ma.w.x5
+		ma.ca5.m = &ma.cm
+		return &ma.ca5, nil
+	default:
+		return nil, ipld.ErrInvalidKey{TypeName: "ast.UnionRepresentation.Repr", Key: &_String{k}}
+	}
+}
+func (ma *_UnionRepresentation__ReprAssembler) AssembleKey() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleKey cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleKey cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleKey cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on for the moment, but we'll still be erroring shortly... or rather, the keyassembler will be.
+	case maState_finished:
+		panic("invalid state: AssembleKey cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midKey
+	return (*_UnionRepresentation__ReprKeyAssembler)(ma)
+}
+func (ma *_UnionRepresentation__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		panic("invalid state: AssembleValue cannot be called when no key is primed")
+	case maState_midKey:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		// carry on
+	case maState_midValue:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling another value")
+	case maState_finished:
+		panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midValue
+	switch ma.ca {
+	case 0:
+		ma.ca1.w = &ma.w.x1
+		ma.ca1.m = &ma.cm
+		return &ma.ca1
+	case 1:
+		ma.ca2.w = &ma.w.x2
+		ma.ca2.m = &ma.cm
+		return &ma.ca2
+	case 2:
+		ma.ca3.w = &ma.w.x3
+		ma.ca3.m = &ma.cm
+		return &ma.ca3
+	case 3:
+		ma.ca4.w = &ma.w.x4
+		ma.ca4.m = &ma.cm
+		return &ma.ca4
+	case 4:
+		ma.ca5.w = &ma.w.x5
+		ma.ca5.m = &ma.cm
+		return &ma.ca5
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_UnionRepresentation__ReprAssembler) Finish() error {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: Finish cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		panic("invalid state: Finish cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: Finish cannot be called on an assembler that's already finished")
+	}
+	if ma.ca == 0 {
+		return schema.ErrNotUnionStructure{TypeName: "ast.UnionRepresentation.Repr", Detail: "a union must have exactly one entry (not none)!"}
+	}
+	ma.state = maState_finished
+	*ma.m = schema.Maybe_Value
+	return nil
+}
+func (ma *_UnionRepresentation__ReprAssembler) KeyPrototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (ma *_UnionRepresentation__ReprAssembler) ValuePrototype(k string) ipld.NodePrototype {
+	switch k {
+	case "UnionRepresentation_Kinded":
+		return _UnionRepresentation_Kinded__ReprPrototype{}
+	case "UnionRepresentation_Keyed":
+		return _UnionRepresentation_Keyed__ReprPrototype{}
+	case "UnionRepresentation_Envelope":
+		return _UnionRepresentation_Envelope__ReprPrototype{}
+	case "UnionRepresentation_Inline":
+		return _UnionRepresentation_Inline__ReprPrototype{}
+	case "UnionRepresentation_BytePrefix":
+		return _UnionRepresentation_BytePrefix__ReprPrototype{}
+	default:
+		return nil
+	}
+}
+
+type _UnionRepresentation__ReprKeyAssembler _UnionRepresentation__ReprAssembler
+
+func (_UnionRepresentation__ReprKeyAssembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	return mixins.StringAssembler{"ast.UnionRepresentation.Repr.KeyAssembler"}.BeginMap(0)
+}
+func (_UnionRepresentation__ReprKeyAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.StringAssembler{"ast.UnionRepresentation.Repr.KeyAssembler"}.BeginList(0)
+}
+func (na *_UnionRepresentation__ReprKeyAssembler) AssignNull() error {
+	return mixins.StringAssembler{"ast.UnionRepresentation.Repr.KeyAssembler"}.AssignNull()
+}
+func (_UnionRepresentation__ReprKeyAssembler) AssignBool(bool) error {
+	return mixins.StringAssembler{"ast.UnionRepresentation.Repr.KeyAssembler"}.AssignBool(false)
+}
+func (_UnionRepresentation__ReprKeyAssembler) AssignInt(int64) error {
+	return mixins.StringAssembler{"ast.UnionRepresentation.Repr.KeyAssembler"}.AssignInt(0)
+}
+func (_UnionRepresentation__ReprKeyAssembler) AssignFloat(float64) error {
+	return mixins.StringAssembler{"ast.UnionRepresentation.Repr.KeyAssembler"}.AssignFloat(0)
+}
+func (ka *_UnionRepresentation__ReprKeyAssembler) AssignString(k string) error {
+	if ka.state != maState_midKey {
+		panic("misuse: KeyAssembler held beyond its valid lifetime")
+	}
+	if ka.ca != 0 {
+		return schema.ErrNotUnionStructure{TypeName: "ast.UnionRepresentation.Repr", Detail: "cannot add another entry -- a union can only contain one thing!"}
+	}
+	switch k {
+	case "kinded":
+		ka.ca = 1
+		ka.w.tag = 1
+		ka.state = maState_expectValue
+		return nil
+	case "keyed":
+		ka.ca = 2
+		ka.w.tag = 2
+		ka.state = maState_expectValue
+		return nil
+	case "envelope":
+		ka.ca = 3
+		ka.w.tag = 3
+		ka.state = maState_expectValue
+		return nil
+	case "inline":
+		ka.ca = 4
+		ka.w.tag = 4
+		ka.state = maState_expectValue
+		return nil
+	case "byteprefix":
+		ka.ca = 5
+		ka.w.tag = 5
+		ka.state = maState_expectValue
+		return nil
+	default:
+		return ipld.ErrInvalidKey{TypeName: "ast.UnionRepresentation.Repr", Key: &_String{k}} // TODO: error quality: ErrInvalidUnionDiscriminant ?
+	}
+	return nil
+}
+func (_UnionRepresentation__ReprKeyAssembler) AssignBytes([]byte) error {
+	return mixins.StringAssembler{"ast.UnionRepresentation.Repr.KeyAssembler"}.AssignBytes(nil)
+}
+func (_UnionRepresentation__ReprKeyAssembler) AssignLink(ipld.Link) error {
+	return mixins.StringAssembler{"ast.UnionRepresentation.Repr.KeyAssembler"}.AssignLink(nil)
+}
+func (ka *_UnionRepresentation__ReprKeyAssembler) AssignNode(v ipld.Node) error {
+	if v2, err := v.AsString(); err != nil {
+		return err
+	} else {
+		return ka.AssignString(v2)
+	}
+}
+func (_UnionRepresentation__ReprKeyAssembler) Prototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
@@ -0,0 +1,922 @@
+package ast
+
+// Code generated by go-ipld-prime gengo.  DO NOT EDIT.
+
+import (
+	ipld "github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/node/mixins"
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+type _UnionRepresentation_Inline struct {
+	discriminantKey   _String
+	discriminantTable _Map__String__TypeName
+}
+type UnionRepresentation_Inline = *_UnionRepresentation_Inline
+
+func (n _UnionRepresentation_Inline) FieldDiscriminantKey() String {
+	return &n.discriminantKey
+}
+func (n _UnionRepresentation_Inline) FieldDiscriminantTable() Map__String__TypeName {
+	return &n.discriminantTable
+}
+
+type _UnionRepresentation_Inline__Maybe struct {
+	m schema.Maybe
+	v UnionRepresentation_Inline
+}
+type MaybeUnionRepresentation_Inline = *_UnionRepresentation_Inline__Maybe
+
+func (m MaybeUnionRepresentation_Inline) IsNull() bool {
+	return m.m == schema.Maybe_Null
+}
+func (m MaybeUnionRepresentation_Inline) IsAbsent() bool {
+	return m.m == schema.Maybe_Absent
+}
+func (m MaybeUnionRepresentation_Inline) Exists() bool {
+	return m.m == schema.Maybe_Value
+}
+func (m MaybeUnionRepresentation_Inline) AsNode() ipld.Node {
+	switch m.m {
+	case schema.Maybe_Absent:
+		return ipld.Absent
+	case schema.Maybe_Null:
+		return ipld.Null
+	case schema.Maybe_Value:
+		return m.v
+	default:
+		panic("unreachable")
+	}
+}
+func (m MaybeUnionRepresentation_Inline) Must() UnionRepresentation_Inline {
+	if !m.Exists() {
+		panic("unbox of a maybe rejected")
+	}
+	return m.v
+}
+
+var (
+	fieldName__UnionRepresentation_Inline_DiscriminantKey   = _String{"discriminantKey"}
+	fieldName__UnionRepresentation_Inline_DiscriminantTable = _String{"discriminantTable"}
+)
+var _ ipld.Node = (UnionRepresentation_Inline)(&_UnionRepresentation_Inline{})
+var _ schema.TypedNode = (UnionRepresentation_Inline)(&_UnionRepresentation_Inline{})
+
+func (UnionRepresentation_Inline) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_Map
+}
+func (n UnionRepresentation_Inline) LookupByString(key string) (ipld.Node, error) {
+	switch key {
+	case "discriminantKey":
+		return &n.discriminantKey, nil
+	case "discriminantTable":
+		return &n.discriminantTable, nil
+	default:
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+	}
+}
+func (n UnionRepresentation_Inline) LookupByNode(key ipld.Node) (ipld.Node, error) {
+	ks, err := key.AsString()
+	if err != nil {
+		return nil, err
+	}
+	return n.LookupByString(ks)
+}
+func (UnionRepresentation_Inline) LookupByIndex(idx int) (ipld.Node, error) {
+	return mixins.Map{"ast.UnionRepresentation_Inline"}.LookupByIndex(0)
+}
+func (n UnionRepresentation_Inline) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	return n.LookupByString(seg.String())
+}
+func (n UnionRepresentation_Inline) MapIterator() ipld.MapIterator {
+	return &_UnionRepresentation_Inline__MapItr{n, 0}
+}
+
+type _UnionRepresentation_Inline__MapItr struct {
+	n   UnionRepresentation_Inline
+	idx int
+}
+
+func (itr *_UnionRepresentation_Inline__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+	if itr.idx >= 2 {
+		return nil, nil, ipld.ErrIteratorOverread{}
+	}
+	switch itr.idx {
+	case 0:
+		k = &fieldName__UnionRepresentation_Inline_DiscriminantKey
+		v = &itr.n.discriminantKey
+	case 1:
+		k = &fieldName__UnionRepresentation_Inline_DiscriminantTable
+		v = &itr.n.discriminantTable
+	default:
+		panic("unreachable")
+	}
+	itr.idx++
+	return
+}
+func (itr *_UnionRepresentation_Inline__MapItr) Done() bool {
+	return itr.idx >= 2
+}
+
+func (UnionRepresentation_Inline) ListIterator() ipld.ListIterator {
+	return nil
+}
+func (UnionRepresentation_Inline) Length() int {
+	return 2
+}
+func (UnionRepresentation_Inline) IsAbsent() bool {
+	return false
+}
+func (UnionRepresentation_Inline) IsNull() bool {
+	return false
+}
+func (UnionRepresentation_Inline) AsBool() (bool, error) {
+	return mixins.Map{"ast.UnionRepresentation_Inline"}.AsBool()
+}
+func (UnionRepresentation_Inline) AsInt() (int64, error) {
+	return mixins.Map{"ast.UnionRepresentation_Inline"}.AsInt()
+}
+func (UnionRepresentation_Inline) AsFloat() (float64, error) {
+	return mixins.Map{"ast.UnionRepresentation_Inline"}.AsFloat()
+}
+func (UnionRepresentation_Inline) AsString() (string, error) {
+	return mixins.Map{"ast.UnionRepresentation_Inline"}.AsString()
+}
+func (UnionRepresentation_Inline) AsBytes() ([]byte, error) {
+	return mixins.Map{"ast.UnionRepresentation_Inline"}.AsBytes()
+}
+func (UnionRepresentation_Inline) AsLink() (ipld.Link, error) {
+	return mixins.Map{"ast.UnionRepresentation_Inline"}.AsLink()
+}
+func (UnionRepresentation_Inline) Prototype() ipld.NodePrototype {
+	return _UnionRepresentation_Inline__Prototype{}
+}
+
+type _UnionRepresentation_Inline__Prototype struct{}
+
+func (_UnionRepresentation_Inline__Prototype) NewBuilder() ipld.NodeBuilder {
+	var nb _UnionRepresentation_Inline__Builder
+	nb.Reset()
+	return &nb
+}
+
+type _UnionRepresentation_Inline__Builder struct {
+	_UnionRepresentation_Inline__Assembler
+}
+
+func (nb *_UnionRepresentation_Inline__Builder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_UnionRepresentation_Inline__Builder) Reset() {
+	var w _UnionRepresentation_Inline
+	var m schema.Maybe
+	*nb = _UnionRepresentation_Inline__Builder{_UnionRepresentation_Inline__Assembler{w: &w, m: &m}}
+}
+
+type _UnionRepresentation_Inline__Assembler struct {
+	w     *_UnionRepresentation_Inline
+	m     *schema.Maybe
+	state maState
+	s     int
+	f     int
+
+	cm                   schema.Maybe
+	ca_discriminantKey   _String__Assembler
+	ca_discriminantTable _Map__String__TypeName__Assembler
+}
+
+func (na *_UnionRepresentation_Inline__Assembler) reset() {
+	na.state = maState_initial
+	na.s = 0
+	na.ca_discriminantKey.reset()
+	na.ca_discriminantTable.reset()
+}
+
+var (
+	fieldBit__UnionRepresentation_Inline_DiscriminantKey   = 1 << 0
+	fieldBit__UnionRepresentation_Inline_DiscriminantTable = 1 << 1
+	fieldBits__UnionRepresentation_Inline_sufficient       = 0 + 1<<0 + 1<<1
+)
+
+func (na *_UnionRepresentation_Inline__Assembler) BeginMap(int) (ipld.MapAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: it makes no sense to 'begin' twice on the same assembler!")
+	}
+	*na.m = midvalue
+	if na.w == nil {
+		na.w = &_UnionRepresentation_Inline{}
+	}
+	return na, nil
+}
+func (_UnionRepresentation_Inline__Assembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.MapAssembler{"ast.UnionRepresentation_Inline"}.BeginList(0)
+}
+func (na *_UnionRepresentation_Inline__Assembler) AssignNull() error {
+	switch *na.m {
+	case allowNull:
+		*na.m = schema.Maybe_Null
+		return nil
+	case schema.Maybe_Absent:
+		return mixins.MapAssembler{"ast.UnionRepresentation_Inline"}.AssignNull()
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+	}
+	panic("unreachable")
+}
+func (_UnionRepresentation_Inline__Assembler) AssignBool(bool) error {
+	return mixins.MapAssembler{"ast.UnionRepresentation_Inline"}.AssignBool(false)
+}
+func (_UnionRepresentation_Inline__Assembler) AssignInt(int64) error {
+	return mixins.MapAssembler{"ast.UnionRepresentation_Inline"}.AssignInt(0)
+}
+func (_UnionRepresentation_Inline__Assembler) AssignFloat(float64) error {
+	return mixins.MapAssembler{"ast.UnionRepresentation_Inline"}.AssignFloat(0)
+}
+func (_UnionRepresentation_Inline__Assembler) AssignString(string) error {
+	return mixins.MapAssembler{"ast.UnionRepresentation_Inline"}.AssignString("")
+}
+func (_UnionRepresentation_Inline__Assembler) AssignBytes([]byte) error {
+	return mixins.MapAssembler{"ast.UnionRepresentation_Inline"}.AssignBytes(nil)
+}
+func (_UnionRepresentation_Inline__Assembler) AssignLink(ipld.Link) error {
+	return mixins.MapAssembler{"ast.UnionRepresentation_Inline"}.AssignLink(nil)
+}
+func (na *_UnionRepresentation_Inline__Assembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_UnionRepresentation_Inline); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		case midvalue:
+			panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	if v.ReprKind() != ipld.ReprKind_Map {
+		return ipld.ErrWrongKind{TypeName: "ast.UnionRepresentation_Inline", MethodName: "AssignNode", AppropriateKind: ipld.ReprKindSet_JustMap, ActualKind: v.ReprKind()}
+	}
+	itr := v.MapIterator()
+	for !itr.Done() {
+		k, v, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if err := na.AssembleKey().AssignNode(k); err != nil {
+			return err
+		}
+		if err := na.AssembleValue().AssignNode(v); err != nil {
+			return err
+		}
+	}
+	return na.Finish()
+}
+func (_UnionRepresentation_Inline__Assembler) Prototype() ipld.NodePrototype {
+	return _UnionRepresentation_Inline__Prototype{}
+}
+func (ma *_UnionRepresentation_Inline__Assembler) valueFinishTidy() bool {
+	switch ma.f {
+	case 0:
+		switch ma.cm {
+		case schema.Maybe_Value:
+			ma.ca_discriminantKey.w = nil
+			ma.cm = schema.Maybe_Absent
+			ma.state = maState_initial
+			return true
+		default:
+			return false
+		}
+	case 1:
+		switch ma.cm {
+		case schema.Maybe_Value:
+			ma.ca_discriminantTable.w = nil
+			ma.cm = schema.Maybe_Absent
+			ma.state = maState_initial
+			return true
+		default:
+			return false
+		}
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_UnionRepresentation_Inline__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleEntry cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleEntry cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleEntry cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleEntry cannot be called on an assembler that's already finished")
+	}
+	switch k {
+	case "discriminantKey":
+		if ma.s&fieldBit__UnionRepresentation_Inline_DiscriminantKey != 0 {
+			return nil, ipld.ErrRepeatedMapKey{&fieldName__UnionRepresentation_Inline_DiscriminantKey}
+		}
+		ma.s += fieldBit__UnionRepresentation_Inline_DiscriminantKey
+		ma.state = maState_midValue
+		ma.f = 0
+		ma.ca_discriminantKey.w = &ma.w.discriminantKey
+		ma.ca_discriminantKey.m = &ma.cm
+		return &ma.ca_discriminantKey, nil
+	case "discriminantTable":
+		if ma.s&fieldBit__UnionRepresentation_Inline_DiscriminantTable != 0 {
+			return nil, ipld.ErrRepeatedMapKey{&fieldName__UnionRepresentation_Inline_DiscriminantTable}
+		}
+		ma.s += fieldBit__UnionRepresentation_Inline_DiscriminantTable
+		ma.state = maState_midValue
+		ma.f = 1
+		ma.ca_discriminantTable.w = &ma.w.discriminantTable
+		ma.ca_discriminantTable.m = &ma.cm
+		return &ma.ca_discriminantTable, nil
+	default:
+		return nil, ipld.ErrInvalidKey{TypeName: "ast.UnionRepresentation_Inline", Key: &_String{k}}
+	}
+}
+func (ma *_UnionRepresentation_Inline__Assembler) AssembleKey() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleKey cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleKey cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleKey cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleKey cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midKey
+	return (*_UnionRepresentation_Inline__KeyAssembler)(ma)
+}
+func (ma *_UnionRepresentation_Inline__Assembler) AssembleValue() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		panic("invalid state: AssembleValue cannot be called when no key is primed")
+	case maState_midKey:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		// carry on
+	case maState_midValue:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling another value")
+	case maState_finished:
+		panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midValue
+	switch ma.f {
+	case 0:
+		ma.ca_discriminantKey.w = &ma.w.discriminantKey
+		ma.ca_discriminantKey.m = &ma.cm
+		return &ma.ca_discriminantKey
+	case 1:
+		ma.ca_discriminantTable.w = &ma.w.discriminantTable
+		ma.ca_discriminantTable.m = &ma.cm
+		return &ma.ca_discriminantTable
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_UnionRepresentation_Inline__Assembler) Finish() error {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: Finish cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		panic("invalid state: Finish cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: Finish cannot be called on an assembler that's already finished")
+	}
+	//FIXME check if all required fields are set
+	ma.state = maState_finished
+	*ma.m = schema.Maybe_Value
+	return nil
+}
+func (ma *_UnionRepresentation_Inline__Assembler) KeyPrototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (ma *_UnionRepresentation_Inline__Assembler) ValuePrototype(k string) ipld.NodePrototype {
+	panic("todo structbuilder mapassembler valueprototype")
+}
+
+type _UnionRepresentation_Inline__KeyAssembler _UnionRepresentation_Inline__Assembler
+
+func (_UnionRepresentation_Inline__KeyAssembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	return mixins.StringAssembler{"ast.UnionRepresentation_Inline.KeyAssembler"}.BeginMap(0)
+}
+func (_UnionRepresentation_Inline__KeyAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.StringAssembler{"ast.UnionRepresentation_Inline.KeyAssembler"}.BeginList(0)
+}
+func (na *_UnionRepresentation_Inline__KeyAssembler) AssignNull() error {
+	return mixins.StringAssembler{"ast.UnionRepresentation_Inline.KeyAssembler"}.AssignNull()
+}
+func (_UnionRepresentation_Inline__KeyAssembler) AssignBool(bool) error {
+	return mixins.StringAssembler{"ast.UnionRepresentation_Inline.KeyAssembler"}.AssignBool(false)
+}
+func (_UnionRepresentation_Inline__KeyAssembler) AssignInt(int64) error {
+	return mixins.StringAssembler{"ast.UnionRepresentation_Inline.KeyAssembler"}.AssignInt(0)
+}
+func (_UnionRepresentation_Inline__KeyAssembler) AssignFloat(float64) error {
+	return mixins.StringAssembler{"ast.UnionRepresentation_Inline.KeyAssembler"}.AssignFloat(0)
+}
+func (ka *_UnionRepresentation_Inline__KeyAssembler) AssignString(k string) error {
+	if ka.state != maState_midKey {
+		panic("misuse: KeyAssembler held beyond its valid lifetime")
+	}
+	switch k {
+	case "discriminantKey":
+		if ka.s&fieldBit__UnionRepresentation_Inline_DiscriminantKey != 0 {
+			return ipld.ErrRepeatedMapKey{&fieldName__UnionRepresentation_Inline_DiscriminantKey}
+		}
+		ka.s += fieldBit__UnionRepresentation_Inline_DiscriminantKey
+		ka.state = maState_expectValue
+		ka.f = 0
+	case "discriminantTable":
+		if ka.s&fieldBit__UnionRepresentation_Inline_DiscriminantTable != 0 {
+			return ipld.ErrRepeatedMapKey{&fieldName__UnionRepresentation_Inline_DiscriminantTable}
+		}
+		ka.s += fieldBit__UnionRepresentation_Inline_DiscriminantTable
+		ka.state = maState_expectValue
+		ka.f = 1
+	default:
+		return ipld.ErrInvalidKey{TypeName: "ast.UnionRepresentation_Inline", Key: &_String{k}}
+	}
+	return nil
+}
+func (_UnionRepresentation_Inline__KeyAssembler) AssignBytes([]byte) error {
+	return mixins.StringAssembler{"ast.UnionRepresentation_Inline.KeyAssembler"}.AssignBytes(nil)
+}
+func (_UnionRepresentation_Inline__KeyAssembler) AssignLink(ipld.Link) error {
+	return mixins.StringAssembler{"ast.UnionRepresentation_Inline.KeyAssembler"}.AssignLink(nil)
+}
+func (ka *_UnionRepresentation_Inline__KeyAssembler) AssignNode(v ipld.Node) error {
+	if v2, err := v.AsString(); err != nil {
+		return err
+	} else {
+		return ka.AssignString(v2)
+	}
+}
+func (_UnionRepresentation_Inline__KeyAssembler) Prototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (UnionRepresentation_Inline) Type() schema.Type {
+	return nil /*TODO:typelit*/
+}
+func (n UnionRepresentation_Inline) Representation() ipld.Node {
+	return (*_UnionRepresentation_Inline__Repr)(n)
+}
+
+type _UnionRepresentation_Inline__Repr _UnionRepresentation_Inline
+
+var (
+	fieldName__UnionRepresentation_Inline_DiscriminantKey_serial   = _String{"discriminantKey"}
+	fieldName__UnionRepresentation_Inline_DiscriminantTable_serial = _String{"discriminantTable"}
+)
+var _ ipld.Node = &_UnionRepresentation_Inline__Repr{}
+
+func (_UnionRepresentation_Inline__Repr) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_Map
+}
+func (n *_UnionRepresentation_Inline__Repr) LookupByString(key string) (ipld.Node, error) {
+	switch key {
+	case "discriminantKey":
+		return n.discriminantKey.Representation(), nil
+	case "discriminantTable":
+		return n.discriminantTable.Representation(), nil
+	default:
+		return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+	}
+}
+func (n *_UnionRepresentation_Inline__Repr) LookupByNode(key ipld.Node) (ipld.Node, error) {
+	ks, err := key.AsString()
+	if err != nil {
+		return nil, err
+	}
+	return n.LookupByString(ks)
+}
+func (_UnionRepresentation_Inline__Repr) LookupByIndex(idx int) (ipld.Node, error) {
+	return mixins.Map{"ast.UnionRepresentation_Inline.Repr"}.LookupByIndex(0)
+}
+func (n _UnionRepresentation_Inline__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	return n.LookupByString(seg.String())
+}
+func (n *_UnionRepresentation_Inline__Repr) MapIterator() ipld.MapIterator {
+	return &_UnionRepresentation_Inline__ReprMapItr{n, 0}
+}
+
+type _UnionRepresentation_Inline__ReprMapItr struct {
+	n   *_UnionRepresentation_Inline__Repr
+	idx int
+}
+
+func (itr *_UnionRepresentation_Inline__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+	if itr.idx >= 2 {
+		return nil, nil, ipld.ErrIteratorOverread{}
+	}
+	switch itr.idx {
+	case 0:
+		k = &fieldName__UnionRepresentation_Inline_DiscriminantKey_serial
+		v = itr.n.discriminantKey.Representation()
+	case 1:
+		k = &fieldName__UnionRepresentation_Inline_DiscriminantTable_serial
+		v = itr.n.discriminantTable.Representation()
+	default:
+		panic("unreachable")
+	}
+	itr.idx++
+	return
+}
+func (itr *_UnionRepresentation_Inline__ReprMapItr) Done() bool {
+	return itr.idx >= 2
+}
+func (_UnionRepresentation_Inline__Repr) ListIterator() ipld.ListIterator {
+	return nil
+}
+func (rn *_UnionRepresentation_Inline__Repr) Length() int {
+	l := 2
+	return l
+}
+func (_UnionRepresentation_Inline__Repr) IsAbsent() bool {
+	return false
+}
+func (_UnionRepresentation_Inline__Repr) IsNull() bool {
+	return false
+}
+func (_UnionRepresentation_Inline__Repr) AsBool() (bool, error) {
+	return mixins.Map{"ast.UnionRepresentation_Inline.Repr"}.AsBool()
+}
+func (_UnionRepresentation_Inline__Repr) AsInt() (int64, error) {
+	return mixins.Map{"ast.UnionRepresentation_Inline.Repr"}.AsInt()
+}
+func (_UnionRepresentation_Inline__Repr) AsFloat() (float64, error) {
+	return mixins.Map{"ast.UnionRepresentation_Inline.Repr"}.AsFloat()
+}
+func (_UnionRepresentation_Inline__Repr) AsString() (string, error) {
+	return mixins.Map{"ast.UnionRepresentation_Inline.Repr"}.AsString()
+}
+func (_UnionRepresentation_Inline__Repr) AsBytes() ([]byte, error) {
+	return mixins.Map{"ast.UnionRepresentation_Inline.Repr"}.AsBytes()
+}
+func (_UnionRepresentation_Inline__Repr) AsLink() (ipld.Link, error) {
+	return mixins.Map{"ast.UnionRepresentation_Inline.Repr"}.AsLink()
+}
+func (_UnionRepresentation_Inline__Repr) Prototype() ipld.NodePrototype {
+	return _UnionRepresentation_Inline__ReprPrototype{}
+}
+
+type _UnionRepresentation_Inline__ReprPrototype struct{}
+
+func (_UnionRepresentation_Inline__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+	var nb _UnionRepresentation_Inline__ReprBuilder
+	nb.Reset()
+	return &nb
+}
+
+type _UnionRepresentation_Inline__ReprBuilder struct {
+	_UnionRepresentation_Inline__ReprAssembler
+}
+
+func (nb *_UnionRepresentation_Inline__ReprBuilder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_UnionRepresentation_Inline__ReprBuilder) Reset() {
+	var w _UnionRepresentation_Inline
+	var m schema.Maybe
+	*nb = _UnionRepresentation_Inline__ReprBuilder{_UnionRepresentation_Inline__ReprAssembler{w: &w, m: &m}}
+}
+
+type _UnionRepresentation_Inline__ReprAssembler struct {
+	w     *_UnionRepresentation_Inline
+	m     *schema.Maybe
+	state maState
+	s     int
+	f     int
+
+	cm                   schema.Maybe
+	ca_discriminantKey   _String__ReprAssembler
+	ca_discriminantTable _Map__String__TypeName__ReprAssembler
+}
+
+func (na *_UnionRepresentation_Inline__ReprAssembler) reset() {
+	na.state = maState_initial
+	na.s = 0
+	na.ca_discriminantKey.reset()
+	na.ca_discriminantTable.reset()
+}
+func (na *_UnionRepresentation_Inline__ReprAssembler) BeginMap(int) (ipld.MapAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: it makes no sense to 'begin' twice on the same assembler!")
+	}
+	*na.m = midvalue
+	if na.w == nil {
+		na.w = &_UnionRepresentation_Inline{}
+	}
+	return na, nil
+}
+func (_UnionRepresentation_Inline__ReprAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.MapAssembler{"ast.UnionRepresentation_Inline.Repr"}.BeginList(0)
+}
+func (na *_UnionRepresentation_Inline__ReprAssembler) AssignNull() error {
+	switch *na.m {
+	case allowNull:
+		*na.m = schema.Maybe_Null
+		return nil
+	case schema.Maybe_Absent:
+		return mixins.MapAssembler{"ast.UnionRepresentation_Inline.Repr.Repr"}.AssignNull()
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+	}
+	panic("unreachable")
+}
+func (_UnionRepresentation_Inline__ReprAssembler) AssignBool(bool) error {
+	return mixins.MapAssembler{"ast.UnionRepresentation_Inline.Repr"}.AssignBool(false)
+}
+func (_UnionRepresentation_Inline__ReprAssembler) AssignInt(int64) error {
+	return mixins.MapAssembler{"ast.UnionRepresentation_Inline.Repr"}.AssignInt(0)
+}
+func (_UnionRepresentation_Inline__ReprAssembler) AssignFloat(float64) error {
+	return mixins.MapAssembler{"ast.UnionRepresentation_Inline.Repr"}.AssignFloat(0)
+}
+func (_UnionRepresentation_Inline__ReprAssembler) AssignString(string) error {
+	return mixins.MapAssembler{"ast.UnionRepresentation_Inline.Repr"}.AssignString("")
+}
+func (_UnionRepresentation_Inline__ReprAssembler) AssignBytes([]byte) error {
+	return mixins.MapAssembler{"ast.UnionRepresentation_Inline.Repr"}.AssignBytes(nil)
+}
+func (_UnionRepresentation_Inline__ReprAssembler) AssignLink(ipld.Link) error {
+	return mixins.MapAssembler{"ast.UnionRepresentation_Inline.Repr"}.AssignLink(nil)
+}
+func (na *_UnionRepresentation_Inline__ReprAssembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_UnionRepresentation_Inline); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		case midvalue:
+			panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	if v.ReprKind() != ipld.ReprKind_Map {
+		return ipld.ErrWrongKind{TypeName: "ast.UnionRepresentation_Inline.Repr", MethodName: "AssignNode", AppropriateKind: ipld.ReprKindSet_JustMap, ActualKind: v.ReprKind()}
+	}
+	itr := v.MapIterator()
+	for !itr.Done() {
+		k, v, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if err := na.AssembleKey().AssignNode(k); err != nil {
+			return err
+		}
+		if err := na.AssembleValue().AssignNode(v); err != nil {
+			return err
+		}
+	}
+	return na.Finish()
+}
+func (_UnionRepresentation_Inline__ReprAssembler) Prototype() ipld.NodePrototype {
+	return _UnionRepresentation_Inline__ReprPrototype{}
+}
+func (ma *_UnionRepresentation_Inline__ReprAssembler) valueFinishTidy() bool {
+	switch ma.f {
+	case 0:
+		switch ma.cm {
+		case schema.Maybe_Value:
+			ma.cm = schema.Maybe_Absent
+			ma.state = maState_initial
+			return true
+		default:
+			return false
+		}
+	case 1:
+		switch ma.cm {
+		case schema.Maybe_Value:
+			ma.cm = schema.Maybe_Absent
+			ma.state = maState_initial
+			return true
+		default:
+			return false
+		}
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_UnionRepresentation_Inline__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleEntry cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleEntry cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleEntry cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleEntry cannot be called on an assembler that's already finished")
+	}
+	switch k {
+	case "discriminantKey":
+		if ma.s&fieldBit__UnionRepresentation_Inline_DiscriminantKey != 0 {
+			return nil, ipld.ErrRepeatedMapKey{&fieldName__UnionRepresentation_Inline_DiscriminantKey_serial}
+		}
+		ma.s += fieldBit__UnionRepresentation_Inline_DiscriminantKey
+		ma.state = maState_midValue
+		ma.f = 0
+		ma.ca_discriminantKey.w = &ma.w.discriminantKey
+		ma.ca_discriminantKey.m = &ma.cm
+		return &ma.ca_discriminantKey, nil
+	case "discriminantTable":
+		if ma.s&fieldBit__UnionRepresentation_Inline_DiscriminantTable != 0 {
+			return nil, ipld.ErrRepeatedMapKey{&fieldName__UnionRepresentation_Inline_DiscriminantTable_serial}
+		}
+		ma.s += fieldBit__UnionRepresentation_Inline_DiscriminantTable
+		ma.state = maState_midValue
+		ma.f = 1
+		ma.ca_discriminantTable.w = &ma.w.discriminantTable
+		ma.ca_discriminantTable.m = &ma.cm
+		return &ma.ca_discriminantTable, nil
+	default:
+		return nil, ipld.ErrInvalidKey{TypeName: "ast.UnionRepresentation_Inline.Repr", Key: &_String{k}}
+	}
+}
+func (ma *_UnionRepresentation_Inline__ReprAssembler) AssembleKey() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleKey cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleKey cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleKey cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleKey cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midKey
+	return (*_UnionRepresentation_Inline__ReprKeyAssembler)(ma)
+}
+func (ma *_UnionRepresentation_Inline__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		panic("invalid state: AssembleValue cannot be called when no key is primed")
+	case maState_midKey:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		// carry on
+	case maState_midValue:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling another value")
+	case maState_finished:
+		panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midValue
+	switch ma.f {
+	case 0:
+		ma.ca_discriminantKey.w = &ma.w.discriminantKey
+		ma.ca_discriminantKey.m = &ma.cm
+		return &ma.ca_discriminantKey
+	case 1:
+		ma.ca_discriminantTable.w = &ma.w.discriminantTable
+		ma.ca_discriminantTable.m = &ma.cm
+		return &ma.ca_discriminantTable
+	default:
+		panic("unreachable")
+	}
+}
+func (ma *_UnionRepresentation_Inline__ReprAssembler) Finish() error {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: Finish cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		panic("invalid state: Finish cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: Finish cannot be called on an assembler that's already finished")
+	}
+	//FIXME check if all required fields are set
+	ma.state = maState_finished
+	*ma.m = schema.Maybe_Value
+	return nil
+}
+func (ma *_UnionRepresentation_Inline__ReprAssembler) KeyPrototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (ma *_UnionRepresentation_Inline__ReprAssembler) ValuePrototype(k string) ipld.NodePrototype {
+	panic("todo structbuilder mapassembler repr valueprototype")
+}
+
+type _UnionRepresentation_Inline__ReprKeyAssembler _UnionRepresentation_Inline__ReprAssembler
+
+func (_UnionRepresentation_Inline__ReprKeyAssembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	return mixins.StringAssembler{"ast.UnionRepresentation_Inline.Repr.KeyAssembler"}.BeginMap(0)
+}
+func (_UnionRepresentation_Inline__ReprKeyAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.StringAssembler{"ast.UnionRepresentation_Inline.Repr.KeyAssembler"}.BeginList(0)
+}
+func (na *_UnionRepresentation_Inline__ReprKeyAssembler) AssignNull() error {
+	return mixins.StringAssembler{"ast.UnionRepresentation_Inline.Repr.KeyAssembler"}.AssignNull()
+}
+func (_UnionRepresentation_Inline__ReprKeyAssembler) AssignBool(bool) error {
+	return mixins.StringAssembler{"ast.UnionRepresentation_Inline.Repr.KeyAssembler"}.AssignBool(false)
+}
+func (_UnionRepresentation_Inline__ReprKeyAssembler) AssignInt(int64) error {
+	return mixins.StringAssembler{"ast.UnionRepresentation_Inline.Repr.KeyAssembler"}.AssignInt(0)
+}
+func (_UnionRepresentation_Inline__ReprKeyAssembler) AssignFloat(float64) error {
+	return mixins.StringAssembler{"ast.UnionRepresentation_Inline.Repr.KeyAssembler"}.AssignFloat(0)
+}
+func (ka *_UnionRepresentation_Inline__ReprKeyAssembler) AssignString(k string) error {
+	if ka.state != maState_midKey {
+		panic("misuse: KeyAssembler held beyond its valid lifetime")
+	}
+	switch k {
+	case "discriminantKey":
+		if ka.s&fieldBit__UnionRepresentation_Inline_DiscriminantKey != 0 {
+			return ipld.ErrRepeatedMapKey{&fieldName__UnionRepresentation_Inline_DiscriminantKey_serial}
+		}
+		ka.s += fieldBit__UnionRepresentation_Inline_DiscriminantKey
+		ka.state = maState_expectValue
+		ka.f = 0
+	case "discriminantTable":
+		if ka.s&fieldBit__UnionRepresentation_Inline_DiscriminantTable != 0 {
+			return ipld.ErrRepeatedMapKey{&fieldName__UnionRepresentation_Inline_DiscriminantTable_serial}
+		}
+		ka.s += fieldBit__UnionRepresentation_Inline_DiscriminantTable
+		ka.state = maState_expectValue
+		ka.f = 1
+	default:
+		return ipld.ErrInvalidKey{TypeName: "ast.UnionRepresentation_Inline.Repr", Key: &_String{k}}
+	}
+	return nil
+}
+func (_UnionRepresentation_Inline__ReprKeyAssembler) AssignBytes([]byte) error {
+	return mixins.StringAssembler{"ast.UnionRepresentation_Inline.Repr.KeyAssembler"}.AssignBytes(nil)
+}
+func (_UnionRepresentation_Inline__ReprKeyAssembler) AssignLink(ipld.Link) error {
+	return mixins.StringAssembler{"ast.UnionRepresentation_Inline.Repr.KeyAssembler"}.AssignLink(nil)
+}
+func (ka *_UnionRepresentation_Inline__ReprKeyAssembler) AssignNode(v ipld.Node) error {
+	if v2, err := v.AsString(); err != nil {
+		return err
+	} else {
+		return ka.AssignString(v2)
+	}
+}
+func (_UnionRepresentation_Inline__ReprKeyAssembler) Prototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
@@ -0,0 +1,759 @@
+package ast
+
+// Code generated by go-ipld-prime gengo.  DO NOT EDIT.
+
+import (
+	ipld "github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/node/mixins"
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+type _UnionRepresentation_Keyed struct {
+	m map[_String]*_TypeName
+	t []_UnionRepresentation_Keyed__entry
+}
+type UnionRepresentation_Keyed = *_UnionRepresentation_Keyed
+type _UnionRepresentation_Keyed__entry struct {
+	k _String
+	v _TypeName
+}
+
+func (n *_UnionRepresentation_Keyed) LookupMaybe(k String) MaybeTypeName {
+	v, ok := n.m[*k]
+	if !ok {
+		return &_UnionRepresentation_Keyed__valueAbsent
+	}
+	return &_TypeName__Maybe{
+		m: schema.Maybe_Value,
+		v: v,
+	}
+}
+
+var _UnionRepresentation_Keyed__valueAbsent = _TypeName__Maybe{m: schema.Maybe_Absent}
+
+// TODO generate also a plain Lookup method that doesn't box and alloc if this type contains non-nullable values!
+type _UnionRepresentation_Keyed__Maybe struct {
+	m schema.Maybe
+	v UnionRepresentation_Keyed
+}
+type MaybeUnionRepresentation_Keyed = *_UnionRepresentation_Keyed__Maybe
+
+func (m MaybeUnionRepresentation_Keyed) IsNull() bool {
+	return m.m == schema.Maybe_Null
+}
+func (m MaybeUnionRepresentation_Keyed) IsAbsent() bool {
+	return m.m == schema.Maybe_Absent
+}
+func (m MaybeUnionRepresentation_Keyed) Exists() bool {
+	return m.m == schema.Maybe_Value
+}
+func (m MaybeUnionRepresentation_Keyed) AsNode() ipld.Node {
+	switch m.m {
+	case schema.Maybe_Absent:
+		return ipld.Absent
+	case schema.Maybe_Null:
+		return ipld.Null
+	case schema.Maybe_Value:
+		return m.v
+	default:
+		panic("unreachable")
+	}
+}
+func (m MaybeUnionRepresentation_Keyed) Must() UnionRepresentation_Keyed {
+	if !m.Exists() {
+		panic("unbox of a maybe rejected")
+	}
+	return m.v
+}
+
+var _ ipld.Node = (UnionRepresentation_Keyed)(&_UnionRepresentation_Keyed{})
+var _ schema.TypedNode = (UnionRepresentation_Keyed)(&_UnionRepresentation_Keyed{})
+
+func (UnionRepresentation_Keyed) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_Map
+}
+func (n UnionRepresentation_Keyed) LookupByString(k string) (ipld.Node, error) {
+	var k2 _String
+	if err := (_String__Prototype{}).fromString(&k2, k); err != nil {
+		return nil, err // TODO wrap in some kind of ErrInvalidKey
+	}
+	v, exists := n.m[k2]
+	if !exists {
+		return nil, ipld.ErrNotExists{ipld.PathSegmentOfString(k)}
+	}
+	return v, nil
+}
+func (n UnionRepresentation_Keyed) LookupByNode(k ipld.Node) (ipld.Node, error) {
+	k2, ok := k.(String)
+	if !ok {
+		panic("todo invalid key type error")
+		// 'ipld.ErrInvalidKey{TypeName:"ast.UnionRepresentation_Keyed", Key:&_String{k}}' doesn't quite cut it: need room to explain the type, and it's not guaranteed k can be turned into a string at all
+	}
+	v, exists := n.m[*k2]
+	if !exists {
+		return nil, ipld.ErrNotExists{ipld.PathSegmentOfString(k2.String())}
+	}
+	return v, nil
+}
+func (UnionRepresentation_Keyed) LookupByIndex(idx int) (ipld.Node, error) {
+	return mixins.Map{"ast.UnionRepresentation_Keyed"}.LookupByIndex(0)
+}
+func (n UnionRepresentation_Keyed) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	return n.LookupByString(seg.String())
+}
+func (n UnionRepresentation_Keyed) MapIterator() ipld.MapIterator {
+	return &_UnionRepresentation_Keyed__MapItr{n, 0}
+}
+
+type _UnionRepresentation_Keyed__MapItr struct {
+	n   UnionRepresentation_Keyed
+	idx int
+}
+
+func (itr *_UnionRepresentation_Keyed__MapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+	if itr.idx >= len(itr.n.t) {
+		return nil, nil, ipld.ErrIteratorOverread{}
+	}
+	x := &itr.n.t[itr.idx]
+	k = &x.k
+	v = &x.v
+	itr.idx++
+	return
+}
+func (itr *_UnionRepresentation_Keyed__MapItr) Done() bool {
+	return itr.idx >= len(itr.n.t)
+}
+
+func (UnionRepresentation_Keyed) ListIterator() ipld.ListIterator {
+	return nil
+}
+func (n UnionRepresentation_Keyed) Length() int {
+	return len(n.t)
+}
+func (UnionRepresentation_Keyed) IsAbsent() bool {
+	return false
+}
+func (UnionRepresentation_Keyed) IsNull() bool {
+	return false
+}
+func (UnionRepresentation_Keyed) AsBool() (bool, error) {
+	return mixins.Map{"ast.UnionRepresentation_Keyed"}.AsBool()
+}
+func (UnionRepresentation_Keyed) AsInt() (int64, error) {
+	return mixins.Map{"ast.UnionRepresentation_Keyed"}.AsInt()
+}
+func (UnionRepresentation_Keyed) AsFloat() (float64, error) {
+	return mixins.Map{"ast.UnionRepresentation_Keyed"}.AsFloat()
+}
+func (UnionRepresentation_Keyed) AsString() (string, error) {
+	return mixins.Map{"ast.UnionRepresentation_Keyed"}.AsString()
+}
+func (UnionRepresentation_Keyed) AsBytes() ([]byte, error) {
+	return mixins.Map{"ast.UnionRepresentation_Keyed"}.AsBytes()
+}
+func (UnionRepresentation_Keyed) AsLink() (ipld.Link, error) {
+	return mixins.Map{"ast.UnionRepresentation_Keyed"}.AsLink()
+}
+func (UnionRepresentation_Keyed) Prototype() ipld.NodePrototype {
+	return _UnionRepresentation_Keyed__Prototype{}
+}
+
+type _UnionRepresentation_Keyed__Prototype struct{}
+
+func (_UnionRepresentation_Keyed__Prototype) NewBuilder() ipld.NodeBuilder {
+	var nb _UnionRepresentation_Keyed__Builder
+	nb.Reset()
+	return &nb
+}
+
+type _UnionRepresentation_Keyed__Builder struct {
+	_UnionRepresentation_Keyed__Assembler
+}
+
+func (nb *_UnionRepresentation_Keyed__Builder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_UnionRepresentation_Keyed__Builder) Reset() {
+	var w _UnionRepresentation_Keyed
+	var m schema.Maybe
+	*nb = _UnionRepresentation_Keyed__Builder{_UnionRepresentation_Keyed__Assembler{w: &w, m: &m}}
+}
+
+type _UnionRepresentation_Keyed__Assembler struct {
+	w     *_UnionRepresentation_Keyed
+	m     *schema.Maybe
+	state maState
+
+	cm schema.Maybe
+	ka _String__Assembler
+	va _TypeName__Assembler
+}
+
+func (na *_UnionRepresentation_Keyed__Assembler) reset() {
+	na.state = maState_initial
+	na.ka.reset()
+	na.va.reset()
+}
+func (na *_UnionRepresentation_Keyed__Assembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: it makes no sense to 'begin' twice on the same assembler!")
+	}
+	*na.m = midvalue
+	if sizeHint < 0 {
+		sizeHint = 0
+	}
+	if na.w == nil {
+		na.w = &_UnionRepresentation_Keyed{}
+	}
+	na.w.m = make(map[_String]*_TypeName, sizeHint)
+	na.w.t = make([]_UnionRepresentation_Keyed__entry, 0, sizeHint)
+	return na, nil
+}
+func (_UnionRepresentation_Keyed__Assembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.MapAssembler{"ast.UnionRepresentation_Keyed"}.BeginList(0)
+}
+func (na *_UnionRepresentation_Keyed__Assembler) AssignNull() error {
+	switch *na.m {
+	case allowNull:
+		*na.m = schema.Maybe_Null
+		return nil
+	case schema.Maybe_Absent:
+		return mixins.MapAssembler{"ast.UnionRepresentation_Keyed"}.AssignNull()
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+	}
+	panic("unreachable")
+}
+func (_UnionRepresentation_Keyed__Assembler) AssignBool(bool) error {
+	return mixins.MapAssembler{"ast.UnionRepresentation_Keyed"}.AssignBool(false)
+}
+func (_UnionRepresentation_Keyed__Assembler) AssignInt(int64) error {
+	return mixins.MapAssembler{"ast.UnionRepresentation_Keyed"}.AssignInt(0)
+}
+func (_UnionRepresentation_Keyed__Assembler) AssignFloat(float64) error {
+	return mixins.MapAssembler{"ast.UnionRepresentation_Keyed"}.AssignFloat(0)
+}
+func (_UnionRepresentation_Keyed__Assembler) AssignString(string) error {
+	return mixins.MapAssembler{"ast.UnionRepresentation_Keyed"}.AssignString("")
+}
+func (_UnionRepresentation_Keyed__Assembler) AssignBytes([]byte) error {
+	return mixins.MapAssembler{"ast.UnionRepresentation_Keyed"}.AssignBytes(nil)
+}
+func (_UnionRepresentation_Keyed__Assembler) AssignLink(ipld.Link) error {
+	return mixins.MapAssembler{"ast.UnionRepresentation_Keyed"}.AssignLink(nil)
+}
+func (na *_UnionRepresentation_Keyed__Assembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_UnionRepresentation_Keyed); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		case midvalue:
+			panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	if v.ReprKind() != ipld.ReprKind_Map {
+		return ipld.ErrWrongKind{TypeName: "ast.UnionRepresentation_Keyed", MethodName: "AssignNode", AppropriateKind: ipld.ReprKindSet_JustMap, ActualKind: v.ReprKind()}
+	}
+	itr := v.MapIterator()
+	for !itr.Done() {
+		k, v, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if err := na.AssembleKey().AssignNode(k); err != nil {
+			return err
+		}
+		if err := na.AssembleValue().AssignNode(v); err != nil {
+			return err
+		}
+	}
+	return na.Finish()
+}
+func (_UnionRepresentation_Keyed__Assembler) Prototype() ipld.NodePrototype {
+	return _UnionRepresentation_Keyed__Prototype{}
+}
+func (ma *_UnionRepresentation_Keyed__Assembler) keyFinishTidy() bool {
+	switch ma.cm {
+	case schema.Maybe_Value:
+		ma.ka.w = nil
+		tz := &ma.w.t[len(ma.w.t)-1]
+		ma.cm = schema.Maybe_Absent
+		ma.state = maState_expectValue
+		ma.w.m[tz.k] = &tz.v
+		ma.va.w = &tz.v
+		ma.va.m = &ma.cm
+		ma.ka.reset()
+		return true
+	default:
+		return false
+	}
+}
+func (ma *_UnionRepresentation_Keyed__Assembler) valueFinishTidy() bool {
+	switch ma.cm {
+	case schema.Maybe_Value:
+		ma.va.w = nil
+		ma.cm = schema.Maybe_Absent
+		ma.state = maState_initial
+		ma.va.reset()
+		return true
+	default:
+		return false
+	}
+}
+func (ma *_UnionRepresentation_Keyed__Assembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleEntry cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleEntry cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleEntry cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleEntry cannot be called on an assembler that's already finished")
+	}
+
+	var k2 _String
+	if err := (_String__Prototype{}).fromString(&k2, k); err != nil {
+		return nil, err // TODO wrap in some kind of ErrInvalidKey
+	}
+	if _, exists := ma.w.m[k2]; exists {
+		return nil, ipld.ErrRepeatedMapKey{&k2}
+	}
+	ma.w.t = append(ma.w.t, _UnionRepresentation_Keyed__entry{k: k2})
+	tz := &ma.w.t[len(ma.w.t)-1]
+	ma.state = maState_midValue
+
+	ma.w.m[k2] = &tz.v
+	ma.va.w = &tz.v
+	ma.va.m = &ma.cm
+	return &ma.va, nil
+}
+func (ma *_UnionRepresentation_Keyed__Assembler) AssembleKey() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleKey cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleKey cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleKey cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleKey cannot be called on an assembler that's already finished")
+	}
+	ma.w.t = append(ma.w.t, _UnionRepresentation_Keyed__entry{})
+	ma.state = maState_midKey
+	ma.ka.m = &ma.cm
+	ma.ka.w = &ma.w.t[len(ma.w.t)-1].k
+	return &ma.ka
+}
+func (ma *_UnionRepresentation_Keyed__Assembler) AssembleValue() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		panic("invalid state: AssembleValue cannot be called when no key is primed")
+	case maState_midKey:
+		if !ma.keyFinishTidy() {
+			panic("invalid state: AssembleValue cannot be called when in the middle of assembling a key")
+		} // if tidy success: carry on
+	case maState_expectValue:
+		// carry on
+	case maState_midValue:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling another value")
+	case maState_finished:
+		panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midValue
+	return &ma.va
+}
+func (ma *_UnionRepresentation_Keyed__Assembler) Finish() error {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: Finish cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		panic("invalid state: Finish cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: Finish cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_finished
+	*ma.m = schema.Maybe_Value
+	return nil
+}
+func (ma *_UnionRepresentation_Keyed__Assembler) KeyPrototype() ipld.NodePrototype {
+	return _String__Prototype{}
+}
+func (ma *_UnionRepresentation_Keyed__Assembler) ValuePrototype(_ string) ipld.NodePrototype {
+	return _TypeName__Prototype{}
+}
+func (UnionRepresentation_Keyed) Type() schema.Type {
+	return nil /*TODO:typelit*/
+}
+func (n UnionRepresentation_Keyed) Representation() ipld.Node {
+	return (*_UnionRepresentation_Keyed__Repr)(n)
+}
+
+type _UnionRepresentation_Keyed__Repr _UnionRepresentation_Keyed
+
+var _ ipld.Node = &_UnionRepresentation_Keyed__Repr{}
+
+func (_UnionRepresentation_Keyed__Repr) ReprKind() ipld.ReprKind {
+	return ipld.ReprKind_Map
+}
+func (nr *_UnionRepresentation_Keyed__Repr) LookupByString(k string) (ipld.Node, error) {
+	v, err := (UnionRepresentation_Keyed)(nr).LookupByString(k)
+	if err != nil || v == ipld.Null {
+		return v, err
+	}
+	return v.(TypeName).Representation(), nil
+}
+func (nr *_UnionRepresentation_Keyed__Repr) LookupByNode(k ipld.Node) (ipld.Node, error) {
+	v, err := (UnionRepresentation_Keyed)(nr).LookupByNode(k)
+	if err != nil || v == ipld.Null {
+		return v, err
+	}
+	return v.(TypeName).Representation(), nil
+}
+func (_UnionRepresentation_Keyed__Repr) LookupByIndex(idx int) (ipld.Node, error) {
+	return mixins.Map{"ast.UnionRepresentation_Keyed.Repr"}.LookupByIndex(0)
+}
+func (n _UnionRepresentation_Keyed__Repr) LookupBySegment(seg ipld.PathSegment) (ipld.Node, error) {
+	return n.LookupByString(seg.String())
+}
+func (nr *_UnionRepresentation_Keyed__Repr) MapIterator() ipld.MapIterator {
+	return &_UnionRepresentation_Keyed__ReprMapItr{(UnionRepresentation_Keyed)(nr), 0}
+}
+
+type _UnionRepresentation_Keyed__ReprMapItr _UnionRepresentation_Keyed__MapItr
+
+func (itr *_UnionRepresentation_Keyed__ReprMapItr) Next() (k ipld.Node, v ipld.Node, err error) {
+	k, v, err = (*_UnionRepresentation_Keyed__MapItr)(itr).Next()
+	if err != nil || v == ipld.Null {
+		return
+	}
+	return k, v.(TypeName).Representation(), nil
+}
+func (itr *_UnionRepresentation_Keyed__ReprMapItr) Done() bool {
+	return (*_UnionRepresentation_Keyed__MapItr)(itr).Done()
+}
+
+func (_UnionRepresentation_Keyed__Repr) ListIterator() ipld.ListIterator {
+	return nil
+}
+func (rn *_UnionRepresentation_Keyed__Repr) Length() int {
+	return len(rn.t)
+}
+func (_UnionRepresentation_Keyed__Repr) IsAbsent() bool {
+	return false
+}
+func (_UnionRepresentation_Keyed__Repr) IsNull() bool {
+	return false
+}
+func (_UnionRepresentation_Keyed__Repr) AsBool() (bool, error) {
+	return mixins.Map{"ast.UnionRepresentation_Keyed.Repr"}.AsBool()
+}
+func (_UnionRepresentation_Keyed__Repr) AsInt() (int64, error) {
+	return mixins.Map{"ast.UnionRepresentation_Keyed.Repr"}.AsInt()
+}
+func (_UnionRepresentation_Keyed__Repr) AsFloat() (float64, error) {
+	return mixins.Map{"ast.UnionRepresentation_Keyed.Repr"}.AsFloat()
+}
+func (_UnionRepresentation_Keyed__Repr) AsString() (string, error) {
+	return mixins.Map{"ast.UnionRepresentation_Keyed.Repr"}.AsString()
+}
+func (_UnionRepresentation_Keyed__Repr) AsBytes() ([]byte, error) {
+	return mixins.Map{"ast.UnionRepresentation_Keyed.Repr"}.AsBytes()
+}
+func (_UnionRepresentation_Keyed__Repr) AsLink() (ipld.Link, error) {
+	return mixins.Map{"ast.UnionRepresentation_Keyed.Repr"}.AsLink()
+}
+func (_UnionRepresentation_Keyed__Repr) Prototype() ipld.NodePrototype {
+	return _UnionRepresentation_Keyed__ReprPrototype{}
+}
+
+type _UnionRepresentation_Keyed__ReprPrototype struct{}
+
+func (_UnionRepresentation_Keyed__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+	var nb _UnionRepresentation_Keyed__ReprBuilder
+	nb.Reset()
+	return &nb
+}
+
+type _UnionRepresentation_Keyed__ReprBuilder struct {
+	_UnionRepresentation_Keyed__ReprAssembler
+}
+
+func (nb *_UnionRepresentation_Keyed__ReprBuilder) Build() ipld.Node {
+	if *nb.m != schema.Maybe_Value {
+		panic("invalid state: cannot call Build on an assembler that's not finished")
+	}
+	return nb.w
+}
+func (nb *_UnionRepresentation_Keyed__ReprBuilder) Reset() {
+	var w _UnionRepresentation_Keyed
+	var m schema.Maybe
+	*nb = _UnionRepresentation_Keyed__ReprBuilder{_UnionRepresentation_Keyed__ReprAssembler{w: &w, m: &m}}
+}
+
+type _UnionRepresentation_Keyed__ReprAssembler struct {
+	w     *_UnionRepresentation_Keyed
+	m     *schema.Maybe
+	state maState
+
+	cm schema.Maybe
+	ka _String__ReprAssembler
+	va _TypeName__ReprAssembler
+}
+
+func (na *_UnionRepresentation_Keyed__ReprAssembler) reset() {
+	na.state = maState_initial
+	na.ka.reset()
+	na.va.reset()
+}
+func (na *_UnionRepresentation_Keyed__ReprAssembler) BeginMap(sizeHint int) (ipld.MapAssembler, error) {
+	switch *na.m {
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: it makes no sense to 'begin' twice on the same assembler!")
+	}
+	*na.m = midvalue
+	if sizeHint < 0 {
+		sizeHint = 0
+	}
+	if na.w == nil {
+		na.w = &_UnionRepresentation_Keyed{}
+	}
+	na.w.m = make(map[_String]*_TypeName, sizeHint)
+	na.w.t = make([]_UnionRepresentation_Keyed__entry, 0, sizeHint)
+	return na, nil
+}
+func (_UnionRepresentation_Keyed__ReprAssembler) BeginList(sizeHint int) (ipld.ListAssembler, error) {
+	return mixins.MapAssembler{"ast.UnionRepresentation_Keyed.Repr"}.BeginList(0)
+}
+func (na *_UnionRepresentation_Keyed__ReprAssembler) AssignNull() error {
+	switch *na.m {
+	case allowNull:
+		*na.m = schema.Maybe_Null
+		return nil
+	case schema.Maybe_Absent:
+		return mixins.MapAssembler{"ast.UnionRepresentation_Keyed.Repr.Repr"}.AssignNull()
+	case schema.Maybe_Value, schema.Maybe_Null:
+		panic("invalid state: cannot assign into assembler that's already finished")
+	case midvalue:
+		panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+	}
+	panic("unreachable")
+}
+func (_UnionRepresentation_Keyed__ReprAssembler) AssignBool(bool) error {
+	return mixins.MapAssembler{"ast.UnionRepresentation_Keyed.Repr"}.AssignBool(false)
+}
+func (_UnionRepresentation_Keyed__ReprAssembler) AssignInt(int64) error {
+	return mixins.MapAssembler{"ast.UnionRepresentation_Keyed.Repr"}.AssignInt(0)
+}
+func (_UnionRepresentation_Keyed__ReprAssembler) AssignFloat(float64) error {
+	return mixins.MapAssembler{"ast.UnionRepresentation_Keyed.Repr"}.AssignFloat(0)
+}
+func (_UnionRepresentation_Keyed__ReprAssembler) AssignString(string) error {
+	return mixins.MapAssembler{"ast.UnionRepresentation_Keyed.Repr"}.AssignString("")
+}
+func (_UnionRepresentation_Keyed__ReprAssembler) AssignBytes([]byte) error {
+	return mixins.MapAssembler{"ast.UnionRepresentation_Keyed.Repr"}.AssignBytes(nil)
+}
+func (_UnionRepresentation_Keyed__ReprAssembler) AssignLink(ipld.Link) error {
+	return mixins.MapAssembler{"ast.UnionRepresentation_Keyed.Repr"}.AssignLink(nil)
+}
+func (na *_UnionRepresentation_Keyed__ReprAssembler) AssignNode(v ipld.Node) error {
+	if v.IsNull() {
+		return na.AssignNull()
+	}
+	if v2, ok := v.(*_UnionRepresentation_Keyed); ok {
+		switch *na.m {
+		case schema.Maybe_Value, schema.Maybe_Null:
+			panic("invalid state: cannot assign into assembler that's already finished")
+		case midvalue:
+			panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+		}
+		if na.w == nil {
+			na.w = v2
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+		*na.w = *v2
+		*na.m = schema.Maybe_Value
+		return nil
+	}
+	if v.ReprKind() != ipld.ReprKind_Map {
+		return ipld.ErrWrongKind{TypeName: "ast.UnionRepresentation_Keyed.Repr", MethodName: "AssignNode", AppropriateKind: ipld.ReprKindSet_JustMap, ActualKind: v.ReprKind()}
+	}
+	itr := v.MapIterator()
+	for !itr.Done() {
+		k, v, err := itr.Next()
+		if err != nil {
+			return err
+		}
+		if err := na.AssembleKey().AssignNode(k); err != nil {
+			return err
+		}
+		if err := na.AssembleValue().AssignNode(v); err != nil {
+			return err
+		}
+	}
+	return na.Finish()
+}
+func (_UnionRepresentation_Keyed__ReprAssembler) Prototype() ipld.NodePrototype {
+	return _UnionRepresentation_Keyed__ReprPrototype{}
+}
+func (ma *_UnionRepresentation_Keyed__ReprAssembler) keyFinishTidy() bool {
+	switch ma.cm {
+	case schema.Maybe_Value:
+		ma.ka.w = nil
+		tz := &ma.w.t[len(ma.w.t)-1]
+		ma.cm = schema.Maybe_Absent
+		ma.state = maState_expectValue
+		ma.w.m[tz.k] = &tz.v
+		ma.va.w = &tz.v
+		ma.va.m = &ma.cm
+		ma.ka.reset()
+		return true
+	default:
+		return false
+	}
+}
+func (ma *_UnionRepresentation_Keyed__ReprAssembler) valueFinishTidy() bool {
+	switch ma.cm {
+	case schema.Maybe_Value:
+		ma.va.w = nil
+		ma.cm = schema.Maybe_Absent
+		ma.state = maState_initial
+		ma.va.reset()
+		return true
+	default:
+		return false
+	}
+}
+func (ma *_UnionRepresentation_Keyed__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleEntry cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleEntry cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleEntry cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleEntry cannot be called on an assembler that's already finished")
+	}
+
+	var k2 _String
+	if err := (_String__ReprPrototype{}).fromString(&k2, k); err != nil {
+		return nil, err // TODO wrap in some kind of ErrInvalidKey
+	}
+	if _, exists := ma.w.m[k2]; exists {
+		return nil, ipld.ErrRepeatedMapKey{&k2}
+	}
+	ma.w.t = append(ma.w.t, _UnionRepresentation_Keyed__entry{k: k2})
+	tz := &ma.w.t[len(ma.w.t)-1]
+	ma.state = maState_midValue
+
+	ma.w.m[k2] = &tz.v
+	ma.va.w = &tz.v
+	ma.va.m = &ma.cm
+	return &ma.va, nil
+}
+func (ma *_UnionRepresentation_Keyed__ReprAssembler) AssembleKey() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: AssembleKey cannot be called when in the middle of assembling another key")
+	case maState_expectValue:
+		panic("invalid state: AssembleKey cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: AssembleKey cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: AssembleKey cannot be called on an assembler that's already finished")
+	}
+	ma.w.t = append(ma.w.t, _UnionRepresentation_Keyed__entry{})
+	ma.state = maState_midKey
+	ma.ka.m = &ma.cm
+	ma.ka.w = &ma.w.t[len(ma.w.t)-1].k
+	return &ma.ka
+}
+func (ma *_UnionRepresentation_Keyed__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+	switch ma.state {
+	case maState_initial:
+		panic("invalid state: AssembleValue cannot be called when no key is primed")
+	case maState_midKey:
+		if !ma.keyFinishTidy() {
+			panic("invalid state: AssembleValue cannot be called when in the middle of assembling a key")
+		} // if tidy success: carry on
+	case maState_expectValue:
+		// carry on
+	case maState_midValue:
+		panic("invalid state: AssembleValue cannot be called when in the middle of assembling another value")
+	case maState_finished:
+		panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_midValue
+	return &ma.va
+}
+func (ma *_UnionRepresentation_Keyed__ReprAssembler) Finish() error {
+	switch ma.state {
+	case maState_initial:
+		// carry on
+	case maState_midKey:
+		panic("invalid state: Finish cannot be called when in the middle of assembling a key")
+	case maState_expectValue:
+		panic("invalid state: Finish cannot be called when expecting start of value assembly")
+	case maState_midValue:
+		if !ma.valueFinishTidy() {
+			panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+		} // if tidy success: carry on
+	case maState_finished:
+		panic("invalid state: Finish cannot be called on an assembler that's already finished")
+	}
+	ma.state = maState_finished
+	*ma.m = schema.Maybe_Value
+	return nil
+}
+func (ma *_UnionRepresentation_Keyed__ReprAssembler) KeyPrototype() ipld.NodePrototype {
+	return _String__ReprPrototype{}
+}
+func (ma *_UnionRepresentation_Keyed__ReprAssembler) ValuePrototype(_ string) ipld.NodePrototype {
+	return _TypeName__ReprPrototype{}
+}
@@ -0,0 +1,51 @@
+// Package dsl parses the IPLD Schema DSL -- the human-friendly text format
+// for schemas, conventionally kept in files with the ".ipldsch" extension --
+// and produces a schema.TypeSystem.
+//
+// A short example of the syntax:
+//
+//	# comments start with a '#' (or '//') and run to the end of the line.
+//	type Foo struct {
+//		a String
+//		b optional nullable [Int]
+//		c {String:&Bar}
+//	} representation map {
+//		field a alias "A"
+//		field c implicit {}
+//	}
+//
+//	type Bar union {
+//		| String "str"
+//		| Foo "foo"
+//	} representation keyed
+//
+//	type Color enum {
+//		| Red
+//		| Green
+//	}
+//
+// All of the type kinds are supported:
+// bool, string, bytes, int, float, link (as either `link` or `&Target`),
+// lists (`[Value]`), maps (`{Key:Value}`), structs, unions, and enums.
+//
+// Structs may use the map, tuple, stringjoin, and stringpairs representations;
+// unions may use the keyed, kinded, envelope, and inline representations.
+// A few things the DSL can express aren't yet supported by schema.TypeSystem,
+// and result in an error if used: for example, enums with custom
+// representation values, and tuple representations with a fieldOrder.
+//
+// Lists, maps, and links can be used anonymously (for example, as the type of
+// a struct field) without declaring a name for them.
+// Anonymous types are given names following the same munging convention used
+// elsewhere for codegen: `[String]` is named "List__String";
+// `[nullable String]` is named "List__nullableString";
+// `{String:Int}` is named "Map__String__Int"; and `&Foo` is named "Link__Foo".
+//
+// The prelude types -- Bool, String, Bytes, Int, Float, and Link -- don't need to
+// be declared: if they're referenced but not declared, they're added automatically.
+//
+// Errors report the line and column in the source where the problem was found.
+// ErrSyntax is returned when the text can't be parsed;
+// ErrInvalidSchema is returned when it can, but describes a schema that isn't valid
+// (for example, it refers to a type that isn't defined anywhere).
+package dsl
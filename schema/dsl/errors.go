@@ -0,0 +1,32 @@
+package dsl
+
+import (
+	"fmt"
+)
+
+// ErrSyntax is returned when schema DSL text is malformed.
+type ErrSyntax struct {
+	Line   int // Line number of the problem, starting at 1.
+	Column int // Column number of the problem (counted in characters), starting at 1.
+
+	Detail string
+}
+
+func (e ErrSyntax) Error() string {
+	return fmt.Sprintf("schema dsl: syntax error at line %d, column %d: %s", e.Line, e.Column, e.Detail)
+}
+
+// ErrInvalidSchema is returned when schema DSL text is well-formed,
+// but the schema it describes is not valid:
+// for example, it refers to types that don't exist,
+// or declares the same type twice.
+type ErrInvalidSchema struct {
+	Line   int // Line number of the problem, starting at 1.
+	Column int // Column number of the problem (counted in characters), starting at 1.
+
+	Detail string
+}
+
+func (e ErrInvalidSchema) Error() string {
+	return fmt.Sprintf("schema dsl: invalid schema at line %d, column %d: %s", e.Line, e.Column, e.Detail)
+}
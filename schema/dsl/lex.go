@@ -0,0 +1,151 @@
+package dsl
+
+import (
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+)
+
+type tokenKind uint8
+
+const (
+	token_EOF    tokenKind = iota
+	token_Word             // identifiers and keywords: `type`, `Foo`, `nullable`, etc.
+	token_String           // quoted string literals; the token text is already unquoted.
+	token_Int              // integer literals, possibly with a leading '-'.
+	token_Punct            // one of the single-character punctuation tokens: `{}[]():|&`.
+)
+
+func (k tokenKind) String() string {
+	switch k {
+	case token_EOF:
+		return "end of input"
+	case token_Word:
+		return "word"
+	case token_String:
+		return "string"
+	case token_Int:
+		return "integer"
+	case token_Punct:
+		return "punctuation"
+	default:
+		panic("invalid enumeration value!")
+	}
+}
+
+type token struct {
+	kind tokenKind
+	text string
+	line int
+	col  int
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case token_EOF:
+		return "end of input"
+	case token_String:
+		return strconv.Quote(t.text)
+	default:
+		return "\"" + t.text + "\""
+	}
+}
+
+// lex splits the source text into tokens, discarding whitespace and comments.
+// The final token is always a token_EOF.
+func lex(src []byte) ([]token, error) {
+	var toks []token
+	line, col := 1, 1
+	advance := func(r rune) {
+		if r == '\n' {
+			line++
+			col = 1
+		} else {
+			col++
+		}
+	}
+	for i := 0; i < len(src); {
+		r, size := utf8.DecodeRune(src[i:])
+		switch {
+		case r == utf8.RuneError && size == 1:
+			return nil, ErrSyntax{line, col, "invalid utf-8"}
+		case unicode.IsSpace(r):
+			advance(r)
+			i += size
+		case r == '#', r == '/' && i+1 < len(src) && src[i+1] == '/':
+			for i < len(src) && src[i] != '\n' {
+				_, size := utf8.DecodeRune(src[i:])
+				i += size
+				col++
+			}
+		case r == '"':
+			start, startCol := i, col
+			i++
+			col++
+			for {
+				if i >= len(src) || src[i] == '\n' {
+					return nil, ErrSyntax{line, startCol, "unterminated string"}
+				}
+				r2, size := utf8.DecodeRune(src[i:])
+				i += size
+				col++
+				if r2 == '\\' && i < len(src) {
+					_, size := utf8.DecodeRune(src[i:])
+					i += size
+					col++
+					continue
+				}
+				if r2 == '"' {
+					break
+				}
+			}
+			s, err := strconv.Unquote(string(src[start:i]))
+			if err != nil {
+				return nil, ErrSyntax{line, startCol, "invalid string literal " + string(src[start:i])}
+			}
+			toks = append(toks, token{token_String, s, line, startCol})
+		case r == '-' || isDigit(r):
+			start, startCol := i, col
+			i++
+			col++
+			for i < len(src) && isDigit(rune(src[i])) {
+				i++
+				col++
+			}
+			if src[i-1] == '-' {
+				return nil, ErrSyntax{line, startCol, "expected digits after '-'"}
+			}
+			toks = append(toks, token{token_Int, string(src[start:i]), line, startCol})
+		case isWordStart(r):
+			start, startCol := i, col
+			for i < len(src) {
+				r2, size := utf8.DecodeRune(src[i:])
+				if !isWordStart(r2) && !isDigit(r2) {
+					break
+				}
+				i += size
+				col++
+			}
+			toks = append(toks, token{token_Word, string(src[start:i]), line, startCol})
+		default:
+			switch r {
+			case '{', '}', '[', ']', '(', ')', ':', '|', '&':
+				toks = append(toks, token{token_Punct, string(r), line, col})
+				advance(r)
+				i += size
+			default:
+				return nil, ErrSyntax{line, col, "unexpected character " + strconv.QuoteRune(r)}
+			}
+		}
+	}
+	toks = append(toks, token{token_EOF, "", line, col})
+	return toks, nil
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+func isWordStart(r rune) bool {
+	return r == '_' || unicode.IsLetter(r)
+}
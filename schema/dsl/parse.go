@@ -0,0 +1,722 @@
+package dsl
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+
+	ipld "github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+// Parse reads schema DSL text from the reader and returns the TypeSystem it describes.
+//
+// If the text can't be parsed, an ErrSyntax will be returned;
+// if it describes a schema that isn't valid, an ErrInvalidSchema will be returned.
+// (Errors from the reader itself are returned unchanged.)
+func Parse(r io.Reader) (*schema.TypeSystem, error) {
+	src, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBytes(src)
+}
+
+// ParseBytes is the equivalent of Parse, for schema DSL text that's already in memory.
+func ParseBytes(src []byte) (*schema.TypeSystem, error) {
+	toks, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+	p := parser{
+		toks:    toks,
+		defined: make(map[schema.TypeName]definition),
+	}
+	p.ts.Init()
+	if err := p.parseFile(); err != nil {
+		return nil, err
+	}
+	if err := p.resolve(); err != nil {
+		return nil, err
+	}
+	return &p.ts, nil
+}
+
+// kindKeywords maps the words used for representation kinds in kinded unions.
+var kindKeywords = map[string]ipld.ReprKind{
+	"map":    ipld.ReprKind_Map,
+	"list":   ipld.ReprKind_List,
+	"bool":   ipld.ReprKind_Bool,
+	"int":    ipld.ReprKind_Int,
+	"float":  ipld.ReprKind_Float,
+	"string": ipld.ReprKind_String,
+	"bytes":  ipld.ReprKind_Bytes,
+	"link":   ipld.ReprKind_Link,
+}
+
+type parser struct {
+	toks []token
+	pos  int
+
+	ts      schema.TypeSystem
+	defined map[schema.TypeName]definition
+	refs    []reference    // every use of a type name, so we can check they're all defined (or pull them from the prelude).
+	checks  []func() error // deferred checks which need the whole TypeSystem to be assembled first.
+}
+
+type definition struct {
+	at   token
+	anon bool
+}
+
+type reference struct {
+	name schema.TypeName
+	at   token
+}
+
+func (p *parser) peek() token {
+	return p.toks[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.toks[p.pos]
+	if tok.kind != token_EOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *parser) peekIsWord(word string) bool {
+	tok := p.peek()
+	return tok.kind == token_Word && tok.text == word
+}
+
+func (p *parser) peekIsPunct(punct string) bool {
+	tok := p.peek()
+	return tok.kind == token_Punct && tok.text == punct
+}
+
+func (p *parser) expectWord(word string) error {
+	tok := p.next()
+	if tok.kind != token_Word || tok.text != word {
+		return errUnexpected(tok, "\""+word+"\"")
+	}
+	return nil
+}
+
+func (p *parser) expectPunct(punct string) error {
+	tok := p.next()
+	if tok.kind != token_Punct || tok.text != punct {
+		return errUnexpected(tok, "\""+punct+"\"")
+	}
+	return nil
+}
+
+func (p *parser) expectName(what string) (token, error) {
+	tok := p.next()
+	if tok.kind != token_Word {
+		return tok, errUnexpected(tok, what)
+	}
+	return tok, nil
+}
+
+func (p *parser) expectString(what string) (token, error) {
+	tok := p.next()
+	if tok.kind != token_String {
+		return tok, errUnexpected(tok, what)
+	}
+	return tok, nil
+}
+
+func errUnexpected(tok token, expected string) error {
+	return ErrSyntax{tok.line, tok.col, fmt.Sprintf("expected %s, got %s", expected, tok)}
+}
+
+func errInvalid(tok token, format string, args ...interface{}) error {
+	return ErrInvalidSchema{tok.line, tok.col, fmt.Sprintf(format, args...)}
+}
+
+func (p *parser) parseFile() error {
+	for p.peek().kind != token_EOF {
+		if p.peekIsWord("advanced") {
+			return errInvalid(p.peek(), "advanced data layouts are not supported")
+		}
+		if err := p.expectWord("type"); err != nil {
+			return err
+		}
+		name, err := p.expectName("type name")
+		if err != nil {
+			return err
+		}
+		if err := p.declare(name, false); err != nil {
+			return err
+		}
+		typ, err := p.parseTypeDefn(schema.TypeName(name.text))
+		if err != nil {
+			return err
+		}
+		p.ts.Accumulate(typ)
+	}
+	return nil
+}
+
+// declare records a type name as being defined.
+// Anonymous types may be declared several times (they're only accumulated once);
+// all other collisions are errors.
+func (p *parser) declare(name token, anon bool) error {
+	tn := schema.TypeName(name.text)
+	if prev, exists := p.defined[tn]; exists {
+		switch {
+		case anon && prev.anon:
+			return nil
+		case anon || prev.anon:
+			return errInvalid(name, "type name %q collides with the name of an anonymous type", tn)
+		default:
+			return errInvalid(name, "type %q is declared more than once (previously at line %d, column %d)", tn, prev.at.line, prev.at.col)
+		}
+	}
+	p.defined[tn] = definition{name, anon}
+	return nil
+}
+
+func (p *parser) parseTypeDefn(name schema.TypeName) (schema.Type, error) {
+	tok := p.peek()
+	var typ schema.Type
+	switch {
+	case tok.kind == token_Word:
+		p.next()
+		switch tok.text {
+		case "bool":
+			typ = schema.SpawnBool(name)
+		case "string":
+			typ = schema.SpawnString(name)
+		case "bytes":
+			typ = schema.SpawnBytes(name)
+		case "int":
+			typ = schema.SpawnInt(name)
+		case "float":
+			typ = schema.SpawnFloat(name)
+		case "link":
+			typ = schema.SpawnLink(name)
+		case "struct":
+			return p.parseStruct(name)
+		case "union":
+			return p.parseUnion(name)
+		case "enum":
+			return p.parseEnum(name)
+		default:
+			return nil, errUnexpected(tok, "a type definition")
+		}
+	case tok.kind == token_Punct && tok.text == "&":
+		p.next()
+		target, err := p.parseTypeName()
+		if err != nil {
+			return nil, err
+		}
+		typ = schema.SpawnLinkReference(name, target)
+	case tok.kind == token_Punct && tok.text == "[":
+		valueType, nullable, err := p.parseListBody()
+		if err != nil {
+			return nil, err
+		}
+		typ = schema.SpawnList(name, valueType, nullable)
+	case tok.kind == token_Punct && tok.text == "{":
+		keyType, valueType, nullable, err := p.parseMapBody()
+		if err != nil {
+			return nil, err
+		}
+		if p.peekIsWord("representation") {
+			p.next()
+			strategy, err := p.expectName("representation strategy")
+			if err != nil {
+				return nil, err
+			}
+			if strategy.text != "map" {
+				return nil, errInvalid(strategy, "map representation %q is not supported", strategy.text)
+			}
+		}
+		typ = schema.SpawnMap(name, keyType, valueType, nullable)
+	default:
+		return nil, errUnexpected(tok, "a type definition")
+	}
+	if p.peekIsWord("representation") {
+		return nil, errInvalid(p.peek(), "representation strategies are not supported for %s types", typ.Kind())
+	}
+	return typ, nil
+}
+
+// parseTypeName parses a reference to a type by name, and records it to be checked later.
+func (p *parser) parseTypeName() (schema.TypeName, error) {
+	tok, err := p.expectName("type name")
+	if err != nil {
+		return "", err
+	}
+	tn := schema.TypeName(tok.text)
+	p.refs = append(p.refs, reference{tn, tok})
+	return tn, nil
+}
+
+// parseTypeExpr parses either a reference to a type by name,
+// or the inline definition of an anonymous list, map, or link type.
+// Either way, it returns the name of the type.
+func (p *parser) parseTypeExpr() (schema.TypeName, error) {
+	tok := p.peek()
+	if tok.kind != token_Punct {
+		return p.parseTypeName()
+	}
+	var typ schema.Type
+	switch tok.text {
+	case "&":
+		p.next()
+		target, err := p.parseTypeName()
+		if err != nil {
+			return "", err
+		}
+		typ = schema.SpawnLinkReference("Link__"+target, target)
+	case "[":
+		valueType, nullable, err := p.parseListBody()
+		if err != nil {
+			return "", err
+		}
+		typ = schema.SpawnList(schema.AnonymousListTypeName(valueType, nullable), valueType, nullable)
+	case "{":
+		keyType, valueType, nullable, err := p.parseMapBody()
+		if err != nil {
+			return "", err
+		}
+		typ = schema.SpawnMap(schema.AnonymousMapTypeName(keyType, valueType, nullable), keyType, valueType, nullable)
+	default:
+		return "", errUnexpected(tok, "type name or anonymous type definition")
+	}
+	nameTok := tok
+	nameTok.text = string(typ.Name())
+	if _, exists := p.defined[typ.Name()]; !exists {
+		p.ts.Accumulate(typ)
+	}
+	return typ.Name(), p.declare(nameTok, true)
+}
+
+func (p *parser) parseListBody() (valueType schema.TypeName, nullable bool, err error) {
+	if err = p.expectPunct("["); err != nil {
+		return
+	}
+	if p.peekIsWord("nullable") {
+		p.next()
+		nullable = true
+	}
+	if valueType, err = p.parseTypeExpr(); err != nil {
+		return
+	}
+	err = p.expectPunct("]")
+	return
+}
+
+func (p *parser) parseMapBody() (keyType schema.TypeName, valueType schema.TypeName, nullable bool, err error) {
+	if err = p.expectPunct("{"); err != nil {
+		return
+	}
+	keyTok := p.peek()
+	if keyType, err = p.parseTypeName(); err != nil {
+		return
+	}
+	p.checks = append(p.checks, func() error {
+		keyTyp := p.ts.TypeByName(string(keyType))
+		if keyTyp.RepresentationBehavior() != ipld.ReprKind_String {
+			return errInvalid(keyTok, "map key type %q is not valid: map keys must be a type with a string representation", keyType)
+		}
+		return nil
+	})
+	if err = p.expectPunct(":"); err != nil {
+		return
+	}
+	if p.peekIsWord("nullable") {
+		p.next()
+		nullable = true
+	}
+	if valueType, err = p.parseTypeExpr(); err != nil {
+		return
+	}
+	err = p.expectPunct("}")
+	return
+}
+
+func (p *parser) parseStruct(name schema.TypeName) (schema.Type, error) {
+	if err := p.expectPunct("{"); err != nil {
+		return nil, err
+	}
+	var fields []schema.StructField
+	fieldToks := map[string]token{}
+	for !p.peekIsPunct("}") {
+		fieldTok, err := p.expectName("field name or \"}\"")
+		if err != nil {
+			return nil, err
+		}
+		if _, exists := fieldToks[fieldTok.text]; exists {
+			return nil, errInvalid(fieldTok, "field %q is declared more than once in struct %q", fieldTok.text, name)
+		}
+		fieldToks[fieldTok.text] = fieldTok
+		var optional, nullable bool
+		for {
+			if p.peekIsWord("optional") && !optional {
+				p.next()
+				optional = true
+			} else if p.peekIsWord("nullable") && !nullable {
+				p.next()
+				nullable = true
+			} else {
+				break
+			}
+		}
+		typ, err := p.parseTypeExpr()
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, schema.SpawnStructField(fieldTok.text, typ, optional, nullable))
+	}
+	p.next()
+
+	var repr schema.StructRepresentation = schema.SpawnStructRepresentationMap(nil)
+	if p.peekIsWord("representation") {
+		p.next()
+		strategy, err := p.expectName("representation strategy")
+		if err != nil {
+			return nil, err
+		}
+		switch strategy.text {
+		case "map":
+			if repr, err = p.parseStructRepresentationMap(name, fieldToks); err != nil {
+				return nil, err
+			}
+		case "tuple":
+			if p.peekIsPunct("{") {
+				p.next()
+				if !p.peekIsPunct("}") {
+					return nil, errInvalid(p.peek(), "tuple representation parameters (such as fieldOrder) are not supported")
+				}
+				p.next()
+			}
+			repr = schema.SpawnStructRepresentationTuple()
+		case "stringjoin":
+			params, err := p.parseParams("join")
+			if err != nil {
+				return nil, err
+			}
+			join, ok := params["join"]
+			if !ok {
+				return nil, errInvalid(strategy, "stringjoin representation requires a \"join\" parameter")
+			}
+			for _, f := range fields {
+				if f.IsMaybe() {
+					return nil, errInvalid(fieldToks[f.Name()], "field %q cannot be optional or nullable: not supported with stringjoin representation", f.Name())
+				}
+			}
+			repr = schema.SpawnStructRepresentationStringjoin(join.text)
+		case "stringpairs":
+			params, err := p.parseParams("innerDelim", "entryDelim")
+			if err != nil {
+				return nil, err
+			}
+			innerDelim, ok1 := params["innerDelim"]
+			entryDelim, ok2 := params["entryDelim"]
+			if !ok1 || !ok2 {
+				return nil, errInvalid(strategy, "stringpairs representation requires both \"innerDelim\" and \"entryDelim\" parameters")
+			}
+			for _, f := range fields {
+				if f.IsNullable() {
+					return nil, errInvalid(fieldToks[f.Name()], "field %q cannot be nullable: not supported with stringpairs representation", f.Name())
+				}
+			}
+			repr = schema.SpawnStructRepresentationStringPairs(innerDelim.text, entryDelim.text)
+		default:
+			return nil, errInvalid(strategy, "struct representation %q is not supported", strategy.text)
+		}
+	}
+	return schema.SpawnStruct(name, fields, repr), nil
+}
+
+// parseStructRepresentationMap parses the optional block following "representation map",
+// which contains lines like `field foo alias "bar"` or `field foo implicit "baz"`.
+func (p *parser) parseStructRepresentationMap(name schema.TypeName, fieldToks map[string]token) (schema.StructRepresentation, error) {
+	if !p.peekIsPunct("{") {
+		return schema.SpawnStructRepresentationMap(nil), nil
+	}
+	p.next()
+	var renames map[string]string
+	var implicits map[string]schema.ImplicitValue
+	for !p.peekIsPunct("}") {
+		if err := p.expectWord("field"); err != nil {
+			return nil, err
+		}
+		fieldTok, err := p.expectName("field name")
+		if err != nil {
+			return nil, err
+		}
+		if _, exists := fieldToks[fieldTok.text]; !exists {
+			return nil, errInvalid(fieldTok, "struct %q has no field named %q", name, fieldTok.text)
+		}
+		for n := 0; ; n++ {
+			if p.peekIsWord("alias") {
+				p.next()
+				alias, err := p.expectString("alias string")
+				if err != nil {
+					return nil, err
+				}
+				if renames == nil {
+					renames = make(map[string]string)
+				}
+				renames[fieldTok.text] = alias.text
+			} else if p.peekIsWord("implicit") {
+				p.next()
+				iv, err := p.parseImplicitValue()
+				if err != nil {
+					return nil, err
+				}
+				if implicits == nil {
+					implicits = make(map[string]schema.ImplicitValue)
+				}
+				implicits[fieldTok.text] = iv
+			} else if n == 0 {
+				return nil, errUnexpected(p.peek(), "\"alias\" or \"implicit\"")
+			} else {
+				break
+			}
+		}
+	}
+	p.next()
+	return schema.SpawnStructRepresentationMapWithImplicits(renames, implicits), nil
+}
+
+func (p *parser) parseImplicitValue() (schema.ImplicitValue, error) {
+	tok := p.next()
+	switch {
+	case tok.kind == token_String:
+		return schema.SpawnImplicitValueString(tok.text), nil
+	case tok.kind == token_Int:
+		i, err := strconv.ParseInt(tok.text, 10, 64)
+		if err != nil {
+			return nil, errInvalid(tok, "implicit value %s is out of range", tok.text)
+		}
+		return schema.SpawnImplicitValueInt(i), nil
+	case tok.kind == token_Punct && tok.text == "[":
+		return schema.ImplicitValue_EmptyList{}, p.expectPunct("]")
+	case tok.kind == token_Punct && tok.text == "{":
+		return schema.ImplicitValue_EmptyMap{}, p.expectPunct("}")
+	default:
+		return nil, errUnexpected(tok, "implicit value (a string, an integer, \"[]\", or \"{}\")")
+	}
+}
+
+// parseParams parses a block of representation parameters like `{ join ":" }`.
+// Each parameter has a string value; only the named parameters are allowed.
+func (p *parser) parseParams(allowed ...string) (map[string]token, error) {
+	if err := p.expectPunct("{"); err != nil {
+		return nil, err
+	}
+	params := make(map[string]token, len(allowed))
+	for !p.peekIsPunct("}") {
+		paramTok, err := p.expectName("parameter name or \"}\"")
+		if err != nil {
+			return nil, err
+		}
+		known := false
+		for _, a := range allowed {
+			known = known || a == paramTok.text
+		}
+		if !known {
+			return nil, errInvalid(paramTok, "unknown representation parameter %q", paramTok.text)
+		}
+		if _, exists := params[paramTok.text]; exists {
+			return nil, errInvalid(paramTok, "representation parameter %q is given more than once", paramTok.text)
+		}
+		value, err := p.expectString("string")
+		if err != nil {
+			return nil, err
+		}
+		params[paramTok.text] = value
+	}
+	p.next()
+	return params, nil
+}
+
+func (p *parser) parseUnion(name schema.TypeName) (schema.Type, error) {
+	if err := p.expectPunct("{"); err != nil {
+		return nil, err
+	}
+	type member struct {
+		name schema.TypeName
+		at   token
+		disc token
+	}
+	var members []member
+	for !p.peekIsPunct("}") {
+		if err := p.expectPunct("|"); err != nil {
+			return nil, err
+		}
+		at := p.peek()
+		mn, err := p.parseTypeExpr()
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range members {
+			if m.name == mn {
+				return nil, errInvalid(at, "type %q appears more than once in union %q", mn, name)
+			}
+		}
+		disc := p.next()
+		if disc.kind != token_String && disc.kind != token_Word {
+			return nil, errUnexpected(disc, "union discriminant")
+		}
+		members = append(members, member{mn, at, disc})
+	}
+	closeTok := p.next()
+	if !p.peekIsWord("representation") {
+		return nil, errInvalid(closeTok, "union %q must declare a representation strategy", name)
+	}
+	p.next()
+	strategy, err := p.expectName("representation strategy")
+	if err != nil {
+		return nil, err
+	}
+
+	memberNames := make([]schema.TypeName, len(members))
+	for i, m := range members {
+		memberNames[i] = m.name
+	}
+	// All of the strategies but kinded have a table keyed by strings; build that up front.
+	var table map[string]schema.TypeName
+	if strategy.text != "kinded" {
+		table = make(map[string]schema.TypeName, len(members))
+		for _, m := range members {
+			if m.disc.kind != token_String {
+				return nil, errUnexpected(m.disc, fmt.Sprintf("string discriminant (for %s union representation)", strategy.text))
+			}
+			if _, exists := table[m.disc.text]; exists {
+				return nil, errInvalid(m.disc, "discriminant %q is used more than once in union %q", m.disc.text, name)
+			}
+			table[m.disc.text] = m.name
+		}
+	}
+	var repr schema.UnionRepresentation
+	switch strategy.text {
+	case "keyed":
+		repr = schema.SpawnUnionRepresentationKeyed(table)
+	case "kinded":
+		kindTable := make(map[ipld.ReprKind]schema.TypeName, len(members))
+		for _, m := range members {
+			k, ok := kindKeywords[m.disc.text]
+			if m.disc.kind != token_Word || !ok {
+				return nil, errUnexpected(m.disc, "representation kind (for kinded union representation)")
+			}
+			if _, exists := kindTable[k]; exists {
+				return nil, errInvalid(m.disc, "representation kind %q is used more than once in union %q", m.disc.text, name)
+			}
+			kindTable[k] = m.name
+			m := m
+			p.checks = append(p.checks, func() error {
+				if rb := p.ts.TypeByName(string(m.name)).RepresentationBehavior(); rb != k {
+					return errInvalid(m.at, "union %q member %q does not have a %s representation", name, m.name, k)
+				}
+				return nil
+			})
+		}
+		repr = schema.SpawnUnionRepresentationKinded(kindTable)
+	case "envelope":
+		params, err := p.parseParams("discriminantKey", "contentKey")
+		if err != nil {
+			return nil, err
+		}
+		discriminantKey, ok1 := params["discriminantKey"]
+		contentKey, ok2 := params["contentKey"]
+		if !ok1 || !ok2 {
+			return nil, errInvalid(strategy, "envelope representation requires both \"discriminantKey\" and \"contentKey\" parameters")
+		}
+		repr = schema.SpawnUnionRepresentationEnvelope(discriminantKey.text, contentKey.text, table)
+	case "inline":
+		params, err := p.parseParams("discriminantKey")
+		if err != nil {
+			return nil, err
+		}
+		discriminantKey, ok := params["discriminantKey"]
+		if !ok {
+			return nil, errInvalid(strategy, "inline representation requires a \"discriminantKey\" parameter")
+		}
+		for _, m := range members {
+			m := m
+			p.checks = append(p.checks, func() error {
+				mt, ok := p.ts.TypeByName(string(m.name)).(*schema.TypeStruct)
+				if !ok {
+					return errInvalid(m.at, "union %q member %q must be a struct (for inline union representation)", name, m.name)
+				}
+				if _, ok := mt.RepresentationStrategy().(schema.StructRepresentation_Map); !ok {
+					return errInvalid(m.at, "union %q member %q must have a map representation (for inline union representation)", name, m.name)
+				}
+				return nil
+			})
+		}
+		repr = schema.SpawnUnionRepresentationInline(discriminantKey.text, table)
+	default:
+		return nil, errInvalid(strategy, "union representation %q is not supported", strategy.text)
+	}
+	return schema.SpawnUnion(name, memberNames, repr), nil
+}
+
+func (p *parser) parseEnum(name schema.TypeName) (schema.Type, error) {
+	if err := p.expectPunct("{"); err != nil {
+		return nil, err
+	}
+	var members []string
+	seen := map[string]struct{}{}
+	for !p.peekIsPunct("}") {
+		if err := p.expectPunct("|"); err != nil {
+			return nil, err
+		}
+		mtok, err := p.expectName("enum member")
+		if err != nil {
+			return nil, err
+		}
+		if _, exists := seen[mtok.text]; exists {
+			return nil, errInvalid(mtok, "member %q appears more than once in enum %q", mtok.text, name)
+		}
+		seen[mtok.text] = struct{}{}
+		if p.peekIsPunct("(") {
+			return nil, errInvalid(p.peek(), "custom representation values for enum members are not supported")
+		}
+		members = append(members, mtok.text)
+	}
+	p.next()
+	if p.peekIsWord("representation") {
+		p.next()
+		strategy, err := p.expectName("representation strategy")
+		if err != nil {
+			return nil, err
+		}
+		if strategy.text != "string" {
+			return nil, errInvalid(strategy, "enum representation %q is not supported", strategy.text)
+		}
+	}
+	return schema.SpawnEnum(name, members), nil
+}
+
+// resolve checks that every type referenced has been defined,
+// adding any prelude types that are used but were not explicitly declared,
+// and then runs any checks that needed the complete TypeSystem.
+func (p *parser) resolve() error {
+	for _, ref := range p.refs {
+		if _, exists := p.defined[ref.name]; exists {
+			continue
+		}
+		typ := schema.SpawnPreludeType(ref.name, ref.name)
+		if typ == nil {
+			return errInvalid(ref.at, "type %q is not defined", ref.name)
+		}
+		p.ts.Accumulate(typ)
+		p.defined[ref.name] = definition{ref.at, false}
+	}
+	for _, check := range p.checks {
+		if err := check(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,248 @@
+package dsl
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/warpfork/go-wish"
+
+	ipld "github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+func mustParse(t *testing.T, src string) *schema.TypeSystem {
+	t.Helper()
+	ts, err := Parse(strings.NewReader(src))
+	Require(t, err, ShouldEqual, nil)
+	Require(t, ts.ValidateGraph(), ShouldEqual, []error(nil))
+	return ts
+}
+
+func TestParseScalars(t *testing.T) {
+	ts := mustParse(t, `
+		type B bool
+		type S string
+		type X bytes
+		type I int
+		type F float
+		type L link
+		type R &S
+	`)
+	Wish(t, ts.TypeByName("B").Kind(), ShouldEqual, schema.Kind_Bool)
+	Wish(t, ts.TypeByName("S").Kind(), ShouldEqual, schema.Kind_String)
+	Wish(t, ts.TypeByName("X").Kind(), ShouldEqual, schema.Kind_Bytes)
+	Wish(t, ts.TypeByName("I").Kind(), ShouldEqual, schema.Kind_Int)
+	Wish(t, ts.TypeByName("F").Kind(), ShouldEqual, schema.Kind_Float)
+	Wish(t, ts.TypeByName("L").(*schema.TypeLink).HasReferencedType(), ShouldEqual, false)
+	Wish(t, ts.TypeByName("R").(*schema.TypeLink).HasReferencedType(), ShouldEqual, true)
+	Wish(t, ts.TypeByName("R").(*schema.TypeLink).ReferencedType().Name(), ShouldEqual, schema.TypeName("S"))
+	Wish(t, len(ts.GetTypes()), ShouldEqual, 7)
+}
+
+func TestParseRecursives(t *testing.T) {
+	ts := mustParse(t, `
+		type Strs [String]
+		type MaybeStrs [nullable String]
+		type Dict {String:Int}
+		type Nest {String:nullable [&Dict]}
+	`)
+	Wish(t, ts.TypeByName("Strs").(*schema.TypeList).ValueType().Name(), ShouldEqual, schema.TypeName("String"))
+	Wish(t, ts.TypeByName("Strs").(*schema.TypeList).ValueIsNullable(), ShouldEqual, false)
+	Wish(t, ts.TypeByName("MaybeStrs").(*schema.TypeList).ValueIsNullable(), ShouldEqual, true)
+	Wish(t, ts.TypeByName("Dict").(*schema.TypeMap).KeyType().Name(), ShouldEqual, schema.TypeName("String"))
+	Wish(t, ts.TypeByName("Dict").(*schema.TypeMap).ValueType().Name(), ShouldEqual, schema.TypeName("Int"))
+	t.Run("anonymous types are named and accumulated", func(t *testing.T) {
+		nest := ts.TypeByName("Nest").(*schema.TypeMap)
+		Wish(t, nest.ValueIsNullable(), ShouldEqual, true)
+		Wish(t, nest.ValueType().Name(), ShouldEqual, schema.TypeName("List__Link__Dict"))
+		Wish(t, nest.ValueType().(*schema.TypeList).ValueType().Name(), ShouldEqual, schema.TypeName("Link__Dict"))
+		Wish(t, nest.ValueType().(*schema.TypeList).ValueType().(*schema.TypeLink).ReferencedType().Name(), ShouldEqual, schema.TypeName("Dict"))
+	})
+	t.Run("prelude types are added when used", func(t *testing.T) {
+		Wish(t, ts.TypeByName("String").Kind(), ShouldEqual, schema.Kind_String)
+		Wish(t, ts.TypeByName("Int").Kind(), ShouldEqual, schema.Kind_Int)
+		Wish(t, ts.TypeByName("Bool"), ShouldEqual, nil)
+	})
+}
+
+func TestParseStructs(t *testing.T) {
+	t.Run("map representation", func(t *testing.T) {
+		ts := mustParse(t, `
+			# a struct with all the trimmings.
+			type Foo struct {
+				a String
+				b optional Int  // comments can go here, too.
+				c nullable [String]
+				d optional nullable {String:Foo}
+			} representation map {
+				field a alias "A"
+				field b implicit 7
+				field c alias "C" implicit []
+			}
+		`)
+		typ := ts.TypeByName("Foo").(*schema.TypeStruct)
+		fields := typ.Fields()
+		Require(t, len(fields), ShouldEqual, 4)
+		Wish(t, fields[0].Name(), ShouldEqual, "a")
+		Wish(t, fields[0].Type().Name(), ShouldEqual, schema.TypeName("String"))
+		Wish(t, fields[0].IsMaybe(), ShouldEqual, false)
+		Wish(t, fields[1].IsOptional(), ShouldEqual, true)
+		Wish(t, fields[1].IsNullable(), ShouldEqual, false)
+		Wish(t, fields[2].IsOptional(), ShouldEqual, false)
+		Wish(t, fields[2].IsNullable(), ShouldEqual, true)
+		Wish(t, fields[2].Type().Name(), ShouldEqual, schema.TypeName("List__String"))
+		Wish(t, fields[3].IsOptional(), ShouldEqual, true)
+		Wish(t, fields[3].IsNullable(), ShouldEqual, true)
+		Wish(t, fields[3].Type().Name(), ShouldEqual, schema.TypeName("Map__String__Foo"))
+		repr := typ.RepresentationStrategy().(schema.StructRepresentation_Map)
+		Wish(t, repr.GetFieldKey(fields[0]), ShouldEqual, "A")
+		Wish(t, repr.GetFieldKey(fields[1]), ShouldEqual, "b")
+		Wish(t, repr.GetFieldKey(fields[2]), ShouldEqual, "C")
+		Wish(t, repr.GetImplicit(fields[0]), ShouldEqual, nil)
+		Wish(t, repr.GetImplicit(fields[1]), ShouldEqual, schema.SpawnImplicitValueInt(7))
+		Wish(t, repr.GetImplicit(fields[2]), ShouldEqual, schema.ImplicitValue_EmptyList{})
+	})
+	t.Run("default representation is map", func(t *testing.T) {
+		ts := mustParse(t, `type Foo struct { a String }`)
+		_, ok := ts.TypeByName("Foo").(*schema.TypeStruct).RepresentationStrategy().(schema.StructRepresentation_Map)
+		Wish(t, ok, ShouldEqual, true)
+	})
+	t.Run("tuple representation", func(t *testing.T) {
+		ts := mustParse(t, `type Foo struct { a String  b Int } representation tuple`)
+		_, ok := ts.TypeByName("Foo").(*schema.TypeStruct).RepresentationStrategy().(schema.StructRepresentation_Tuple)
+		Wish(t, ok, ShouldEqual, true)
+	})
+	t.Run("stringjoin representation", func(t *testing.T) {
+		ts := mustParse(t, `type Foo struct { a String  b String } representation stringjoin { join ":" }`)
+		repr := ts.TypeByName("Foo").(*schema.TypeStruct).RepresentationStrategy().(schema.StructRepresentation_Stringjoin)
+		Wish(t, repr.GetDelim(), ShouldEqual, ":")
+	})
+	t.Run("stringpairs representation", func(t *testing.T) {
+		ts := mustParse(t, `type Foo struct { a String  b String } representation stringpairs { innerDelim "=" entryDelim "," }`)
+		repr := ts.TypeByName("Foo").(*schema.TypeStruct).RepresentationStrategy().(schema.StructRepresentation_StringPairs)
+		Wish(t, repr.GetInnerDelim(), ShouldEqual, "=")
+		Wish(t, repr.GetEntryDelim(), ShouldEqual, ",")
+	})
+}
+
+func TestParseUnions(t *testing.T) {
+	const members = `
+		type Foo struct { a String }
+		type Bar struct { b String }
+	`
+	t.Run("keyed", func(t *testing.T) {
+		ts := mustParse(t, members+`
+			type U union {
+				| Foo "foo"
+				| Bar "bar"
+			} representation keyed
+		`)
+		typ := ts.TypeByName("U").(*schema.TypeUnion)
+		Wish(t, len(typ.Members()), ShouldEqual, 2)
+		repr := typ.RepresentationStrategy().(schema.UnionRepresentation_Keyed)
+		Wish(t, repr.GetDiscriminant(ts.TypeByName("Foo")), ShouldEqual, "foo")
+		Wish(t, repr.GetDiscriminant(ts.TypeByName("Bar")), ShouldEqual, "bar")
+	})
+	t.Run("kinded", func(t *testing.T) {
+		ts := mustParse(t, members+`
+			type U union {
+				| Foo map
+				| String string
+				| &Bar link
+			} representation kinded
+		`)
+		repr := ts.TypeByName("U").(*schema.TypeUnion).RepresentationStrategy().(schema.UnionRepresentation_Kinded)
+		Wish(t, repr.GetMember(ipld.ReprKind_Map), ShouldEqual, schema.TypeName("Foo"))
+		Wish(t, repr.GetMember(ipld.ReprKind_String), ShouldEqual, schema.TypeName("String"))
+		Wish(t, repr.GetMember(ipld.ReprKind_Link), ShouldEqual, schema.TypeName("Link__Bar"))
+	})
+	t.Run("envelope", func(t *testing.T) {
+		ts := mustParse(t, members+`
+			type U union {
+				| Foo "foo"
+				| Bar "bar"
+			} representation envelope {
+				discriminantKey "tag"
+				contentKey "content"
+			}
+		`)
+		repr := ts.TypeByName("U").(*schema.TypeUnion).RepresentationStrategy().(schema.UnionRepresentation_Envelope)
+		Wish(t, repr.GetDiscriminantKey(), ShouldEqual, "tag")
+		Wish(t, repr.GetContentKey(), ShouldEqual, "content")
+		Wish(t, repr.GetDiscriminant(ts.TypeByName("Bar")), ShouldEqual, "bar")
+	})
+	t.Run("inline", func(t *testing.T) {
+		ts := mustParse(t, members+`
+			type U union {
+				| Foo "foo"
+				| Bar "bar"
+			} representation inline {
+				discriminantKey "tag"
+			}
+		`)
+		repr := ts.TypeByName("U").(*schema.TypeUnion).RepresentationStrategy().(schema.UnionRepresentation_Inline)
+		Wish(t, repr.GetDiscriminantKey(), ShouldEqual, "tag")
+		Wish(t, repr.GetDiscriminant(ts.TypeByName("Foo")), ShouldEqual, "foo")
+	})
+}
+
+func TestParseEnums(t *testing.T) {
+	ts := mustParse(t, `
+		type Color enum {
+			| Red
+			| Green
+			| Blue
+		} representation string
+	`)
+	Wish(t, ts.TypeByName("Color").(*schema.TypeEnum).Members(), ShouldEqual, []string{"Red", "Green", "Blue"})
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, tcase := range []struct {
+		name   string
+		src    string
+		expect error
+	}{
+		{"missing type keyword", `Foo string`,
+			ErrSyntax{1, 1, `expected "type", got "Foo"`}},
+		{"unknown kind", "type Foo\n  strang",
+			ErrSyntax{2, 3, `expected a type definition, got "strang"`}},
+		{"unterminated string", "type Foo struct {\n\ta String\n} representation stringjoin { join \":}",
+			ErrSyntax{3, 36, `unterminated string`}},
+		{"unexpected character", "type Foo [String]\ntype Bar = Foo",
+			ErrSyntax{2, 10, `unexpected character '='`}},
+		{"unclosed struct", "type Foo struct {\n\ta String\n",
+			ErrSyntax{3, 1, `expected field name or "}", got end of input`}},
+		{"undefined type", "type Foo struct {\n\ta String\n\tb Bar\n}",
+			ErrInvalidSchema{3, 4, `type "Bar" is not defined`}},
+		{"duplicate type", "type Foo string\ntype Foo int",
+			ErrInvalidSchema{2, 6, `type "Foo" is declared more than once (previously at line 1, column 6)`}},
+		{"duplicate field", "type Foo struct {\n\ta String\n\ta Int\n}",
+			ErrInvalidSchema{3, 2, `field "a" is declared more than once in struct "Foo"`}},
+		{"invalid map key", "type Foo {Int:String}",
+			ErrInvalidSchema{1, 11, `map key type "Int" is not valid: map keys must be a type with a string representation`}},
+		{"union without representation", "type Foo union {\n\t| String \"s\"\n}",
+			ErrInvalidSchema{3, 1, `union "Foo" must declare a representation strategy`}},
+		{"kinded union member with wrong kind", "type Foo union {\n\t| String map\n} representation kinded",
+			ErrInvalidSchema{2, 4, `union "Foo" member "String" does not have a map representation`}},
+		{"kinded union with repeated kind", "type Foo union {\n\t| String string\n\t| Bar string\n} representation kinded\ntype Bar string",
+			ErrInvalidSchema{3, 8, `representation kind "string" is used more than once in union "Foo"`}},
+		{"keyed union with kind discriminant", "type Foo union {\n\t| String string\n} representation keyed",
+			ErrSyntax{2, 11, `expected string discriminant (for keyed union representation), got "string"`}},
+		{"inline union with non-struct member", "type Foo union {\n\t| String \"s\"\n} representation inline { discriminantKey \"k\" }",
+			ErrInvalidSchema{2, 4, `union "Foo" member "String" must be a struct (for inline union representation)`}},
+		{"stringjoin with optional field", "type Foo struct {\n\ta optional String\n} representation stringjoin { join \":\" }",
+			ErrInvalidSchema{2, 2, `field "a" cannot be optional or nullable: not supported with stringjoin representation`}},
+		{"stringpairs with nullable field", "type Foo struct {\n\ta String\n\tb nullable String\n} representation stringpairs { innerDelim \"=\" entryDelim \",\" }",
+			ErrInvalidSchema{3, 2, `field "b" cannot be nullable: not supported with stringpairs representation`}},
+		{"rename of missing field", "type Foo struct {\n\ta String\n} representation map {\n\tfield b alias \"B\"\n}",
+			ErrInvalidSchema{4, 8, `struct "Foo" has no field named "b"`}},
+		{"anonymous name collision", "type Foo struct { a [String] }\ntype List__String string",
+			ErrInvalidSchema{2, 6, `type name "List__String" collides with the name of an anonymous type`}},
+	} {
+		t.Run(tcase.name, func(t *testing.T) {
+			_, err := ParseBytes([]byte(tcase.src))
+			Wish(t, err, ShouldEqual, tcase.expect)
+		})
+	}
+}
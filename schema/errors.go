@@ -60,3 +60,37 @@ type ErrNotUnionStructure struct {
 func (e ErrNotUnionStructure) Error() string {
 	return fmt.Sprintf("cannot match schema: union structure constraints for %s caused rejection: %s", e.TypeName, e.Detail)
 }
+
+// ErrNotEnumMember is returned when assigning a value to an enum type
+// if the value isn't one of the enum's members.
+//
+// TypeName is currently a string... see comments at the top of this file for
+// remarks on the issues we need to address about these identifiers in errors in general.
+type ErrNotEnumMember struct {
+	TypeName string
+
+	Value string
+}
+
+func (e ErrNotEnumMember) Error() string {
+	return fmt.Sprintf("cannot match schema: %q is not a member of enum %s", e.Value, e.TypeName)
+}
+
+// ErrInvalidData is returned by Validate, once for each problem found
+// where the data doesn't match the type it was supposed to:
+// for example, a missing required field, an unexpected map key,
+// data of the wrong kind, or an unknown union discriminant.
+//
+// Path locates the problematic node in the data; TypeName is the type
+// that node was expected to match (for problems with struct fields, that's
+// the struct type, and the Detail names the field).
+type ErrInvalidData struct {
+	Path     ipld.Path
+	TypeName TypeName
+
+	Detail string
+}
+
+func (e ErrInvalidData) Error() string {
+	return fmt.Sprintf("invalid data for type %s at %q: %s", e.TypeName, e.Path, e.Detail)
+}
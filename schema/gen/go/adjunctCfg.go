@@ -3,6 +3,7 @@ package gengo
 import (
 	"fmt"
 	"strings"
+	"unicode"
 
 	"github.com/ipld/go-ipld-prime/schema"
 )
@@ -18,10 +19,16 @@ type FieldTuple struct {
 	FieldName string
 }
 
+type EnumMemberTuple struct {
+	TypeName schema.TypeName
+	Member   string
+}
+
 type AdjunctCfg struct {
 	typeSymbolOverrides       map[schema.TypeName]string
 	FieldSymbolLowerOverrides map[FieldTuple]string
 	fieldSymbolUpperOverrides map[FieldTuple]string
+	EnumMemberSymbolOverrides map[EnumMemberTuple]string
 	maybeUsesPtr              map[schema.TypeName]bool   // treat absent as true
 	CfgUnionMemlayout         map[schema.TypeName]string // "embedAll"|"interface"; maybe more options later, unclear for now.
 
@@ -78,6 +85,26 @@ func (cfg *AdjunctCfg) MaybeUsesPtr(t schema.Type) bool {
 	return true
 }
 
+// EnumMemberSymbol returns the symbol for the constant naming an enum member;
+// by default, it's the type symbol and the member joined by an underscore
+// (e.g. "Color_Red"), with any characters that can't appear in
+// a Go identifier replaced by underscores,
+// but it can be overriden.
+//
+// Members which differ only in those replaced characters (e.g. "a-b" and "a_b")
+// get the same default symbol; one of them has to be given an override.
+func (cfg *AdjunctCfg) EnumMemberSymbol(t schema.Type, member string) string {
+	if x, ok := cfg.EnumMemberSymbolOverrides[EnumMemberTuple{t.Name(), member}]; ok {
+		return x
+	}
+	return cfg.TypeSymbol(t) + "_" + strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' {
+			return r
+		}
+		return '_'
+	}, member)
+}
+
 // UnionMemlayout returns a plain string at present;
 // there's a case-switch in the templates that processes it.
 // We validate that it's a known string when this method is called.
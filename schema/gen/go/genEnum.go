@@ -0,0 +1,175 @@
+package gengo
+
+import (
+	"io"
+
+	"github.com/ipld/go-ipld-prime/schema"
+	"github.com/ipld/go-ipld-prime/schema/gen/go/mixins"
+)
+
+// Enums are laid out in memory exactly like strings: the member is the string.
+// The differences are all in the checking: any path that produces a value
+// of an enum type goes through 'fromString', which rejects non-members.
+
+type enumGenerator struct {
+	AdjCfg *AdjunctCfg
+	mixins.StringTraits
+	PkgName string
+	Type    *schema.TypeEnum
+}
+
+func (enumGenerator) IsRepr() bool { return false } // hint used in some generalized templates.
+
+// --- native content and specializations --->
+
+func (g enumGenerator) EmitNativeType(w io.Writer) {
+	emitNativeType_scalar(w, g.AdjCfg, g)
+	// Constants for each member are plain strings,
+	//  so they can be used in switch statements over the result of the String method.
+	doTemplate(`
+		const (
+			{{- range $member := .Type.Members }}
+			{{ EnumMemberSymbol (dot).Type $member }} = {{ printf "%q" $member }}
+			{{- end}}
+		)
+	`, w, g.AdjCfg, g)
+}
+func (g enumGenerator) EmitNativeAccessors(w io.Writer) {
+	emitNativeAccessors_scalar(w, g.AdjCfg, g)
+}
+func (g enumGenerator) EmitNativeBuilder(w io.Writer) {
+	// The internal single-step construction function is where membership is checked.
+	//  Everything else -- the exported constructor, the assemblers, map keys, stringjoin struct fields -- goes through it.
+	doTemplate(`
+		func (_{{ .Type | TypeSymbol }}__Prototype) fromString(w *_{{ .Type | TypeSymbol }}, v string) error {
+			switch v {
+			{{- if .Type.Members }}
+			case {{ range $i, $member := .Type.Members }}{{ if $i }}, {{ end }}{{ EnumMemberSymbol (dot).Type $member }}{{ end }}:
+				*w = _{{ .Type | TypeSymbol }}{v}
+				return nil
+			{{- end}}
+			default:
+				return schema.ErrNotEnumMember{TypeName: "{{ .PkgName }}.{{ .Type.Name }}", Value: v}
+			}
+		}
+		func (_{{ .Type | TypeSymbol }}__Prototype) FromString(v string) ({{ .Type | TypeSymbol }}, error) {
+			var n _{{ .Type | TypeSymbol }}
+			if err := (_{{ .Type | TypeSymbol }}__Prototype{}).fromString(&n, v); err != nil {
+				return nil, err
+			}
+			return &n, nil
+		}
+	`, w, g.AdjCfg, g)
+}
+
+func (g enumGenerator) EmitNativeMaybe(w io.Writer) {
+	emitNativeMaybe(w, g.AdjCfg, g)
+}
+
+// --- type info --->
+
+func (g enumGenerator) EmitTypeConst(w io.Writer) {
+	doTemplate(`
+		// TODO EmitTypeConst
+	`, w, g.AdjCfg, g)
+}
+
+// --- TypedNode interface satisfaction --->
+
+func (g enumGenerator) EmitTypedNodeMethodType(w io.Writer) {
+	doTemplate(`
+		func ({{ .Type | TypeSymbol }}) Type() schema.Type {
+			return nil /*TODO:typelit*/
+		}
+	`, w, g.AdjCfg, g)
+}
+
+func (g enumGenerator) EmitTypedNodeMethodRepresentation(w io.Writer) {
+	emitTypicalTypedNodeMethodRepresentation(w, g.AdjCfg, g)
+}
+
+// --- Node interface satisfaction --->
+
+func (g enumGenerator) EmitNodeType(w io.Writer) {
+	// No additional types needed.  Methods all attach to the native type.
+}
+
+func (g enumGenerator) EmitNodeTypeAssertions(w io.Writer) {
+	emitNodeTypeAssertions_typical(w, g.AdjCfg, g)
+}
+func (g enumGenerator) EmitNodeMethodAsString(w io.Writer) {
+	emitNodeMethodAsKind_scalar(w, g.AdjCfg, g)
+}
+func (g enumGenerator) EmitNodeMethodPrototype(w io.Writer) {
+	emitNodeMethodPrototype_typical(w, g.AdjCfg, g)
+}
+func (g enumGenerator) EmitNodePrototypeType(w io.Writer) {
+	emitNodePrototypeType_typical(w, g.AdjCfg, g)
+}
+
+// --- NodeBuilder and NodeAssembler --->
+
+func (g enumGenerator) GetNodeBuilderGenerator() NodeBuilderGenerator {
+	return enumBuilderGenerator{
+		g.AdjCfg,
+		mixins.StringAssemblerTraits{
+			g.PkgName,
+			g.TypeName,
+			"_" + g.AdjCfg.TypeSymbol(g.Type) + "__",
+		},
+		g.PkgName,
+		g.Type,
+	}
+}
+
+type enumBuilderGenerator struct {
+	AdjCfg *AdjunctCfg
+	mixins.StringAssemblerTraits
+	PkgName string
+	Type    *schema.TypeEnum
+}
+
+func (enumBuilderGenerator) IsRepr() bool { return false } // hint used in some generalized templates.
+
+func (g enumBuilderGenerator) EmitNodeBuilderType(w io.Writer) {
+	emitEmitNodeBuilderType_typical(w, g.AdjCfg, g)
+}
+func (g enumBuilderGenerator) EmitNodeBuilderMethods(w io.Writer) {
+	emitNodeBuilderMethods_typical(w, g.AdjCfg, g)
+}
+func (g enumBuilderGenerator) EmitNodeAssemblerType(w io.Writer) {
+	emitNodeAssemblerType_scalar(w, g.AdjCfg, g)
+}
+func (g enumBuilderGenerator) EmitNodeAssemblerMethodAssignNull(w io.Writer) {
+	emitNodeAssemblerMethodAssignNull_scalar(w, g.AdjCfg, g)
+}
+func (g enumBuilderGenerator) EmitNodeAssemblerMethodAssignString(w io.Writer) {
+	// Like the typical scalar AssignString, except the value is checked for membership;
+	//  if it's rejected, the assembler is left unfinished.
+	doTemplate(`
+		func (na *_{{ .Type | TypeSymbol }}__Assembler) AssignString(v string) error {
+			switch *na.m {
+			case schema.Maybe_Value, schema.Maybe_Null:
+				panic("invalid state: cannot assign into assembler that's already finished")
+			}
+			{{- if .Type | MaybeUsesPtr }}
+			if na.w == nil {
+				na.w = &_{{ .Type | TypeSymbol }}{}
+			}
+			{{- end}}
+			if err := (_{{ .Type | TypeSymbol }}__Prototype{}).fromString(na.w, v); err != nil {
+				return err
+			}
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+	`, w, g.AdjCfg, g)
+}
+func (g enumBuilderGenerator) EmitNodeAssemblerMethodAssignNode(w io.Writer) {
+	// The typical scalar AssignNode is fine: values of our own type are already known members,
+	//  and anything else goes through AssignString.
+	emitNodeAssemblerMethodAssignNode_scalar(w, g.AdjCfg, g)
+}
+func (g enumBuilderGenerator) EmitNodeAssemblerOtherBits(w io.Writer) {
+	// Nothing needed here for enum kinds.
+}
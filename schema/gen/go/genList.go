@@ -100,7 +100,7 @@ func (g listGenerator) EmitNodeMethodLookupByNode(w io.Writer) {
 			if err != nil {
 				return nil, err
 			}
-			return n.LookupByIndex(idx)
+			return n.LookupByIndex(int(idx))
 		}
 	`, w, g.AdjCfg, g)
 }
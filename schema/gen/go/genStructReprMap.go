@@ -10,7 +10,13 @@ import (
 
 var _ TypeGenerator = &structReprMapGenerator{}
 
+// NewStructReprMapGenerator returns a generator for a struct with the map representation.
+// Renames and implicits in the representation are supported;
+// it panics if an implicit is given for a field it can't be supported on (see structReprImplicit).
 func NewStructReprMapGenerator(pkgName string, typ *schema.TypeStruct, adjCfg *AdjunctCfg) TypeGenerator {
+	for _, field := range typ.Fields() {
+		structReprImplicit(field)
+	}
 	return structReprMapGenerator{
 		structGenerator{
 			adjCfg,
@@ -51,6 +57,18 @@ type structReprMapReprGenerator struct {
 
 func (structReprMapReprGenerator) IsRepr() bool { return true } // hint used in some generalized templates.
 
+// AbsentCondition returns an expression which is true when the field is left out of the representation:
+// optional fields are left out when absent, and fields with implicits are left out when their value is the implicit.
+// The expression reaches the struct through 'recv'.
+// If the field is never left out, it returns an empty string.
+func (g structReprMapReprGenerator) AbsentCondition(recv string, field schema.StructField) string {
+	ref := recv + "." + g.AdjCfg.FieldSymbolLower(field)
+	if field.IsOptional() {
+		return ref + ".m == schema.Maybe_Absent"
+	}
+	return implicitCondition(ref, field)
+}
+
 func (g structReprMapReprGenerator) EmitNodeType(w io.Writer) {
 	// The type is structurally the same, but will have a different set of methods.
 	doTemplate(`
@@ -80,13 +98,14 @@ func (g structReprMapReprGenerator) EmitNodeTypeAssertions(w io.Writer) {
 
 func (g structReprMapReprGenerator) EmitNodeMethodLookupByString(w io.Writer) {
 	// Similar to the type-level method, except any absent fields also return ErrNotExists.
+	//  So do fields whose value is their implicit, since they're left out of the representation too.
 	doTemplate(`
 		func (n *_{{ .Type | TypeSymbol }}__Repr) LookupByString(key string) (ipld.Node, error) {
 			switch key {
 			{{- range $field := .Type.Fields }}
 			case "{{ $field | $field.Parent.RepresentationStrategy.GetFieldKey }}":
-				{{- if $field.IsOptional }}
-				if n.{{ $field | FieldSymbolLower }}.m == schema.Maybe_Absent {
+				{{- with (dot).AbsentCondition "n" $field }}
+				if {{ . }} {
 					return ipld.Absent, ipld.ErrNotExists{ipld.PathSegmentOfString(key)}
 				}
 				{{- end}}
@@ -126,14 +145,15 @@ func (g structReprMapReprGenerator) EmitNodeMethodMapIterator(w io.Writer) {
 	//  This makes things a bit trickier -- especially the 'Done' predicate,
 	//   since it may have to do lookahead if there's any optionals at the end of the structure!
 	//  It also means 'idx' can jump ahead by more than one per Next call in order to skip over absent fields.
-	// TODO : support for implicits is still future work.
+	// Fields whose value is their implicit are skipped in exactly the same way as absent optionals;
+	//  everything below that says "optional" means "optional, or has an implicit".
 
 	// First: Determine if there are any optionals at all.
 	//  If there are none, some control flow symbols need to not be emitted.
 	fields := g.Type.Fields()
 	haveOptionals := false
 	for _, field := range fields {
-		if field.IsOptional() {
+		if g.AbsentCondition("", field) != "" {
 			haveOptionals = true
 			break
 		}
@@ -144,7 +164,7 @@ func (g structReprMapReprGenerator) EmitNodeMethodMapIterator(w io.Writer) {
 	fieldCount := len(fields)
 	beginTrailingOptionalField := fieldCount
 	for i := fieldCount - 1; i >= 0; i-- {
-		if !fields[i].IsOptional() {
+		if g.AbsentCondition("", fields[i]) == "" {
 			break
 		}
 		beginTrailingOptionalField = i
@@ -159,7 +179,7 @@ func (g structReprMapReprGenerator) EmitNodeMethodMapIterator(w io.Writer) {
 		func() string { // this next part was too silly in templates due to lack of reverse ranging.
 			v := "\n"
 			for i := fieldCount - 1; i >= beginTrailingOptionalField; i-- {
-				v += "\t\t\tif n." + g.AdjCfg.FieldSymbolLower(fields[i]) + ".m == schema.Maybe_Absent {\n"
+				v += "\t\t\tif " + g.AbsentCondition("n", fields[i]) + " {\n"
 				v += "\t\t\t\tend = " + strconv.Itoa(i) + "\n"
 				v += "\t\t\t} else {\n"
 				v += "\t\t\t\tgoto done\n"
@@ -189,8 +209,8 @@ func (g structReprMapReprGenerator) EmitNodeMethodMapIterator(w io.Writer) {
 			{{- range $i, $field := .Type.Fields }}
 			case {{ $i }}:
 				k = &fieldName__{{ $type | TypeSymbol }}_{{ $field | FieldSymbolUpper }}_serial
-				{{- if $field.IsOptional }}
-				if itr.n.{{ $field | FieldSymbolLower }}.m == schema.Maybe_Absent {
+				{{- with $.Gen.AbsentCondition "itr.n" $field }}
+				if {{ . }} {
 					itr.idx++
 					goto advance
 				}
@@ -223,11 +243,13 @@ func (g structReprMapReprGenerator) EmitNodeMethodMapIterator(w io.Writer) {
 		}
 		{{- end}}
 	`, w, g.AdjCfg, struct {
+		Gen                        structReprMapReprGenerator
 		Type                       *schema.TypeStruct
 		HaveOptionals              bool
 		HaveTrailingOptionals      bool
 		BeginTrailingOptionalField int
 	}{
+		g,
 		g.Type,
 		haveOptionals,
 		haveTrailingOptionals,
@@ -236,14 +258,13 @@ func (g structReprMapReprGenerator) EmitNodeMethodMapIterator(w io.Writer) {
 }
 
 func (g structReprMapReprGenerator) EmitNodeMethodLength(w io.Writer) {
-	// This is fun: it has to count down for any unset optional fields.
-	// TODO : support for implicits is still future work.
+	// This is fun: it has to count down for any unset optional fields, and any fields whose value is their implicit.
 	doTemplate(`
 		func (rn *_{{ .Type | TypeSymbol }}__Repr) Length() int {
 			l := {{ len .Type.Fields }}
 			{{- range $field := .Type.Fields }}
-			{{- if $field.IsOptional }}
-			if rn.{{ $field | FieldSymbolLower }}.m == schema.Maybe_Absent {
+			{{- with (dot).AbsentCondition "rn" $field }}
+			if {{ . }} {
 				l--
 			}
 			{{- end}}
@@ -285,6 +306,13 @@ type structReprMapReprBuilderGenerator struct {
 
 func (structReprMapReprBuilderGenerator) IsRepr() bool { return true } // hint used in some generalized templates.
 
+// ImplicitAssignment returns the statements which assign the field's implicit value
+// using the field's child assembler (which must already be pointed at the field),
+// or an empty string if the field has no implicit.
+func (g structReprMapReprBuilderGenerator) ImplicitAssignment(field schema.StructField) string {
+	return implicitAssignment("ma.ca_"+g.AdjCfg.FieldSymbolLower(field), field)
+}
+
 func (g structReprMapReprBuilderGenerator) EmitNodeBuilderType(w io.Writer) {
 	emitEmitNodeBuilderType_typical(w, g.AdjCfg, g)
 }
@@ -543,6 +571,18 @@ func (g structReprMapReprBuilderGenerator) emitMapAssemblerMethods(w io.Writer)
 			case maState_finished:
 				panic("invalid state: Finish cannot be called on an assembler that's already finished")
 			}
+			{{- $type := .Type -}} {{- /* ranging modifies dot, unhelpfully */ -}}
+			{{- range $field := .Type.Fields }}
+			{{- with (dot).ImplicitAssignment $field }}
+			if ma.s & fieldBit__{{ $type | TypeSymbol }}_{{ $field | FieldSymbolUpper }} == 0 {
+				ma.ca_{{ $field | FieldSymbolLower }}.w = &ma.w.{{ $field | FieldSymbolLower }}
+				ma.ca_{{ $field | FieldSymbolLower }}.m = &ma.cm
+				{{ . }}
+				ma.cm = schema.Maybe_Absent
+				ma.s += fieldBit__{{ $type | TypeSymbol }}_{{ $field | FieldSymbolUpper }}
+			}
+			{{- end}}
+			{{- end}}
 			//FIXME check if all required fields are set
 			ma.state = maState_finished
 			*ma.m = schema.Maybe_Value
@@ -0,0 +1,296 @@
+package gengo
+
+import (
+	"io"
+
+	"github.com/ipld/go-ipld-prime/schema"
+	"github.com/ipld/go-ipld-prime/schema/gen/go/mixins"
+)
+
+var _ TypeGenerator = &structReprStringpairsGenerator{}
+
+// NewStructReprStringpairsGenerator returns a generator for a struct with the stringpairs representation,
+// in which the struct is a string of "key=value" entries joined by another delimiter
+// (the delimiters are configured by the representation, of course).
+// It panics if any field is nullable, since there's no way to express null in that string.
+func NewStructReprStringpairsGenerator(pkgName string, typ *schema.TypeStruct, adjCfg *AdjunctCfg) TypeGenerator {
+	for _, field := range typ.Fields() {
+		if field.IsNullable() {
+			panic("stringpairs representation can't support nullable fields (field " + field.Name() + " of " + string(typ.Name()) + ")")
+		}
+	}
+	return structReprStringpairsGenerator{
+		structGenerator{
+			adjCfg,
+			mixins.MapTraits{
+				pkgName,
+				string(typ.Name()),
+				adjCfg.TypeSymbol(typ),
+			},
+			pkgName,
+			typ,
+		},
+	}
+}
+
+type structReprStringpairsGenerator struct {
+	structGenerator
+}
+
+func (g structReprStringpairsGenerator) GetRepresentationNodeGen() NodeGenerator {
+	return structReprStringpairsReprGenerator{
+		g.AdjCfg,
+		mixins.StringTraits{
+			g.PkgName,
+			string(g.Type.Name()) + ".Repr",
+			"_" + g.AdjCfg.TypeSymbol(g.Type) + "__Repr",
+		},
+		g.PkgName,
+		g.Type,
+	}
+}
+
+type structReprStringpairsReprGenerator struct {
+	AdjCfg *AdjunctCfg
+	mixins.StringTraits
+	PkgName string
+	Type    *schema.TypeStruct
+}
+
+func (g structReprStringpairsReprGenerator) EmitNodeType(w io.Writer) {
+	// The type is structurally the same, but will have a different set of methods.
+	doTemplate(`
+		type _{{ .Type | TypeSymbol }}__Repr _{{ .Type | TypeSymbol }}
+	`, w, g.AdjCfg, g)
+}
+
+func (g structReprStringpairsReprGenerator) EmitNodeTypeAssertions(w io.Writer) {
+	doTemplate(`
+		var _ ipld.Node = &_{{ .Type | TypeSymbol }}__Repr{}
+	`, w, g.AdjCfg, g)
+}
+
+func (g structReprStringpairsReprGenerator) EmitNodeMethodAsString(w io.Writer) {
+	// Prerequisites:
+	//  - every field must be a string, or have string representation.
+	//    - this should've been checked when compiling the type system info.
+	//  - there are NO sanity checks that your value doesn't contain either delimiter
+	//    - you need to do this in validation hooks or some other way
+	//  - optional fields are supported: absent ones are simply left out.
+	//  - nullable fields are not supported, since there's no way to say null in a string.
+	//    - NewStructReprStringpairsGenerator rejects them.
+	//
+	// Entries are emitted in the order the fields are declared in.
+	//
+	// As with stringjoin, a String method is generated on both the representation and the type-level node.
+	doTemplate(`
+		func (n *_{{ .Type | TypeSymbol }}__Repr) AsString() (string, error) {
+			return n.String(), nil
+		}
+		func (n *_{{ .Type | TypeSymbol }}__Repr) String() string {
+			s := ""
+			{{- $type := .Type -}} {{- /* ranging modifies dot, unhelpfully */ -}}
+			{{- range $i, $field := .Type.Fields }}
+			{{- $ref := printf "&n.%s" ($field | FieldSymbolLower) }}
+			{{- if $field.IsOptional }}
+			{{- if $field.Type | MaybeUsesPtr }}{{ $ref = printf "n.%s.v" ($field | FieldSymbolLower) }}{{ else }}{{ $ref = printf "&n.%s.v" ($field | FieldSymbolLower) }}{{ end }}
+			if n.{{ $field | FieldSymbolLower }}.m == schema.Maybe_Value {
+			{{- else }}
+			{
+			{{- end }}
+				if s != "" {
+					s += "{{ $type.RepresentationStrategy.GetEntryDelim }}"
+				}
+				s += "{{ $field.Name }}{{ $type.RepresentationStrategy.GetInnerDelim }}" + (*_{{ $field.Type | TypeSymbol }}__Repr)({{ $ref }}).String()
+			}
+			{{- end}}
+			return s
+		}
+		func (n {{ .Type | TypeSymbol }}) String() string {
+			return (*_{{ .Type | TypeSymbol }}__Repr)(n).String()
+		}
+	`, w, g.AdjCfg, g)
+}
+
+func (g structReprStringpairsReprGenerator) EmitNodeMethodPrototype(w io.Writer) {
+	// REVIEW: this appears to be standard even across kinds; can we extract it?
+	doTemplate(`
+		func (_{{ .Type | TypeSymbol }}__Repr) Prototype() ipld.NodePrototype {
+			return _{{ .Type | TypeSymbol }}__ReprPrototype{}
+		}
+	`, w, g.AdjCfg, g)
+}
+
+func (g structReprStringpairsReprGenerator) EmitNodePrototypeType(w io.Writer) {
+	// REVIEW: this appears to be standard even across kinds; can we extract it?
+	doTemplate(`
+		type _{{ .Type | TypeSymbol }}__ReprPrototype struct{}
+
+		func (_{{ .Type | TypeSymbol }}__ReprPrototype) NewBuilder() ipld.NodeBuilder {
+			var nb _{{ .Type | TypeSymbol }}__ReprBuilder
+			nb.Reset()
+			return &nb
+		}
+	`, w, g.AdjCfg, g)
+}
+
+// --- NodeBuilder and NodeAssembler --->
+
+func (g structReprStringpairsReprGenerator) GetNodeBuilderGenerator() NodeBuilderGenerator {
+	return structReprStringpairsReprBuilderGenerator{
+		g.AdjCfg,
+		mixins.StringAssemblerTraits{
+			g.PkgName,
+			g.TypeName,
+			"_" + g.AdjCfg.TypeSymbol(g.Type) + "__Repr",
+		},
+		g.PkgName,
+		g.Type,
+	}
+}
+
+type structReprStringpairsReprBuilderGenerator struct {
+	AdjCfg *AdjunctCfg
+	mixins.StringAssemblerTraits
+	PkgName string
+	Type    *schema.TypeStruct
+}
+
+func (structReprStringpairsReprBuilderGenerator) IsRepr() bool { return true } // hint used in some generalized templates.
+
+func (g structReprStringpairsReprBuilderGenerator) EmitNodeBuilderType(w io.Writer) {
+	emitEmitNodeBuilderType_typical(w, g.AdjCfg, g)
+}
+func (g structReprStringpairsReprBuilderGenerator) EmitNodeBuilderMethods(w io.Writer) {
+	emitNodeBuilderMethods_typical(w, g.AdjCfg, g)
+
+	// The single-step construction function, just like the one for stringjoin (see comments there).
+	// Parsing errors are wrapped in ErrUnmatchable, as is anything our members reject;
+	//  the errors about keys (unknown, repeated, or missing) are the same ones a map representation would give.
+	doTemplate(`
+		func (_{{ .Type | TypeSymbol }}__ReprPrototype) fromString(w *_{{ .Type | TypeSymbol }}, v string) error {
+			ps, err := mixins.SplitPairs(v, "{{ .Type.RepresentationStrategy.GetInnerDelim }}", "{{ .Type.RepresentationStrategy.GetEntryDelim }}")
+			if err != nil {
+				return ipld.ErrUnmatchable{TypeName:"{{ .PkgName }}.{{ .Type.Name }}.Repr", Reason: err}
+			}
+			{{- $dot := . -}} {{- /* ranging modifies dot, unhelpfully */ -}}
+			{{- if .Type.Fields }}
+			var seen [{{ len .Type.Fields }}]bool
+			{{- end}}
+			for _, p := range ps {
+				switch p[0] {
+				{{- range $i, $field := .Type.Fields }}
+				{{- $ref := printf "&w.%s" ($field | FieldSymbolLower) }}
+				case "{{ $field.Name }}":
+					if seen[{{ $i }}] {
+						return ipld.ErrRepeatedMapKey{&fieldName__{{ $dot.Type | TypeSymbol }}_{{ $field | FieldSymbolUpper }}}
+					}
+					seen[{{ $i }}] = true
+					{{- if $field.IsOptional }}
+					{{- if $field.Type | MaybeUsesPtr }}
+					{{- $ref = printf "w.%s.v" ($field | FieldSymbolLower) }}
+					w.{{ $field | FieldSymbolLower }}.v = &_{{ $field.Type | TypeSymbol }}{}
+					{{- else }}
+					{{- $ref = printf "&w.%s.v" ($field | FieldSymbolLower) }}
+					{{- end}}
+					w.{{ $field | FieldSymbolLower }}.m = schema.Maybe_Value
+					{{- end}}
+					if err := (_{{ $field.Type | TypeSymbol }}__ReprPrototype{}).fromString({{ $ref }}, p[1]); err != nil {
+						return ipld.ErrUnmatchable{TypeName:"{{ $dot.PkgName }}.{{ $dot.Type.Name }}.Repr", Reason: err}
+					}
+				{{- end}}
+				default:
+					return ipld.ErrInvalidKey{TypeName:"{{ .PkgName }}.{{ .Type.Name }}.Repr", Key:&_String{p[0]}}
+				}
+			}
+			var missing []string
+			{{- range $i, $field := .Type.Fields }}
+			if !seen[{{ $i }}] {
+				{{- if $field.IsOptional }}
+				w.{{ $field | FieldSymbolLower }}.m = schema.Maybe_Absent
+				{{- else }}
+				missing = append(missing, "{{ $field.Name }}")
+				{{- end}}
+			}
+			{{- end}}
+			if missing != nil {
+				return ipld.ErrMissingRequiredField{TypeName:"{{ .PkgName }}.{{ .Type.Name }}.Repr", Missing: missing}
+			}
+			return nil
+		}
+	`, w, g.AdjCfg, g)
+}
+func (g structReprStringpairsReprBuilderGenerator) EmitNodeAssemblerType(w io.Writer) {
+	doTemplate(`
+		type _{{ .Type | TypeSymbol }}__ReprAssembler struct {
+			w *_{{ .Type | TypeSymbol }}
+			m *schema.Maybe
+		}
+
+		func (na *_{{ .Type | TypeSymbol }}__ReprAssembler) reset() {}
+	`, w, g.AdjCfg, g)
+}
+func (g structReprStringpairsReprBuilderGenerator) EmitNodeAssemblerMethodAssignNull(w io.Writer) {
+	emitNodeAssemblerMethodAssignNull_scalar(w, g.AdjCfg, g)
+}
+func (g structReprStringpairsReprBuilderGenerator) EmitNodeAssemblerMethodAssignString(w io.Writer) {
+	// This method contains a branch to support MaybeUsesPtr because new memory may need to be allocated.
+	//  This allocation only happens if the 'w' ptr is nil, which means we're being used on a Maybe;
+	//  otherwise, the 'w' ptr should already be set, and we fill that memory location without allocating, as usual.
+	doTemplate(`
+		func (na *_{{ .Type | TypeSymbol }}__ReprAssembler) AssignString(v string) error {
+			switch *na.m {
+			case schema.Maybe_Value, schema.Maybe_Null:
+				panic("invalid state: cannot assign into assembler that's already finished")
+			}
+			{{- if .Type | MaybeUsesPtr }}
+			if na.w == nil {
+				na.w = &_{{ .Type | TypeSymbol }}{}
+			}
+			{{- end}}
+			if err := (_{{ .Type | TypeSymbol }}__ReprPrototype{}).fromString(na.w, v); err != nil {
+				return err
+			}
+			*na.m = schema.Maybe_Value
+			return nil
+		}
+	`, w, g.AdjCfg, g)
+}
+
+func (g structReprStringpairsReprBuilderGenerator) EmitNodeAssemblerMethodAssignNode(w io.Writer) {
+	// AssignNode goes through three phases:
+	// 1. is it null?  Jump over to AssignNull (which may or may not reject it).
+	// 2. is it our own type?  Handle specially -- we might be able to do efficient things.
+	// 3. is it the right kind to morph into us?  Do so.
+	doTemplate(`
+		func (na *_{{ .Type | TypeSymbol }}__ReprAssembler) AssignNode(v ipld.Node) error {
+			if v.IsNull() {
+				return na.AssignNull()
+			}
+			if v2, ok := v.(*_{{ .Type | TypeSymbol }}); ok {
+				switch *na.m {
+				case schema.Maybe_Value, schema.Maybe_Null:
+					panic("invalid state: cannot assign into assembler that's already finished")
+				}
+				{{- if .Type | MaybeUsesPtr }}
+				if na.w == nil {
+					na.w = v2
+					*na.m = schema.Maybe_Value
+					return nil
+				}
+				{{- end}}
+				*na.w = *v2
+				*na.m = schema.Maybe_Value
+				return nil
+			}
+			if v2, err := v.AsString(); err != nil {
+				return err
+			} else {
+				return na.AssignString(v2)
+			}
+		}
+	`, w, g.AdjCfg, g)
+}
+func (g structReprStringpairsReprBuilderGenerator) EmitNodeAssemblerOtherBits(w io.Writer) {
+	// None for this.
+}
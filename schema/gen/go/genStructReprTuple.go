@@ -18,11 +18,26 @@ var _ TypeGenerator = &structReprTupleGenerator{}
 //   few people seem to want this;
 //   the implementation complexity would rise dramatically;
 //   and the next nearest substitutes for such behavior are already available, and cheap (and also sturdier).
-// It would make about as much sense to support implicits as it does trailing optionals,
-//  which means we probably should consider that someday,
-//   but it's not implemented today.
+// Implicits make about as much sense as trailing optionals, and follow the same rule:
+//  they're only allowed in the run of fields at the end which may be left out.
+//   When reading, a field holding its implicit value is left out if everything after it is left out too
+//    (a field in the middle can't be skipped; the ones after it would land in the wrong places).
+//   When assembling, fields with implicits that weren't reached are filled in with the implicit value.
 
+// NewStructReprTupleGenerator returns a generator for a struct with the tuple representation.
+// It panics if an implicit is given for a field it can't be supported on:
+// see structReprImplicit, and the trailing-fields rule above.
 func NewStructReprTupleGenerator(pkgName string, typ *schema.TypeStruct, adjCfg *AdjunctCfg) TypeGenerator {
+	fields := typ.Fields()
+	trailing := true
+	for i := len(fields) - 1; i >= 0; i-- {
+		switch implicit := structReprImplicit(fields[i]); {
+		case implicit != nil && !trailing:
+			panic("implicits in the tuple representation are only supported on fields which are followed only by optional fields or fields with implicits (field " + fields[i].Name() + " of " + string(typ.Name()) + ")")
+		case implicit == nil && !fields[i].IsOptional():
+			trailing = false
+		}
+	}
 	return structReprTupleGenerator{
 		structGenerator{
 			adjCfg,
@@ -63,6 +78,49 @@ type structReprTupleReprGenerator struct {
 
 func (structReprTupleReprGenerator) IsRepr() bool { return true } // hint used in some generalized templates.
 
+// AbsentCondition returns an expression which is true when the field may be left off the end of the representation:
+// optional fields when absent, and fields with implicits when their value is the implicit.
+// The expression reaches the struct through 'recv'.
+// If the field is never left out, it returns an empty string.
+func (g structReprTupleReprGenerator) AbsentCondition(recv string, field schema.StructField) string {
+	ref := recv + "." + g.AdjCfg.FieldSymbolLower(field)
+	if field.IsOptional() {
+		return ref + ".m == schema.Maybe_Absent"
+	}
+	return implicitCondition(ref, field)
+}
+
+// beginTrailingOptionalFields returns the index of the first of the fields at the end which may be left out
+// (or the number of fields, if there are none).
+// "Optional" here means "optional, or has an implicit".
+func (g structReprTupleReprGenerator) beginTrailingOptionalFields() int {
+	fields := g.Type.Fields()
+	begin := len(fields)
+	for i := len(fields) - 1; i >= 0; i-- {
+		if g.AbsentCondition("", fields[i]) == "" {
+			break
+		}
+		begin = i
+	}
+	return begin
+}
+
+// emitEndLookahead returns statements which set 'end' (which must already be declared and set to the number of fields)
+// to the number of entries in the representation, by counting back over the trailing fields which are left out;
+// they then jump to the label 'done', which must follow them.
+func (g structReprTupleReprGenerator) emitEndLookahead(recv string) string {
+	fields := g.Type.Fields()
+	v := "\n"
+	for i := len(fields) - 1; i >= g.beginTrailingOptionalFields(); i-- {
+		v += "\t\t\tif " + g.AbsentCondition(recv, fields[i]) + " {\n"
+		v += "\t\t\t\tend = " + strconv.Itoa(i) + "\n"
+		v += "\t\t\t} else {\n"
+		v += "\t\t\t\tgoto done\n"
+		v += "\t\t\t}\n"
+	}
+	return v
+}
+
 func (g structReprTupleReprGenerator) EmitNodeType(w io.Writer) {
 	// The type is structurally the same, but will have a different set of methods.
 	doTemplate(`
@@ -86,6 +144,10 @@ func (g structReprTupleReprGenerator) EmitNodeMethodLookupByIndex(w io.Writer) {
 				if n.{{ $field | FieldSymbolLower }}.m == schema.Maybe_Absent {
 					return ipld.Absent, ipld.ErrNotExists{ipld.PathSegmentOfInt(idx)}
 				}
+				{{- else if (dot).AbsentCondition "n" $field }}
+				if idx >= n.Length() {
+					return ipld.Absent, ipld.ErrNotExists{ipld.PathSegmentOfInt(idx)}
+				}
 				{{- end}}
 				{{- if $field.IsNullable }}
 				if n.{{ $field | FieldSymbolLower }}.m == schema.Maybe_Null {
@@ -112,7 +174,7 @@ func (g structReprTupleReprGenerator) EmitNodeMethodLookupByNode(w io.Writer) {
 			if err != nil {
 				return nil, err
 			}
-			return n.LookupByIndex(ki)
+			return n.LookupByIndex(int(ki))
 		}
 	`, w, g.AdjCfg, g)
 }
@@ -129,35 +191,17 @@ func (g structReprTupleReprGenerator) EmitNodeMethodListIterator(w io.Writer) {
 	//  This makes things a bit trickier -- especially the 'Done' predicate,
 	//   since it may have to do lookahead if there's any optionals at the end of the structure!
 
-	// Count how many trailing fields are optional.
+	// Count how many trailing fields are optional (or have implicits).
 	//  The 'Done' predicate gets more complex when in the trailing optionals.
-	fields := g.Type.Fields()
-	fieldCount := len(fields)
-	beginTrailingOptionalField := fieldCount
-	for i := fieldCount - 1; i >= 0; i-- {
-		if !fields[i].IsOptional() {
-			break
-		}
-		beginTrailingOptionalField = i
-	}
-	haveTrailingOptionals := beginTrailingOptionalField < fieldCount
+	haveTrailingOptionals := g.beginTrailingOptionalFields() < len(g.Type.Fields())
 
 	// Now: finally we can get on with the actual templating.
 	doTemplate(`
 		func (n *_{{ .Type | TypeSymbol }}__Repr) ListIterator() ipld.ListIterator {
 			{{- if .HaveTrailingOptionals }}
 			end := {{ len .Type.Fields }}`+
-		func() string { // this next part was too silly in templates due to lack of reverse ranging.
-			v := "\n"
-			for i := fieldCount - 1; i >= beginTrailingOptionalField; i-- {
-				v += "\t\t\tif n." + g.AdjCfg.FieldSymbolLower(fields[i]) + ".m == schema.Maybe_Absent {\n"
-				v += "\t\t\t\tend = " + strconv.Itoa(i) + "\n"
-				v += "\t\t\t} else {\n"
-				v += "\t\t\t\tgoto done\n"
-				v += "\t\t\t}\n"
-			}
-			return v
-		}()+`done:
+		g.emitEndLookahead("n")+ // this part was too silly in templates due to lack of reverse ranging.
+		`done:
 			return &_{{ .Type | TypeSymbol }}__ReprListItr{n, 0, end}
 			{{- else}}
 			return &_{{ .Type | TypeSymbol }}__ReprListItr{n, 0}
@@ -171,7 +215,11 @@ func (g structReprTupleReprGenerator) EmitNodeMethodListIterator(w io.Writer) {
 		}
 
 		func (itr *_{{ .Type | TypeSymbol }}__ReprListItr) Next() (idx int, v ipld.Node, err error) {
+			{{- if .HaveTrailingOptionals }}
+			if itr.idx >= itr.end {
+			{{- else}}
 			if itr.idx >= {{ len .Type.Fields }} {
+			{{- end}}
 				return -1, nil, ipld.ErrIteratorOverread{}
 			}
 			switch itr.idx {
@@ -221,18 +269,21 @@ func (g structReprTupleReprGenerator) EmitNodeMethodListIterator(w io.Writer) {
 }
 
 func (g structReprTupleReprGenerator) EmitNodeMethodLength(w io.Writer) {
-	// This is fun: it has to count down for any unset optional fields.
+	// This is fun: it has to count back over the trailing fields which are left out -- the same way the iterator finds its end.
+	if g.beginTrailingOptionalFields() == len(g.Type.Fields()) {
+		doTemplate(`
+			func (rn *_{{ .Type | TypeSymbol }}__Repr) Length() int {
+				return {{ len .Type.Fields }}
+			}
+		`, w, g.AdjCfg, g)
+		return
+	}
 	doTemplate(`
 		func (rn *_{{ .Type | TypeSymbol }}__Repr) Length() int {
-			l := {{ len .Type.Fields }}
-			{{- range $field := .Type.Fields }}
-			{{- if $field.IsOptional }}
-			if rn.{{ $field | FieldSymbolLower }}.m == schema.Maybe_Absent {
-				l--
-			}
-			{{- end}}
-			{{- end}}
-			return l
+			end := {{ len .Type.Fields }}`+
+		g.emitEndLookahead("rn")+
+		`done:
+			return end
 		}
 	`, w, g.AdjCfg, g)
 }
@@ -269,6 +320,13 @@ type structReprTupleReprBuilderGenerator struct {
 
 func (structReprTupleReprBuilderGenerator) IsRepr() bool { return true } // hint used in some generalized templates.
 
+// ImplicitAssignment returns the statements which assign the field's implicit value
+// using the field's child assembler (which must already be pointed at the field),
+// or an empty string if the field has no implicit.
+func (g structReprTupleReprBuilderGenerator) ImplicitAssignment(field schema.StructField) string {
+	return implicitAssignment("la.ca_"+g.AdjCfg.FieldSymbolLower(field), field)
+}
+
 func (g structReprTupleReprBuilderGenerator) EmitNodeBuilderType(w io.Writer) {
 	emitEmitNodeBuilderType_typical(w, g.AdjCfg, g)
 }
@@ -417,6 +475,7 @@ func (g structReprTupleReprBuilderGenerator) emitListAssemblerChildListAssembler
 	`, w, g.AdjCfg, g)
 	// Surprisingly, the Finish method doesn't have anything to do regarding any trailing optionals:
 	//  if they weren't assigned yet, their Maybe state is still the zero value: absent.  And that's correct.
+	// Trailing fields with implicits that weren't reached are a different story: those get their implicit value assigned here.
 	// DRY: okay, this finish component is actually identical, both textually and in terms of linking, to lists.  This we should actually extract.
 	doTemplate(`
 		func (la *_{{ .Type | TypeSymbol }}__ReprAssembler) Finish() error {
@@ -430,6 +489,16 @@ func (g structReprTupleReprBuilderGenerator) emitListAssemblerChildListAssembler
 			case laState_finished:
 				panic("invalid state: Finish cannot be called on an assembler that's already finished")
 			}
+			{{- range $i, $field := .Type.Fields }}
+			{{- with (dot).ImplicitAssignment $field }}
+			if la.f <= {{ $i }} {
+				la.ca_{{ $field | FieldSymbolLower }}.w = &la.w.{{ $field | FieldSymbolLower }}
+				la.ca_{{ $field | FieldSymbolLower }}.m = &la.cm
+				{{ . }}
+				la.cm = schema.Maybe_Absent
+			}
+			{{- end}}
+			{{- end}}
 			la.state = laState_finished
 			*la.m = schema.Maybe_Value
 			return nil
@@ -0,0 +1,417 @@
+package gengo
+
+import (
+	"io"
+
+	"github.com/ipld/go-ipld-prime/schema"
+	"github.com/ipld/go-ipld-prime/schema/gen/go/mixins"
+)
+
+var _ TypeGenerator = &unionReprEnvelopeGenerator{}
+
+// The envelope representation of unions is a map of exactly two entries:
+//  the discriminant, and the content (which is the member's representation, whatever kind that is).
+// If the content arrives before the discriminant during assembly, it's buffered as a whole;
+//  see the comments at the top of genpartsUnionDiscriminated.go.
+
+func NewUnionReprEnvelopeGenerator(pkgName string, typ *schema.TypeUnion, adjCfg *AdjunctCfg) TypeGenerator {
+	return unionReprEnvelopeGenerator{
+		unionGenerator{
+			adjCfg,
+			mixins.MapTraits{
+				pkgName,
+				string(typ.Name()),
+				adjCfg.TypeSymbol(typ),
+			},
+			pkgName,
+			typ,
+		},
+	}
+}
+
+type unionReprEnvelopeGenerator struct {
+	unionGenerator
+}
+
+func (g unionReprEnvelopeGenerator) GetRepresentationNodeGen() NodeGenerator {
+	return unionReprEnvelopeReprGenerator{
+		g.AdjCfg,
+		mixins.MapTraits{
+			g.PkgName,
+			string(g.Type.Name()) + ".Repr",
+			"_" + g.AdjCfg.TypeSymbol(g.Type) + "__Repr",
+		},
+		g.PkgName,
+		g.Type,
+	}
+}
+
+type unionReprEnvelopeReprGenerator struct {
+	AdjCfg *AdjunctCfg
+	mixins.MapTraits
+	PkgName string
+	Type    *schema.TypeUnion
+}
+
+func (unionReprEnvelopeReprGenerator) IsRepr() bool { return true } // hint used in some generalized templates.
+
+func (g unionReprEnvelopeReprGenerator) EmitNodeType(w io.Writer) {
+	// The type is structurally the same, but will have a different set of methods.
+	doTemplate(`
+		type _{{ .Type | TypeSymbol }}__Repr _{{ .Type | TypeSymbol }}
+	`, w, g.AdjCfg, g)
+
+	emitUnionReprDiscriminated_constants(w, g.AdjCfg, g)
+	doTemplate(`
+		var contentKey__{{ .Type | TypeSymbol }} = _String{"{{ .Type.RepresentationStrategy.GetContentKey }}"}
+	`, w, g.AdjCfg, g)
+	emitUnionReprDiscriminated_memberHelper(w, g.AdjCfg, g)
+}
+
+func (g unionReprEnvelopeReprGenerator) EmitNodeTypeAssertions(w io.Writer) {
+	doTemplate(`
+		var _ ipld.Node = &_{{ .Type | TypeSymbol }}__Repr{}
+	`, w, g.AdjCfg, g)
+}
+
+func (g unionReprEnvelopeReprGenerator) EmitNodeMethodLookupByString(w io.Writer) {
+	doTemplate(`
+		func (n *_{{ .Type | TypeSymbol }}__Repr) LookupByString(key string) (ipld.Node, error) {
+			switch key {
+			case "{{ .Type.RepresentationStrategy.GetDiscriminantKey }}":
+				d, _ := n.member()
+				return d, nil
+			case "{{ .Type.RepresentationStrategy.GetContentKey }}":
+				_, v := n.member()
+				return v, nil
+			default:
+				return nil, schema.ErrNoSuchField{Type: nil /*TODO*/, Field: ipld.PathSegmentOfString(key)}
+			}
+		}
+	`, w, g.AdjCfg, g)
+}
+
+func (g unionReprEnvelopeReprGenerator) EmitNodeMethodLookupByNode(w io.Writer) {
+	doTemplate(`
+		func (n *_{{ .Type | TypeSymbol }}__Repr) LookupByNode(key ipld.Node) (ipld.Node, error) {
+			ks, err := key.AsString()
+			if err != nil {
+				return nil, err
+			}
+			return n.LookupByString(ks)
+		}
+	`, w, g.AdjCfg, g)
+}
+
+func (g unionReprEnvelopeReprGenerator) EmitNodeMethodMapIterator(w io.Writer) {
+	// The discriminant comes first, so that anyone reading a serial form of this can stream it.
+	doTemplate(`
+		func (n *_{{ .Type | TypeSymbol }}__Repr) MapIterator() ipld.MapIterator {
+			d, v := n.member()
+			return &_{{ .Type | TypeSymbol }}__ReprMapItr{d, v, 0}
+		}
+
+		type _{{ .Type | TypeSymbol }}__ReprMapItr struct {
+			d   *_String
+			v   ipld.Node
+			idx int
+		}
+
+		func (itr *_{{ .Type | TypeSymbol }}__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+			switch itr.idx {
+			case 0:
+				k, v = &discriminantKey__{{ .Type | TypeSymbol }}, itr.d
+			case 1:
+				k, v = &contentKey__{{ .Type | TypeSymbol }}, itr.v
+			default:
+				return nil, nil, ipld.ErrIteratorOverread{}
+			}
+			itr.idx++
+			return
+		}
+		func (itr *_{{ .Type | TypeSymbol }}__ReprMapItr) Done() bool {
+			return itr.idx >= 2
+		}
+
+	`, w, g.AdjCfg, g)
+}
+
+func (g unionReprEnvelopeReprGenerator) EmitNodeMethodLength(w io.Writer) {
+	doTemplate(`
+		func (_{{ .Type | TypeSymbol }}__Repr) Length() int {
+			return 2
+		}
+	`, w, g.AdjCfg, g)
+}
+
+func (g unionReprEnvelopeReprGenerator) EmitNodeMethodPrototype(w io.Writer) {
+	emitNodeMethodPrototype_typical(w, g.AdjCfg, g)
+}
+
+func (g unionReprEnvelopeReprGenerator) EmitNodePrototypeType(w io.Writer) {
+	emitNodePrototypeType_typical(w, g.AdjCfg, g)
+}
+
+// --- NodeBuilder and NodeAssembler --->
+
+func (g unionReprEnvelopeReprGenerator) GetNodeBuilderGenerator() NodeBuilderGenerator {
+	return unionReprEnvelopeReprBuilderGenerator{
+		g.AdjCfg,
+		mixins.MapAssemblerTraits{
+			g.PkgName,
+			g.TypeName,
+			"_" + g.AdjCfg.TypeSymbol(g.Type) + "__Repr",
+		},
+		g.PkgName,
+		g.Type,
+	}
+}
+
+type unionReprEnvelopeReprBuilderGenerator struct {
+	AdjCfg *AdjunctCfg
+	mixins.MapAssemblerTraits
+	PkgName string
+	Type    *schema.TypeUnion
+}
+
+func (unionReprEnvelopeReprBuilderGenerator) IsRepr() bool { return true } // hint used in some generalized templates.
+
+func (g unionReprEnvelopeReprBuilderGenerator) EmitNodeBuilderType(w io.Writer) {
+	emitEmitNodeBuilderType_typical(w, g.AdjCfg, g)
+}
+func (g unionReprEnvelopeReprBuilderGenerator) EmitNodeBuilderMethods(w io.Writer) {
+	emitNodeBuilderMethods_typical(w, g.AdjCfg, g)
+}
+func (g unionReprEnvelopeReprBuilderGenerator) EmitNodeAssemblerType(w io.Writer) {
+	// Like the keyed representation's assembler, plus:
+	//  'ck' records which key's value is expected next (1 for the discriminant, 2 for the content);
+	//  'buf' holds the content if it arrives before the discriminant.
+	doTemplate(`
+		type _{{ .Type | TypeSymbol }}__ReprAssembler struct {
+			w *_{{ .Type | TypeSymbol }}
+			m *schema.Maybe
+			state maState
+
+			cm schema.Maybe
+			{{- range $i, $member := .Type.Members }}
+			ca{{ add $i 1 }} {{ if (eq (dot.AdjCfg.UnionMemlayout dot.Type) "interface") }}*{{end}}_{{ $member | TypeSymbol }}__ReprAssembler
+			{{end -}}
+			ca uint
+
+			ck uint8
+			buf ipld.NodeBuilder
+		}
+	`, w, g.AdjCfg, g)
+
+	doTemplate(`
+		func (na *_{{ .Type | TypeSymbol }}__ReprAssembler) reset() {
+			na.state = maState_initial
+			na.ck = 0
+			na.buf = nil
+			switch na.ca {
+			case 0:
+				return
+			{{- range $i, $member := .Type.Members }}
+			case {{ add $i 1 }}:
+				na.ca{{ add $i 1 }}.reset()
+			{{end -}}
+			default:
+				panic("unreachable")
+			}
+			na.ca = 0
+			na.cm = schema.Maybe_Absent
+		}
+	`, w, g.AdjCfg, g)
+}
+func (g unionReprEnvelopeReprBuilderGenerator) EmitNodeAssemblerMethodBeginMap(w io.Writer) {
+	emitNodeAssemblerMethodBeginMap_strictoid(w, g.AdjCfg, g)
+}
+func (g unionReprEnvelopeReprBuilderGenerator) EmitNodeAssemblerMethodAssignNull(w io.Writer) {
+	emitNodeAssemblerMethodAssignNull_recursive(w, g.AdjCfg, g)
+}
+func (g unionReprEnvelopeReprBuilderGenerator) EmitNodeAssemblerMethodAssignNode(w io.Writer) {
+	emitUnionReprDiscriminated_assignNode(w, g.AdjCfg, g)
+}
+func (g unionReprEnvelopeReprBuilderGenerator) EmitNodeAssemblerOtherBits(w io.Writer) {
+	emitUnionReprDiscriminated_assemblerMemberHelpers(w, g.AdjCfg, g)
+	g.emitMapAssemblerChildTidyHelper(w)
+	g.emitDiscriminantHandling(w)
+	g.emitMapAssemblerMethods(w)
+	emitUnionReprDiscriminated_keyAssembler(w, g.AdjCfg, g,
+		mixins.StringAssemblerTraits{
+			g.PkgName,
+			g.TypeName + ".KeyAssembler", // ".Repr" is already in `g.TypeName`, so don't stutter the "Repr" part.
+			"_" + g.AdjCfg.TypeSymbol(g.Type) + "__ReprKey",
+		}, `
+		func (ka *_{{ .Type | TypeSymbol }}__ReprKeyAssembler) AssignString(k string) error {
+			if ka.state != maState_midKey {
+				panic("misuse: KeyAssembler held beyond its valid lifetime")
+			}
+			switch k {
+			case "{{ .Type.RepresentationStrategy.GetDiscriminantKey }}":
+				if ka.ca != 0 {
+					return ipld.ErrRepeatedMapKey{&discriminantKey__{{ .Type | TypeSymbol }}}
+				}
+				ka.ck = 1
+			case "{{ .Type.RepresentationStrategy.GetContentKey }}":
+				if ka.buf != nil || ka.cm != schema.Maybe_Absent {
+					return ipld.ErrRepeatedMapKey{&contentKey__{{ .Type | TypeSymbol }}}
+				}
+				ka.ck = 2
+			default:
+				return ipld.ErrInvalidKey{TypeName:"{{ .PkgName }}.{{ .Type.Name }}.Repr", Key:&_String{k}}
+			}
+			ka.state = maState_expectValue
+			return nil
+		}
+	`)
+	emitUnionReprDiscriminated_discriminantAssembler(w, g.AdjCfg, g,
+		mixins.StringAssemblerTraits{
+			g.PkgName,
+			g.TypeName + ".DiscriminantAssembler",
+			"_" + g.AdjCfg.TypeSymbol(g.Type) + "__ReprDiscriminant",
+		})
+}
+func (g unionReprEnvelopeReprBuilderGenerator) emitMapAssemblerChildTidyHelper(w io.Writer) {
+	// Only content which was assembled directly into the member needs tidying;
+	//  the discriminant, and content being buffered, return to maState_initial on their own.
+	doTemplate(`
+		func (ma *_{{ .Type | TypeSymbol }}__ReprAssembler) valueFinishTidy() bool {
+			if ma.ck == 2 && ma.cm == schema.Maybe_Value {
+				ma.state = maState_initial
+				return true
+			}
+			return false
+		}
+	`, w, g.AdjCfg, g)
+}
+func (g unionReprEnvelopeReprBuilderGenerator) emitDiscriminantHandling(w io.Writer) {
+	// If the content was buffered, it's given to the member's assembler as soon as the discriminant is known.
+	doTemplate(`
+		func (ma *_{{ .Type | TypeSymbol }}__ReprAssembler) acceptDiscriminant(d string) error {
+			if err := ma.chooseMember(d); err != nil {
+				return err
+			}
+			ma.state = maState_initial
+			if ma.buf == nil {
+				return nil
+			}
+			content := ma.buf.Build()
+			ma.buf = nil
+			return ma.memberAssembler().AssignNode(content)
+		}
+	`, w, g.AdjCfg, g)
+}
+func (g unionReprEnvelopeReprBuilderGenerator) emitMapAssemblerMethods(w io.Writer) {
+	doTemplate(`
+		func (ma *_{{ .Type | TypeSymbol }}__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+			if err := ma.AssembleKey().AssignString(k); err != nil {
+				ma.state = maState_initial
+				return nil, err
+			}
+			return ma.AssembleValue(), nil
+		}
+	`, w, g.AdjCfg, g)
+
+	doTemplate(`
+		func (ma *_{{ .Type | TypeSymbol }}__ReprAssembler) AssembleKey() ipld.NodeAssembler {
+			switch ma.state {
+			case maState_initial:
+				// carry on
+			case maState_midKey:
+				panic("invalid state: AssembleKey cannot be called when in the middle of assembling another key")
+			case maState_expectValue:
+				panic("invalid state: AssembleKey cannot be called when expecting start of value assembly")
+			case maState_midValue:
+				if !ma.valueFinishTidy() {
+					panic("invalid state: AssembleKey cannot be called when in the middle of assembling a value")
+				} // if tidy success: carry on
+			case maState_finished:
+				panic("invalid state: AssembleKey cannot be called on an assembler that's already finished")
+			}
+			ma.state = maState_midKey
+			return (*_{{ .Type | TypeSymbol }}__ReprKeyAssembler)(ma)
+		}
+	`, w, g.AdjCfg, g)
+
+	doTemplate(`
+		func (ma *_{{ .Type | TypeSymbol }}__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+			switch ma.state {
+			case maState_initial:
+				panic("invalid state: AssembleValue cannot be called when no key is primed")
+			case maState_midKey:
+				panic("invalid state: AssembleValue cannot be called when in the middle of assembling a key")
+			case maState_expectValue:
+				// carry on
+			case maState_midValue:
+				panic("invalid state: AssembleValue cannot be called when in the middle of assembling another value")
+			case maState_finished:
+				panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+			}
+			if ma.ck == 1 {
+				ma.state = maState_midValue
+				return (*_{{ .Type | TypeSymbol }}__ReprDiscriminantAssembler)(ma)
+			}
+			if ma.ca == 0 {
+				// We don't know the member yet, so the content has to be buffered.
+				//  The buffer's own assembler is responsible for its state.
+				ma.state = maState_initial
+				ma.buf = basicnode.Prototype.Any.NewBuilder()
+				return ma.buf
+			}
+			ma.state = maState_midValue
+			return ma.memberAssembler()
+		}
+	`, w, g.AdjCfg, g)
+
+	doTemplate(`
+		func (ma *_{{ .Type | TypeSymbol }}__ReprAssembler) Finish() error {
+			switch ma.state {
+			case maState_initial:
+				// carry on
+			case maState_midKey:
+				panic("invalid state: Finish cannot be called when in the middle of assembling a key")
+			case maState_expectValue:
+				panic("invalid state: Finish cannot be called when expecting start of value assembly")
+			case maState_midValue:
+				if !ma.valueFinishTidy() {
+					panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+				} // if tidy success: carry on
+			case maState_finished:
+				panic("invalid state: Finish cannot be called on an assembler that's already finished")
+			}
+			if ma.ca == 0 {
+				return schema.ErrNotUnionStructure{TypeName:"{{ .PkgName }}.{{ .Type.Name }}.Repr", Detail: "missing discriminant key: {{ .Type.RepresentationStrategy.GetDiscriminantKey }}"}
+			}
+			if ma.cm != schema.Maybe_Value {
+				return schema.ErrNotUnionStructure{TypeName:"{{ .PkgName }}.{{ .Type.Name }}.Repr", Detail: "missing content key: {{ .Type.RepresentationStrategy.GetContentKey }}"}
+			}
+			ma.state = maState_finished
+			*ma.m = schema.Maybe_Value
+			return nil
+		}
+	`, w, g.AdjCfg, g)
+
+	doTemplate(`
+		func (ma *_{{ .Type | TypeSymbol }}__ReprAssembler) KeyPrototype() ipld.NodePrototype {
+			return _String__Prototype{}
+		}
+		func (ma *_{{ .Type | TypeSymbol }}__ReprAssembler) ValuePrototype(k string) ipld.NodePrototype {
+			switch k {
+			case "{{ .Type.RepresentationStrategy.GetDiscriminantKey }}":
+				return _String__Prototype{}
+			case "{{ .Type.RepresentationStrategy.GetContentKey }}":
+				switch ma.ca {
+				{{- range $i, $member := .Type.Members }}
+				case {{ add $i 1 }}:
+					return _{{ $member | TypeSymbol }}__ReprPrototype{}
+				{{- end}}
+				default:
+					return basicnode.Prototype.Any
+				}
+			default:
+				return nil
+			}
+		}
+	`, w, g.AdjCfg, g)
+}
@@ -0,0 +1,412 @@
+package gengo
+
+import (
+	"io"
+
+	"github.com/ipld/go-ipld-prime/schema"
+	"github.com/ipld/go-ipld-prime/schema/gen/go/mixins"
+)
+
+var _ TypeGenerator = &unionReprInlineGenerator{}
+
+// The inline representation of unions puts the discriminant in the same map as the member's own entries,
+//  so all members must have representations of map kind (typically, they're structs with map representation).
+// The read side is cheap: it yields the discriminant, and then just proxies to the member.
+// The assembly side is where the work is: see the comments at the top of genpartsUnionDiscriminated.go.
+
+func NewUnionReprInlineGenerator(pkgName string, typ *schema.TypeUnion, adjCfg *AdjunctCfg) TypeGenerator {
+	return unionReprInlineGenerator{
+		unionGenerator{
+			adjCfg,
+			mixins.MapTraits{
+				pkgName,
+				string(typ.Name()),
+				adjCfg.TypeSymbol(typ),
+			},
+			pkgName,
+			typ,
+		},
+	}
+}
+
+type unionReprInlineGenerator struct {
+	unionGenerator
+}
+
+func (g unionReprInlineGenerator) GetRepresentationNodeGen() NodeGenerator {
+	return unionReprInlineReprGenerator{
+		g.AdjCfg,
+		mixins.MapTraits{
+			g.PkgName,
+			string(g.Type.Name()) + ".Repr",
+			"_" + g.AdjCfg.TypeSymbol(g.Type) + "__Repr",
+		},
+		g.PkgName,
+		g.Type,
+	}
+}
+
+type unionReprInlineReprGenerator struct {
+	AdjCfg *AdjunctCfg
+	mixins.MapTraits
+	PkgName string
+	Type    *schema.TypeUnion
+}
+
+func (unionReprInlineReprGenerator) IsRepr() bool { return true } // hint used in some generalized templates.
+
+func (g unionReprInlineReprGenerator) EmitNodeType(w io.Writer) {
+	// The type is structurally the same, but will have a different set of methods.
+	doTemplate(`
+		type _{{ .Type | TypeSymbol }}__Repr _{{ .Type | TypeSymbol }}
+	`, w, g.AdjCfg, g)
+
+	emitUnionReprDiscriminated_constants(w, g.AdjCfg, g)
+	emitUnionReprDiscriminated_memberHelper(w, g.AdjCfg, g)
+}
+
+func (g unionReprInlineReprGenerator) EmitNodeTypeAssertions(w io.Writer) {
+	doTemplate(`
+		var _ ipld.Node = &_{{ .Type | TypeSymbol }}__Repr{}
+	`, w, g.AdjCfg, g)
+}
+
+func (g unionReprInlineReprGenerator) EmitNodeMethodLookupByString(w io.Writer) {
+	doTemplate(`
+		func (n *_{{ .Type | TypeSymbol }}__Repr) LookupByString(key string) (ipld.Node, error) {
+			d, v := n.member()
+			if key == "{{ .Type.RepresentationStrategy.GetDiscriminantKey }}" {
+				return d, nil
+			}
+			return v.LookupByString(key)
+		}
+	`, w, g.AdjCfg, g)
+}
+
+func (g unionReprInlineReprGenerator) EmitNodeMethodLookupByNode(w io.Writer) {
+	doTemplate(`
+		func (n *_{{ .Type | TypeSymbol }}__Repr) LookupByNode(key ipld.Node) (ipld.Node, error) {
+			ks, err := key.AsString()
+			if err != nil {
+				return nil, err
+			}
+			return n.LookupByString(ks)
+		}
+	`, w, g.AdjCfg, g)
+}
+
+func (g unionReprInlineReprGenerator) EmitNodeMethodMapIterator(w io.Writer) {
+	// The discriminant comes first, so that anyone reading a serial form of this can stream it.
+	doTemplate(`
+		func (n *_{{ .Type | TypeSymbol }}__Repr) MapIterator() ipld.MapIterator {
+			d, v := n.member()
+			return &_{{ .Type | TypeSymbol }}__ReprMapItr{d, v.MapIterator()}
+		}
+
+		type _{{ .Type | TypeSymbol }}__ReprMapItr struct {
+			d   *_String // set to nil once yielded.
+			itr ipld.MapIterator
+		}
+
+		func (itr *_{{ .Type | TypeSymbol }}__ReprMapItr) Next() (k ipld.Node, v ipld.Node, _ error) {
+			if itr.d != nil {
+				k, v = &discriminantKey__{{ .Type | TypeSymbol }}, itr.d
+				itr.d = nil
+				return
+			}
+			return itr.itr.Next()
+		}
+		func (itr *_{{ .Type | TypeSymbol }}__ReprMapItr) Done() bool {
+			return itr.d == nil && itr.itr.Done()
+		}
+
+	`, w, g.AdjCfg, g)
+}
+
+func (g unionReprInlineReprGenerator) EmitNodeMethodLength(w io.Writer) {
+	doTemplate(`
+		func (n *_{{ .Type | TypeSymbol }}__Repr) Length() int {
+			_, v := n.member()
+			return v.Length() + 1
+		}
+	`, w, g.AdjCfg, g)
+}
+
+func (g unionReprInlineReprGenerator) EmitNodeMethodPrototype(w io.Writer) {
+	emitNodeMethodPrototype_typical(w, g.AdjCfg, g)
+}
+
+func (g unionReprInlineReprGenerator) EmitNodePrototypeType(w io.Writer) {
+	emitNodePrototypeType_typical(w, g.AdjCfg, g)
+}
+
+// --- NodeBuilder and NodeAssembler --->
+
+func (g unionReprInlineReprGenerator) GetNodeBuilderGenerator() NodeBuilderGenerator {
+	return unionReprInlineReprBuilderGenerator{
+		g.AdjCfg,
+		mixins.MapAssemblerTraits{
+			g.PkgName,
+			g.TypeName,
+			"_" + g.AdjCfg.TypeSymbol(g.Type) + "__Repr",
+		},
+		g.PkgName,
+		g.Type,
+	}
+}
+
+type unionReprInlineReprBuilderGenerator struct {
+	AdjCfg *AdjunctCfg
+	mixins.MapAssemblerTraits
+	PkgName string
+	Type    *schema.TypeUnion
+}
+
+func (unionReprInlineReprBuilderGenerator) IsRepr() bool { return true } // hint used in some generalized templates.
+
+func (g unionReprInlineReprBuilderGenerator) EmitNodeBuilderType(w io.Writer) {
+	emitEmitNodeBuilderType_typical(w, g.AdjCfg, g)
+}
+func (g unionReprInlineReprBuilderGenerator) EmitNodeBuilderMethods(w io.Writer) {
+	emitNodeBuilderMethods_typical(w, g.AdjCfg, g)
+}
+func (g unionReprInlineReprBuilderGenerator) EmitNodeAssemblerType(w io.Writer) {
+	// Like the keyed representation's assembler, plus a few fields for handling the discriminant:
+	//  'cma' is the member's map assembler, which all entries are forwarded to once the discriminant is known;
+	//  'buf' and 'bufma' hold any entries which arrive before that.
+	//  Since entries are forwarded, this assembler never stays in maState_midValue except while the discriminant is assembled.
+	doTemplate(`
+		type _{{ .Type | TypeSymbol }}__ReprAssembler struct {
+			w *_{{ .Type | TypeSymbol }}
+			m *schema.Maybe
+			state maState
+
+			cm schema.Maybe
+			{{- range $i, $member := .Type.Members }}
+			ca{{ add $i 1 }} {{ if (eq (dot.AdjCfg.UnionMemlayout dot.Type) "interface") }}*{{end}}_{{ $member | TypeSymbol }}__ReprAssembler
+			{{end -}}
+			ca uint
+
+			disc bool
+			cma ipld.MapAssembler
+			buf ipld.NodeBuilder
+			bufma ipld.MapAssembler
+		}
+	`, w, g.AdjCfg, g)
+
+	doTemplate(`
+		func (na *_{{ .Type | TypeSymbol }}__ReprAssembler) reset() {
+			na.state = maState_initial
+			na.disc = false
+			na.cma = nil
+			na.buf, na.bufma = nil, nil
+			switch na.ca {
+			case 0:
+				return
+			{{- range $i, $member := .Type.Members }}
+			case {{ add $i 1 }}:
+				na.ca{{ add $i 1 }}.reset()
+			{{end -}}
+			default:
+				panic("unreachable")
+			}
+			na.ca = 0
+			na.cm = schema.Maybe_Absent
+		}
+	`, w, g.AdjCfg, g)
+}
+func (g unionReprInlineReprBuilderGenerator) EmitNodeAssemblerMethodBeginMap(w io.Writer) {
+	emitNodeAssemblerMethodBeginMap_strictoid(w, g.AdjCfg, g)
+}
+func (g unionReprInlineReprBuilderGenerator) EmitNodeAssemblerMethodAssignNull(w io.Writer) {
+	emitNodeAssemblerMethodAssignNull_recursive(w, g.AdjCfg, g)
+}
+func (g unionReprInlineReprBuilderGenerator) EmitNodeAssemblerMethodAssignNode(w io.Writer) {
+	emitUnionReprDiscriminated_assignNode(w, g.AdjCfg, g)
+}
+func (g unionReprInlineReprBuilderGenerator) EmitNodeAssemblerOtherBits(w io.Writer) {
+	emitUnionReprDiscriminated_assemblerMemberHelpers(w, g.AdjCfg, g)
+	g.emitDiscriminantHandling(w)
+	g.emitMapAssemblerMethods(w)
+	emitUnionReprDiscriminated_keyAssembler(w, g.AdjCfg, g,
+		mixins.StringAssemblerTraits{
+			g.PkgName,
+			g.TypeName + ".KeyAssembler", // ".Repr" is already in `g.TypeName`, so don't stutter the "Repr" part.
+			"_" + g.AdjCfg.TypeSymbol(g.Type) + "__ReprKey",
+		}, `
+		func (ka *_{{ .Type | TypeSymbol }}__ReprKeyAssembler) AssignString(k string) error {
+			if ka.state != maState_midKey {
+				panic("misuse: KeyAssembler held beyond its valid lifetime")
+			}
+			ma := (*_{{ .Type | TypeSymbol }}__ReprAssembler)(ka)
+			switch {
+			case k == "{{ .Type.RepresentationStrategy.GetDiscriminantKey }}":
+				if ma.ca != 0 {
+					return ipld.ErrRepeatedMapKey{&discriminantKey__{{ .Type | TypeSymbol }}}
+				}
+				ma.disc = true
+			case ma.cma != nil:
+				if err := ma.cma.AssembleKey().AssignString(k); err != nil {
+					return err
+				}
+			default:
+				ma.beginBuffer()
+				if err := ma.bufma.AssembleKey().AssignString(k); err != nil {
+					return err
+				}
+			}
+			ma.state = maState_expectValue
+			return nil
+		}
+	`)
+	emitUnionReprDiscriminated_discriminantAssembler(w, g.AdjCfg, g,
+		mixins.StringAssemblerTraits{
+			g.PkgName,
+			g.TypeName + ".DiscriminantAssembler",
+			"_" + g.AdjCfg.TypeSymbol(g.Type) + "__ReprDiscriminant",
+		})
+}
+func (g unionReprInlineReprBuilderGenerator) emitDiscriminantHandling(w io.Writer) {
+	// Entries which arrive before the discriminant are buffered in a basicnode map,
+	//  and replayed into the member's map assembler as soon as the discriminant is known.
+	doTemplate(`
+		func (ma *_{{ .Type | TypeSymbol }}__ReprAssembler) beginBuffer() {
+			if ma.bufma != nil {
+				return
+			}
+			ma.buf = basicnode.Prototype.Map.NewBuilder()
+			ma.bufma, _ = ma.buf.BeginMap(0) // can't fail on a fresh basicnode builder.
+		}
+		func (ma *_{{ .Type | TypeSymbol }}__ReprAssembler) acceptDiscriminant(d string) error {
+			if err := ma.chooseMember(d); err != nil {
+				return err
+			}
+			cma, err := ma.memberAssembler().BeginMap(0)
+			if err != nil {
+				return err
+			}
+			ma.cma = cma
+			ma.disc = false
+			ma.state = maState_initial
+			if ma.bufma == nil {
+				return nil
+			}
+			if err := ma.bufma.Finish(); err != nil {
+				return err
+			}
+			buf := ma.buf.Build()
+			ma.buf, ma.bufma = nil, nil
+			for itr := buf.MapIterator(); !itr.Done(); {
+				k, v, err := itr.Next()
+				if err != nil {
+					return err
+				}
+				if err := cma.AssembleKey().AssignNode(k); err != nil {
+					return err
+				}
+				if err := cma.AssembleValue().AssignNode(v); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	`, w, g.AdjCfg, g)
+}
+func (g unionReprInlineReprBuilderGenerator) emitMapAssemblerMethods(w io.Writer) {
+	doTemplate(`
+		func (ma *_{{ .Type | TypeSymbol }}__ReprAssembler) AssembleEntry(k string) (ipld.NodeAssembler, error) {
+			if err := ma.AssembleKey().AssignString(k); err != nil {
+				ma.state = maState_initial
+				return nil, err
+			}
+			return ma.AssembleValue(), nil
+		}
+	`, w, g.AdjCfg, g)
+
+	doTemplate(`
+		func (ma *_{{ .Type | TypeSymbol }}__ReprAssembler) AssembleKey() ipld.NodeAssembler {
+			switch ma.state {
+			case maState_initial:
+				// carry on
+			case maState_midKey:
+				panic("invalid state: AssembleKey cannot be called when in the middle of assembling another key")
+			case maState_expectValue:
+				panic("invalid state: AssembleKey cannot be called when expecting start of value assembly")
+			case maState_midValue:
+				panic("invalid state: AssembleKey cannot be called when in the middle of assembling a value")
+			case maState_finished:
+				panic("invalid state: AssembleKey cannot be called on an assembler that's already finished")
+			}
+			ma.state = maState_midKey
+			return (*_{{ .Type | TypeSymbol }}__ReprKeyAssembler)(ma)
+		}
+	`, w, g.AdjCfg, g)
+
+	doTemplate(`
+		func (ma *_{{ .Type | TypeSymbol }}__ReprAssembler) AssembleValue() ipld.NodeAssembler {
+			switch ma.state {
+			case maState_initial:
+				panic("invalid state: AssembleValue cannot be called when no key is primed")
+			case maState_midKey:
+				panic("invalid state: AssembleValue cannot be called when in the middle of assembling a key")
+			case maState_expectValue:
+				// carry on
+			case maState_midValue:
+				panic("invalid state: AssembleValue cannot be called when in the middle of assembling another value")
+			case maState_finished:
+				panic("invalid state: AssembleValue cannot be called on an assembler that's already finished")
+			}
+			if ma.disc {
+				ma.state = maState_midValue
+				return (*_{{ .Type | TypeSymbol }}__ReprDiscriminantAssembler)(ma)
+			}
+			// Other values are forwarded; the assembler they're forwarded to is responsible for their state.
+			ma.state = maState_initial
+			if ma.cma != nil {
+				return ma.cma.AssembleValue()
+			}
+			return ma.bufma.AssembleValue()
+		}
+	`, w, g.AdjCfg, g)
+
+	doTemplate(`
+		func (ma *_{{ .Type | TypeSymbol }}__ReprAssembler) Finish() error {
+			switch ma.state {
+			case maState_initial:
+				// carry on
+			case maState_midKey:
+				panic("invalid state: Finish cannot be called when in the middle of assembling a key")
+			case maState_expectValue:
+				panic("invalid state: Finish cannot be called when expecting start of value assembly")
+			case maState_midValue:
+				panic("invalid state: Finish cannot be called when in the middle of assembling a value")
+			case maState_finished:
+				panic("invalid state: Finish cannot be called on an assembler that's already finished")
+			}
+			if ma.ca == 0 {
+				return schema.ErrNotUnionStructure{TypeName:"{{ .PkgName }}.{{ .Type.Name }}.Repr", Detail: "missing discriminant key: {{ .Type.RepresentationStrategy.GetDiscriminantKey }}"}
+			}
+			if err := ma.cma.Finish(); err != nil {
+				return err
+			}
+			ma.state = maState_finished
+			*ma.m = schema.Maybe_Value
+			return nil
+		}
+	`, w, g.AdjCfg, g)
+
+	doTemplate(`
+		func (ma *_{{ .Type | TypeSymbol }}__ReprAssembler) KeyPrototype() ipld.NodePrototype {
+			return _String__Prototype{}
+		}
+		func (ma *_{{ .Type | TypeSymbol }}__ReprAssembler) ValuePrototype(k string) ipld.NodePrototype {
+			if k == "{{ .Type.RepresentationStrategy.GetDiscriminantKey }}" {
+				return _String__Prototype{}
+			}
+			if ma.cma != nil {
+				return ma.cma.ValuePrototype(k)
+			}
+			return basicnode.Prototype.Any
+		}
+	`, w, g.AdjCfg, g)
+}
@@ -270,7 +270,7 @@ func (g unionReprKindedReprGenerator) EmitNodeMethodAsBool(w io.Writer) {
 func (g unionReprKindedReprGenerator) EmitNodeMethodAsInt(w io.Writer) {
 	doTemplate(kindedUnionNodeMethodTemplateMunge(
 		`AsInt`,
-		`AsInt() (int, error)`,
+		`AsInt() (int64, error)`,
 		`{{- if .Type.RepresentationStrategy.GetMember (Kind "int") }}`,
 		`{{- if eq $member.RepresentationBehavior.String "int" }}`,
 		`.AsInt()`,
@@ -500,7 +500,7 @@ func (g unionReprKindedReprBuilderGenerator) EmitNodeAssemblerMethodAssignBool(w
 func (g unionReprKindedReprBuilderGenerator) EmitNodeAssemblerMethodAssignInt(w io.Writer) {
 	doTemplate(kindedUnionNodeAssemblerMethodTemplateMunge(
 		`AssignInt`,
-		`AssignInt(v int) error `,
+		`AssignInt(v int64) error `,
 		`{{- if eq $member.RepresentationBehavior.String "int" }}`,
 		`.AssignInt(v)`,
 		false,
@@ -31,6 +31,8 @@ func Generate(pth string, pkgName string, ts schema.TypeSystem, adjCfg *AdjunctC
 				EmitEntireType(NewBytesReprBytesGenerator(pkgName, t2, adjCfg), f)
 			case *schema.TypeLink:
 				EmitEntireType(NewLinkReprLinkGenerator(pkgName, t2, adjCfg), f)
+			case *schema.TypeEnum:
+				EmitEntireType(NewEnumReprStringGenerator(pkgName, t2, adjCfg), f)
 			case *schema.TypeStruct:
 				switch t2.RepresentationStrategy().(type) {
 				case schema.StructRepresentation_Map:
@@ -39,6 +41,8 @@ func Generate(pth string, pkgName string, ts schema.TypeSystem, adjCfg *AdjunctC
 					EmitEntireType(NewStructReprTupleGenerator(pkgName, t2, adjCfg), f)
 				case schema.StructRepresentation_Stringjoin:
 					EmitEntireType(NewStructReprStringjoinGenerator(pkgName, t2, adjCfg), f)
+				case schema.StructRepresentation_StringPairs:
+					EmitEntireType(NewStructReprStringpairsGenerator(pkgName, t2, adjCfg), f)
 				default:
 					panic("unrecognized struct representation strategy")
 				}
@@ -52,6 +56,12 @@ func Generate(pth string, pkgName string, ts schema.TypeSystem, adjCfg *AdjunctC
 					EmitEntireType(NewUnionReprKeyedGenerator(pkgName, t2, adjCfg), f)
 				case schema.UnionRepresentation_Kinded:
 					EmitEntireType(NewUnionReprKindedGenerator(pkgName, t2, adjCfg), f)
+				case schema.UnionRepresentation_Envelope:
+					EmitFileImport("basicnode", "github.com/ipld/go-ipld-prime/node/basic", f)
+					EmitEntireType(NewUnionReprEnvelopeGenerator(pkgName, t2, adjCfg), f)
+				case schema.UnionRepresentation_Inline:
+					EmitFileImport("basicnode", "github.com/ipld/go-ipld-prime/node/basic", f)
+					EmitEntireType(NewUnionReprInlineGenerator(pkgName, t2, adjCfg), f)
 				default:
 					panic("unrecognized union representation strategy")
 				}
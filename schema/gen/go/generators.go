@@ -79,7 +79,8 @@ type NodeBuilderGenerator interface {
 
 // EmitFileHeader emits a baseline package header that will
 // allow a file with a generated type to compile.
-// (Fortunately, there are no variations in this.)
+// (Fortunately, there are few variations in this;
+// see EmitFileImport for the exceptions.)
 func EmitFileHeader(packageName string, w io.Writer) {
 	fmt.Fprintf(w, "package %s\n\n", packageName)
 	fmt.Fprintf(w, doNotEditComment+"\n\n")
@@ -90,6 +91,14 @@ func EmitFileHeader(packageName string, w io.Writer) {
 	fmt.Fprintf(w, ")\n\n")
 }
 
+// EmitFileImport emits an additional import declaration,
+// for the few generated types which need more than EmitFileHeader provides
+// (for example, unions whose representations must buffer data).
+// It must be called immediately after EmitFileHeader.
+func EmitFileImport(name string, path string, w io.Writer) {
+	fmt.Fprintf(w, "import %s %q\n\n", name, path)
+}
+
 // EmitEntireType calls all methods of TypeGenerator and streams
 // all results into a single writer.
 func EmitEntireType(tg TypeGenerator, w io.Writer) {
@@ -71,7 +71,7 @@ func EmitInternalEnums(packageName string, w io.Writer) {
 		func (ea _ErrorThunkAssembler) BeginList(_ int) (ipld.ListAssembler, error) { return nil, ea.e }
 		func (ea _ErrorThunkAssembler) AssignNull() error { return ea.e }
 		func (ea _ErrorThunkAssembler) AssignBool(bool) error { return ea.e }
-		func (ea _ErrorThunkAssembler) AssignInt(int) error { return ea.e }
+		func (ea _ErrorThunkAssembler) AssignInt(int64) error { return ea.e }
 		func (ea _ErrorThunkAssembler) AssignFloat(float64) error { return ea.e }
 		func (ea _ErrorThunkAssembler) AssignString(string) error { return ea.e }
 		func (ea _ErrorThunkAssembler) AssignBytes([]byte) error { return ea.e }
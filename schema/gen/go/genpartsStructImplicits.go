@@ -0,0 +1,85 @@
+package gengo
+
+import (
+	"strconv"
+
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+// These helpers are shared by the struct representations which support implicits (map and tuple).
+
+// structReprImplicit returns the implicit value for a field, or nil if it has none.
+//
+// Implicits are only supported on fields which are neither optional nor nullable
+// (the combination with optional isn't meaningful; the combination with nullable might be, but isn't handled yet),
+// and the field's type has to be one where the implicit can be checked cheaply:
+// strings and enums for string implicits, ints for int implicits, and lists and maps for the empty ones.
+// Anything else panics.
+func structReprImplicit(field schema.StructField) schema.ImplicitValue {
+	var implicit schema.ImplicitValue
+	switch r := field.Parent().RepresentationStrategy().(type) {
+	case schema.StructRepresentation_Map:
+		implicit = r.GetImplicit(field)
+	case schema.StructRepresentation_Tuple:
+		implicit = r.GetImplicit(field)
+	}
+	if implicit == nil {
+		return nil
+	}
+	if field.IsMaybe() {
+		panic("implicits are not supported on optional or nullable fields (field " + field.Name() + " of " + string(field.Parent().Name()) + ")")
+	}
+	ok := false
+	switch implicit.(type) {
+	case schema.ImplicitValue_String:
+		switch field.Type().(type) {
+		case *schema.TypeString, *schema.TypeEnum:
+			ok = true
+		}
+	case schema.ImplicitValue_Int:
+		_, ok = field.Type().(*schema.TypeInt)
+	case schema.ImplicitValue_EmptyList:
+		_, ok = field.Type().(*schema.TypeList)
+	case schema.ImplicitValue_EmptyMap:
+		_, ok = field.Type().(*schema.TypeMap)
+	}
+	if !ok {
+		panic("implicit value doesn't match the type of field " + field.Name() + " of " + string(field.Parent().Name()))
+	}
+	return implicit
+}
+
+// implicitCondition returns an expression which is true when the field (reached through 'ref') holds its implicit value,
+// or an empty string if the field has no implicit.
+func implicitCondition(ref string, field schema.StructField) string {
+	switch iv := structReprImplicit(field).(type) {
+	case schema.ImplicitValue_String:
+		return ref + ".x == " + strconv.Quote(iv.String())
+	case schema.ImplicitValue_Int:
+		return ref + ".x == " + strconv.FormatInt(iv.Int(), 10)
+	case schema.ImplicitValue_EmptyList:
+		return "len(" + ref + ".x) == 0"
+	case schema.ImplicitValue_EmptyMap:
+		return "len(" + ref + ".t) == 0"
+	default:
+		return ""
+	}
+}
+
+// implicitAssignment returns the statements which assign the field's implicit value
+// using the child assembler 'ca' (which must already be pointed at the field),
+// or an empty string if the field has no implicit.
+func implicitAssignment(ca string, field schema.StructField) string {
+	switch iv := structReprImplicit(field).(type) {
+	case schema.ImplicitValue_String:
+		return "if err := " + ca + ".AssignString(" + strconv.Quote(iv.String()) + "); err != nil {\n\treturn err\n}"
+	case schema.ImplicitValue_Int:
+		return "if err := " + ca + ".AssignInt(" + strconv.FormatInt(iv.Int(), 10) + "); err != nil {\n\treturn err\n}"
+	case schema.ImplicitValue_EmptyList:
+		return "if la, err := " + ca + ".BeginList(0); err != nil {\n\treturn err\n} else if err := la.Finish(); err != nil {\n\treturn err\n}"
+	case schema.ImplicitValue_EmptyMap:
+		return "if ma2, err := " + ca + ".BeginMap(0); err != nil {\n\treturn err\n} else if err := ma2.Finish(); err != nil {\n\treturn err\n}"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,213 @@
+package gengo
+
+import (
+	"io"
+
+	"github.com/ipld/go-ipld-prime/schema/gen/go/mixins"
+)
+
+// This file contains parts shared by the union representations which carry
+//  their discriminant as the value of a well-known key in a map:
+//  that's the envelope and inline representations.
+//
+// Both representations have the same problem on the assembly side:
+//  the discriminant can arrive after other entries in the map,
+//  so anything that shows up before it has to be buffered,
+//  and can only be handed to the member's assembler once we know which member that is.
+// The buffering uses basicnode, so files using these parts must import it
+//  (see EmitFileImport).
+//
+// The templates here expect `.Type` to be a *schema.TypeUnion with one of those representation strategies,
+//  and `.PkgName` and `.AdjCfg` to be available as on all the other generators.
+
+func emitUnionReprDiscriminated_constants(w io.Writer, adjCfg *AdjunctCfg, data interface{}) {
+	// Constants for the discriminant values, and for the discriminant key;
+	//  they'll make iterators able to work faster.
+	doTemplate(`
+		var (
+			discriminantKey__{{ .Type | TypeSymbol }} = _String{"{{ .Type.RepresentationStrategy.GetDiscriminantKey }}"}
+			{{- range $member := .Type.Members }}
+			memberName__{{ dot.Type | TypeSymbol }}_{{ $member.Name }}_serial = _String{"{{ $member | dot.Type.RepresentationStrategy.GetDiscriminant }}"}
+			{{- end }}
+		)
+	`, w, adjCfg, data)
+}
+
+func emitUnionReprDiscriminated_memberHelper(w io.Writer, adjCfg *AdjunctCfg, data interface{}) {
+	// The read-side methods all start by figuring out which member is present;
+	//  this helper does that once, and yields both the discriminant and the member's representation node.
+	doTemplate(`
+		func (n *_{{ .Type | TypeSymbol }}__Repr) member() (*_String, ipld.Node) {
+			{{- if (eq (.AdjCfg.UnionMemlayout .Type) "embedAll") }}
+			switch n.tag {
+			{{- range $i, $member := .Type.Members }}
+			case {{ add $i 1 }}:
+				return &memberName__{{ dot.Type | TypeSymbol }}_{{ $member.Name }}_serial, n.x{{ add $i 1 }}.Representation()
+			{{- end}}
+			{{- else if (eq (.AdjCfg.UnionMemlayout .Type) "interface") }}
+			switch n2 := n.x.(type) {
+			{{- range $member := .Type.Members }}
+			case {{ $member | TypeSymbol }}:
+				return &memberName__{{ dot.Type | TypeSymbol }}_{{ $member.Name }}_serial, n2.Representation()
+			{{- end}}
+			{{- end}}
+			default:
+				panic("unreachable")
+			}
+		}
+	`, w, adjCfg, data)
+}
+
+func emitUnionReprDiscriminated_assemblerMemberHelpers(w io.Writer, adjCfg *AdjunctCfg, data interface{}) {
+	// 'chooseMember' is called when the discriminant arrives, and readies the child assembler for that member;
+	//  'memberAssembler' yields that child assembler afterwards.
+	doTemplate(`
+		func (ma *_{{ .Type | TypeSymbol }}__ReprAssembler) chooseMember(d string) error {
+			switch d {
+			{{- range $i, $member := .Type.Members }}
+			case "{{ $member | dot.Type.RepresentationStrategy.GetDiscriminant }}":
+				ma.ca = {{ add $i 1 }}
+				{{- if (eq (dot.AdjCfg.UnionMemlayout dot.Type) "embedAll") }}
+				ma.w.tag = {{ add $i 1 }}
+				ma.ca{{ add $i 1 }}.w = &ma.w.x{{ add $i 1 }}
+				ma.ca{{ add $i 1 }}.m = &ma.cm
+				{{- else if (eq (dot.AdjCfg.UnionMemlayout dot.Type) "interface") }}
+				x := &_{{ $member | TypeSymbol }}{}
+				ma.w.x = x
+				if ma.ca{{ add $i 1 }} == nil {
+					ma.ca{{ add $i 1 }} = &_{{ $member | TypeSymbol }}__ReprAssembler{}
+				}
+				ma.ca{{ add $i 1 }}.w = x
+				ma.ca{{ add $i 1 }}.m = &ma.cm
+				{{- end}}
+				return nil
+			{{- end}}
+			default:
+				return schema.ErrNotUnionStructure{TypeName: "{{ .PkgName }}.{{ .Type.Name }}.Repr", Detail: "unknown discriminant: " + d}
+			}
+		}
+		func (ma *_{{ .Type | TypeSymbol }}__ReprAssembler) memberAssembler() ipld.NodeAssembler {
+			switch ma.ca {
+			{{- range $i, $member := .Type.Members }}
+			case {{ add $i 1 }}:
+				return {{ if (eq (dot.AdjCfg.UnionMemlayout dot.Type) "embedAll") }}&{{end}}ma.ca{{ add $i 1 }}
+			{{- end}}
+			default:
+				panic("unreachable")
+			}
+		}
+	`, w, adjCfg, data)
+}
+
+// emitUnionReprDiscriminated_discriminantAssembler emits the assembler for the discriminant's value.
+// It's a type conversion of the map assembler (just like the key assembler is),
+// and calls back to an 'acceptDiscriminant' method on the map assembler, which each representation supplies.
+func emitUnionReprDiscriminated_discriminantAssembler(w io.Writer, adjCfg *AdjunctCfg, data interface{}, stubs mixins.StringAssemblerTraits) {
+	doTemplate(`
+		type _{{ .Type | TypeSymbol }}__ReprDiscriminantAssembler _{{ .Type | TypeSymbol }}__ReprAssembler
+	`, w, adjCfg, data)
+	stubs.EmitNodeAssemblerMethodBeginMap(w)
+	stubs.EmitNodeAssemblerMethodBeginList(w)
+	stubs.EmitNodeAssemblerMethodAssignNull(w)
+	stubs.EmitNodeAssemblerMethodAssignBool(w)
+	stubs.EmitNodeAssemblerMethodAssignInt(w)
+	stubs.EmitNodeAssemblerMethodAssignFloat(w)
+	doTemplate(`
+		func (da *_{{ .Type | TypeSymbol }}__ReprDiscriminantAssembler) AssignString(v string) error {
+			if da.state != maState_midValue {
+				panic("misuse: DiscriminantAssembler held beyond its valid lifetime")
+			}
+			return (*_{{ .Type | TypeSymbol }}__ReprAssembler)(da).acceptDiscriminant(v)
+		}
+	`, w, adjCfg, data)
+	stubs.EmitNodeAssemblerMethodAssignBytes(w)
+	stubs.EmitNodeAssemblerMethodAssignLink(w)
+	doTemplate(`
+		func (da *_{{ .Type | TypeSymbol }}__ReprDiscriminantAssembler) AssignNode(v ipld.Node) error {
+			if v2, err := v.AsString(); err != nil {
+				return err
+			} else {
+				return da.AssignString(v2)
+			}
+		}
+		func (_{{ .Type | TypeSymbol }}__ReprDiscriminantAssembler) Prototype() ipld.NodePrototype {
+			return _String__Prototype{}
+		}
+	`, w, adjCfg, data)
+}
+
+// emitUnionReprDiscriminated_keyAssembler emits the key assembler type and all its methods;
+// AssignString is given as a template by the caller,
+// which differs between the representations.
+func emitUnionReprDiscriminated_keyAssembler(w io.Writer, adjCfg *AdjunctCfg, data interface{}, stubs mixins.StringAssemblerTraits, assignString string) {
+	doTemplate(`
+		type _{{ .Type | TypeSymbol }}__ReprKeyAssembler _{{ .Type | TypeSymbol }}__ReprAssembler
+	`, w, adjCfg, data)
+	stubs.EmitNodeAssemblerMethodBeginMap(w)
+	stubs.EmitNodeAssemblerMethodBeginList(w)
+	stubs.EmitNodeAssemblerMethodAssignNull(w)
+	stubs.EmitNodeAssemblerMethodAssignBool(w)
+	stubs.EmitNodeAssemblerMethodAssignInt(w)
+	stubs.EmitNodeAssemblerMethodAssignFloat(w)
+	doTemplate(assignString, w, adjCfg, data)
+	stubs.EmitNodeAssemblerMethodAssignBytes(w)
+	stubs.EmitNodeAssemblerMethodAssignLink(w)
+	doTemplate(`
+		func (ka *_{{ .Type | TypeSymbol }}__ReprKeyAssembler) AssignNode(v ipld.Node) error {
+			if v2, err := v.AsString(); err != nil {
+				return err
+			} else {
+				return ka.AssignString(v2)
+			}
+		}
+		func (_{{ .Type | TypeSymbol }}__ReprKeyAssembler) Prototype() ipld.NodePrototype {
+			return _String__Prototype{}
+		}
+	`, w, adjCfg, data)
+}
+
+func emitUnionReprDiscriminated_assignNode(w io.Writer, adjCfg *AdjunctCfg, data interface{}) {
+	// Same as the keyed representation's method: anything other than our own type gets fed through as map entries.
+	doTemplate(`
+		func (na *_{{ .Type | TypeSymbol }}__ReprAssembler) AssignNode(v ipld.Node) error {
+			if v.IsNull() {
+				return na.AssignNull()
+			}
+			if v2, ok := v.(*_{{ .Type | TypeSymbol }}); ok {
+				switch *na.m {
+				case schema.Maybe_Value, schema.Maybe_Null:
+					panic("invalid state: cannot assign into assembler that's already finished")
+				case midvalue:
+					panic("invalid state: cannot assign null into an assembler that's already begun working on recursive structures!")
+				}
+				{{- if .Type | MaybeUsesPtr }}
+				if na.w == nil {
+					na.w = v2
+					*na.m = schema.Maybe_Value
+					return nil
+				}
+				{{- end}}
+				*na.w = *v2
+				*na.m = schema.Maybe_Value
+				return nil
+			}
+			if v.ReprKind() != ipld.ReprKind_Map {
+				return ipld.ErrWrongKind{TypeName: "{{ .PkgName }}.{{ .Type.Name }}.Repr", MethodName: "AssignNode", AppropriateKind: ipld.ReprKindSet_JustMap, ActualKind: v.ReprKind()}
+			}
+			itr := v.MapIterator()
+			for !itr.Done() {
+				k, v, err := itr.Next()
+				if err != nil {
+					return err
+				}
+				if err := na.AssembleKey().AssignNode(k); err != nil {
+					return err
+				}
+				if err := na.AssembleValue().AssignNode(v); err != nil {
+					return err
+				}
+			}
+			return na.Finish()
+		}
+	`, w, adjCfg, data)
+}
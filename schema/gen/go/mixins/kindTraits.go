@@ -140,7 +140,7 @@ func (g kindTraitsGenerator) emitNodeMethodAsInt(w io.Writer) {
 		panic("gen internals error: you should've overriden this")
 	}
 	doTemplate(`
-		func ({{ .TypeSymbol }}) AsInt() (int, error) {
+		func ({{ .TypeSymbol }}) AsInt() (int64, error) {
 			return mixins.{{ .Kind.String | title }}{"{{ .PkgName }}.{{ .TypeName }}"}.AsInt()
 		}
 	`, w, g)
@@ -256,7 +256,7 @@ func (g kindAssemblerTraitsGenerator) emitNodeAssemblerMethodAssignInt(w io.Writ
 		panic("gen internals error: you should've overriden this")
 	}
 	doTemplate(`
-		func ({{ .AppliedPrefix }}Assembler) AssignInt(int) error {
+		func ({{ .AppliedPrefix }}Assembler) AssignInt(int64) error {
 			return mixins.{{ .Kind.String | title }}Assembler{"{{ .PkgName }}.{{ .TypeName }}"}.AssignInt(0)
 		}
 	`, w, g)
@@ -20,6 +20,7 @@ func doTemplate(tmplstr string, w io.Writer, adjCfg *AdjunctCfg, data interface{
 			"FieldSymbolLower": adjCfg.FieldSymbolLower,
 			"FieldSymbolUpper": adjCfg.FieldSymbolUpper,
 			"MaybeUsesPtr":     adjCfg.MaybeUsesPtr,
+			"EnumMemberSymbol": adjCfg.EnumMemberSymbol,
 
 			// The whole AdjunctConfig can be accessed.
 			//  Access methods like UnionMemlayout through this, as e.g. `.AdjCfg.UnionMemlayout`.
@@ -41,7 +42,7 @@ func doTemplate(tmplstr string, w io.Writer, adjCfg *AdjunctCfg, data interface{
 				case ipld.ReprKind_Bool:
 					return "bool"
 				case ipld.ReprKind_Int:
-					return "int"
+					return "int64"
 				case ipld.ReprKind_Float:
 					return "float64"
 				case ipld.ReprKind_String:
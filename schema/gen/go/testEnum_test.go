@@ -0,0 +1,133 @@
+package gengo
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/warpfork/go-wish"
+
+	"github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/fluent"
+	"github.com/ipld/go-ipld-prime/must"
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+func TestEnum(t *testing.T) {
+	t.Parallel()
+
+	ts := schema.TypeSystem{}
+	ts.Init()
+	adjCfg := &AdjunctCfg{
+		maybeUsesPtr: map[schema.TypeName]bool{},
+	}
+	ts.Accumulate(schema.SpawnString("String"))
+	ts.Accumulate(schema.SpawnEnum("Color", []string{"Red", "Green"}))
+	ts.Accumulate(schema.SpawnMap("Map__Color__String",
+		"Color", "String", false))
+	ts.Accumulate(schema.SpawnStruct("Tagged",
+		[]schema.StructField{
+			schema.SpawnStructField("name", "String", false, false),
+			schema.SpawnStructField("color", "Color", false, false),
+		},
+		schema.SpawnStructRepresentationStringjoin(":"),
+	))
+
+	prefix := "enum"
+	pkgName := "main"
+	genAndCompileAndTest(t, prefix, pkgName, ts, adjCfg, func(t *testing.T, getPrototypeByName func(string) ipld.NodePrototype) {
+		np := getPrototypeByName("Color")
+		nrp := getPrototypeByName("Color.Repr")
+		t.Run("create member", func(t *testing.T) {
+			nb := np.NewBuilder()
+			Wish(t, nb.AssignString("Green"), ShouldEqual, nil)
+			n := nb.Build().(schema.TypedNode)
+			Wish(t, n.ReprKind(), ShouldEqual, ipld.ReprKind_String)
+			Wish(t, str(n), ShouldEqual, "Green")
+			t.Run("read representation", func(t *testing.T) {
+				nr := n.Representation()
+				Wish(t, nr.ReprKind(), ShouldEqual, ipld.ReprKind_String)
+				Wish(t, str(nr), ShouldEqual, "Green")
+			})
+			t.Run("repr-create", func(t *testing.T) {
+				nb := nrp.NewBuilder()
+				Wish(t, nb.AssignString("Green"), ShouldEqual, nil)
+				Wish(t, nb.Build(), ShouldEqual, n)
+			})
+		})
+		t.Run("create non-member is rejected", func(t *testing.T) {
+			for _, np := range []ipld.NodePrototype{np, nrp} {
+				nb := np.NewBuilder()
+				Wish(t, nb.AssignString("Blue"), ShouldEqual, schema.ErrNotEnumMember{TypeName: "main.Color", Value: "Blue"})
+				Wish(t, nb.AssignString("red"), ShouldEqual, schema.ErrNotEnumMember{TypeName: "main.Color", Value: "red"})
+				// The assembler isn't finished by a rejected value, so it can still be used.
+				Wish(t, nb.AssignString("Red"), ShouldEqual, nil)
+				Wish(t, str(nb.Build()), ShouldEqual, "Red")
+			}
+		})
+		t.Run("create null is rejected", func(t *testing.T) {
+			nb := np.NewBuilder()
+			Wish(t, nb.AssignNull(), ShouldBeSameTypeAs, ipld.ErrWrongKind{})
+		})
+		t.Run("map keys are checked", func(t *testing.T) {
+			np := getPrototypeByName("Map__Color__String")
+			n := fluent.MustBuildMap(np, 1, func(ma fluent.MapAssembler) {
+				ma.AssembleEntry("Red").AssignString("apple")
+			})
+			Wish(t, must.String(must.Node(n.LookupByString("Red"))), ShouldEqual, "apple")
+			_, err := n.LookupByString("Blue")
+			Wish(t, err, ShouldEqual, schema.ErrNotEnumMember{TypeName: "main.Color", Value: "Blue"})
+
+			nb := np.NewBuilder()
+			ma, err := nb.BeginMap(1)
+			Require(t, err, ShouldEqual, nil)
+			_, err = ma.AssembleEntry("Blue")
+			Wish(t, err, ShouldEqual, schema.ErrNotEnumMember{TypeName: "main.Color", Value: "Blue"})
+		})
+		t.Run("stringjoin struct fields are checked", func(t *testing.T) {
+			nrp := getPrototypeByName("Tagged.Repr")
+			nb := nrp.NewBuilder()
+			Wish(t, nb.AssignString("apple:Red"), ShouldEqual, nil)
+			Wish(t, must.String(must.Node(nb.Build().LookupByString("color"))), ShouldEqual, "Red")
+			nb = nrp.NewBuilder()
+			Wish(t, nb.AssignString("apple:Blue"), ShouldEqual, ipld.ErrUnmatchable{
+				TypeName: "main.Tagged.Repr",
+				Reason:   schema.ErrNotEnumMember{TypeName: "main.Color", Value: "Blue"},
+			})
+		})
+	})
+}
+
+func TestEnumMemberSymbolCollisions(t *testing.T) {
+	t.Parallel()
+
+	ts := schema.TypeSystem{}
+	ts.Init()
+	ts.Accumulate(schema.SpawnEnum("Punct", []string{"a-b", "a_b", "x.y", "x y"}))
+	typ := ts.TypeByName("Punct").(*schema.TypeEnum)
+
+	t.Run("colliding members are rejected", func(t *testing.T) {
+		defer func() {
+			Wish(t, fmt.Sprint(recover()), ShouldEqual, `enum members "a-b" and "a_b" of Punct would both get the symbol Punct_a_b; give one of them another with AdjunctCfg.EnumMemberSymbolOverrides`)
+		}()
+		NewEnumReprStringGenerator("main", typ, &AdjunctCfg{})
+	})
+
+	adjCfg := &AdjunctCfg{
+		maybeUsesPtr: map[schema.TypeName]bool{},
+		EnumMemberSymbolOverrides: map[EnumMemberTuple]string{
+			{"Punct", "a-b"}: "Punct_a_dash_b",
+			{"Punct", "x y"}: "Punct_x_space_y",
+		},
+	}
+	prefix := "enum-collisions"
+	pkgName := "main"
+	genAndCompileAndTest(t, prefix, pkgName, ts, adjCfg, func(t *testing.T, getPrototypeByName func(string) ipld.NodePrototype) {
+		t.Run("overridden members are all usable", func(t *testing.T) {
+			for _, member := range []string{"a-b", "a_b", "x.y", "x y"} {
+				nb := getPrototypeByName("Punct").NewBuilder()
+				Wish(t, nb.AssignString(member), ShouldEqual, nil)
+				Wish(t, str(nb.Build()), ShouldEqual, member)
+			}
+		})
+	})
+}
@@ -0,0 +1,94 @@
+package gengo
+
+import (
+	"testing"
+
+	. "github.com/warpfork/go-wish"
+
+	"github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+func TestStructReprMapImplicits(t *testing.T) {
+	t.Parallel()
+
+	ts := schema.TypeSystem{}
+	ts.Init()
+	adjCfg := &AdjunctCfg{
+		maybeUsesPtr: map[schema.TypeName]bool{},
+	}
+	ts.Accumulate(schema.SpawnString("String"))
+	ts.Accumulate(schema.SpawnInt("Int"))
+	ts.Accumulate(schema.SpawnList("List__String", "String", false))
+	ts.Accumulate(schema.SpawnMap("Map__String__String", "String", "String", false))
+	ts.Accumulate(schema.SpawnStruct("Defaulty",
+		[]schema.StructField{
+			schema.SpawnStructField("name", "String", false, false),
+			schema.SpawnStructField("kind", "String", false, false),
+			schema.SpawnStructField("count", "Int", false, false),
+			schema.SpawnStructField("tags", "List__String", false, false),
+			schema.SpawnStructField("meta", "Map__String__String", false, false),
+		},
+		schema.SpawnStructRepresentationMapWithImplicits(
+			map[string]string{"kind": "k"},
+			map[string]schema.ImplicitValue{
+				"kind":  schema.SpawnImplicitValueString("plain"),
+				"count": schema.SpawnImplicitValueInt(0),
+				"tags":  schema.ImplicitValue_EmptyList{},
+				"meta":  schema.ImplicitValue_EmptyMap{},
+			},
+		),
+	))
+
+	specs := []testcase{
+		{
+			name:     "AllImplicit",
+			typeJson: `{"name":"x","kind":"plain","count":0,"tags":[],"meta":{}}`,
+			reprJson: `{"name":"x"}`,
+			typePoints: []testcasePoint{
+				{"kind", "plain"},
+				{"count", 0},
+				{"tags", ipld.ReprKind_List},
+				{"meta", ipld.ReprKind_Map},
+			},
+		},
+		{
+			name:     "NoneImplicit",
+			typeJson: `{"name":"x","kind":"fancy","count":2,"tags":["a"],"meta":{"b":"c"}}`,
+			reprJson: `{"name":"x","k":"fancy","count":2,"tags":["a"],"meta":{"b":"c"}}`,
+			reprPoints: []testcasePoint{
+				{"k", "fancy"},
+				{"count", 2},
+				{"tags/0", "a"},
+				{"meta/b", "c"},
+			},
+		},
+		{
+			name:     "SomeImplicit",
+			typeJson: `{"name":"x","kind":"plain","count":2,"tags":[],"meta":{"b":"c"}}`,
+			reprJson: `{"name":"x","count":2,"meta":{"b":"c"}}`,
+		},
+	}
+
+	prefix := "struct-map-implicits"
+	pkgName := "main"
+	genAndCompileAndTest(t, prefix, pkgName, ts, adjCfg, func(t *testing.T, getPrototypeByName func(string) ipld.NodePrototype) {
+		np := getPrototypeByName("Defaulty")
+		nrp := getPrototypeByName("Defaulty.Repr")
+		for _, tcase := range specs {
+			tcase.Test(t, np, nrp)
+		}
+		t.Run("implicit values are left out of the representation", func(t *testing.T) {
+			nr := testUnmarshal(t, np, specs[0].typeJson, nil).(schema.TypedNode).Representation()
+			Wish(t, nr.Length(), ShouldEqual, 1)
+			_, err := nr.LookupByString("k")
+			Wish(t, err, ShouldBeSameTypeAs, ipld.ErrNotExists{})
+			_, err = nr.LookupByString("tags")
+			Wish(t, err, ShouldBeSameTypeAs, ipld.ErrNotExists{})
+		})
+		t.Run("implicit values given explicitly are accepted", func(t *testing.T) {
+			n := testUnmarshal(t, nrp, `{"name":"x","k":"plain","count":0,"tags":[],"meta":{}}`, nil)
+			Wish(t, n, ShouldEqual, testUnmarshal(t, nrp, specs[0].reprJson, nil))
+		})
+	})
+}
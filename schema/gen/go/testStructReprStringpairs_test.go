@@ -0,0 +1,77 @@
+package gengo
+
+import (
+	"testing"
+
+	. "github.com/warpfork/go-wish"
+
+	"github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/fluent"
+	"github.com/ipld/go-ipld-prime/must"
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+func TestStructReprStringpairs(t *testing.T) {
+	t.Parallel()
+
+	prefix := "structstrpairs"
+	pkgName := "main"
+
+	ts := schema.TypeSystem{}
+	ts.Init()
+	adjCfg := &AdjunctCfg{
+		maybeUsesPtr: map[schema.TypeName]bool{},
+	}
+	ts.Accumulate(schema.SpawnString("String"))
+	ts.Accumulate(schema.SpawnStruct("Params",
+		[]schema.StructField{
+			schema.SpawnStructField("a", "String", false, false),
+			schema.SpawnStructField("b", "String", true, false),
+		},
+		schema.SpawnStructRepresentationStringPairs("=", ","),
+	))
+
+	genAndCompileAndTest(t, prefix, pkgName, ts, adjCfg, func(t *testing.T, getPrototypeByName func(string) ipld.NodePrototype) {
+		np := getPrototypeByName("Params")
+		nrp := getPrototypeByName("Params.Repr")
+		t.Run("all fields", func(t *testing.T) {
+			n := fluent.MustBuildMap(np, 2, func(ma fluent.MapAssembler) {
+				ma.AssembleEntry("a").AssignString("1")
+				ma.AssembleEntry("b").AssignString("2")
+			}).(schema.TypedNode)
+			t.Run("repr-read", func(t *testing.T) {
+				nr := n.Representation()
+				Require(t, nr.ReprKind(), ShouldEqual, ipld.ReprKind_String)
+				Wish(t, must.String(nr), ShouldEqual, "a=1,b=2")
+			})
+			t.Run("repr-create", func(t *testing.T) {
+				for _, s := range []string{"a=1,b=2", "b=2,a=1"} {
+					nb := nrp.NewBuilder()
+					Require(t, nb.AssignString(s), ShouldEqual, nil)
+					Wish(t, nb.Build(), ShouldEqual, n)
+				}
+			})
+		})
+		t.Run("optional field absent", func(t *testing.T) {
+			n := fluent.MustBuildMap(np, 1, func(ma fluent.MapAssembler) {
+				ma.AssembleEntry("a").AssignString("1")
+			}).(schema.TypedNode)
+			Wish(t, must.String(n.Representation()), ShouldEqual, "a=1")
+			nb := nrp.NewBuilder()
+			Require(t, nb.AssignString("a=1"), ShouldEqual, nil)
+			nr := nb.Build()
+			Wish(t, nr, ShouldEqual, n)
+			Wish(t, must.Node(nr.LookupByString("b")), ShouldEqual, ipld.Absent)
+		})
+		t.Run("parse errors", func(t *testing.T) {
+			nb := nrp.NewBuilder()
+			err := nb.AssignString("a:1")
+			Wish(t, err, ShouldBeSameTypeAs, ipld.ErrUnmatchable{})
+			Wish(t, err.Error(), ShouldEqual, `parsing of main.Params.Repr rejected: entry "a:1" does not contain the delimiter "="`)
+			Wish(t, nb.AssignString("a=1,c=3"), ShouldBeSameTypeAs, ipld.ErrInvalidKey{})
+			Wish(t, nb.AssignString("a=1,a=2"), ShouldBeSameTypeAs, ipld.ErrRepeatedMapKey{})
+			Wish(t, nb.AssignString("b=2"), ShouldEqual, ipld.ErrMissingRequiredField{TypeName: "main.Params.Repr", Missing: []string{"a"}})
+			Wish(t, nb.AssignString(""), ShouldEqual, ipld.ErrMissingRequiredField{TypeName: "main.Params.Repr", Missing: []string{"a"}})
+		})
+	})
+}
@@ -0,0 +1,106 @@
+package gengo
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/warpfork/go-wish"
+
+	"github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+func TestStructReprTupleImplicits(t *testing.T) {
+	t.Parallel()
+
+	ts := schema.TypeSystem{}
+	ts.Init()
+	adjCfg := &AdjunctCfg{
+		maybeUsesPtr: map[schema.TypeName]bool{},
+	}
+	ts.Accumulate(schema.SpawnString("String"))
+	ts.Accumulate(schema.SpawnInt("Int"))
+	ts.Accumulate(schema.SpawnList("List__String", "String", false))
+	ts.Accumulate(schema.SpawnStruct("Defaulty",
+		[]schema.StructField{
+			schema.SpawnStructField("name", "String", false, false),
+			schema.SpawnStructField("kind", "String", false, false),
+			schema.SpawnStructField("count", "Int", false, false),
+			schema.SpawnStructField("tags", "List__String", false, false),
+		},
+		schema.SpawnStructRepresentationTupleWithImplicits(map[string]schema.ImplicitValue{
+			"kind":  schema.SpawnImplicitValueString("plain"),
+			"count": schema.SpawnImplicitValueInt(0),
+			"tags":  schema.ImplicitValue_EmptyList{},
+		}),
+	))
+
+	specs := []testcase{
+		{
+			name:     "AllImplicit",
+			typeJson: `{"name":"x","kind":"plain","count":0,"tags":[]}`,
+			reprJson: `["x"]`,
+			typePoints: []testcasePoint{
+				{"kind", "plain"},
+				{"count", 0},
+				{"tags", ipld.ReprKind_List},
+			},
+		},
+		{
+			name:     "NoneImplicit",
+			typeJson: `{"name":"x","kind":"fancy","count":2,"tags":["a"]}`,
+			reprJson: `["x","fancy",2,["a"]]`,
+			reprPoints: []testcasePoint{
+				{"1", "fancy"},
+				{"2", 2},
+				{"3/0", "a"},
+			},
+		},
+		{
+			name:     "ImplicitsBeforeAValueAreKept",
+			typeJson: `{"name":"x","kind":"plain","count":2,"tags":[]}`,
+			reprJson: `["x","plain",2]`,
+		},
+	}
+
+	t.Run("implicits on fields which aren't at the end are rejected", func(t *testing.T) {
+		var ts schema.TypeSystem
+		ts.Init()
+		ts.Accumulate(schema.SpawnString("String"))
+		ts.Accumulate(schema.SpawnStruct("Bad",
+			[]schema.StructField{
+				schema.SpawnStructField("a", "String", false, false),
+				schema.SpawnStructField("b", "String", false, false),
+			},
+			schema.SpawnStructRepresentationTupleWithImplicits(map[string]schema.ImplicitValue{
+				"a": schema.SpawnImplicitValueString("z"),
+			}),
+		))
+		defer func() {
+			Wish(t, fmt.Sprint(recover()), ShouldEqual, "implicits in the tuple representation are only supported on fields which are followed only by optional fields or fields with implicits (field a of Bad)")
+		}()
+		NewStructReprTupleGenerator("main", ts.TypeByName("Bad").(*schema.TypeStruct), &AdjunctCfg{})
+	})
+
+	prefix := "struct-tuple-implicits"
+	pkgName := "main"
+	genAndCompileAndTest(t, prefix, pkgName, ts, adjCfg, func(t *testing.T, getPrototypeByName func(string) ipld.NodePrototype) {
+		np := getPrototypeByName("Defaulty")
+		nrp := getPrototypeByName("Defaulty.Repr")
+		for _, tcase := range specs {
+			tcase.Test(t, np, nrp)
+		}
+		t.Run("implicit values are left off the end of the representation", func(t *testing.T) {
+			nr := testUnmarshal(t, np, specs[0].typeJson, nil).(schema.TypedNode).Representation()
+			Wish(t, nr.Length(), ShouldEqual, 1)
+			_, err := nr.LookupByIndex(1)
+			Wish(t, err, ShouldBeSameTypeAs, ipld.ErrNotExists{})
+			_, err = nr.LookupByIndex(3)
+			Wish(t, err, ShouldBeSameTypeAs, ipld.ErrNotExists{})
+		})
+		t.Run("implicit values given explicitly are accepted", func(t *testing.T) {
+			n := testUnmarshal(t, nrp, `["x","plain"]`, nil)
+			Wish(t, n, ShouldEqual, testUnmarshal(t, nrp, specs[0].reprJson, nil))
+		})
+	})
+}
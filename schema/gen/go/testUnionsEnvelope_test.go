@@ -0,0 +1,134 @@
+package gengo
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/polydawn/refmt/json"
+	. "github.com/warpfork/go-wish"
+
+	"github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/codec/dagjson"
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+func TestUnionEnvelope(t *testing.T) {
+	t.Parallel()
+
+	ts := schema.TypeSystem{}
+	ts.Init()
+	adjCfg := &AdjunctCfg{}
+	ts.Accumulate(schema.SpawnString("String"))
+	ts.Accumulate(schema.SpawnStruct("SmolStruct",
+		[]schema.StructField{
+			schema.SpawnStructField("s", "String", false, false),
+		},
+		schema.SpawnStructRepresentationMap(map[string]string{
+			"s": "q",
+		}),
+	))
+	ts.Accumulate(schema.SpawnUnion("WheeUnion",
+		[]schema.TypeName{
+			"String",
+			"SmolStruct",
+		},
+		schema.SpawnUnionRepresentationEnvelope("tag", "content", map[string]schema.TypeName{
+			"a": "String",
+			"b": "SmolStruct",
+		}),
+	))
+
+	specs := []testcase{
+		{
+			name:     "InhabitantA",
+			typeJson: `{"String":"whee"}`,
+			reprJson: `{"tag":"a","content":"whee"}`,
+			typePoints: []testcasePoint{
+				{"", ipld.ReprKind_Map},
+				{"String", "whee"},
+			},
+			reprPoints: []testcasePoint{
+				{"", ipld.ReprKind_Map},
+				{"tag", "a"},
+				{"content", "whee"},
+			},
+		},
+		{
+			name:     "InhabitantB",
+			typeJson: `{"SmolStruct":{"s":"whee"}}`,
+			reprJson: `{"tag":"b","content":{"q":"whee"}}`,
+			typePoints: []testcasePoint{
+				{"", ipld.ReprKind_Map},
+				{"SmolStruct", ipld.ReprKind_Map},
+				{"SmolStruct/s", "whee"},
+			},
+			reprPoints: []testcasePoint{
+				{"", ipld.ReprKind_Map},
+				{"tag", "b"},
+				{"content", ipld.ReprKind_Map},
+				{"content/q", "whee"},
+			},
+		},
+	}
+
+	test := func(t *testing.T, getPrototypeByName func(string) ipld.NodePrototype) {
+		np := getPrototypeByName("WheeUnion")
+		nrp := getPrototypeByName("WheeUnion.Repr")
+		for _, tcase := range specs {
+			tcase.Test(t, np, nrp)
+		}
+		t.Run("content before discriminant", func(t *testing.T) {
+			for _, tcase := range []struct{ typeJson, reprJson string }{
+				{`{"String":"whee"}`, `{"content":"whee","tag":"a"}`},
+				{`{"SmolStruct":{"s":"whee"}}`, `{"content":{"q":"whee"},"tag":"b"}`},
+			} {
+				n := testUnmarshal(t, np, tcase.typeJson, nil)
+				Wish(t, testUnmarshal(t, nrp, tcase.reprJson, nil), ShouldEqual, n)
+			}
+		})
+		t.Run("content before discriminant is checked", func(t *testing.T) {
+			nb := nrp.NewBuilder()
+			err := dagjson.Unmarshal(nb, json.NewDecoder(strings.NewReader(`{"content":{"z":"whee"},"tag":"b"}`)))
+			Wish(t, err, ShouldBeSameTypeAs, ipld.ErrInvalidKey{})
+		})
+		t.Run("unknown discriminant", func(t *testing.T) {
+			nb := nrp.NewBuilder()
+			err := dagjson.Unmarshal(nb, json.NewDecoder(strings.NewReader(`{"tag":"c","content":"whee"}`)))
+			Wish(t, err, ShouldEqual, schema.ErrNotUnionStructure{TypeName: "main.WheeUnion.Repr", Detail: "unknown discriminant: c"})
+		})
+		t.Run("unknown key", func(t *testing.T) {
+			nb := nrp.NewBuilder()
+			err := dagjson.Unmarshal(nb, json.NewDecoder(strings.NewReader(`{"tag":"b","other":{"q":"whee"}}`)))
+			Wish(t, err, ShouldBeSameTypeAs, ipld.ErrInvalidKey{})
+		})
+		t.Run("missing content", func(t *testing.T) {
+			nb := nrp.NewBuilder()
+			err := dagjson.Unmarshal(nb, json.NewDecoder(strings.NewReader(`{"tag":"b"}`)))
+			Wish(t, err, ShouldEqual, schema.ErrNotUnionStructure{TypeName: "main.WheeUnion.Repr", Detail: "missing content key: content"})
+		})
+		t.Run("missing discriminant", func(t *testing.T) {
+			nb := nrp.NewBuilder()
+			err := dagjson.Unmarshal(nb, json.NewDecoder(strings.NewReader(`{"content":"whee"}`)))
+			Wish(t, err, ShouldEqual, schema.ErrNotUnionStructure{TypeName: "main.WheeUnion.Repr", Detail: "missing discriminant key: tag"})
+		})
+	}
+
+	t.Run("union-using-embed", func(t *testing.T) {
+		adjCfg.CfgUnionMemlayout = map[schema.TypeName]string{"WheeUnion": "embedAll"}
+
+		prefix := "union-envelope-using-embed"
+		pkgName := "main"
+		genAndCompileAndTest(t, prefix, pkgName, ts, adjCfg, func(t *testing.T, getPrototypeByName func(string) ipld.NodePrototype) {
+			test(t, getPrototypeByName)
+		})
+	})
+	t.Run("union-using-interface", func(t *testing.T) {
+		adjCfg.CfgUnionMemlayout = map[schema.TypeName]string{"WheeUnion": "interface"}
+
+		prefix := "union-envelope-using-interface"
+		pkgName := "main"
+		genAndCompileAndTest(t, prefix, pkgName, ts, adjCfg, func(t *testing.T, getPrototypeByName func(string) ipld.NodePrototype) {
+			test(t, getPrototypeByName)
+		})
+	})
+}
@@ -0,0 +1,136 @@
+package gengo
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/polydawn/refmt/json"
+	. "github.com/warpfork/go-wish"
+
+	"github.com/ipld/go-ipld-prime"
+	"github.com/ipld/go-ipld-prime/codec/dagjson"
+	"github.com/ipld/go-ipld-prime/schema"
+)
+
+func TestUnionInline(t *testing.T) {
+	t.Parallel()
+
+	ts := schema.TypeSystem{}
+	ts.Init()
+	adjCfg := &AdjunctCfg{}
+	ts.Accumulate(schema.SpawnString("String"))
+	ts.Accumulate(schema.SpawnStruct("Foo",
+		[]schema.StructField{
+			schema.SpawnStructField("s", "String", false, false),
+		},
+		schema.SpawnStructRepresentationMap(map[string]string{
+			"s": "q",
+		}),
+	))
+	ts.Accumulate(schema.SpawnStruct("Bar",
+		[]schema.StructField{
+			schema.SpawnStructField("x", "String", false, false),
+			schema.SpawnStructField("y", "String", false, false),
+		},
+		schema.SpawnStructRepresentationMap(nil),
+	))
+	ts.Accumulate(schema.SpawnUnion("WheeUnion",
+		[]schema.TypeName{
+			"Foo",
+			"Bar",
+		},
+		schema.SpawnUnionRepresentationInline("kind", map[string]schema.TypeName{
+			"foo": "Foo",
+			"bar": "Bar",
+		}),
+	))
+
+	specs := []testcase{
+		{
+			name:     "InhabitantFoo",
+			typeJson: `{"Foo":{"s":"whee"}}`,
+			reprJson: `{"kind":"foo","q":"whee"}`,
+			typePoints: []testcasePoint{
+				{"", ipld.ReprKind_Map},
+				{"Foo/s", "whee"},
+			},
+			reprPoints: []testcasePoint{
+				{"", ipld.ReprKind_Map},
+				{"kind", "foo"},
+				{"q", "whee"},
+			},
+		},
+		{
+			name:     "InhabitantBar",
+			typeJson: `{"Bar":{"x":"1","y":"2"}}`,
+			reprJson: `{"kind":"bar","x":"1","y":"2"}`,
+			typePoints: []testcasePoint{
+				{"", ipld.ReprKind_Map},
+				{"Bar/x", "1"},
+				{"Bar/y", "2"},
+			},
+			reprPoints: []testcasePoint{
+				{"", ipld.ReprKind_Map},
+				{"kind", "bar"},
+				{"x", "1"},
+				{"y", "2"},
+			},
+		},
+	}
+
+	test := func(t *testing.T, getPrototypeByName func(string) ipld.NodePrototype) {
+		np := getPrototypeByName("WheeUnion")
+		nrp := getPrototypeByName("WheeUnion.Repr")
+		for _, tcase := range specs {
+			tcase.Test(t, np, nrp)
+		}
+		t.Run("repr length", func(t *testing.T) {
+			n := testUnmarshal(t, nrp, `{"kind":"bar","x":"1","y":"2"}`, nil)
+			Wish(t, n.(schema.TypedNode).Representation().Length(), ShouldEqual, 3)
+		})
+		t.Run("discriminant after other entries", func(t *testing.T) {
+			n := testUnmarshal(t, np, `{"Bar":{"x":"1","y":"2"}}`, nil)
+			Wish(t, testUnmarshal(t, nrp, `{"x":"1","y":"2","kind":"bar"}`, nil), ShouldEqual, n)
+			Wish(t, testUnmarshal(t, nrp, `{"x":"1","kind":"bar","y":"2"}`, nil), ShouldEqual, n)
+		})
+		t.Run("buffered entries are checked", func(t *testing.T) {
+			nb := nrp.NewBuilder()
+			err := dagjson.Unmarshal(nb, json.NewDecoder(strings.NewReader(`{"z":"1","kind":"bar"}`)))
+			Wish(t, err, ShouldBeSameTypeAs, ipld.ErrInvalidKey{})
+		})
+		t.Run("repeated discriminant", func(t *testing.T) {
+			nb := nrp.NewBuilder()
+			err := dagjson.Unmarshal(nb, json.NewDecoder(strings.NewReader(`{"kind":"foo","kind":"foo","q":"whee"}`)))
+			Wish(t, err, ShouldBeSameTypeAs, ipld.ErrRepeatedMapKey{})
+		})
+		t.Run("unknown discriminant", func(t *testing.T) {
+			nb := nrp.NewBuilder()
+			err := dagjson.Unmarshal(nb, json.NewDecoder(strings.NewReader(`{"kind":"baz","q":"whee"}`)))
+			Wish(t, err, ShouldEqual, schema.ErrNotUnionStructure{TypeName: "main.WheeUnion.Repr", Detail: "unknown discriminant: baz"})
+		})
+		t.Run("missing discriminant", func(t *testing.T) {
+			nb := nrp.NewBuilder()
+			err := dagjson.Unmarshal(nb, json.NewDecoder(strings.NewReader(`{"q":"whee"}`)))
+			Wish(t, err, ShouldEqual, schema.ErrNotUnionStructure{TypeName: "main.WheeUnion.Repr", Detail: "missing discriminant key: kind"})
+		})
+	}
+
+	t.Run("union-using-embed", func(t *testing.T) {
+		adjCfg.CfgUnionMemlayout = map[schema.TypeName]string{"WheeUnion": "embedAll"}
+
+		prefix := "union-inline-using-embed"
+		pkgName := "main"
+		genAndCompileAndTest(t, prefix, pkgName, ts, adjCfg, func(t *testing.T, getPrototypeByName func(string) ipld.NodePrototype) {
+			test(t, getPrototypeByName)
+		})
+	})
+	t.Run("union-using-interface", func(t *testing.T) {
+		adjCfg.CfgUnionMemlayout = map[schema.TypeName]string{"WheeUnion": "interface"}
+
+		prefix := "union-inline-using-interface"
+		pkgName := "main"
+		genAndCompileAndTest(t, prefix, pkgName, ts, adjCfg, func(t *testing.T, getPrototypeByName func(string) ipld.NodePrototype) {
+			test(t, getPrototypeByName)
+		})
+	})
+}
@@ -262,7 +262,7 @@ func closeEnough(actual, expected interface{}) (string, bool) {
 			return fmt.Sprintf("expected something with kind int, got kind %s", a.ReprKind()), false
 		}
 		x, _ := a.AsInt()
-		return ShouldEqual(x, expected)
+		return ShouldEqual(x, int64(expected.(int)))
 	case ipld.Node:
 		return ShouldEqual(actual, expected)
 	default:
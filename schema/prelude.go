@@ -0,0 +1,44 @@
+package schema
+
+// prelude holds the types which may be used without declaring them.
+var prelude = map[TypeName]func(TypeName) Type{
+	"Bool":   func(n TypeName) Type { return SpawnBool(n) },
+	"String": func(n TypeName) Type { return SpawnString(n) },
+	"Bytes":  func(n TypeName) Type { return SpawnBytes(n) },
+	"Int":    func(n TypeName) Type { return SpawnInt(n) },
+	"Float":  func(n TypeName) Type { return SpawnFloat(n) },
+	"Link":   func(n TypeName) Type { return SpawnLink(n) },
+}
+
+// SpawnPreludeType returns a new type called 'name',
+// of the same kind as the prelude type called 'preludeName'
+// (one of Bool, String, Bytes, Int, Float, or Link).
+// The prelude types are the ones a schema may use without declaring them.
+//
+// If there's no prelude type called 'preludeName', it returns nil.
+func SpawnPreludeType(preludeName TypeName, name TypeName) Type {
+	spawn, ok := prelude[preludeName]
+	if !ok {
+		return nil
+	}
+	return spawn(name)
+}
+
+// AnonymousListTypeName returns the name used for a list type
+// which is used without being declared, such as one written inline as the type of a struct field.
+func AnonymousListTypeName(valueType TypeName, nullable bool) TypeName {
+	return "List__" + nullablePrefix(nullable) + valueType
+}
+
+// AnonymousMapTypeName returns the name used for a map type
+// which is used without being declared, such as one written inline as the type of a struct field.
+func AnonymousMapTypeName(keyType TypeName, valueType TypeName, nullable bool) TypeName {
+	return "Map__" + keyType + "__" + nullablePrefix(nullable) + valueType
+}
+
+func nullablePrefix(nullable bool) TypeName {
+	if nullable {
+		return "nullable"
+	}
+	return ""
+}
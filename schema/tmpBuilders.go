@@ -82,6 +82,12 @@ func SpawnStruct(name TypeName, fields []StructField, repr StructRepresentation)
 				panic("neither nullable nor optional is supported on struct stringjoin representation")
 			}
 		}
+	case StructRepresentation_StringPairs:
+		for _, f := range fields {
+			if f.IsNullable() {
+				panic("nullable is not supported on struct stringpairs representation")
+			}
+		}
 	}
 	return v
 }
@@ -91,12 +97,33 @@ func SpawnStructField(name string, typ TypeName, optional bool, nullable bool) S
 func SpawnStructRepresentationMap(renames map[string]string) StructRepresentation_Map {
 	return StructRepresentation_Map{renames, nil}
 }
+func SpawnStructRepresentationMapWithImplicits(renames map[string]string, implicits map[string]ImplicitValue) StructRepresentation_Map {
+	return StructRepresentation_Map{renames, implicits}
+}
 func SpawnStructRepresentationTuple() StructRepresentation_Tuple {
-	return StructRepresentation_Tuple{}
+	return StructRepresentation_Tuple{nil}
+}
+
+// SpawnStructRepresentationTupleWithImplicits is like SpawnStructRepresentationTuple, but gives fields implicit values.
+// Since list entries are matched to fields by position, a field can only be left out of the list
+// if every field after it is left out too: so a field with an implicit is left out when its value is the implicit
+// and all the fields after it are absent or have their implicit values.
+func SpawnStructRepresentationTupleWithImplicits(implicits map[string]ImplicitValue) StructRepresentation_Tuple {
+	return StructRepresentation_Tuple{implicits}
 }
 func SpawnStructRepresentationStringjoin(delim string) StructRepresentation_Stringjoin {
 	return StructRepresentation_Stringjoin{delim}
 }
+func SpawnStructRepresentationStringPairs(innerDelim string, entryDelim string) StructRepresentation_StringPairs {
+	return StructRepresentation_StringPairs{innerDelim, entryDelim}
+}
+
+func SpawnImplicitValueString(x string) ImplicitValue_String {
+	return ImplicitValue_String{x}
+}
+func SpawnImplicitValueInt(x int64) ImplicitValue_Int {
+	return ImplicitValue_Int{x}
+}
 
 func SpawnUnion(name TypeName, members []TypeName, repr UnionRepresentation) *TypeUnion {
 	return &TypeUnion{typeBase{name, nil}, members, repr}
@@ -107,6 +134,16 @@ func SpawnUnionRepresentationKeyed(table map[string]TypeName) UnionRepresentatio
 func SpawnUnionRepresentationKinded(table map[ipld.ReprKind]TypeName) UnionRepresentation_Kinded {
 	return UnionRepresentation_Kinded{table}
 }
+func SpawnUnionRepresentationEnvelope(discriminantKey string, contentKey string, table map[string]TypeName) UnionRepresentation_Envelope {
+	return UnionRepresentation_Envelope{discriminantKey, contentKey, table}
+}
+func SpawnUnionRepresentationInline(discriminantKey string, table map[string]TypeName) UnionRepresentation_Inline {
+	return UnionRepresentation_Inline{discriminantKey, table}
+}
+
+func SpawnEnum(name TypeName, members []string) *TypeEnum {
+	return &TypeEnum{typeBase{name, nil}, members}
+}
 
 // The methods relating to TypeSystem are also mutation-heavy and placeholdery.
 
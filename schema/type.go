@@ -219,7 +219,9 @@ type StructRepresentation_Map struct {
 	renames   map[string]string
 	implicits map[string]ImplicitValue
 }
-type StructRepresentation_Tuple struct{}
+type StructRepresentation_Tuple struct {
+	implicits map[string]ImplicitValue
+}
 type StructRepresentation_StringPairs struct{ sep1, sep2 string }
 type StructRepresentation_Stringjoin struct{ sep string }
 
@@ -234,7 +236,12 @@ type TypeEnum struct {
 // but if so, only its empty value is valid here).
 type ImplicitValue interface{ _ImplicitValue() }
 
+func (ImplicitValue_EmptyList) _ImplicitValue() {}
+func (ImplicitValue_EmptyMap) _ImplicitValue()  {}
+func (ImplicitValue_String) _ImplicitValue()    {}
+func (ImplicitValue_Int) _ImplicitValue()       {}
+
 type ImplicitValue_EmptyList struct{}
 type ImplicitValue_EmptyMap struct{}
 type ImplicitValue_String struct{ x string }
-type ImplicitValue_Int struct{ x int }
+type ImplicitValue_Int struct{ x int64 }
@@ -154,6 +154,40 @@ func (r UnionRepresentation_Kinded) GetMember(k ipld.ReprKind) TypeName {
 	return r.table[k]
 }
 
+// GetDiscriminantKey returns the map key which holds the discriminant.
+func (r UnionRepresentation_Envelope) GetDiscriminantKey() string {
+	return r.discriminantKey
+}
+
+// GetContentKey returns the map key which holds the content of the member.
+func (r UnionRepresentation_Envelope) GetContentKey() string {
+	return r.contentKey
+}
+
+func (r UnionRepresentation_Envelope) GetDiscriminant(t Type) string {
+	for d, t2 := range r.table {
+		if t2 == t.Name() {
+			return d
+		}
+	}
+	panic("that type isn't a member of this union")
+}
+
+// GetDiscriminantKey returns the map key which holds the discriminant.
+// The discriminant is found in the same map as the member's own fields.
+func (r UnionRepresentation_Inline) GetDiscriminantKey() string {
+	return r.discriminantKey
+}
+
+func (r UnionRepresentation_Inline) GetDiscriminant(t Type) string {
+	for d, t2 := range r.table {
+		if t2 == t.Name() {
+			return d
+		}
+	}
+	panic("that type isn't a member of this union")
+}
+
 // Fields returns a slice of descriptions of the object's fields.
 func (t TypeStruct) Fields() []StructField {
 	a := make([]StructField, len(t.fields))
@@ -226,10 +260,38 @@ func (r StructRepresentation_Map) GetFieldKey(field StructField) string {
 	return field.name
 }
 
+// GetImplicit returns the implicit value for a field,
+// or nil if the field has no implicit value.
+func (r StructRepresentation_Map) GetImplicit(field StructField) ImplicitValue {
+	return r.implicits[field.name]
+}
+
+// GetImplicit returns the implicit value for a field,
+// or nil if the field has no implicit value.
+//
+// In the tuple representation, a field's implicit can only be left out
+// when the field is at the end of the list; see SpawnStructRepresentationTupleWithImplicits.
+func (r StructRepresentation_Tuple) GetImplicit(field StructField) ImplicitValue {
+	return r.implicits[field.name]
+}
+
 func (r StructRepresentation_Stringjoin) GetDelim() string {
 	return r.sep
 }
 
+// GetInnerDelim returns the delimiter between a key and its value.
+func (r StructRepresentation_StringPairs) GetInnerDelim() string {
+	return r.sep1
+}
+
+// GetEntryDelim returns the delimiter between one key-value pair and the next.
+func (r StructRepresentation_StringPairs) GetEntryDelim() string {
+	return r.sep2
+}
+
+func (iv ImplicitValue_String) String() string { return iv.x }
+func (iv ImplicitValue_Int) Int() int64        { return iv.x }
+
 // Members returns a slice the strings which are valid inhabitants of this enum.
 func (t TypeEnum) Members() []string {
 	a := make([]string, len(t.members))
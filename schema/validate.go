@@ -1,5 +1,12 @@
 package schema
 
+import (
+	"fmt"
+	"strings"
+
+	ipld "github.com/ipld/go-ipld-prime"
+)
+
 /*
 	Okay, so.  There are several fun considerations for a "validate" method.
 
@@ -68,3 +75,370 @@ package schema
 	returns *only* errors: only then we can have it in the schema package.
 
 */
+
+// Validate checks whether a node matches the representation of the named type.
+//
+// The node is expected to be plain Data Model: for example, a basicnode tree
+// produced by decoding some serial data.  (It's the representation of the type
+// that's checked, so e.g. a struct with a tuple representation should be a list.)
+//
+// All the problems found are returned, each as an ErrInvalidData which says
+// where in the node tree the problem is.  Validate keeps going after the first
+// problem where it can: every field of a struct and every entry of a map or
+// list is checked.  It doesn't look inside a union whose discriminant doesn't
+// match any member, though; there's nothing sensible to compare the content to.
+//
+// A nil return means the node matches.
+func Validate(ts *TypeSystem, typeName TypeName, node ipld.Node) []error {
+	t := ts.TypeByName(string(typeName))
+	if t == nil {
+		return []error{fmt.Errorf("cannot validate: no type named %s in the type system", typeName)}
+	}
+	var v validator
+	v.validate(t, ipld.Path{}, node)
+	return v.errs
+}
+
+type validator struct {
+	errs []error
+}
+
+func (v *validator) reject(t Type, path ipld.Path, format string, args ...interface{}) {
+	v.errs = append(v.errs, ErrInvalidData{path, t.Name(), fmt.Sprintf(format, args...)})
+}
+
+// checkKind rejects the node if it's not of the kind wanted, and returns whether it was.
+func (v *validator) checkKind(t Type, path ipld.Path, n ipld.Node, want ipld.ReprKind) bool {
+	if n.ReprKind() != want {
+		v.reject(t, path, "expected %s, got %s", want, n.ReprKind())
+		return false
+	}
+	return true
+}
+
+func (v *validator) validate(t Type, path ipld.Path, n ipld.Node) {
+	switch t2 := t.(type) {
+	case *TypeBool, *TypeInt, *TypeFloat, *TypeBytes, *TypeLink:
+		v.checkKind(t, path, n, t.RepresentationBehavior())
+	case *TypeString, *TypeEnum:
+		v.validateFromNode(t, path, n)
+	case *TypeMap:
+		v.validateMap(t2, path, n)
+	case *TypeList:
+		v.validateList(t2, path, n)
+	case *TypeStruct:
+		switch r := t2.representation.(type) {
+		case StructRepresentation_Map:
+			v.validateStructMap(t2, r, path, n, "")
+		case StructRepresentation_Tuple:
+			v.validateStructTuple(t2, r, path, n)
+		case StructRepresentation_StringPairs, StructRepresentation_Stringjoin:
+			v.validateFromNode(t, path, n)
+		default:
+			panic("unreachable")
+		}
+	case *TypeUnion:
+		v.validateUnion(t2, path, n)
+	default:
+		panic("unreachable")
+	}
+}
+
+// validateFromNode handles types with string representations:
+// it unpacks the string and hands off to validateString.
+func (v *validator) validateFromNode(t Type, path ipld.Path, n ipld.Node) {
+	if !v.checkKind(t, path, n, ipld.ReprKind_String) {
+		return
+	}
+	s, err := n.AsString()
+	if err != nil {
+		v.reject(t, path, "%s", err)
+		return
+	}
+	v.validateString(t, path, s)
+}
+
+// validateString checks a string against a type with a string representation.
+// It's used for whole nodes, and also for map keys and the parts of stringjoin and stringpairs structs.
+func (v *validator) validateString(t Type, path ipld.Path, s string) {
+	switch t2 := t.(type) {
+	case *TypeString:
+		// Any string will do.
+	case *TypeEnum:
+		for _, m := range t2.members {
+			if m == s {
+				return
+			}
+		}
+		v.reject(t, path, "%q is not a member of the enum", s)
+	case *TypeStruct:
+		switch r := t2.representation.(type) {
+		case StructRepresentation_Stringjoin:
+			parts := strings.Split(s, r.sep)
+			if len(parts) != len(t2.fields) {
+				v.reject(t, path, "expected %d fields joined by %q, got %d", len(t2.fields), r.sep, len(parts))
+				return
+			}
+			for i, f := range t2.fields {
+				v.validateString(f.Type(), path.AppendSegmentString(f.name), parts[i])
+			}
+		case StructRepresentation_StringPairs:
+			values := map[string]string{}
+			if s != "" {
+				for _, entry := range strings.Split(s, r.sep2) {
+					kv := strings.SplitN(entry, r.sep1, 2)
+					if len(kv) != 2 {
+						v.reject(t, path, "entry %q is not a key and value separated by %q", entry, r.sep1)
+						continue
+					}
+					if _, exists := values[kv[0]]; exists {
+						v.reject(t, path, "repeated key %q", kv[0])
+						continue
+					}
+					if t2.Field(kv[0]) == nil {
+						v.reject(t, path, "unexpected key %q", kv[0])
+						continue
+					}
+					values[kv[0]] = kv[1]
+				}
+			}
+			for _, f := range t2.fields {
+				fv, exists := values[f.name]
+				if !exists {
+					if !f.optional {
+						v.reject(t, path, "missing required field %s", f.name)
+					}
+					continue
+				}
+				v.validateString(f.Type(), path.AppendSegmentString(f.name), fv)
+			}
+		default:
+			v.reject(t, path, "expected %s, got string", t.RepresentationBehavior())
+		}
+	default:
+		v.reject(t, path, "expected %s, got string", describeRepresentation(t))
+	}
+}
+
+func describeRepresentation(t Type) string {
+	if rb := t.RepresentationBehavior(); rb != ipld.ReprKind_Invalid {
+		return rb.String()
+	}
+	return "one of the union's representation kinds" // only kinded unions get here.
+}
+
+// validateValue checks a map or list value, or a struct field, which may be null if nullable.
+func (v *validator) validateValue(t Type, nullable bool, path ipld.Path, n ipld.Node) {
+	if n.IsNull() {
+		if !nullable {
+			v.reject(t, path, "expected %s, got null", describeRepresentation(t))
+		}
+		return
+	}
+	v.validate(t, path, n)
+}
+
+func (v *validator) validateMap(t *TypeMap, path ipld.Path, n ipld.Node) {
+	if !v.checkKind(t, path, n, ipld.ReprKind_Map) {
+		return
+	}
+	for itr := n.MapIterator(); !itr.Done(); {
+		k, mv, err := itr.Next()
+		if err != nil {
+			v.reject(t, path, "%s", err)
+			return
+		}
+		ks, err := k.AsString()
+		if err != nil {
+			v.reject(t, path, "map key is not a string: %s", err)
+			continue
+		}
+		kpath := path.AppendSegmentString(ks)
+		v.validateString(t.KeyType(), kpath, ks)
+		v.validateValue(t.ValueType(), t.valueNullable, kpath, mv)
+	}
+}
+
+func (v *validator) validateList(t *TypeList, path ipld.Path, n ipld.Node) {
+	if !v.checkKind(t, path, n, ipld.ReprKind_List) {
+		return
+	}
+	for itr := n.ListIterator(); !itr.Done(); {
+		idx, lv, err := itr.Next()
+		if err != nil {
+			v.reject(t, path, "%s", err)
+			return
+		}
+		v.validateValue(t.ValueType(), t.valueNullable, path.AppendSegment(ipld.PathSegmentOfInt(idx)), lv)
+	}
+}
+
+// validateStructMap checks a struct with map representation.
+// If skipKey isn't empty, that key is ignored: inline unions use this for their discriminant.
+func (v *validator) validateStructMap(t *TypeStruct, r StructRepresentation_Map, path ipld.Path, n ipld.Node, skipKey string) {
+	if !v.checkKind(t, path, n, ipld.ReprKind_Map) {
+		return
+	}
+	expected := make(map[string]struct{}, len(t.fields))
+	for _, f := range t.fields {
+		key := r.GetFieldKey(f)
+		expected[key] = struct{}{}
+		fv, err := n.LookupByString(key)
+		if err != nil {
+			if _, ok := err.(ipld.ErrNotExists); !ok {
+				v.reject(t, path, "%s", err)
+				continue
+			}
+			fv = ipld.Absent
+		}
+		if fv.IsAbsent() {
+			if !f.optional && r.GetImplicit(f) == nil {
+				v.reject(t, path, "missing required field %s (key %q)", f.name, key)
+			}
+			continue
+		}
+		v.validateValue(f.Type(), f.nullable, path.AppendSegmentString(key), fv)
+	}
+	for itr := n.MapIterator(); !itr.Done(); {
+		k, _, err := itr.Next()
+		if err != nil {
+			v.reject(t, path, "%s", err)
+			return
+		}
+		ks, err := k.AsString()
+		if err != nil {
+			v.reject(t, path, "map key is not a string: %s", err)
+			continue
+		}
+		if _, ok := expected[ks]; !ok && ks != skipKey {
+			v.reject(t, path, "unexpected key %q", ks)
+		}
+	}
+}
+
+func (v *validator) validateStructTuple(t *TypeStruct, r StructRepresentation_Tuple, path ipld.Path, n ipld.Node) {
+	if !v.checkKind(t, path, n, ipld.ReprKind_List) {
+		return
+	}
+	length := n.Length()
+	if length > len(t.fields) {
+		v.reject(t, path, "expected at most %d entries, got %d", len(t.fields), length)
+	}
+	for i, f := range t.fields {
+		if i >= length {
+			if !f.optional && r.GetImplicit(f) == nil {
+				v.reject(t, path, "missing required field %s (index %d)", f.name, i)
+			}
+			continue
+		}
+		fv, err := n.LookupByIndex(i)
+		if err != nil {
+			v.reject(t, path, "%s", err)
+			continue
+		}
+		v.validateValue(f.Type(), f.nullable, path.AppendSegment(ipld.PathSegmentOfInt(i)), fv)
+	}
+}
+
+func (v *validator) validateUnion(t *TypeUnion, path ipld.Path, n ipld.Node) {
+	switch r := t.representation.(type) {
+	case UnionRepresentation_Keyed:
+		if !v.checkKind(t, path, n, ipld.ReprKind_Map) {
+			return
+		}
+		if n.Length() != 1 {
+			v.reject(t, path, "keyed union must have exactly one entry, got %d", n.Length())
+			return
+		}
+		k, mv, err := n.MapIterator().Next()
+		if err != nil {
+			v.reject(t, path, "%s", err)
+			return
+		}
+		ks, err := k.AsString()
+		if err != nil {
+			v.reject(t, path, "map key is not a string: %s", err)
+			return
+		}
+		mn, ok := r.table[ks]
+		if !ok {
+			v.reject(t, path, "unknown union discriminant %q", ks)
+			return
+		}
+		v.validate(t.universe.namedTypes[mn], path.AppendSegmentString(ks), mv)
+	case UnionRepresentation_Kinded:
+		mn, ok := r.table[n.ReprKind()]
+		if !ok {
+			v.reject(t, path, "no union member has representation kind %s", n.ReprKind())
+			return
+		}
+		v.validate(t.universe.namedTypes[mn], path, n)
+	case UnionRepresentation_Envelope:
+		if !v.checkKind(t, path, n, ipld.ReprKind_Map) {
+			return
+		}
+		mn, ok := v.discriminant(t, r.discriminantKey, r.table, path, n)
+		if !ok {
+			return
+		}
+		for itr := n.MapIterator(); !itr.Done(); {
+			k, _, err := itr.Next()
+			if err != nil {
+				v.reject(t, path, "%s", err)
+				return
+			}
+			if ks, _ := k.AsString(); ks != r.discriminantKey && ks != r.contentKey {
+				v.reject(t, path, "unexpected key %q", ks)
+			}
+		}
+		content, err := n.LookupByString(r.contentKey)
+		if err != nil {
+			v.reject(t, path, "missing content key %q", r.contentKey)
+			return
+		}
+		v.validate(t.universe.namedTypes[mn], path.AppendSegmentString(r.contentKey), content)
+	case UnionRepresentation_Inline:
+		if !v.checkKind(t, path, n, ipld.ReprKind_Map) {
+			return
+		}
+		mn, ok := v.discriminant(t, r.discriminantKey, r.table, path, n)
+		if !ok {
+			return
+		}
+		// Inline unions only make sense for members which are structs with map representations;
+		//  if the type system says otherwise, that's rejected here rather than trusted.
+		mt, ok := t.universe.namedTypes[mn].(*TypeStruct)
+		if !ok {
+			v.reject(t, path, "inline union member %s is not a struct type", mn)
+			return
+		}
+		mr, ok := mt.representation.(StructRepresentation_Map)
+		if !ok {
+			v.reject(t, path, "inline union member %s does not have a map representation", mn)
+			return
+		}
+		v.validateStructMap(mt, mr, path, n, r.discriminantKey)
+	default:
+		panic("unreachable")
+	}
+}
+
+// discriminant finds the member named by the discriminant of an envelope or inline union.
+func (v *validator) discriminant(t *TypeUnion, key string, table map[string]TypeName, path ipld.Path, n ipld.Node) (TypeName, bool) {
+	dn, err := n.LookupByString(key)
+	if err != nil {
+		v.reject(t, path, "missing discriminant key %q", key)
+		return "", false
+	}
+	ds, err := dn.AsString()
+	if err != nil {
+		v.reject(t, path.AppendSegmentString(key), "union discriminant must be a string, got %s", dn.ReprKind())
+		return "", false
+	}
+	mn, ok := table[ds]
+	if !ok {
+		v.reject(t, path.AppendSegmentString(key), "unknown union discriminant %q", ds)
+		return "", false
+	}
+	return mn, true
+}
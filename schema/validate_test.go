@@ -0,0 +1,248 @@
+package schema_test
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/warpfork/go-wish"
+
+	"github.com/ipld/go-ipld-prime/codec/dagjson"
+	basicnode "github.com/ipld/go-ipld-prime/node/basic"
+	"github.com/ipld/go-ipld-prime/schema"
+	"github.com/ipld/go-ipld-prime/schema/dsl"
+)
+
+var validateSchema = `
+	type Foo struct {
+		name String
+		count optional Int
+		note nullable String
+		tags [String]
+		extra {String:nullable Int}
+		color Color
+	} representation map {
+		field name alias "n"
+		field count implicit 0
+	}
+	type Color enum {
+		| Red
+		| Green
+	}
+	type Point struct {
+		x Int
+		y Int
+		z optional Int
+	} representation tuple
+	type Joined struct {
+		a String
+		b Color
+	} representation stringjoin {
+		join ":"
+	}
+	type Pairs struct {
+		a String
+		b optional String
+	} representation stringpairs {
+		innerDelim "="
+		entryDelim ","
+	}
+	type ByJoined {Joined:Int}
+	type Keyed union {
+		| Point "point"
+		| Joined "joined"
+	} representation keyed
+	type Kinded union {
+		| String string
+		| Point list
+	} representation kinded
+	type Env union {
+		| Point "point"
+		| Joined "joined"
+	} representation envelope {
+		discriminantKey "tag"
+		contentKey "content"
+	}
+	type Inl union {
+		| Foo "foo"
+		| Other "other"
+	} representation inline {
+		discriminantKey "tag"
+	}
+	type Other struct {
+		name String
+	}
+`
+
+func validate(t *testing.T, typeName schema.TypeName, js string) []string {
+	t.Helper()
+	ts, err := dsl.ParseBytes([]byte(validateSchema))
+	Require(t, err, ShouldEqual, nil)
+	nb := basicnode.Prototype.Any.NewBuilder()
+	Require(t, dagjson.Decoder(nb, strings.NewReader(js)), ShouldEqual, nil)
+	var msgs []string
+	for _, err := range schema.Validate(ts, typeName, nb.Build()) {
+		msgs = append(msgs, err.Error())
+	}
+	return msgs
+}
+
+func TestValidateStructs(t *testing.T) {
+	t.Run("valid map struct", func(t *testing.T) {
+		Wish(t, validate(t, "Foo", `{"n": "x", "note": null, "tags": ["a"], "extra": {"k": null, "j": 1}, "color": "Red"}`), ShouldEqual, []string(nil))
+	})
+	t.Run("problems with several fields are all reported", func(t *testing.T) {
+		Wish(t, validate(t, "Foo", `{"name": "x", "note": 1, "tags": ["a", 2], "extra": {"k": "v"}, "color": "Blue"}`), ShouldEqual, []string{
+			`invalid data for type Foo at "": missing required field name (key "n")`,
+			`invalid data for type String at "note": expected string, got int`,
+			`invalid data for type String at "tags/1": expected string, got int`,
+			`invalid data for type Int at "extra/k": expected int, got string`,
+			`invalid data for type Color at "color": "Blue" is not a member of the enum`,
+			`invalid data for type Foo at "": unexpected key "name"`,
+		})
+	})
+	t.Run("null for a non-nullable field", func(t *testing.T) {
+		Wish(t, validate(t, "Other", `{"name": null}`), ShouldEqual, []string{
+			`invalid data for type String at "name": expected string, got null`,
+		})
+	})
+	t.Run("wrong kind", func(t *testing.T) {
+		Wish(t, validate(t, "Foo", `["n"]`), ShouldEqual, []string{
+			`invalid data for type Foo at "": expected map, got list`,
+		})
+	})
+	t.Run("tuple", func(t *testing.T) {
+		Wish(t, validate(t, "Point", `[1, 2]`), ShouldEqual, []string(nil))
+		Wish(t, validate(t, "Point", `[1, 2, 3]`), ShouldEqual, []string(nil))
+		Wish(t, validate(t, "Point", `[1]`), ShouldEqual, []string{
+			`invalid data for type Point at "": missing required field y (index 1)`,
+		})
+		Wish(t, validate(t, "Point", `[1, "2", 3, 4]`), ShouldEqual, []string{
+			`invalid data for type Point at "": expected at most 3 entries, got 4`,
+			`invalid data for type Int at "1": expected int, got string`,
+		})
+	})
+	t.Run("tuple with implicits", func(t *testing.T) {
+		var ts schema.TypeSystem
+		ts.Init()
+		ts.Accumulate(schema.SpawnInt("Int"))
+		ts.Accumulate(schema.SpawnStruct("Pt",
+			[]schema.StructField{
+				schema.SpawnStructField("x", "Int", false, false),
+				schema.SpawnStructField("y", "Int", false, false),
+			},
+			schema.SpawnStructRepresentationTupleWithImplicits(map[string]schema.ImplicitValue{
+				"y": schema.SpawnImplicitValueInt(0),
+			}),
+		))
+		for js, expect := range map[string]int{`[1]`: 0, `[1, 2]`: 0, `[]`: 1} {
+			nb := basicnode.Prototype.Any.NewBuilder()
+			Require(t, dagjson.Decoder(nb, strings.NewReader(js)), ShouldEqual, nil)
+			Wish(t, len(schema.Validate(&ts, "Pt", nb.Build())), ShouldEqual, expect)
+		}
+	})
+	t.Run("stringjoin", func(t *testing.T) {
+		Wish(t, validate(t, "Joined", `"x:Red"`), ShouldEqual, []string(nil))
+		Wish(t, validate(t, "Joined", `"x:Blue"`), ShouldEqual, []string{
+			`invalid data for type Color at "b": "Blue" is not a member of the enum`,
+		})
+		Wish(t, validate(t, "Joined", `"x"`), ShouldEqual, []string{
+			`invalid data for type Joined at "": expected 2 fields joined by ":", got 1`,
+		})
+	})
+	t.Run("stringpairs", func(t *testing.T) {
+		Wish(t, validate(t, "Pairs", `"a=1,b=2"`), ShouldEqual, []string(nil))
+		Wish(t, validate(t, "Pairs", `"a=1"`), ShouldEqual, []string(nil))
+		Wish(t, validate(t, "Pairs", `"b=2,c=3,d"`), ShouldEqual, []string{
+			`invalid data for type Pairs at "": unexpected key "c"`,
+			`invalid data for type Pairs at "": entry "d" is not a key and value separated by "="`,
+			`invalid data for type Pairs at "": missing required field a`,
+		})
+	})
+}
+
+func TestValidateMaps(t *testing.T) {
+	t.Run("keys are validated against the key type", func(t *testing.T) {
+		Wish(t, validate(t, "ByJoined", `{"x:Red": 1, "y:Blue": 2, "z": 3}`), ShouldEqual, []string{
+			`invalid data for type Color at "y:Blue/b": "Blue" is not a member of the enum`,
+			`invalid data for type Joined at "z": expected 2 fields joined by ":", got 1`,
+		})
+	})
+}
+
+func TestValidateUnions(t *testing.T) {
+	t.Run("keyed", func(t *testing.T) {
+		Wish(t, validate(t, "Keyed", `{"point": [1, 2]}`), ShouldEqual, []string(nil))
+		Wish(t, validate(t, "Keyed", `{"joined": "x"}`), ShouldEqual, []string{
+			`invalid data for type Joined at "joined": expected 2 fields joined by ":", got 1`,
+		})
+		Wish(t, validate(t, "Keyed", `{"nope": [1, 2]}`), ShouldEqual, []string{
+			`invalid data for type Keyed at "": unknown union discriminant "nope"`,
+		})
+		Wish(t, validate(t, "Keyed", `{"point": [1, 2], "joined": "x:Red"}`), ShouldEqual, []string{
+			`invalid data for type Keyed at "": keyed union must have exactly one entry, got 2`,
+		})
+	})
+	t.Run("kinded", func(t *testing.T) {
+		Wish(t, validate(t, "Kinded", `"x"`), ShouldEqual, []string(nil))
+		Wish(t, validate(t, "Kinded", `[1, 2]`), ShouldEqual, []string(nil))
+		Wish(t, validate(t, "Kinded", `1`), ShouldEqual, []string{
+			`invalid data for type Kinded at "": no union member has representation kind int`,
+		})
+	})
+	t.Run("envelope", func(t *testing.T) {
+		Wish(t, validate(t, "Env", `{"tag": "point", "content": [1, 2]}`), ShouldEqual, []string(nil))
+		Wish(t, validate(t, "Env", `{"tag": "joined", "content": [1, 2], "more": 1}`), ShouldEqual, []string{
+			`invalid data for type Env at "": unexpected key "more"`,
+			`invalid data for type Joined at "content": expected string, got list`,
+		})
+		Wish(t, validate(t, "Env", `{"tag": "nope", "content": [1, 2]}`), ShouldEqual, []string{
+			`invalid data for type Env at "tag": unknown union discriminant "nope"`,
+		})
+		Wish(t, validate(t, "Env", `{"content": [1, 2]}`), ShouldEqual, []string{
+			`invalid data for type Env at "": missing discriminant key "tag"`,
+		})
+	})
+	t.Run("inline", func(t *testing.T) {
+		Wish(t, validate(t, "Inl", `{"tag": "other", "name": "x"}`), ShouldEqual, []string(nil))
+		Wish(t, validate(t, "Inl", `{"tag": "other", "name": "x", "n": "y"}`), ShouldEqual, []string{
+			`invalid data for type Other at "": unexpected key "n"`,
+		})
+		Wish(t, validate(t, "Inl", `{"tag": 1}`), ShouldEqual, []string{
+			`invalid data for type Inl at "tag": union discriminant must be a string, got int`,
+		})
+	})
+	t.Run("inline with a member that isn't a struct", func(t *testing.T) {
+		var ts schema.TypeSystem
+		ts.Init()
+		ts.Accumulate(schema.SpawnString("String"))
+		ts.Accumulate(schema.SpawnUnion("Bad",
+			[]schema.TypeName{"String"},
+			schema.SpawnUnionRepresentationInline("tag", map[string]schema.TypeName{"str": "String"}),
+		))
+		nb := basicnode.Prototype.Any.NewBuilder()
+		Require(t, dagjson.Decoder(nb, strings.NewReader(`{"tag": "str"}`)), ShouldEqual, nil)
+		errs := schema.Validate(&ts, "Bad", nb.Build())
+		Require(t, len(errs), ShouldEqual, 1)
+		Wish(t, errs[0].Error(), ShouldEqual, `invalid data for type Bad at "": inline union member String is not a struct type`)
+	})
+}
+
+func TestValidateUnknownType(t *testing.T) {
+	ts, err := dsl.ParseBytes([]byte(validateSchema))
+	Require(t, err, ShouldEqual, nil)
+	errs := schema.Validate(ts, "Nope", basicnode.NewString("x"))
+	Wish(t, len(errs), ShouldEqual, 1)
+	_, isInvalidData := errs[0].(schema.ErrInvalidData)
+	Wish(t, isInvalidData, ShouldEqual, false)
+}
+
+func TestValidateErrorPaths(t *testing.T) {
+	ts, err := dsl.ParseBytes([]byte(validateSchema))
+	Require(t, err, ShouldEqual, nil)
+	nb := basicnode.Prototype.Any.NewBuilder()
+	Require(t, dagjson.Decoder(nb, strings.NewReader(`{"point": [1, "two"]}`)), ShouldEqual, nil)
+	errs := schema.Validate(ts, "Keyed", nb.Build())
+	Require(t, len(errs), ShouldEqual, 1)
+	Wish(t, errs[0].(schema.ErrInvalidData).Path.String(), ShouldEqual, "point/1")
+	Wish(t, errs[0].(schema.ErrInvalidData).TypeName, ShouldEqual, schema.TypeName("Int"))
+}
@@ -0,0 +1,23 @@
+// The storage package provides ready-made Loader and Storer functions,
+// so that linking can be used without writing the closures by hand.
+//
+// MemoryStore keeps blocks in a map, and is safe for concurrent use.
+// It's handy for tests, and for working with small amounts of data.
+//
+// FileStore keeps each block in its own file, in a directory that's
+// sharded into subdirectories so that no one directory gets too large.
+// Writes go to a tempfile first, which is renamed into place when the
+// StoreCommitter is called, so readers never see a partially written block.
+//
+// Both have a Loader and a Storer method, which have the signatures of
+// ipld.Loader and ipld.Storer, and so can be handed directly to Link.Load
+// and LinkBuilder.Build (or to the traversal package):
+//
+//	store := storage.MemoryStore{}
+//	lnk, err := lb.Build(ctx, ipld.LinkContext{}, n, store.Storer)
+//	...
+//	err = lnk.Load(ctx, ipld.LinkContext{}, nb, store.Loader)
+//
+// Neither of them verifies the data it stores or loads: hashing is the
+// business of the Link implementation (cidlink checks the hash when loading).
+package storage
@@ -0,0 +1,11 @@
+package storage
+
+import (
+	ipld "github.com/ipld/go-ipld-prime"
+)
+
+// ErrNotFound is returned by the Loaders in this package when there's no block stored for the link.
+//
+// It's the same type as ipld.ErrBlockNotFound, which Loaders in general should return
+// in this situation; the name is kept so that code which already checks for it keeps working.
+type ErrNotFound = ipld.ErrBlockNotFound
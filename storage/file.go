@@ -0,0 +1,172 @@
+package storage
+
+import (
+	"encoding/base32"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	ipld "github.com/ipld/go-ipld-prime"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+)
+
+// FileStore is a block store that keeps each block in its own file,
+// under a root directory.
+//
+// Files are named for the CID of the block (its binary form, in lowercase
+// unpadded base32, so the names are safe on case-insensitive filesystems),
+// and sharded into subdirectories named for the next-to-last two characters
+// of that name.  (The ends of the names are the ends of the hashes, so
+// they're evenly distributed; the starts are mostly the same prefix.)
+//
+// Writes go to a tempfile in the root directory, which is renamed into
+// its final place when the StoreCommitter is called; so a block is never
+// visible partially written, even to other processes.
+// A Storer call that's never committed (say, because encoding failed)
+// holds its tempfile open until the writer is garbage collected,
+// and then closes and removes it.  Tempfiles left behind by a process that
+// exits before then are named with a ".tmp-" prefix, so they're easy to clean up.
+//
+// Only cidlink.Link is supported.  FileStore is safe for concurrent use.
+type FileStore struct {
+	root string
+}
+
+// NewFileStore returns a FileStore that keeps its files under the root directory,
+// creating the directory if it doesn't exist.
+func NewFileStore(root string) (*FileStore, error) {
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return nil, err
+	}
+	return &FileStore{root}, nil
+}
+
+var fileNameEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// path returns the path of the file for the link.
+func (s *FileStore) path(lnk ipld.Link) (string, error) {
+	cl, ok := lnk.(cidlink.Link)
+	if !ok {
+		return "", fmt.Errorf("storage: FileStore only supports cidlink.Link, not %T", lnk)
+	}
+	name := strings.ToLower(fileNameEncoding.EncodeToString(cl.Cid.Bytes()))
+	return filepath.Join(s.root, name[len(name)-3:len(name)-1], name), nil
+}
+
+// Loader has the signature of an ipld.Loader, and returns a reader for
+// the block stored for the link, or ipld.ErrBlockNotFound.
+//
+// The reader is an open file, which is closed when it's read to the end
+// (or a read fails).  If it's abandoned partway, the file is closed when
+// the reader is garbage collected.
+func (s *FileStore) Loader(lnk ipld.Link, _ ipld.LinkContext) (io.Reader, error) {
+	p, err := s.path(lnk)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(p)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ipld.ErrBlockNotFound{Link: lnk}
+		}
+		return nil, err
+	}
+	return &closingReader{f: f}, nil
+}
+
+// Storer has the signature of an ipld.Storer.
+// The data is written to a tempfile, which is renamed into place
+// when the StoreCommitter is called.
+// If it's never called, the tempfile is removed when the writer is garbage collected.
+func (s *FileStore) Storer(_ ipld.LinkContext) (io.Writer, ipld.StoreCommitter, error) {
+	f, err := ioutil.TempFile(s.root, ".tmp-")
+	if err != nil {
+		return nil, nil, err
+	}
+	w := &tempWriter{f}
+	runtime.SetFinalizer(w, (*tempWriter).discard)
+	return w, func(lnk ipld.Link) error {
+		runtime.SetFinalizer(w, nil)
+		if err := s.commit(f, lnk); err != nil {
+			os.Remove(f.Name())
+			return err
+		}
+		return nil
+	}, nil
+}
+
+// tempWriter writes to a tempfile.
+// It's a separate object from the file so that it can have a finalizer of its own,
+// which cleans up after writes that are never committed.
+type tempWriter struct {
+	f *os.File
+}
+
+func (w *tempWriter) Write(p []byte) (int, error) {
+	return w.f.Write(p)
+}
+
+func (w *tempWriter) discard() {
+	w.f.Close()
+	os.Remove(w.f.Name())
+}
+
+func (s *FileStore) commit(f *os.File, lnk ipld.Link) error {
+	p, err := s.path(lnk)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	// Sync before the rename, so the rename can't land before the content does.
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), p)
+}
+
+// Has reports whether a block is stored for the link.
+func (s *FileStore) Has(lnk ipld.Link) (bool, error) {
+	p, err := s.path(lnk)
+	if err != nil {
+		return false, err
+	}
+	_, err = os.Stat(p)
+	switch {
+	case err == nil:
+		return true, nil
+	case os.IsNotExist(err):
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// closingReader closes the file when it's been read to the end.
+// Further reads return the same error (typically io.EOF) again.
+type closingReader struct {
+	f   *os.File
+	err error
+}
+
+func (r *closingReader) Read(p []byte) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+	n, err := r.f.Read(p)
+	if err != nil {
+		r.err = err
+		r.f.Close()
+	}
+	return n, err
+}
@@ -0,0 +1,64 @@
+package storage
+
+import (
+	"bytes"
+	"io"
+	"sync"
+
+	ipld "github.com/ipld/go-ipld-prime"
+)
+
+// MemoryStore is a block store that keeps everything in memory.
+//
+// It's keyed by the Link itself, so it works with any Link implementation
+// whose values can be compared with ==.  (cidlink.Link can.)
+//
+// The zero value is ready to use.  MemoryStore is safe for concurrent use;
+// it must not be copied after first use.
+type MemoryStore struct {
+	mu  sync.RWMutex
+	bag map[ipld.Link][]byte
+}
+
+// Loader has the signature of an ipld.Loader, and returns a reader for
+// the block stored for the link, or ipld.ErrBlockNotFound.
+func (s *MemoryStore) Loader(lnk ipld.Link, _ ipld.LinkContext) (io.Reader, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, exists := s.bag[lnk]
+	if !exists {
+		return nil, ipld.ErrBlockNotFound{Link: lnk}
+	}
+	return bytes.NewReader(data), nil
+}
+
+// Storer has the signature of an ipld.Storer.
+// The data written is buffered, and only becomes visible to Loader
+// when the StoreCommitter is called.
+func (s *MemoryStore) Storer(_ ipld.LinkContext) (io.Writer, ipld.StoreCommitter, error) {
+	var buf bytes.Buffer
+	return &buf, func(lnk ipld.Link) error {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if s.bag == nil {
+			s.bag = make(map[ipld.Link][]byte)
+		}
+		s.bag[lnk] = buf.Bytes()
+		return nil
+	}, nil
+}
+
+// Has reports whether a block is stored for the link.
+func (s *MemoryStore) Has(lnk ipld.Link) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	_, exists := s.bag[lnk]
+	return exists
+}
+
+// Len returns the number of blocks stored.
+func (s *MemoryStore) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.bag)
+}
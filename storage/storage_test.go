@@ -0,0 +1,156 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	cid "github.com/ipfs/go-cid"
+	. "github.com/warpfork/go-wish"
+
+	ipld "github.com/ipld/go-ipld-prime"
+	_ "github.com/ipld/go-ipld-prime/codec/dagcbor"
+	"github.com/ipld/go-ipld-prime/fluent"
+	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
+	basicnode "github.com/ipld/go-ipld-prime/node/basic"
+)
+
+var lb = cidlink.LinkBuilder{cid.Prefix{
+	Version:  1,
+	Codec:    0x71,
+	MhType:   0x12,
+	MhLength: 32,
+}}
+
+func testNode(i int) ipld.Node {
+	return fluent.MustBuildMap(basicnode.Prototype.Map, 1, func(na fluent.MapAssembler) {
+		na.AssembleEntry("i").AssignInt(int64(i))
+	})
+}
+
+// testStore runs the tests that every store should pass.
+func testStore(t *testing.T, loader ipld.Loader, storer ipld.Storer) {
+	ctx := context.Background()
+	t.Run("roundtrip", func(t *testing.T) {
+		n := testNode(0)
+		lnk, err := lb.Build(ctx, ipld.LinkContext{}, n, storer)
+		Require(t, err, ShouldEqual, nil)
+		nb := basicnode.Prototype.Any.NewBuilder()
+		Require(t, lnk.Load(ctx, ipld.LinkContext{}, nb, loader), ShouldEqual, nil)
+		Wish(t, nb.Build(), ShouldEqual, n)
+	})
+	t.Run("storing again", func(t *testing.T) {
+		n := testNode(0)
+		_, err := lb.Build(ctx, ipld.LinkContext{}, n, storer)
+		Wish(t, err, ShouldEqual, nil)
+	})
+	t.Run("not found", func(t *testing.T) {
+		lnk, err := lb.Build(ctx, ipld.LinkContext{}, testNode(-1), func(ipld.LinkContext) (io.Writer, ipld.StoreCommitter, error) {
+			return ioutil.Discard, func(ipld.Link) error { return nil }, nil
+		})
+		Require(t, err, ShouldEqual, nil)
+		_, err = loader(lnk, ipld.LinkContext{})
+		Wish(t, err, ShouldEqual, ipld.ErrBlockNotFound{Link: lnk})
+		_, isNotFound := err.(ErrNotFound)
+		Wish(t, isNotFound, ShouldEqual, true)
+	})
+	t.Run("uncommitted writes aren't visible", func(t *testing.T) {
+		n := testNode(-2)
+		var lnk ipld.Link
+		_, err := lb.Build(ctx, ipld.LinkContext{}, n, func(lnkCtx ipld.LinkContext) (io.Writer, ipld.StoreCommitter, error) {
+			w, _, err := storer(lnkCtx)
+			return w, func(l ipld.Link) error { lnk = l; return nil }, err
+		})
+		Require(t, err, ShouldEqual, nil)
+		_, err = loader(lnk, ipld.LinkContext{})
+		Wish(t, err, ShouldEqual, ipld.ErrBlockNotFound{Link: lnk})
+	})
+	t.Run("concurrent use", func(t *testing.T) {
+		var wg sync.WaitGroup
+		errs := make(chan error, 50)
+		for i := 1; i <= 50; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				lnk, err := lb.Build(ctx, ipld.LinkContext{}, testNode(i), storer)
+				if err == nil {
+					err = lnk.Load(ctx, ipld.LinkContext{}, basicnode.Prototype.Any.NewBuilder(), loader)
+				}
+				if err != nil {
+					errs <- fmt.Errorf("node %d: %w", i, err)
+				}
+			}(i)
+		}
+		wg.Wait()
+		close(errs)
+		for err := range errs {
+			t.Error(err)
+		}
+	})
+}
+
+func TestMemoryStore(t *testing.T) {
+	store := MemoryStore{}
+	testStore(t, store.Loader, store.Storer)
+	Wish(t, store.Len(), ShouldEqual, 51)
+}
+
+func TestFileStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "storage-test")
+	Require(t, err, ShouldEqual, nil)
+	defer os.RemoveAll(dir)
+
+	store, err := NewFileStore(filepath.Join(dir, "blocks"))
+	Require(t, err, ShouldEqual, nil)
+	testStore(t, store.Loader, store.Storer)
+
+	t.Run("uncommitted tempfiles are removed", func(t *testing.T) {
+		// The "uncommitted writes" test above left one behind, until its writer is collected.
+		_, _, err := store.Storer(ipld.LinkContext{})
+		Require(t, err, ShouldEqual, nil)
+		for i := 0; i < 100 && countTempfiles(t, store.root) > 0; i++ {
+			runtime.GC()
+			time.Sleep(10 * time.Millisecond)
+		}
+		Wish(t, countTempfiles(t, store.root), ShouldEqual, 0)
+	})
+	t.Run("layout", func(t *testing.T) {
+		lnk, err := lb.Build(context.Background(), ipld.LinkContext{}, testNode(0), store.Storer)
+		Require(t, err, ShouldEqual, nil)
+		has, err := store.Has(lnk)
+		Wish(t, err, ShouldEqual, nil)
+		Wish(t, has, ShouldEqual, true)
+		// The name is the CID in (multibase-less) base32; the shard is its next-to-last two characters.
+		name := lnk.String()[1:]
+		_, err = os.Stat(filepath.Join(dir, "blocks", name[len(name)-3:len(name)-1], name))
+		Wish(t, err, ShouldEqual, nil)
+		// Only shard directories are left in the root: the tempfiles of committed writes are gone.
+		Wish(t, countTempfiles(t, store.root), ShouldEqual, 0)
+	})
+	t.Run("other links", func(t *testing.T) {
+		_, err := store.Has(otherLink{})
+		Wish(t, err != nil, ShouldEqual, true)
+	})
+}
+
+// countTempfiles counts the files (as opposed to shard directories) in the root of a FileStore.
+func countTempfiles(t *testing.T, root string) int {
+	entries, err := ioutil.ReadDir(root)
+	Require(t, err, ShouldEqual, nil)
+	var files int
+	for _, e := range entries {
+		if !e.IsDir() {
+			files++
+		}
+	}
+	return files
+}
+
+type otherLink struct{ ipld.Link }
@@ -121,7 +121,7 @@ func (prog *Progress) get(n ipld.Node, p ipld.Path, trackProgress bool) (ipld.No
 			// Pick what in-memory format we will build.
 			np, err := prog.Cfg.LinkTargetNodePrototypeChooser(lnk, lnkCtx)
 			if err != nil {
-				return nil, fmt.Errorf("error traversing node at %q: could not load link %q: %s", p.Truncate(i+1), lnk, err)
+				return nil, fmt.Errorf("error traversing node at %q: could not load link %q: %w", p.Truncate(i+1), lnk, err)
 			}
 			nb := np.NewBuilder()
 			// Load link!
@@ -132,7 +132,7 @@ func (prog *Progress) get(n ipld.Node, p ipld.Path, trackProgress bool) (ipld.No
 				prog.Cfg.LinkLoader,
 			)
 			if err != nil {
-				return nil, fmt.Errorf("error traversing node at %q: could not load link %q: %s", p.Truncate(i+1), lnk, err)
+				return nil, fmt.Errorf("error traversing node at %q: could not load link %q: %w", p.Truncate(i+1), lnk, err)
 			}
 			if trackProgress {
 				prog.LastBlock.Path = p.Truncate(i + 1)
@@ -177,7 +177,90 @@ func (prog *Progress) get(n ipld.Node, p ipld.Path, trackProgress bool) (ipld.No
 // does a large amount of the intermediate bookkeeping that's useful when
 // creating new values which are partial updates to existing values.
 //
-// This feature is not yet implemented.
+// This walk will automatically cross links, but requires some configuration
+// with link loading functions to do so.
+// When the path crosses links, each block on the way to the reached node
+// will be re-encoded and stored using the Config.LinkStorer,
+// with a new link built by the original link's LinkBuilder
+// (so it will use the same codec, hashing, etc, as the link it replaces);
+// the new links are then placed in their parents, all the way back up to the root.
+// (If the starting node is itself the content of a block, then that's left for
+// the caller to do, since it's the caller that knows how it was loaded.)
 func (prog Progress) FocusedTransform(n ipld.Node, p ipld.Path, fn TransformFn) (ipld.Node, error) {
-	panic("TODO") // TODO surprisingly different from Focus -- need to store nodes we traversed, and able do building.
+	prog.init()
+	return prog.focusedTransform(n, p, 0, fn)
+}
+
+// focusedTransform is the internal implementation of FocusedTransform.
+// It recurses once per path segment; the Progress it's called on
+// has a Path already extended to include all segments before i.
+// On the way back out, each node is rebuilt if its child was replaced.
+func (prog Progress) focusedTransform(n ipld.Node, p ipld.Path, i int, fn TransformFn) (ipld.Node, error) {
+	segments := p.Segments()
+	if i == len(segments) {
+		return fn(prog, n)
+	}
+	seg := segments[i]
+	// Traverse the segment.
+	var next ipld.Node
+	switch n.ReprKind() {
+	case ipld.ReprKind_Invalid:
+		panic(fmt.Errorf("invalid node encountered at %q", prog.Path))
+	case ipld.ReprKind_Map:
+		v, err := n.LookupByString(seg.String())
+		if err != nil {
+			return nil, fmt.Errorf("error traversing segment %q on node at %q: %s", seg, prog.Path, err)
+		}
+		next = v
+	case ipld.ReprKind_List:
+		intSeg, err := seg.Index()
+		if err != nil {
+			return nil, fmt.Errorf("error traversing segment %q on node at %q: the segment cannot be parsed as a number and the node is a list", seg, prog.Path)
+		}
+		v, err := n.LookupByIndex(intSeg)
+		if err != nil {
+			return nil, fmt.Errorf("error traversing segment %q on node at %q: %s", seg, prog.Path, err)
+		}
+		next = v
+	default:
+		return nil, fmt.Errorf("cannot traverse node at %q: %s", prog.Path, fmt.Errorf("cannot traverse terminals"))
+	}
+	progNext := prog
+	progNext.Path = prog.Path.AppendSegment(seg)
+	next2, err := progNext.focusedTransformThroughLinks(next, n, p, i+1, fn)
+	if err != nil {
+		return nil, err
+	}
+	if isSameNode(next, next2) {
+		return n, nil
+	}
+	return prog.rebuild(n, map[string]ipld.Node{seg.String(): next2})
+}
+
+// focusedTransformThroughLinks dereferences any links (repeatedly, if the target is itself a link)
+// before continuing the transform, and stores new blocks for any link targets that were changed.
+// If n is not a link, this is simply a call to focusedTransform.
+func (prog Progress) focusedTransformThroughLinks(n ipld.Node, parent ipld.Node, p ipld.Path, i int, fn TransformFn) (ipld.Node, error) {
+	if n.ReprKind() != ipld.ReprKind_Link {
+		return prog.focusedTransform(n, p, i, fn)
+	}
+	lnk, _ := n.AsLink()
+	prog.LastBlock.Path = prog.Path
+	prog.LastBlock.Link = lnk
+	loaded, err := prog.loadLink(n, parent)
+	if err != nil {
+		return nil, err
+	}
+	loaded2, err := prog.focusedTransformThroughLinks(loaded, n, p, i, fn)
+	if err != nil {
+		return nil, err
+	}
+	if isSameNode(loaded, loaded2) {
+		return n, nil
+	}
+	lnk2, err := prog.storeLink(lnk, loaded2, parent)
+	if err != nil {
+		return nil, err
+	}
+	return prog.relink(n, lnk2)
 }
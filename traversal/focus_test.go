@@ -3,6 +3,7 @@ package traversal_test
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"strings"
@@ -16,6 +17,7 @@ import (
 
 	_ "github.com/ipld/go-ipld-prime/codec/dagjson"
 	"github.com/ipld/go-ipld-prime/fluent"
+	"github.com/ipld/go-ipld-prime/must"
 	cidlink "github.com/ipld/go-ipld-prime/linking/cid"
 	basicnode "github.com/ipld/go-ipld-prime/node/basic"
 	"github.com/ipld/go-ipld-prime/traversal"
@@ -181,6 +183,66 @@ func TestFocusWithLinkLoading(t *testing.T) {
 	})
 }
 
+func TestLinkLoadingErrors(t *testing.T) {
+	focus := func(n ipld.Node, path string, cfg traversal.Config) error {
+		if cfg.LinkLoader == nil {
+			cfg.LinkLoader = func(lnk ipld.Link, _ ipld.LinkContext) (io.Reader, error) {
+				return bytes.NewReader(storage[lnk]), nil
+			}
+		}
+		if cfg.LinkTargetNodePrototypeChooser == nil {
+			cfg.LinkTargetNodePrototypeChooser = func(_ ipld.Link, _ ipld.LinkContext) (ipld.NodePrototype, error) {
+				return basicnode.Prototype__Any{}, nil
+			}
+		}
+		return traversal.Progress{Cfg: &cfg}.Focus(n, ipld.ParsePath(path), func(traversal.Progress, ipld.Node) error {
+			return nil
+		})
+	}
+	t.Run("block not found", func(t *testing.T) {
+		err := focus(rootNode, "linkedMap/nested/nonlink", traversal.Config{
+			LinkLoader: func(lnk ipld.Link, _ ipld.LinkContext) (io.Reader, error) {
+				return nil, ipld.ErrBlockNotFound{Link: lnk}
+			},
+		})
+		var target ipld.ErrBlockNotFound
+		Require(t, errors.As(err, &target), ShouldEqual, true)
+		Wish(t, target.Link, ShouldEqual, middleMapNodeLnk)
+	})
+	t.Run("hash mismatch", func(t *testing.T) {
+		err := focus(rootNode, "linkedMap/nested/nonlink", traversal.Config{
+			LinkLoader: func(lnk ipld.Link, _ ipld.LinkContext) (io.Reader, error) {
+				return bytes.NewReader(storage[leafBetaLnk]), nil
+			},
+		})
+		var target ipld.ErrHashMismatch
+		Require(t, errors.As(err, &target), ShouldEqual, true)
+		Wish(t, target, ShouldEqual, ipld.ErrHashMismatch{Expected: middleMapNodeLnk, Actual: leafBetaLnk})
+	})
+	t.Run("unknown codec", func(t *testing.T) {
+		c := cid.NewCidV1(0x300000, leafAlphaLnk.(cidlink.Link).Hash())
+		n := fluent.MustBuildMap(basicnode.Prototype__Map{}, 1, func(na fluent.MapAssembler) {
+			na.AssembleEntry("strange").AssignLink(cidlink.Link{c})
+		})
+		err := focus(n, "strange", traversal.Config{})
+		var target ipld.ErrUnknownCodec
+		Require(t, errors.As(err, &target), ShouldEqual, true)
+		Wish(t, target.Codec, ShouldEqual, uint64(0x300000))
+	})
+	t.Run("decode error", func(t *testing.T) {
+		err := focus(rootNode, "linkedMap/nested/nonlink", traversal.Config{
+			LinkTargetNodePrototypeChooser: func(_ ipld.Link, _ ipld.LinkContext) (ipld.NodePrototype, error) {
+				return basicnode.Prototype__String{}, nil
+			},
+		})
+		var target ipld.ErrDecode
+		Require(t, errors.As(err, &target), ShouldEqual, true)
+		Wish(t, target.Link, ShouldEqual, middleMapNodeLnk)
+		var reason ipld.ErrWrongKind
+		Wish(t, errors.As(err, &reason), ShouldEqual, true)
+	})
+}
+
 func TestGetWithLinkLoading(t *testing.T) {
 	t.Run("link traversal with no configured loader should fail", func(t *testing.T) {
 		t.Run("terminal link should fail", func(t *testing.T) {
@@ -207,3 +269,167 @@ func TestGetWithLinkLoading(t *testing.T) {
 		Wish(t, n, ShouldEqual, basicnode.NewString("zoo"))
 	})
 }
+
+func TestFocusedTransform(t *testing.T) {
+	t.Run("UpdateMapEntry", func(t *testing.T) {
+		n, err := traversal.FocusedTransform(rootNode, ipld.ParsePath("plain"), func(prog traversal.Progress, prev ipld.Node) (ipld.Node, error) {
+			Wish(t, prog.Path.String(), ShouldEqual, "plain")
+			Wish(t, must.String(prev), ShouldEqual, "olde string")
+			nb := prev.Prototype().NewBuilder()
+			nb.AssignString("new string!")
+			return nb.Build(), nil
+		})
+		Wish(t, err, ShouldEqual, nil)
+		Wish(t, n.ReprKind(), ShouldEqual, ipld.ReprKind_Map)
+		// updated value should be there
+		Wish(t, must.Node(n.LookupByString("plain")), ShouldEqual, basicnode.NewString("new string!"))
+		// everything else should be there
+		Wish(t, must.Node(n.LookupByString("linkedString")), ShouldEqual, must.Node(rootNode.LookupByString("linkedString")))
+		Wish(t, must.Node(n.LookupByString("linkedMap")), ShouldEqual, must.Node(rootNode.LookupByString("linkedMap")))
+		Wish(t, must.Node(n.LookupByString("linkedList")), ShouldEqual, must.Node(rootNode.LookupByString("linkedList")))
+		// everything should still be in the same order
+		Wish(t, keys(n), ShouldEqual, []string{"plain", "linkedString", "linkedMap", "linkedList"})
+		// the original should be untouched
+		Wish(t, must.Node(rootNode.LookupByString("plain")), ShouldEqual, basicnode.NewString("olde string"))
+	})
+	t.Run("UpdateDeeperMap", func(t *testing.T) {
+		n, err := traversal.FocusedTransform(middleMapNode, ipld.ParsePath("nested/nonlink"), func(prog traversal.Progress, prev ipld.Node) (ipld.Node, error) {
+			Wish(t, prog.Path.String(), ShouldEqual, "nested/nonlink")
+			Wish(t, must.String(prev), ShouldEqual, "zoo")
+			return basicnode.NewString("new string!"), nil
+		})
+		Wish(t, err, ShouldEqual, nil)
+		Wish(t, must.Node(must.Node(n.LookupByString("nested")).LookupByString("nonlink")), ShouldEqual, basicnode.NewString("new string!"))
+		Wish(t, must.Node(n.LookupByString("foo")) == must.Node(middleMapNode.LookupByString("foo")), ShouldEqual, true)
+		Wish(t, keys(must.Node(n.LookupByString("nested"))), ShouldEqual, []string{"alink", "nonlink"})
+	})
+	t.Run("UpdateListEntry", func(t *testing.T) {
+		listNode := fluent.MustBuildList(basicnode.Prototype__List{}, 3, func(na fluent.ListAssembler) {
+			na.AssembleValue().AssignString("a")
+			na.AssembleValue().AssignString("b")
+			na.AssembleValue().AssignString("c")
+		})
+		n, err := traversal.FocusedTransform(listNode, ipld.ParsePath("1"), func(prog traversal.Progress, prev ipld.Node) (ipld.Node, error) {
+			Wish(t, prog.Path.String(), ShouldEqual, "1")
+			Wish(t, must.String(prev), ShouldEqual, "b")
+			return basicnode.NewString("z"), nil
+		})
+		Wish(t, err, ShouldEqual, nil)
+		Wish(t, n, ShouldEqual, fluent.MustBuildList(basicnode.Prototype__List{}, 3, func(na fluent.ListAssembler) {
+			na.AssembleValue().AssignString("a")
+			na.AssembleValue().AssignString("z")
+			na.AssembleValue().AssignString("c")
+		}))
+	})
+	t.Run("NoopReturnsSameRoot", func(t *testing.T) {
+		n, err := traversal.FocusedTransform(middleMapNode, ipld.ParsePath("nested/nonlink"), func(prog traversal.Progress, prev ipld.Node) (ipld.Node, error) {
+			return prev, nil
+		})
+		Wish(t, err, ShouldEqual, nil)
+		Wish(t, n == middleMapNode, ShouldEqual, true)
+	})
+	t.Run("ErrorsFromTransformFnArePropagated", func(t *testing.T) {
+		_, err := traversal.FocusedTransform(middleMapNode, ipld.ParsePath("nested/nonlink"), func(prog traversal.Progress, prev ipld.Node) (ipld.Node, error) {
+			return nil, fmt.Errorf("nope")
+		})
+		Wish(t, err, ShouldEqual, fmt.Errorf("nope"))
+	})
+	t.Run("MissingPathFails", func(t *testing.T) {
+		_, err := traversal.FocusedTransform(middleMapNode, ipld.ParsePath("nested/nope"), func(prog traversal.Progress, prev ipld.Node) (ipld.Node, error) {
+			t.Errorf("should not be reached")
+			return prev, nil
+		})
+		Wish(t, err.Error(), ShouldEqual, `error traversing segment "nope" on node at "nested": key not found: "nope"`)
+	})
+}
+
+func TestFocusedTransformWithLinks(t *testing.T) {
+	// New blocks go in a map of their own, so the shared fixtures aren't changed for other tests.
+	var stored []ipld.Link
+	newStorage := make(map[ipld.Link][]byte)
+	prog := traversal.Progress{
+		Cfg: &traversal.Config{
+			LinkLoader: func(lnk ipld.Link, _ ipld.LinkContext) (io.Reader, error) {
+				if data, ok := newStorage[lnk]; ok {
+					return bytes.NewReader(data), nil
+				}
+				return bytes.NewReader(storage[lnk]), nil
+			},
+			LinkTargetNodePrototypeChooser: func(_ ipld.Link, _ ipld.LinkContext) (ipld.NodePrototype, error) {
+				return basicnode.Prototype__Any{}, nil
+			},
+			LinkStorer: func(ipld.LinkContext) (io.Writer, ipld.StoreCommitter, error) {
+				buf := bytes.Buffer{}
+				return &buf, func(lnk ipld.Link) error {
+					stored = append(stored, lnk)
+					newStorage[lnk] = buf.Bytes()
+					return nil
+				}, nil
+			},
+		},
+	}
+	t.Run("UpdateBeyondLink", func(t *testing.T) {
+		stored = nil
+		n, err := prog.FocusedTransform(rootNode, ipld.ParsePath("linkedMap/nested/nonlink"), func(prog traversal.Progress, prev ipld.Node) (ipld.Node, error) {
+			Wish(t, prog.Path.String(), ShouldEqual, "linkedMap/nested/nonlink")
+			Wish(t, prog.LastBlock.Path.String(), ShouldEqual, "linkedMap")
+			Wish(t, prog.LastBlock.Link, ShouldEqual, middleMapNodeLnk)
+			Wish(t, must.String(prev), ShouldEqual, "zoo")
+			return basicnode.NewString("new string!"), nil
+		})
+		Wish(t, err, ShouldEqual, nil)
+		// one new block should have been stored, and the root should link to it.
+		Require(t, len(stored), ShouldEqual, 1)
+		Wish(t, must.Node(n.LookupByString("linkedMap")), ShouldEqual, basicnode.NewLink(stored[0]))
+		Wish(t, stored[0].LinkBuilder(), ShouldEqual, middleMapNodeLnk.LinkBuilder())
+		Wish(t, must.Node(prog.Get(n, ipld.ParsePath("linkedMap/nested/nonlink"))), ShouldEqual, basicnode.NewString("new string!"))
+		Wish(t, must.Node(prog.Get(n, ipld.ParsePath("linkedMap/foo"))), ShouldEqual, basicnode.NewBool(true))
+		// other links should be untouched.
+		Wish(t, must.Node(n.LookupByString("linkedList")), ShouldEqual, basicnode.NewLink(middleListNodeLnk))
+	})
+	t.Run("UpdateLinkTarget", func(t *testing.T) {
+		stored = nil
+		n, err := prog.FocusedTransform(rootNode, ipld.ParsePath("linkedList/2"), func(prog traversal.Progress, prev ipld.Node) (ipld.Node, error) {
+			Wish(t, prog.Path.String(), ShouldEqual, "linkedList/2")
+			Wish(t, prog.LastBlock.Path.String(), ShouldEqual, "linkedList/2")
+			Wish(t, prog.LastBlock.Link, ShouldEqual, leafBetaLnk)
+			Wish(t, must.String(prev), ShouldEqual, "beta")
+			return basicnode.NewString("gamma"), nil
+		})
+		Wish(t, err, ShouldEqual, nil)
+		// both the new leaf and the list that links to it should have been stored.
+		Require(t, len(stored), ShouldEqual, 2)
+		Wish(t, must.Node(n.LookupByString("linkedList")), ShouldEqual, basicnode.NewLink(stored[1]))
+		Wish(t, must.Node(prog.Get(n, ipld.ParsePath("linkedList/2"))), ShouldEqual, basicnode.NewString("gamma"))
+		Wish(t, must.Node(prog.Get(n, ipld.ParsePath("linkedList/1"))), ShouldEqual, basicnode.NewString("alpha"))
+	})
+	t.Run("NoopAcrossLinksStoresNothing", func(t *testing.T) {
+		stored = nil
+		n, err := prog.FocusedTransform(rootNode, ipld.ParsePath("linkedMap/nested/alink"), func(prog traversal.Progress, prev ipld.Node) (ipld.Node, error) {
+			return prev, nil
+		})
+		Wish(t, err, ShouldEqual, nil)
+		Wish(t, len(stored), ShouldEqual, 0)
+		Wish(t, n == rootNode, ShouldEqual, true)
+	})
+	t.Run("NoStorerFails", func(t *testing.T) {
+		_, err := traversal.Progress{
+			Cfg: &traversal.Config{
+				LinkLoader:                     prog.Cfg.LinkLoader,
+				LinkTargetNodePrototypeChooser: prog.Cfg.LinkTargetNodePrototypeChooser,
+			},
+		}.FocusedTransform(rootNode, ipld.ParsePath("linkedMap/foo"), func(prog traversal.Progress, prev ipld.Node) (ipld.Node, error) {
+			return basicnode.NewBool(false), nil
+		})
+		Wish(t, err.Error(), ShouldEqual, `error transforming node at "linkedMap": could not store replacement for link "`+middleMapNodeLnk.String()+`": no link storer configured`)
+	})
+}
+
+func keys(n ipld.Node) []string {
+	var ks []string
+	for itr := n.MapIterator(); !itr.Done(); {
+		k, _, _ := itr.Next()
+		ks = append(ks, must.String(k))
+	}
+	return ks
+}
@@ -81,7 +81,7 @@ func (ssb *selectorSpecBuilder) ExploreRecursive(limit selector.RecursionLimit,
 				na.AssembleEntry(selector.SelectorKey_Limit).CreateMap(1, func(na fluent.MapAssembler) {
 					switch limit.Mode() {
 					case selector.RecursionLimit_Depth:
-						na.AssembleEntry(selector.SelectorKey_LimitDepth).AssignInt(limit.Depth())
+						na.AssembleEntry(selector.SelectorKey_LimitDepth).AssignInt(int64(limit.Depth()))
 					case selector.RecursionLimit_None:
 						na.AssembleEntry(selector.SelectorKey_LimitNone).CreateMap(0, func(na fluent.MapAssembler) {})
 					default:
@@ -107,7 +107,7 @@ func (ssb *selectorSpecBuilder) ExploreIndex(index int, next SelectorSpec) Selec
 	return selectorSpec{
 		fluent.MustBuildMap(ssb.np, 1, func(na fluent.MapAssembler) {
 			na.AssembleEntry(selector.SelectorKey_ExploreIndex).CreateMap(2, func(na fluent.MapAssembler) {
-				na.AssembleEntry(selector.SelectorKey_Index).AssignInt(index)
+				na.AssembleEntry(selector.SelectorKey_Index).AssignInt(int64(index))
 				na.AssembleEntry(selector.SelectorKey_Next).AssignNode(next.Node())
 			})
 		}),
@@ -118,8 +118,8 @@ func (ssb *selectorSpecBuilder) ExploreRange(start int, end int, next SelectorSp
 	return selectorSpec{
 		fluent.MustBuildMap(ssb.np, 1, func(na fluent.MapAssembler) {
 			na.AssembleEntry(selector.SelectorKey_ExploreRange).CreateMap(3, func(na fluent.MapAssembler) {
-				na.AssembleEntry(selector.SelectorKey_Start).AssignInt(start)
-				na.AssembleEntry(selector.SelectorKey_End).AssignInt(end)
+				na.AssembleEntry(selector.SelectorKey_Start).AssignInt(int64(start))
+				na.AssembleEntry(selector.SelectorKey_End).AssignInt(int64(end))
 				na.AssembleEntry(selector.SelectorKey_Next).AssignNode(next.Node())
 			})
 		}),
@@ -59,5 +59,5 @@ func (pc ParseContext) ParseExploreIndex(n ipld.Node) (Selector, error) {
 	if err != nil {
 		return nil, err
 	}
-	return ExploreIndex{selector, [1]ipld.PathSegment{ipld.PathSegmentOfInt(indexValue)}}, nil
+	return ExploreIndex{selector, [1]ipld.PathSegment{ipld.PathSegmentOfInt(int(indexValue))}}, nil
 }
@@ -76,12 +76,12 @@ func (pc ParseContext) ParseExploreRange(n ipld.Node) (Selector, error) {
 	}
 	x := ExploreRange{
 		selector,
-		startValue,
-		endValue,
+		int(startValue),
+		int(endValue),
 		make([]ipld.PathSegment, 0, endValue-startValue),
 	}
 	for i := startValue; i < endValue; i++ {
-		x.interest = append(x.interest, ipld.PathSegmentOfInt(i))
+		x.interest = append(x.interest, ipld.PathSegmentOfInt(int(i)))
 	}
 	return x, nil
 }
@@ -210,7 +210,7 @@ func parseLimit(n ipld.Node) (RecursionLimit, error) {
 		if err != nil {
 			return RecursionLimit{}, fmt.Errorf("selector spec parse rejected: limit field of type depth must be a number in ExploreRecursive selector")
 		}
-		return RecursionLimit{RecursionLimit_Depth, maxDepthValue}, nil
+		return RecursionLimit{RecursionLimit_Depth, int(maxDepthValue)}, nil
 	case SelectorKey_LimitNone:
 		return RecursionLimit{RecursionLimit_None, 0}, nil
 	default:
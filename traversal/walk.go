@@ -2,6 +2,7 @@ package traversal
 
 import (
 	"fmt"
+	"reflect"
 
 	ipld "github.com/ipld/go-ipld-prime"
 	"github.com/ipld/go-ipld-prime/traversal/selector"
@@ -186,7 +187,7 @@ func (prog Progress) loadLink(v ipld.Node, parent ipld.Node) (ipld.Node, error)
 	// Pick what in-memory format we will build.
 	np, err := prog.Cfg.LinkTargetNodePrototypeChooser(lnk, lnkCtx)
 	if err != nil {
-		return nil, fmt.Errorf("error traversing node at %q: could not load link %q: %s", prog.Path, lnk, err)
+		return nil, fmt.Errorf("error traversing node at %q: could not load link %q: %w", prog.Path, lnk, err)
 	}
 	nb := np.NewBuilder()
 	// Load link!
@@ -200,7 +201,7 @@ func (prog Progress) loadLink(v ipld.Node, parent ipld.Node) (ipld.Node, error)
 		if _, ok := err.(SkipMe); ok {
 			return nil, err
 		}
-		return nil, fmt.Errorf("error traversing node at %q: could not load link %q: %s", prog.Path, lnk, err)
+		return nil, fmt.Errorf("error traversing node at %q: could not load link %q: %w", prog.Path, lnk, err)
 	}
 	return nb.Build(), nil
 }
@@ -223,7 +224,220 @@ func (prog Progress) loadLink(v ipld.Node, parent ipld.Node) (ipld.Node, error)
 // (literally, builders used to construct any new needed intermediate nodes
 // are chosen by asking the existing nodes about their prototype).
 //
-// This feature is not yet implemented.
+// If the walk crosses a link and something beneath it is replaced,
+// the new content is stored using the Config.LinkStorer,
+// and a new link is built using the original link's LinkBuilder
+// (so it will have the same codec, hashing, etc, as the link it replaces).
+// The new link is then placed in the parent node, and so on up the tree.
 func (prog Progress) WalkTransforming(n ipld.Node, s selector.Selector, fn TransformFn) (ipld.Node, error) {
-	panic("TODO")
+	prog.init()
+	return prog.walkTransforming(n, s, fn)
+}
+
+func (prog Progress) walkTransforming(n ipld.Node, s selector.Selector, fn TransformFn) (ipld.Node, error) {
+	if s.Decide(n) {
+		n2, err := fn(prog, n)
+		if err != nil {
+			return nil, err
+		}
+		if !isSameNode(n, n2) {
+			return n2, nil
+		}
+	}
+	nk := n.ReprKind()
+	switch nk {
+	case ipld.ReprKind_Map, ipld.ReprKind_List: // continue
+	default:
+		return n, nil
+	}
+	var replacements map[string]ipld.Node
+	visit := func(ps ipld.PathSegment, v ipld.Node) error {
+		sNext := s.Explore(n, ps)
+		if sNext == nil {
+			return nil
+		}
+		progNext := prog
+		progNext.Path = prog.Path.AppendSegment(ps)
+		var v2 ipld.Node
+		var err error
+		if v.ReprKind() == ipld.ReprKind_Link {
+			lnk, _ := v.AsLink()
+			progNext.LastBlock.Path = progNext.Path
+			progNext.LastBlock.Link = lnk
+			loaded, err := progNext.loadLink(v, n)
+			if err != nil {
+				if _, ok := err.(SkipMe); ok {
+					return nil
+				}
+				return err
+			}
+			loaded2, err := progNext.walkTransforming(loaded, sNext, fn)
+			if err != nil {
+				return err
+			}
+			if isSameNode(loaded, loaded2) {
+				return nil
+			}
+			lnk2, err := progNext.storeLink(lnk, loaded2, n)
+			if err != nil {
+				return err
+			}
+			v2, err = prog.relink(v, lnk2)
+			if err != nil {
+				return err
+			}
+		} else {
+			v2, err = progNext.walkTransforming(v, sNext, fn)
+			if err != nil {
+				return err
+			}
+			if isSameNode(v, v2) {
+				return nil
+			}
+		}
+		if replacements == nil {
+			replacements = make(map[string]ipld.Node)
+		}
+		replacements[ps.String()] = v2
+		return nil
+	}
+	attn := s.Interests()
+	if attn == nil {
+		for itr := selector.NewSegmentIterator(n); !itr.Done(); {
+			ps, v, err := itr.Next()
+			if err != nil {
+				return nil, err
+			}
+			if err := visit(ps, v); err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		for _, ps := range attn {
+			v, err := n.LookupBySegment(ps)
+			if err != nil {
+				continue
+			}
+			if err := visit(ps, v); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if replacements == nil {
+		return n, nil
+	}
+	return prog.rebuild(n, replacements)
+}
+
+// storeLink encodes a node that's replacing the target of an existing link,
+// and returns a new link to it.
+// The original link's LinkBuilder is used, so the new link will have the same
+// properties (codec, hash function, and so on) as the one it replaces.
+func (prog Progress) storeLink(oldLnk ipld.Link, n ipld.Node, parent ipld.Node) (ipld.Link, error) {
+	lnkCtx := ipld.LinkContext{
+		LinkPath:   prog.Path,
+		ParentNode: parent,
+	}
+	lnk, err := oldLnk.LinkBuilder().Build(
+		prog.Cfg.Ctx,
+		lnkCtx,
+		n,
+		prog.Cfg.LinkStorer,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error transforming node at %q: could not store replacement for link %q: %s", prog.Path, oldLnk, err)
+	}
+	return lnk, nil
+}
+
+// relink creates a node holding a new link, using the same prototype as the node that held the old one.
+// (This keeps us from depending on any particular node implementation;
+// and when the old node was typed, the new one is too.)
+func (prog Progress) relink(oldN ipld.Node, lnk ipld.Link) (ipld.Node, error) {
+	nb := oldN.Prototype().NewBuilder()
+	if err := nb.AssignLink(lnk); err != nil {
+		return nil, fmt.Errorf("error transforming node at %q: %s", prog.Path, err)
+	}
+	return nb.Build(), nil
+}
+
+// rebuild creates a copy of a map or list node, using the same prototype as the original,
+// in which the children named in the replacements map (keyed by path segment string)
+// are swapped for new values.  All other children are carried over unchanged.
+func (prog Progress) rebuild(n ipld.Node, replacements map[string]ipld.Node) (ipld.Node, error) {
+	nb := n.Prototype().NewBuilder()
+	switch n.ReprKind() {
+	case ipld.ReprKind_Map:
+		ma, err := nb.BeginMap(n.Length())
+		if err != nil {
+			return nil, fmt.Errorf("error transforming node at %q: %s", prog.Path, err)
+		}
+		for itr := n.MapIterator(); !itr.Done(); {
+			k, v, err := itr.Next()
+			if err != nil {
+				return nil, fmt.Errorf("error transforming node at %q: %s", prog.Path, err)
+			}
+			kstr, _ := k.AsString()
+			if v2, ok := replacements[kstr]; ok {
+				v = v2
+			}
+			if err := ma.AssembleKey().AssignNode(k); err != nil {
+				return nil, fmt.Errorf("error transforming node at %q: %s", prog.Path, err)
+			}
+			if err := ma.AssembleValue().AssignNode(v); err != nil {
+				return nil, fmt.Errorf("error transforming node at %q: %s", prog.Path.AppendSegmentString(kstr), err)
+			}
+		}
+		if err := ma.Finish(); err != nil {
+			return nil, fmt.Errorf("error transforming node at %q: %s", prog.Path, err)
+		}
+	case ipld.ReprKind_List:
+		la, err := nb.BeginList(n.Length())
+		if err != nil {
+			return nil, fmt.Errorf("error transforming node at %q: %s", prog.Path, err)
+		}
+		for itr := n.ListIterator(); !itr.Done(); {
+			idx, v, err := itr.Next()
+			if err != nil {
+				return nil, fmt.Errorf("error transforming node at %q: %s", prog.Path, err)
+			}
+			ps := ipld.PathSegmentOfInt(idx)
+			if v2, ok := replacements[ps.String()]; ok {
+				v = v2
+			}
+			if err := la.AssembleValue().AssignNode(v); err != nil {
+				return nil, fmt.Errorf("error transforming node at %q: %s", prog.Path.AppendSegment(ps), err)
+			}
+		}
+		if err := la.Finish(); err != nil {
+			return nil, fmt.Errorf("error transforming node at %q: %s", prog.Path, err)
+		}
+	default:
+		return nil, fmt.Errorf("error transforming node at %q: cannot rebuild a node of kind %s", prog.Path, n.ReprKind())
+	}
+	return nb.Build(), nil
+}
+
+// isSameNode reports whether two Node values are identical -- not merely equal in content,
+// but literally the same value -- which is how transforms decide whether anything was replaced.
+//
+// Some Node implementations have underlying types that can't be compared with '=='
+// (a slice of bytes, for example); for those, we check if the memory is the same.
+func isSameNode(a, b ipld.Node) bool {
+	ta := reflect.TypeOf(a)
+	if ta != reflect.TypeOf(b) {
+		return false
+	}
+	if ta == nil || ta.Comparable() {
+		return a == b
+	}
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	switch va.Kind() {
+	case reflect.Slice:
+		return va.Pointer() == vb.Pointer() && va.Len() == vb.Len()
+	case reflect.Map, reflect.Func:
+		return va.Pointer() == vb.Pointer()
+	default:
+		return false
+	}
 }
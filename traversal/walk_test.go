@@ -2,6 +2,7 @@ package traversal_test
 
 import (
 	"bytes"
+	"errors"
 	"io"
 	"testing"
 
@@ -10,6 +11,7 @@ import (
 	ipld "github.com/ipld/go-ipld-prime"
 	_ "github.com/ipld/go-ipld-prime/codec/dagjson"
 	"github.com/ipld/go-ipld-prime/fluent"
+	"github.com/ipld/go-ipld-prime/must"
 	basicnode "github.com/ipld/go-ipld-prime/node/basic"
 	"github.com/ipld/go-ipld-prime/traversal"
 	"github.com/ipld/go-ipld-prime/traversal/selector"
@@ -263,4 +265,168 @@ func TestWalkMatching(t *testing.T) {
 		Wish(t, err, ShouldEqual, nil)
 		Wish(t, order, ShouldEqual, 7)
 	})
+	t.Run("link loading errors can be inspected", func(t *testing.T) {
+		s, err := ssb.ExploreRecursive(selector.RecursionLimitNone(), ssb.ExploreAll(ssb.ExploreRecursiveEdge())).Selector()
+		Require(t, err, ShouldEqual, nil)
+		err = traversal.Progress{
+			Cfg: &traversal.Config{
+				LinkLoader: func(lnk ipld.Link, _ ipld.LinkContext) (io.Reader, error) {
+					if lnk == leafBetaLnk {
+						return nil, ipld.ErrBlockNotFound{Link: lnk}
+					}
+					return bytes.NewReader(storage[lnk]), nil
+				},
+				LinkTargetNodePrototypeChooser: func(_ ipld.Link, _ ipld.LinkContext) (ipld.NodePrototype, error) {
+					return basicnode.Prototype__Any{}, nil
+				},
+			},
+		}.WalkMatching(rootNode, s, func(prog traversal.Progress, n ipld.Node) error {
+			return nil
+		})
+		var target ipld.ErrBlockNotFound
+		Require(t, errors.As(err, &target), ShouldEqual, true)
+		Wish(t, target.Link, ShouldEqual, leafBetaLnk)
+		Wish(t, err.Error(), ShouldEqual, `error traversing node at "linkedList/2": could not load link "`+leafBetaLnk.String()+`": block not found: `+leafBetaLnk.String())
+	})
+}
+
+func TestWalkTransforming(t *testing.T) {
+	ssb := builder.NewSelectorSpecBuilder(basicnode.Prototype__Any{})
+	t.Run("transform returning the same node should return the same root", func(t *testing.T) {
+		ss := ssb.ExploreRecursive(selector.RecursionLimitDepth(3), ssb.ExploreUnion(
+			ssb.Matcher(),
+			ssb.ExploreAll(ssb.ExploreRecursiveEdge()),
+		))
+		s, err := ss.Selector()
+		Require(t, err, ShouldEqual, nil)
+		var order int
+		n, err := traversal.Progress{
+			Cfg: &traversal.Config{
+				LinkLoader: func(lnk ipld.Link, _ ipld.LinkContext) (io.Reader, error) {
+					return bytes.NewReader(storage[lnk]), nil
+				},
+				LinkTargetNodePrototypeChooser: func(_ ipld.Link, _ ipld.LinkContext) (ipld.NodePrototype, error) {
+					return basicnode.Prototype__Any{}, nil
+				},
+			},
+		}.WalkTransforming(middleMapNode, s, func(prog traversal.Progress, n ipld.Node) (ipld.Node, error) {
+			order++
+			return n, nil
+		})
+		Wish(t, err, ShouldEqual, nil)
+		Wish(t, order, ShouldEqual, 6)
+		Wish(t, n == middleMapNode, ShouldEqual, true)
+	})
+	t.Run("transforming fields should replace only those fields", func(t *testing.T) {
+		ss := ssb.ExploreFields(func(efsb builder.ExploreFieldsSpecBuilder) {
+			efsb.Insert("foo", ssb.Matcher())
+			efsb.Insert("nested", ssb.ExploreFields(func(efsb builder.ExploreFieldsSpecBuilder) {
+				efsb.Insert("nonlink", ssb.Matcher())
+			}))
+		})
+		s, err := ss.Selector()
+		Require(t, err, ShouldEqual, nil)
+		n, err := traversal.WalkTransforming(middleMapNode, s, func(prog traversal.Progress, n ipld.Node) (ipld.Node, error) {
+			switch prog.Path.String() {
+			case "foo":
+				return basicnode.NewBool(false), nil
+			case "nested/nonlink":
+				return basicnode.NewString("new string"), nil
+			}
+			return n, nil
+		})
+		Wish(t, err, ShouldEqual, nil)
+		Wish(t, n, ShouldEqual, fluent.MustBuildMap(basicnode.Prototype__Map{}, 3, func(na fluent.MapAssembler) {
+			na.AssembleEntry("foo").AssignBool(false)
+			na.AssembleEntry("bar").AssignBool(false)
+			na.AssembleEntry("nested").CreateMap(2, func(na fluent.MapAssembler) {
+				na.AssembleEntry("alink").AssignLink(leafAlphaLnk)
+				na.AssembleEntry("nonlink").AssignString("new string")
+			})
+		}))
+		// The original should be untouched.
+		Wish(t, must.Node(middleMapNode.LookupByString("foo")), ShouldEqual, basicnode.NewBool(true))
+	})
+	t.Run("untouched subtrees should be shared with the original", func(t *testing.T) {
+		ss := ssb.ExploreFields(func(efsb builder.ExploreFieldsSpecBuilder) {
+			efsb.Insert("foo", ssb.Matcher())
+		})
+		s, err := ss.Selector()
+		Require(t, err, ShouldEqual, nil)
+		n, err := traversal.WalkTransforming(middleMapNode, s, func(prog traversal.Progress, n ipld.Node) (ipld.Node, error) {
+			return basicnode.NewBool(false), nil
+		})
+		Wish(t, err, ShouldEqual, nil)
+		Wish(t, must.Node(n.LookupByString("nested")) == must.Node(middleMapNode.LookupByString("nested")), ShouldEqual, true)
+	})
+	t.Run("transforming lists should work", func(t *testing.T) {
+		ss := ssb.ExploreRange(1, 3, ssb.Matcher())
+		s, err := ss.Selector()
+		Require(t, err, ShouldEqual, nil)
+		listNode := fluent.MustBuildList(basicnode.Prototype__List{}, 4, func(na fluent.ListAssembler) {
+			na.AssembleValue().AssignInt(0)
+			na.AssembleValue().AssignInt(1)
+			na.AssembleValue().AssignInt(2)
+			na.AssembleValue().AssignInt(3)
+		})
+		n, err := traversal.WalkTransforming(listNode, s, func(prog traversal.Progress, n ipld.Node) (ipld.Node, error) {
+			return basicnode.NewInt(must.Int(n) * 10), nil
+		})
+		Wish(t, err, ShouldEqual, nil)
+		Wish(t, n, ShouldEqual, fluent.MustBuildList(basicnode.Prototype__List{}, 4, func(na fluent.ListAssembler) {
+			na.AssembleValue().AssignInt(0)
+			na.AssembleValue().AssignInt(10)
+			na.AssembleValue().AssignInt(20)
+			na.AssembleValue().AssignInt(3)
+		}))
+	})
+	t.Run("transforming across links should store new blocks", func(t *testing.T) {
+		ss := ssb.ExploreFields(func(efsb builder.ExploreFieldsSpecBuilder) {
+			efsb.Insert("linkedMap", ssb.ExploreFields(func(efsb builder.ExploreFieldsSpecBuilder) {
+				efsb.Insert("nested", ssb.ExploreFields(func(efsb builder.ExploreFieldsSpecBuilder) {
+					efsb.Insert("alink", ssb.Matcher())
+				}))
+			}))
+		})
+		s, err := ss.Selector()
+		Require(t, err, ShouldEqual, nil)
+		// New blocks go in a map of their own, so the shared fixtures aren't changed for other tests.
+		var stored []ipld.Link
+		newStorage := make(map[ipld.Link][]byte)
+		prog := traversal.Progress{
+			Cfg: &traversal.Config{
+				LinkLoader: func(lnk ipld.Link, _ ipld.LinkContext) (io.Reader, error) {
+					if data, ok := newStorage[lnk]; ok {
+						return bytes.NewReader(data), nil
+					}
+					return bytes.NewReader(storage[lnk]), nil
+				},
+				LinkTargetNodePrototypeChooser: func(_ ipld.Link, _ ipld.LinkContext) (ipld.NodePrototype, error) {
+					return basicnode.Prototype__Any{}, nil
+				},
+				LinkStorer: func(ipld.LinkContext) (io.Writer, ipld.StoreCommitter, error) {
+					buf := bytes.Buffer{}
+					return &buf, func(lnk ipld.Link) error {
+						stored = append(stored, lnk)
+						newStorage[lnk] = buf.Bytes()
+						return nil
+					}, nil
+				},
+			},
+		}
+		n, err := prog.WalkTransforming(rootNode, s, func(prog traversal.Progress, n ipld.Node) (ipld.Node, error) {
+			Wish(t, n, ShouldEqual, basicnode.NewString("alpha"))
+			Wish(t, prog.Path.String(), ShouldEqual, "linkedMap/nested/alink")
+			return basicnode.NewString("replaced"), nil
+		})
+		Wish(t, err, ShouldEqual, nil)
+		// Both the replaced leaf and the map that links to it should have been stored.
+		Require(t, len(stored), ShouldEqual, 2)
+		Wish(t, must.Node(n.LookupByString("linkedMap")), ShouldEqual, basicnode.NewLink(stored[1]))
+		Wish(t, must.Node(prog.Get(n, ipld.ParsePath("linkedMap/nested/alink"))), ShouldEqual, basicnode.NewString("replaced"))
+		Wish(t, must.Node(prog.Get(n, ipld.ParsePath("linkedMap/nested/nonlink"))), ShouldEqual, basicnode.NewString("zoo"))
+		// Other links should be untouched.
+		Wish(t, must.Node(n.LookupByString("linkedList")), ShouldEqual, basicnode.NewLink(middleListNodeLnk))
+		Wish(t, must.Node(n.LookupByString("linkedString")), ShouldEqual, basicnode.NewLink(leafAlphaLnk))
+	})
 }
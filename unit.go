@@ -37,7 +37,7 @@ func (nullNode) IsNull() bool {
 func (nullNode) AsBool() (bool, error) {
 	return false, ErrWrongKind{TypeName: "null", MethodName: "AsBool", AppropriateKind: ReprKindSet_JustBool, ActualKind: ReprKind_Null}
 }
-func (nullNode) AsInt() (int, error) {
+func (nullNode) AsInt() (int64, error) {
 	return 0, ErrWrongKind{TypeName: "null", MethodName: "AsInt", AppropriateKind: ReprKindSet_JustInt, ActualKind: ReprKind_Null}
 }
 func (nullNode) AsFloat() (float64, error) {
@@ -99,7 +99,7 @@ func (absentNode) IsNull() bool {
 func (absentNode) AsBool() (bool, error) {
 	return false, ErrWrongKind{TypeName: "absent", MethodName: "AsBool", AppropriateKind: ReprKindSet_JustBool, ActualKind: ReprKind_Null}
 }
-func (absentNode) AsInt() (int, error) {
+func (absentNode) AsInt() (int64, error) {
 	return 0, ErrWrongKind{TypeName: "absent", MethodName: "AsInt", AppropriateKind: ReprKindSet_JustInt, ActualKind: ReprKind_Null}
 }
 func (absentNode) AsFloat() (float64, error) {